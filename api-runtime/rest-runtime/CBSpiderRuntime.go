@@ -58,6 +58,15 @@ func main() {
 		{"GET", "/connectionconfig", listConnectionConfig},
 		{"GET", "/connectionconfig/:ConfigName", getConnectionConfig},
 		{"DELETE", "/connectionconfig/:ConfigName", deleteConnectionConfig},
+		{"PUT", "/connectionconfig/:ConfigName/bastion", setBastion},
+		{"GET", "/connectionconfig/:ConfigName/bastion", getBastion},
+		{"DELETE", "/connectionconfig/:ConfigName/bastion", deleteBastion},
+
+		//----------ScriptInfo
+		{"POST", "/script", registerScript},
+		{"GET", "/script", listScript},
+		{"GET", "/script/:ScriptName", getScript},
+		{"DELETE", "/script/:ScriptName", unRegisterScript},
 
 		//-------------------------------------------------------------------//
 
@@ -66,6 +75,7 @@ func main() {
 		{"GET", "/vmimage", listImage},
 		{"GET", "/vmimage/:ImageName", getImage},
 		{"DELETE", "/vmimage/:ImageName", deleteImage},
+		{"GET", "/myimage", listMyImage},
 
 		//----------VNet Handler
 		{"POST", "/vnetwork", createVNetwork},
@@ -78,9 +88,12 @@ func main() {
 		{"GET", "/securitygroup", listSecurity},
 		{"GET", "/securitygroup/:SecurityGroupId", getSecurity},
 		{"DELETE", "/securitygroup/:SecurityGroupId", deleteSecurity},
+		{"POST", "/securitygroup/:SecurityGroupId/rules", addSecurityRules},
+		{"DELETE", "/securitygroup/:SecurityGroupId/rules", removeSecurityRules},
 
 		//----------KeyPair Handler
 		{"POST", "/keypair", createKey},
+		{"POST", "/keypair/import", importKey},
 		{"GET", "/keypair", listKey},
 		{"GET", "/keypair/:KeyPairId", getKey},
 		{"DELETE", "/keypair/:KeyPairId", deleteKey},
@@ -108,9 +121,132 @@ func main() {
 
 		{"GET", "/controlvm/:VmId", controlVM}, // suspend, resume, reboot
 
+		//----------VMSpec Handler
+		{"GET", "/vmspec", listVMSpec},
+		{"GET", "/vmspec/:Name", getVMSpec},
+		{"GET", "/vmorgspec", listOrgVMSpec},
+		{"POST", "/vmspec/recommend", recommendVMSpec},
+
+		//----------Disk Handler
+		{"POST", "/disk", createDisk},
+		{"GET", "/disk", listDisk},
+		{"GET", "/disk/:DiskId", getDisk},
+		{"DELETE", "/disk/:DiskId", deleteDisk},
+		{"PUT", "/disk/:DiskId/size", changeDiskSize},
+		{"PUT", "/disk/:DiskId/attach", attachDisk},
+		{"PUT", "/disk/:DiskId/detach", detachDisk},
+
+		//----------Snapshot Handler
+		{"POST", "/snapshot", createSnapshot},
+		{"GET", "/snapshot", listSnapshot},
+		{"GET", "/snapshot/:SnapshotId", getSnapshot},
+		{"DELETE", "/snapshot/:SnapshotId", deleteSnapshot},
+		{"POST", "/snapshot/:SnapshotId/disk", createDiskFromSnapshot},
+
+		//----------VPC Handler
+		{"POST", "/vpc", createVPC},
+		{"GET", "/vpc", listVPC},
+		{"GET", "/vpc/:VPCId", getVPC},
+		{"DELETE", "/vpc/:VPCId", deleteVPC},
+		{"POST", "/vpc/:VPCId/subnet", addSubnet},
+		{"DELETE", "/vpc/:VPCId/subnet/:SubnetId", removeSubnet},
+
+		//----------Router Handler
+		{"POST", "/router", createRouter},
+		{"GET", "/router", listRouter},
+		{"GET", "/router/:RouterId", getRouter},
+		{"DELETE", "/router/:RouterId", deleteRouter},
+		{"POST", "/router/:RouterId/route", addRoute},
+		{"DELETE", "/router/:RouterId/route", removeRoute},
+		{"PUT", "/router/:RouterId/subnet/:SubnetId", addRouterInterface},
+		{"DELETE", "/router/:RouterId/subnet/:SubnetId", deleteRouterInterface},
+		{"PUT", "/router/:RouterId/internetgateway", attachInternetGateway},
+		{"DELETE", "/router/:RouterId/internetgateway", detachInternetGateway},
+
+		//----------NATGateway Handler
+		{"POST", "/natgateway", createNATGateway},
+		{"GET", "/natgateway", listNATGateway},
+		{"GET", "/natgateway/:NATGatewayId", getNATGateway},
+		{"DELETE", "/natgateway/:NATGatewayId", deleteNATGateway},
+		{"PUT", "/natgateway/:NATGatewayId/association", associateNATGateway},
+		{"DELETE", "/natgateway/:NATGatewayId/association", disassociateNATGateway},
+
+		//----------NLB Handler
+		{"POST", "/nlb", createNLB},
+		{"GET", "/nlb", listNLB},
+		{"GET", "/nlb/:NLBId", getNLB},
+		{"DELETE", "/nlb/:NLBId", deleteNLB},
+		{"POST", "/nlb/:NLBId/vms", addNLBVMs},
+		{"DELETE", "/nlb/:NLBId/vms", removeNLBVMs},
+		{"GET", "/nlb/:NLBId/health", getNLBVMGroupHealthInfo},
+
+		//----------ObjectStorage Handler
+		{"POST", "/bucket", createBucket},
+		{"GET", "/bucket", listBucket},
+		{"DELETE", "/bucket/:BucketName", deleteBucket},
+		{"GET", "/bucket/:BucketName/object", listObject},
+		{"PUT", "/bucket/:BucketName/object/*", putObject},
+		{"GET", "/bucket/:BucketName/object/*", getObject},
+		{"DELETE", "/bucket/:BucketName/object/*", deleteObject},
+		{"POST", "/bucket/:BucketName/presignedurl", getPresignedURL},
+
+		//----------Cluster Handler
+		{"POST", "/cluster", createCluster},
+		{"GET", "/cluster", listCluster},
+		{"GET", "/cluster/:ClusterId", getCluster},
+		{"DELETE", "/cluster/:ClusterId", deleteCluster},
+		{"POST", "/cluster/:ClusterId/nodegroup", addNodeGroup},
+		{"GET", "/cluster/:ClusterId/nodegroup", listNodeGroup},
+		{"DELETE", "/cluster/:ClusterId/nodegroup/:NodeGroupId", removeNodeGroup},
+		{"PUT", "/cluster/:ClusterId/nodegroup/:NodeGroupId/scaling", changeNodeGroupScaling},
+		{"PUT", "/cluster/:ClusterId/upgrade", upgradeCluster},
+		{"GET", "/cluster/:ClusterId/kubeconfig", getKubeConfig},
+
+		//----------DNS Handler
+		{"POST", "/dnszone", createDNSZone},
+		{"GET", "/dnszone", listDNSZone},
+		{"GET", "/dnszone/:ZoneId", getDNSZone},
+		{"DELETE", "/dnszone/:ZoneId", deleteDNSZone},
+		{"POST", "/dnszone/:ZoneId/record", createDNSRecord},
+		{"GET", "/dnszone/:ZoneId/record", listDNSRecord},
+		{"PUT", "/dnszone/:ZoneId/record", updateDNSRecord},
+		{"DELETE", "/dnszone/:ZoneId/record", deleteDNSRecord},
+		{"GET", "/dnszone/:ZoneId/change/:ChangeId", waitForDNSChange},
+
+		//----------AutoScaling Handler
+		{"POST", "/autoscalinggroup", createAutoScalingGroup},
+		{"GET", "/autoscalinggroup", listAutoScalingGroup},
+		{"GET", "/autoscalinggroup/:GroupId", getAutoScalingGroup},
+		{"DELETE", "/autoscalinggroup/:GroupId", deleteAutoScalingGroup},
+		{"PUT", "/autoscalinggroup/:GroupId/size", changeAutoScalingGroupSize},
+		{"GET", "/autoscalinggroup/:GroupId/member", listAutoScalingGroupMember},
+		{"POST", "/autoscalinggroup/:GroupId/policy", addScalingPolicy},
+		{"GET", "/autoscalinggroup/:GroupId/policy", listScalingPolicy},
+		{"DELETE", "/autoscalinggroup/:GroupId/policy/:PolicyName", removeScalingPolicy},
+
+		//----------Tag Handler
+		{"POST", "/tag", addTag},
+		{"GET", "/tag", listTag},
+		{"GET", "/tag/:Key", getTag},
+		{"DELETE", "/tag/:Key", removeTag},
+		{"GET", "/taggedresource", findTaggedResources},
+
+		//----------IPAM
+		{"POST", "/ipam/pool", createIPAMPool},
+		{"GET", "/ipam/pool", listIPAMPool},
+		{"GET", "/ipam/pool/:PoolName", getIPAMPool},
+		{"DELETE", "/ipam/pool/:PoolName", deleteIPAMPool},
+		{"GET", "/ipam/pool/:PoolName/allocation", listIPAMAllocation},
+
 		//-------------------------------------------------------------------//
 		//----------SSH RUN
 		{"POST", "/sshrun", sshRun},
+		{"POST", "/sshrun/upload", sshUpload},
+		{"POST", "/sshrun/download", sshDownload},
+		{"POST", "/sshrun/copydir", sshCopyDir},
+		{"GET", "/sshrun/transfer/:TransferId", getTransferStatus},
+		{"GET", "/sshrun/pool", listSSHPool},
+		{"POST", "/sshrun/script", sshScript},
 
 	}
 	//======================================= setup routes
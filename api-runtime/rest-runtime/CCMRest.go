@@ -10,13 +10,20 @@ package main
 
 import (
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/ipam"
+	vmimage "github.com/cloud-barista/cb-spider/cloud-control-manager/vm-image"
+	vmspec "github.com/cloud-barista/cb-spider/cloud-control-manager/vm-spec"
 
 	// REST API (echo)
 	"github.com/labstack/echo"
 	"net/http"
 
+	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 //================ Image Handler
@@ -60,7 +67,57 @@ func listImage(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
 
-	infoList, err := handler.ListImage()
+	// ex) /vmimage?connection_name=aws-config01&os_family=ubuntu&os_version=22.04&architecture=x86_64&latest=true
+	filterInfo := cres.ImageFilterInfo{
+		OSFamily:      c.QueryParam("os_family"),
+		OSVersion:     c.QueryParam("os_version"),
+		Architecture:  c.QueryParam("architecture"),
+		Owner:         c.QueryParam("owner"),
+		NamePattern:   c.QueryParam("name"),
+		CreatedAfter:  c.QueryParam("created_after"),
+		CreatedBefore: c.QueryParam("created_before"),
+		Latest:        c.QueryParam("latest") == "true",
+	}
+	if filterInfo == (cres.ImageFilterInfo{}) {
+		infoList, err := handler.ListImage()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		return c.JSON(http.StatusOK, &infoList)
+	}
+
+	// validate the filter before calling the driver
+	if _, err := vmimage.Filter(nil, filterInfo); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	infoList, err := handler.ListImageByFilter(filterInfo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err = vmimage.Filter(infoList, filterInfo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func listMyImage(c echo.Context) error {
+	cblog.Info("call listMyImage()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateImageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListMyImage()
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
@@ -128,6 +185,9 @@ func createVNetwork(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 
 	info, err := handler.CreateVNetwork(*req)
 	if err != nil {
@@ -218,6 +278,9 @@ func createSecurity(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 
 	info, err := handler.CreateSecurity(*req)
 	if err != nil {
@@ -290,6 +353,68 @@ func deleteSecurity(c echo.Context) error {
 	return c.JSON(http.StatusOK, &result)
 }
 
+type SecurityRulesReqInfo struct {
+	SecurityRules *[]cres.SecurityRuleInfo
+}
+
+func addSecurityRules(c echo.Context) error {
+	cblog.Info("call addSecurityRules()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &SecurityRulesReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.SecurityRules == nil || len(*req.SecurityRules) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "SecurityRules is required!!")
+	}
+
+	info, err := handler.AddRules(c.Param("SecurityGroupId"), req.SecurityRules)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func removeSecurityRules(c echo.Context) error {
+	cblog.Info("call removeSecurityRules()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &SecurityRulesReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.SecurityRules == nil || len(*req.SecurityRules) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "SecurityRules is required!!")
+	}
+
+	result, err := handler.RemoveRules(c.Param("SecurityGroupId"), req.SecurityRules)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
 //================ KeyPair Handler
 func createKey(c echo.Context) error {
 	cblog.Info("call createKey()")
@@ -317,6 +442,35 @@ func createKey(c echo.Context) error {
 	return c.JSON(http.StatusOK, &info)
 }
 
+func importKey(c echo.Context) error {
+	cblog.Info("call importKey()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateKeyPairHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.KeyPairReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.PublicKey == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "PublicKey is required!!")
+	}
+
+	info, err := handler.ImportKey(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
 func listKey(c echo.Context) error {
 	cblog.Info("call listKey()")
 
@@ -488,6 +642,9 @@ func createPublicIP(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 
 	info, err := handler.CreatePublicIP(*req)
 	if err != nil {
@@ -578,6 +735,9 @@ func startVM(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 
 	info, err := handler.StartVM(*req)
 	if err != nil {
@@ -733,3 +893,2281 @@ func controlVM(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, "SUCCESS")
 }
+
+//================ VMSpec Handler
+func listVMSpec(c echo.Context) error {
+	cblog.Info("call listVMSpec()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVMSpecHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListVMSpec()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getVMSpec(c echo.Context) error {
+	cblog.Info("call getVMSpec()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVMSpecHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetVMSpec(c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+// the native spec list of the cloud is already a JSON string.
+func listOrgVMSpec(c echo.Context) error {
+	cblog.Info("call listOrgVMSpec()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVMSpecHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.ListOrgVMSpec()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSONBlob(http.StatusOK, []byte(result))
+}
+
+type VMSpecRecommendReqInfo struct {
+	ConnectionNames []string // ex) ["aws-config01", "azure-config01", "openstack-config01"]
+	vmspec.RequirementInfo
+}
+
+type VMSpecRecommendInfo struct {
+	ConnectionName string
+	VMSpecList     []*vmspec.RecommendedSpecInfo // best fit first
+	Error          string                        // failure of this connection only
+}
+
+func recommendVMSpec(c echo.Context) error {
+	cblog.Info("call recommendVMSpec()")
+
+	req := &VMSpecRecommendReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if len(req.ConnectionNames) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "ConnectionNames is empty!")
+	}
+	// check the requirements before calling the clouds.
+	if _, err := vmspec.Recommend(nil, req.RequirementInfo); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	infoList := make([]VMSpecRecommendInfo, len(req.ConnectionNames))
+	var wg sync.WaitGroup
+	for i, connectionName := range req.ConnectionNames {
+		wg.Add(1)
+		go func(i int, connectionName string) {
+			defer wg.Done()
+			infoList[i].ConnectionName = connectionName
+			specList, err := recommendConnectionVMSpec(connectionName, req.RequirementInfo)
+			if err != nil {
+				cblog.Error(err)
+				infoList[i].Error = err.Error()
+				return
+			}
+			infoList[i].VMSpecList = specList
+		}(i, connectionName)
+	}
+	wg.Wait()
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func recommendConnectionVMSpec(connectionName string, req vmspec.RequirementInfo) ([]*vmspec.RecommendedSpecInfo, error) {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMSpecHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	specList, err := handler.ListVMSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	return vmspec.Recommend(specList, req)
+}
+
+//================ Disk Handler
+func createDisk(c echo.Context) error {
+	cblog.Info("call createDisk()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.DiskReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	info, err := handler.CreateDisk(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listDisk(c echo.Context) error {
+	cblog.Info("call listDisk()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListDisk()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getDisk(c echo.Context) error {
+	cblog.Info("call getDisk()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetDisk(c.Param("DiskId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteDisk(c echo.Context) error {
+	cblog.Info("call deleteDisk()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteDisk(c.Param("DiskId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type DiskSizeReqInfo struct {
+	Size string // GB
+}
+
+func changeDiskSize(c echo.Context) error {
+	cblog.Info("call changeDiskSize()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &DiskSizeReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Size == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Size is required!!")
+	}
+
+	result, err := handler.ChangeDiskSize(c.Param("DiskId"), req.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type DiskAttachReqInfo struct {
+	VMId string
+}
+
+func attachDisk(c echo.Context) error {
+	cblog.Info("call attachDisk()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &DiskAttachReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.VMId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "VMId is required!!")
+	}
+
+	info, err := handler.AttachDisk(c.Param("DiskId"), req.VMId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func detachDisk(c echo.Context) error {
+	cblog.Info("call detachDisk()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &DiskAttachReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.VMId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "VMId is required!!")
+	}
+
+	result, err := handler.DetachDisk(c.Param("DiskId"), req.VMId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+//================ Snapshot Handler
+func createSnapshot(c echo.Context) error {
+	cblog.Info("call createSnapshot()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSnapshotHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.SnapshotReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.CreateSnapshot(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listSnapshot(c echo.Context) error {
+	cblog.Info("call listSnapshot()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSnapshotHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListSnapshot()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getSnapshot(c echo.Context) error {
+	cblog.Info("call getSnapshot()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSnapshotHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetSnapshot(c.Param("SnapshotId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteSnapshot(c echo.Context) error {
+	cblog.Info("call deleteSnapshot()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSnapshotHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteSnapshot(c.Param("SnapshotId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func createDiskFromSnapshot(c echo.Context) error {
+	cblog.Info("call createDiskFromSnapshot()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateSnapshotHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.DiskReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	info, err := handler.CreateDiskFromSnapshot(c.Param("SnapshotId"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+//================ VPC Handler
+func createVPC(c echo.Context) error {
+	cblog.Info("call createVPC()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.VPCReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if err := checkCreateTags(cldConn, req.TagList); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// with ipam_pool, the empty CIDRs are allocated by IPAM.
+	poolName := c.QueryParam("ipam_pool")
+	var vpcAlloc *ipam.AllocationInfo
+	if poolName != "" {
+		vpcAlloc, err = allocateVPC(c, poolName, req)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	info, err := handler.CreateVPC(*req)
+	if err != nil {
+		if vpcAlloc != nil {
+			if _, errRelease := ipam.Release(poolName, "", vpcAlloc.CIDR); errRelease != nil {
+				cblog.Error(errRelease)
+			}
+		}
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if vpcAlloc != nil {
+		if err := setAllocatedVPCId(poolName, vpcAlloc, info); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "VPC "+info.Id+" is created, but IPAM failed: "+err.Error())
+		}
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listVPC(c echo.Context) error {
+	cblog.Info("call listVPC()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListVPC()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getVPC(c echo.Context) error {
+	cblog.Info("call getVPC()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetVPC(c.Param("VPCId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteVPC(c echo.Context) error {
+	cblog.Info("call deleteVPC()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteVPC(c.Param("VPCId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	releaseAllocation(c.QueryParam("connection_name"), c.Param("VPCId"))
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func addSubnet(c echo.Context) error {
+	cblog.Info("call addSubnet()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.SubnetInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	// the VPC allocated by IPAM allocates the CIDR of the subnet too.
+	poolName, subnetAlloc, err := allocateSubnet(c, c.Param("VPCId"), req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if req.CIDR == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "CIDR is required!!")
+	}
+
+	info, err := handler.AddSubnet(c.Param("VPCId"), *req)
+	if err != nil {
+		if subnetAlloc != nil {
+			if _, errRelease := ipam.Release(poolName, subnetAlloc.ParentCIDR, subnetAlloc.CIDR); errRelease != nil {
+				cblog.Error(errRelease)
+			}
+		}
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if subnetAlloc != nil {
+		if err := setAllocatedSubnetId(poolName, subnetAlloc, info); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "subnet "+req.Name+" is added, but IPAM failed: "+err.Error())
+		}
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func removeSubnet(c echo.Context) error {
+	cblog.Info("call removeSubnet()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.RemoveSubnet(c.Param("VPCId"), c.Param("SubnetId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	releaseAllocation(c.QueryParam("connection_name"), c.Param("SubnetId"))
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+//================ Router Handler
+func createRouter(c echo.Context) error {
+	cblog.Info("call createRouter()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.RouterReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.CreateRouter(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listRouter(c echo.Context) error {
+	cblog.Info("call listRouter()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListRouter()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getRouter(c echo.Context) error {
+	cblog.Info("call getRouter()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetRouter(c.Param("RouterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteRouter(c echo.Context) error {
+	cblog.Info("call deleteRouter()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteRouter(c.Param("RouterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func addRoute(c echo.Context) error {
+	cblog.Info("call addRoute()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.RouteInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.DestinationCIDR == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "DestinationCIDR is required!!")
+	}
+
+	info, err := handler.AddRoute(c.Param("RouterId"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+// the destination CIDR can not be a path parameter because of the '/'.
+// ex) /router/rtb-01/route?connection_name=aws-config01&destination=0.0.0.0/0
+func removeRoute(c echo.Context) error {
+	cblog.Info("call removeRoute()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	destinationCIDR := c.QueryParam("destination")
+	if destinationCIDR == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "destination is required!!")
+	}
+
+	result, err := handler.RemoveRoute(c.Param("RouterId"), destinationCIDR)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func addRouterInterface(c echo.Context) error {
+	cblog.Info("call addRouterInterface()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.AddInterface(c.Param("RouterId"), c.Param("SubnetId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteRouterInterface(c echo.Context) error {
+	cblog.Info("call deleteRouterInterface()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteInterface(c.Param("RouterId"), c.Param("SubnetId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func attachInternetGateway(c echo.Context) error {
+	cblog.Info("call attachInternetGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.AttachInternetGateway(c.Param("RouterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func detachInternetGateway(c echo.Context) error {
+	cblog.Info("call detachInternetGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateRouterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DetachInternetGateway(c.Param("RouterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+//================ NATGateway Handler
+func createNATGateway(c echo.Context) error {
+	cblog.Info("call createNATGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.NATGatewayReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+
+	info, err := handler.CreateNATGateway(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listNATGateway(c echo.Context) error {
+	cblog.Info("call listNATGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListNATGateway()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getNATGateway(c echo.Context) error {
+	cblog.Info("call getNATGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetNATGateway(c.Param("NATGatewayId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteNATGateway(c echo.Context) error {
+	cblog.Info("call deleteNATGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteNATGateway(c.Param("NATGatewayId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func associateNATGateway(c echo.Context) error {
+	cblog.Info("call associateNATGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.NATAssociationInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.RouterId == "" && req.SubnetId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "RouterId or SubnetId is required!!")
+	}
+
+	info, err := handler.AssociateNATGateway(c.Param("NATGatewayId"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func disassociateNATGateway(c echo.Context) error {
+	cblog.Info("call disassociateNATGateway()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.NATAssociationInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.RouterId == "" && req.SubnetId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "RouterId or SubnetId is required!!")
+	}
+
+	result, err := handler.DisassociateNATGateway(c.Param("NATGatewayId"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+//================ NLB Handler
+func createNLB(c echo.Context) error {
+	cblog.Info("call createNLB()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.NLBReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+	if req.Listener.Port == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Listener Port is required!!")
+	}
+	// VM group port is the same as the listener port, if it is not specified.
+	if req.VMGroup.Port == "" {
+		req.VMGroup.Port = req.Listener.Port
+	}
+
+	info, err := handler.CreateNLB(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listNLB(c echo.Context) error {
+	cblog.Info("call listNLB()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListNLB()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getNLB(c echo.Context) error {
+	cblog.Info("call getNLB()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetNLB(c.Param("NLBId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteNLB(c echo.Context) error {
+	cblog.Info("call deleteNLB()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteNLB(c.Param("NLBId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type NLBVMsReqInfo struct {
+	VMs []string
+}
+
+func addNLBVMs(c echo.Context) error {
+	cblog.Info("call addNLBVMs()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &NLBVMsReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if len(req.VMs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "VMs is required!!")
+	}
+
+	info, err := handler.AddVMs(c.Param("NLBId"), req.VMs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func removeNLBVMs(c echo.Context) error {
+	cblog.Info("call removeNLBVMs()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &NLBVMsReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if len(req.VMs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "VMs is required!!")
+	}
+
+	result, err := handler.RemoveVMs(c.Param("NLBId"), req.VMs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func getNLBVMGroupHealthInfo(c echo.Context) error {
+	cblog.Info("call getNLBVMGroupHealthInfo()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetVMGroupHealthInfo(c.Param("NLBId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+//================ ObjectStorage Handler
+type BucketReqInfo struct {
+	Name string
+}
+
+func createBucket(c echo.Context) error {
+	cblog.Info("call createBucket()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &BucketReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+
+	info, err := handler.CreateBucket(req.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listBucket(c echo.Context) error {
+	cblog.Info("call listBucket()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListBucket()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func deleteBucket(c echo.Context) error {
+	cblog.Info("call deleteBucket()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteBucket(c.Param("BucketName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+// The request body is the object content itself, not JSON.
+func putObject(c echo.Context) error {
+	cblog.Info("call putObject()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	objectKey := c.Param("*")
+	if objectKey == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "ObjectKey is required!!")
+	}
+
+	body := c.Request().Body
+	defer body.Close()
+	info, err := handler.PutObject(c.Param("BucketName"), objectKey, body, c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+// The response body is the object content, streamed from the cloud.
+func getObject(c echo.Context) error {
+	cblog.Info("call getObject()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	reader, info, err := handler.GetObject(c.Param("BucketName"), c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	defer reader.Close()
+
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+	if info.Size > 0 {
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(info.Size, 10))
+	}
+	if info.ETag != "" {
+		c.Response().Header().Set("ETag", info.ETag)
+	}
+
+	return c.Stream(http.StatusOK, contentType, reader)
+}
+
+func listObject(c echo.Context) error {
+	cblog.Info("call listObject()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListObject(c.Param("BucketName"), c.QueryParam("prefix"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func deleteObject(c echo.Context) error {
+	cblog.Info("call deleteObject()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteObject(c.Param("BucketName"), c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type PresignedURLReqInfo struct {
+	ObjectKey      string
+	Method         string // GET | PUT, default: GET
+	ExpiresSeconds int    // default: 3600
+}
+
+type PresignedURLInfo struct {
+	URL string
+}
+
+func getPresignedURL(c echo.Context) error {
+	cblog.Info("call getPresignedURL()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateObjectStorageHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &PresignedURLReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.ObjectKey == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "ObjectKey is required!!")
+	}
+	if req.Method == "" {
+		req.Method = cres.PresignedURLGet
+	}
+	if req.ExpiresSeconds <= 0 {
+		req.ExpiresSeconds = 3600
+	}
+
+	url, err := handler.GetPresignedURL(c.Param("BucketName"), req.ObjectKey, req.Method, req.ExpiresSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &PresignedURLInfo{URL: url})
+}
+
+//================ Cluster Handler
+func createCluster(c echo.Context) error {
+	cblog.Info("call createCluster()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.ClusterReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+	if req.VPCId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "VPCId is required!!")
+	}
+
+	info, err := handler.CreateCluster(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listCluster(c echo.Context) error {
+	cblog.Info("call listCluster()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListCluster()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getCluster(c echo.Context) error {
+	cblog.Info("call getCluster()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetCluster(c.Param("ClusterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteCluster(c echo.Context) error {
+	cblog.Info("call deleteCluster()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteCluster(c.Param("ClusterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func addNodeGroup(c echo.Context) error {
+	cblog.Info("call addNodeGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.NodeGroupReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+
+	info, err := handler.AddNodeGroup(c.Param("ClusterId"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listNodeGroup(c echo.Context) error {
+	cblog.Info("call listNodeGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListNodeGroup(c.Param("ClusterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func removeNodeGroup(c echo.Context) error {
+	cblog.Info("call removeNodeGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.RemoveNodeGroup(c.Param("ClusterId"), c.Param("NodeGroupId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type NodeGroupScalingReqInfo struct {
+	DesiredNodeSize int
+	MinNodeSize     int
+	MaxNodeSize     int
+}
+
+func changeNodeGroupScaling(c echo.Context) error {
+	cblog.Info("call changeNodeGroupScaling()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &NodeGroupScalingReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.MinNodeSize > req.DesiredNodeSize || req.DesiredNodeSize > req.MaxNodeSize {
+		return echo.NewHTTPError(http.StatusBadRequest, "MinNodeSize <= DesiredNodeSize <= MaxNodeSize is required!!")
+	}
+
+	info, err := handler.ChangeNodeGroupScaling(c.Param("ClusterId"), c.Param("NodeGroupId"), req.DesiredNodeSize, req.MinNodeSize, req.MaxNodeSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+type ClusterUpgradeReqInfo struct {
+	Version string
+}
+
+func upgradeCluster(c echo.Context) error {
+	cblog.Info("call upgradeCluster()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &ClusterUpgradeReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Version == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Version is required!!")
+	}
+
+	info, err := handler.UpgradeCluster(c.Param("ClusterId"), req.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+type KubeConfigInfo struct {
+	KubeConfig string
+}
+
+func getKubeConfig(c echo.Context) error {
+	cblog.Info("call getKubeConfig()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateClusterHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	kubeConfig, err := handler.GetKubeConfig(c.Param("ClusterId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &KubeConfigInfo{KubeConfig: kubeConfig})
+}
+
+//================ DNS Handler
+func createDNSZone(c echo.Context) error {
+	cblog.Info("call createDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.DNSZoneReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+
+	info, err := handler.CreateZone(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listDNSZone(c echo.Context) error {
+	cblog.Info("call listDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListZone()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getDNSZone(c echo.Context) error {
+	cblog.Info("call getDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetZone(c.Param("ZoneId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteDNSZone(c echo.Context) error {
+	cblog.Info("call deleteDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteZone(c.Param("ZoneId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type DNSRecordReqInfo struct {
+	cres.DNSRecordReqInfo
+	VMId string // if Values is empty, the PublicIP of this VM is used as the value of the A record
+	Wait bool   // if true, returns after the change is propagated to all the name servers
+}
+
+// fills the record values with the PublicIP of VMId, and waits for the change if requested.
+func putDNSRecord(c echo.Context, isUpdate bool) error {
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &DNSRecordReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Type == "" {
+		req.Type = cres.DNSRecordA
+	}
+	if len(req.Values) == 0 {
+		if req.VMId == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "Values or VMId is required!!")
+		}
+		if req.Type != cres.DNSRecordA {
+			return echo.NewHTTPError(http.StatusBadRequest, "VMId is only available for A record!!")
+		}
+
+		vmHandler, err := cldConn.CreateVMHandler()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		vmInfo, err := vmHandler.GetVM(req.VMId)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		if vmInfo.PublicIP == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "VM "+req.VMId+" has no PublicIP!!")
+		}
+		req.Values = []string{vmInfo.PublicIP}
+	}
+
+	var info cres.DNSRecordInfo
+	if isUpdate {
+		info, err = handler.UpdateRecord(c.Param("ZoneId"), req.DNSRecordReqInfo)
+	} else {
+		info, err = handler.CreateRecord(c.Param("ZoneId"), req.DNSRecordReqInfo)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	if req.Wait && info.ChangeStatus != cres.DNSChangeInSync {
+		info.ChangeStatus, err = handler.WaitForDNSChange(c.Param("ZoneId"), info.ChangeId)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func createDNSRecord(c echo.Context) error {
+	cblog.Info("call createDNSRecord()")
+
+	return putDNSRecord(c, false)
+}
+
+func listDNSRecord(c echo.Context) error {
+	cblog.Info("call listDNSRecord()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListRecord(c.Param("ZoneId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func updateDNSRecord(c echo.Context) error {
+	cblog.Info("call updateDNSRecord()")
+
+	return putDNSRecord(c, true)
+}
+
+// record is identified with name and type, so they are passed as query params.
+// ex) /dnszone/example.com/record?connection_name=aws-config01&name=www&type=A
+func deleteDNSRecord(c echo.Context) error {
+	cblog.Info("call deleteDNSRecord()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	recordType := c.QueryParam("type")
+	if recordType == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "type is required!!")
+	}
+
+	result, err := handler.DeleteRecord(c.Param("ZoneId"), c.QueryParam("name"), cres.DNSRecordType(recordType))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type DNSChangeInfo struct {
+	ChangeId     string
+	ChangeStatus cres.DNSChangeStatus
+}
+
+func waitForDNSChange(c echo.Context) error {
+	cblog.Info("call waitForDNSChange()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	status, err := handler.WaitForDNSChange(c.Param("ZoneId"), c.Param("ChangeId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &DNSChangeInfo{ChangeId: c.Param("ChangeId"), ChangeStatus: status})
+}
+
+//================ AutoScaling Handler
+func createAutoScalingGroup(c echo.Context) error {
+	cblog.Info("call createAutoScalingGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.AutoScalingGroupReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+	if req.MinSize > req.DesiredSize || req.DesiredSize > req.MaxSize {
+		return echo.NewHTTPError(http.StatusBadRequest, "MinSize <= DesiredSize <= MaxSize is required!!")
+	}
+
+	info, err := handler.CreateAutoScalingGroup(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listAutoScalingGroup(c echo.Context) error {
+	cblog.Info("call listAutoScalingGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListAutoScalingGroup()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getAutoScalingGroup(c echo.Context) error {
+	cblog.Info("call getAutoScalingGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetAutoScalingGroup(c.Param("GroupId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteAutoScalingGroup(c echo.Context) error {
+	cblog.Info("call deleteAutoScalingGroup()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.DeleteAutoScalingGroup(c.Param("GroupId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+type AutoScalingGroupSizeReqInfo struct {
+	DesiredSize int
+	MinSize     int
+	MaxSize     int
+}
+
+func changeAutoScalingGroupSize(c echo.Context) error {
+	cblog.Info("call changeAutoScalingGroupSize()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &AutoScalingGroupSizeReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.MinSize > req.DesiredSize || req.DesiredSize > req.MaxSize {
+		return echo.NewHTTPError(http.StatusBadRequest, "MinSize <= DesiredSize <= MaxSize is required!!")
+	}
+
+	info, err := handler.ChangeAutoScalingGroupSize(c.Param("GroupId"), req.DesiredSize, req.MinSize, req.MaxSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listAutoScalingGroupMember(c echo.Context) error {
+	cblog.Info("call listAutoScalingGroupMember()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListAutoScalingGroupMember(c.Param("GroupId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func addScalingPolicy(c echo.Context) error {
+	cblog.Info("call addScalingPolicy()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &cres.ScalingPolicyReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Name is required!!")
+	}
+	if req.TargetCPUUtilization <= 0 || req.TargetCPUUtilization > 100 {
+		return echo.NewHTTPError(http.StatusBadRequest, "TargetCPUUtilization(1~100) is required!!")
+	}
+
+	info, err := handler.AddScalingPolicy(c.Param("GroupId"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listScalingPolicy(c echo.Context) error {
+	cblog.Info("call listScalingPolicy()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListScalingPolicy(c.Param("GroupId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func removeScalingPolicy(c echo.Context) error {
+	cblog.Info("call removeScalingPolicy()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateAutoScalingHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.RemoveScalingPolicy(c.Param("GroupId"), c.Param("PolicyName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+//================ Tag Handler
+type TagReqInfo struct {
+	ResourceType cres.RSType
+	ResourceId   string
+	Tag          cres.KeyValue
+}
+
+// rejects the TagList of the create request before creating the resource,
+// if the driver has no TagHandler. The drivers set the TagList in the create call.
+func checkCreateTags(cldConn icon.CloudConnection, tagList []cres.KeyValue) error {
+	if len(tagList) == 0 {
+		return nil
+	}
+	if _, err := cldConn.CreateTagHandler(); err != nil {
+		return fmt.Errorf("tags are not supported by this connection: %v", err)
+	}
+	return nil
+}
+
+func addTag(c echo.Context) error {
+	cblog.Info("call addTag()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateTagHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	req := &TagReqInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	if req.ResourceType == "" || req.ResourceId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "ResourceType and ResourceId are required!!")
+	}
+	if req.Tag.Key == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Tag Key is required!!")
+	}
+
+	info, err := handler.AddTag(req.ResourceType, req.ResourceId, req.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listTag(c echo.Context) error {
+	cblog.Info("call listTag()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateTagHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.ListTag(cres.RSType(c.QueryParam("resource_type")), c.QueryParam("resource_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
+
+func getTag(c echo.Context) error {
+	cblog.Info("call getTag()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateTagHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	info, err := handler.GetTag(cres.RSType(c.QueryParam("resource_type")), c.QueryParam("resource_id"), c.Param("Key"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func removeTag(c echo.Context) error {
+	cblog.Info("call removeTag()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateTagHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	result, err := handler.RemoveTag(cres.RSType(c.QueryParam("resource_type")), c.QueryParam("resource_id"), c.Param("Key"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func findTaggedResources(c echo.Context) error {
+	cblog.Info("call findTaggedResources()")
+
+	cldConn, err := ccm.GetCloudConnection(c.QueryParam("connection_name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	handler, err := cldConn.CreateTagHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	infoList, err := handler.FindTaggedResources(cres.RSType(c.QueryParam("resource_type")), c.QueryParam("keyword"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &infoList)
+}
@@ -13,6 +13,7 @@ import (
 	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	sim "github.com/cloud-barista/cb-spider/cloud-info-manager/script-info-manager"
 
         // REST API (echo)
         "net/http"
@@ -219,3 +220,92 @@ func deleteConnectionConfig(c echo.Context) error {
 
         return c.JSON(http.StatusOK, &result)
 }
+
+func setBastion(c echo.Context) error {
+        cblog.Info("call setBastion()")
+
+        req := &ccim.BastionInfo{}
+        if err := c.Bind(req); err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+        req.ConfigName = c.Param("ConfigName")
+
+        bastionInfo, err:= ccim.SetBastion(*req)
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &bastionInfo)
+}
+
+func getBastion(c echo.Context) error {
+        cblog.Info("call getBastion()")
+
+        bastionInfo, err:= ccim.GetBastion(c.Param("ConfigName"))
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &bastionInfo)
+}
+
+func deleteBastion(c echo.Context) error {
+        cblog.Info("call deleteBastion()")
+
+        result, err:= ccim.DeleteBastion(c.Param("ConfigName"))
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &result)
+}
+
+//================ Script Handler
+func registerScript(c echo.Context) error {
+        cblog.Info("call registerScript()")
+
+        req := &sim.ScriptInfo{}
+        if err := c.Bind(req); err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        scriptInfo, err:= sim.RegisterScriptInfo(*req)
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &scriptInfo)
+}
+
+func listScript(c echo.Context) error {
+        cblog.Info("call listScript()")
+
+        scriptInfoList, err:= sim.ListScript()
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &scriptInfoList)
+}
+
+func getScript(c echo.Context) error {
+        cblog.Info("call getScript()")
+
+        scriptInfo, err:= sim.GetScript(c.Param("ScriptName"))
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &scriptInfo)
+}
+
+func unRegisterScript(c echo.Context) error {
+        cblog.Info("call unRegisterScript()")
+
+        result, err:= sim.UnRegisterScript(c.Param("ScriptName"))
+        if err != nil {
+                return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+        }
+
+        return c.JSON(http.StatusOK, &result)
+}
@@ -0,0 +1,206 @@
+// Rest Runtime Server for IP Address Management(IPAM) of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by agent@local, 2026.10.
+
+package main
+
+import (
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/ipam"
+
+	"fmt"
+	"strconv"
+	// REST API (echo)
+	"github.com/labstack/echo"
+	"net/http"
+)
+
+//================ IPAM Pool Handler
+func createIPAMPool(c echo.Context) error {
+	cblog.Info("call createIPAMPool()")
+
+	req := &ipam.PoolInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	poolInfo, err := ipam.CreatePool(req.PoolName, req.CIDR)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &poolInfo)
+}
+
+func listIPAMPool(c echo.Context) error {
+	cblog.Info("call listIPAMPool()")
+
+	poolInfoList, err := ipam.ListPool()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &poolInfoList)
+}
+
+func getIPAMPool(c echo.Context) error {
+	cblog.Info("call getIPAMPool()")
+
+	poolInfo, err := ipam.GetPool(c.Param("PoolName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &poolInfo)
+}
+
+func deleteIPAMPool(c echo.Context) error {
+	cblog.Info("call deleteIPAMPool()")
+
+	result, err := ipam.DeletePool(c.Param("PoolName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
+func listIPAMAllocation(c echo.Context) error {
+	cblog.Info("call listIPAMAllocation()")
+
+	allocInfoList, err := ipam.ListAllocation(c.Param("PoolName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allocInfoList)
+}
+
+//================ IPAM for VPC Handler
+
+// ex) /vpc?connection_name=aws-config01&ipam_pool=cb-pool01&vpc_prefix=16&subnet_prefix=24
+func prefixLenParam(c echo.Context, name string, defaultLen int) (int, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return defaultLen, nil
+	}
+	prefixLen, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s(%s) is not a number!", name, value)
+	}
+	return prefixLen, nil
+}
+
+// allocates the empty CIDRs of the VPC & its subnets, reserves the given CIDRs.
+// returns the allocation of the VPC, it should be released when the creation is failed.
+func allocateVPC(c echo.Context, poolName string, req *cres.VPCReqInfo) (*ipam.AllocationInfo, error) {
+	vpcPrefixLen, err := prefixLenParam(c, "vpc_prefix", ipam.DefaultVPCPrefixLen)
+	if err != nil {
+		return nil, err
+	}
+	subnetPrefixLen, err := prefixLenParam(c, "subnet_prefix", ipam.DefaultSubnetPrefixLen)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcAlloc, err := ipam.Allocate(poolName, vpcPrefixLen, ipam.AllocationInfo{
+		CIDR:           req.CIDR,
+		ConnectionName: c.QueryParam("connection_name"),
+		ResourceName:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req.CIDR = vpcAlloc.CIDR
+
+	for i, subnetInfo := range req.SubnetInfoList {
+		subnetAlloc, err := ipam.Allocate(poolName, subnetPrefixLen, ipam.AllocationInfo{
+			CIDR:           subnetInfo.CIDR,
+			ParentCIDR:     vpcAlloc.CIDR,
+			ConnectionName: vpcAlloc.ConnectionName,
+			ResourceName:   subnetInfo.Name,
+		})
+		if err != nil {
+			if _, errRelease := ipam.Release(poolName, "", vpcAlloc.CIDR); errRelease != nil {
+				cblog.Error(errRelease)
+			}
+			return nil, err
+		}
+		req.SubnetInfoList[i].CIDR = subnetAlloc.CIDR
+	}
+	return vpcAlloc, nil
+}
+
+// allocates the CIDR of the subnet in the VPC allocated by IPAM.
+// returns the pool name & allocation of the subnet, nil if the VPC is not allocated by IPAM.
+func allocateSubnet(c echo.Context, vpcID string, req *cres.SubnetInfo) (string, *ipam.AllocationInfo, error) {
+	connectionName := c.QueryParam("connection_name")
+	poolName, vpcAlloc, err := ipam.FindAllocation(connectionName, vpcID)
+	if err != nil || vpcAlloc == nil {
+		return "", nil, err
+	}
+
+	subnetPrefixLen, err := prefixLenParam(c, "subnet_prefix", ipam.DefaultSubnetPrefixLen)
+	if err != nil {
+		return "", nil, err
+	}
+	subnetAlloc, err := ipam.Allocate(poolName, subnetPrefixLen, ipam.AllocationInfo{
+		CIDR:           req.CIDR,
+		ParentCIDR:     vpcAlloc.CIDR,
+		ConnectionName: connectionName,
+		ResourceName:   req.Name,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	req.CIDR = subnetAlloc.CIDR
+	return poolName, subnetAlloc, nil
+}
+
+// records the ids of the created VPC & subnets to release them on delete.
+func setAllocatedVPCId(poolName string, vpcAlloc *ipam.AllocationInfo, vpcInfo cres.VPCInfo) error {
+	if err := ipam.SetResourceId(poolName, "", vpcAlloc.CIDR, vpcInfo.Id); err != nil {
+		cblog.Error(err)
+		return err
+	}
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if err := ipam.SetResourceId(poolName, vpcAlloc.CIDR, subnetInfo.CIDR, subnetInfo.Id); err != nil {
+			cblog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// records the id of the added subnet to release it on remove.
+func setAllocatedSubnetId(poolName string, subnetAlloc *ipam.AllocationInfo, vpcInfo cres.VPCInfo) error {
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if subnetInfo.CIDR == subnetAlloc.CIDR {
+			if err := ipam.SetResourceId(poolName, subnetAlloc.ParentCIDR, subnetAlloc.CIDR, subnetInfo.Id); err != nil {
+				cblog.Error(err)
+				return err
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: subnet is not exist in the VPC %s!", subnetAlloc.CIDR, vpcInfo.Id)
+}
+
+// releases the allocation of the deleted VPC or subnet, skips the one not allocated by IPAM.
+func releaseAllocation(connectionName string, resourceId string) {
+	poolName, allocInfo, err := ipam.FindAllocation(connectionName, resourceId)
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	if allocInfo == nil {
+		return
+	}
+	if _, err := ipam.Release(poolName, allocInfo.ParentCIDR, allocInfo.CIDR); err != nil {
+		cblog.Error(err)
+	}
+}
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/autoscalinggroup?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-asg01", "VMTemplate": { "ImageId": "ami-047f7b46bd6dd5d84", "VMSpecId": "t2.micro", "VirtualNetworkId": "subnet-0a1b2c3d4e5f60001", "SecurityGroupIds": [ "sg-0a1b2c3d4e5f60001" ], "KeyPairName": "mcb-keypair01" }, "DesiredSize": 2, "MinSize": 1, "MaxSize": 4 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/bucket/mcb-bucket01/object/test/hello.txt?connection_name=aws-config01 |json_pp
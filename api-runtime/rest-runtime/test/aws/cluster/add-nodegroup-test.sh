@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/cluster/mcb-cluster01/nodegroup?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-nodegroup02", "VMSpecName": "t3.large", "RootDiskSize": "20", "KeyPairName": "mcb-keypair01", "OnAutoScaling": true, "DesiredNodeSize": 1, "MinNodeSize": 1, "MaxNodeSize": 2 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/cluster?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-cluster01", "Version": "1.18", "VPCId": "vpc-0a1b2c3d4e5f60001", "SubnetIds": [ "subnet-0a1b2c3d4e5f60001", "subnet-0a1b2c3d4e5f60002" ], "SecurityGroupIds": [ "sg-0a1b2c3d4e5f60001" ], "NodeGroupList": [ { "Name": "mcb-nodegroup01", "VMSpecName": "t3.medium", "RootDiskSize": "20", "KeyPairName": "mcb-keypair01", "OnAutoScaling": true, "DesiredNodeSize": 2, "MinNodeSize": 1, "MaxNodeSize": 3 } ], "KeyValueList": [ { "Key": "ClusterRoleArn", "Value": "arn:aws:iam::123456789012:role/mcb-eks-cluster-role" }, { "Key": "NodeRoleArn", "Value": "arn:aws:iam::123456789012:role/mcb-eks-node-role" } ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/cluster/mcb-cluster01/nodegroup?connection_name=aws-config01 |json_pp
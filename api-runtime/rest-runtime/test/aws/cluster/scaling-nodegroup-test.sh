@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/cluster/mcb-cluster01/nodegroup/mcb-nodegroup02/scaling?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "DesiredNodeSize": 2, "MinNodeSize": 1, "MaxNodeSize": 4 }' |json_pp
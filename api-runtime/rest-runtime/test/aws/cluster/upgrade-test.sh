@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/cluster/mcb-cluster01/upgrade?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Version": "1.19" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/disk?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-disk01", "DiskType": "gp2", "DiskSize": "10" }' |json_pp
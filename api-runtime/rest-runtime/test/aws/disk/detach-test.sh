@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/disk/vol-0a1b2c3d4e5f60001/detach?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "VMId": "i-0a1b2c3d4e5f60001" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/dnszone/Z0123456789ABCDEFGHIJ/record?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "vm01", "Type": "A", "VMId": "i-0a1b2c3d4e5f60001", "Wait": true }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE "http://$RESTSERVER:1024/dnszone/Z0123456789ABCDEFGHIJ/record?connection_name=aws-config01&name=www&type=A" |json_pp
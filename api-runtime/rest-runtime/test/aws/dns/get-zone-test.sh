@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/dnszone/Z0123456789ABCDEFGHIJ?connection_name=aws-config01 |json_pp
@@ -0,0 +1,4 @@
+RESTSERVER=localhost
+
+# 실행 중인 VM으로부터 이미지(MyImage) 생성 - 생성 상태(Status)는 get-test.sh로 확인
+curl -X POST http://$RESTSERVER:1024/vmimage?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-myimage01", "SourceVMId": "i-0a1b2c3d4e5f60001", "Description": "mcb golden image", "NoReboot": true }' |json_pp
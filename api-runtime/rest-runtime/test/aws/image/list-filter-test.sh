@@ -0,0 +1,4 @@
+RESTSERVER=localhost
+
+# 조건에 맞는 이미지 검색 - os_family, os_version, architecture, owner, name(* 사용 가능), created_after, created_before(RFC3339), latest
+curl -X GET "http://$RESTSERVER:1024/vmimage?connection_name=aws-config01&os_family=ubuntu&os_version=22.04&architecture=x86_64&owner=099720109477&latest=true" |json_pp
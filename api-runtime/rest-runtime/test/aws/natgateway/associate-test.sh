@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/natgateway/nat-0a1b2c3d4e5f60001/association?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "RouterId": "rtb-0a1b2c3d4e5f60003" }' |json_pp
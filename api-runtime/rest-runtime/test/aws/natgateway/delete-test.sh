@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/natgateway/nat-0a1b2c3d4e5f60001?connection_name=aws-config01 |json_pp
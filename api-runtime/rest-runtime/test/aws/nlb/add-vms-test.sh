@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/nlb/mcb-nlb01/vms?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "VMs": [ "i-0a1b2c3d4e5f60002" ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/nlb?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-nlb01", "VPCId": "vpc-0a1b2c3d4e5f60001", "Type": "PUBLIC", "Listener": { "Protocol": "TCP", "Port": "80" }, "VMGroup": { "Protocol": "TCP", "Port": "80", "VMs": [ "i-0a1b2c3d4e5f60001" ] }, "HealthChecker": { "Protocol": "TCP", "Port": "80", "Interval": 10, "Timeout": 5, "Threshold": 3 } }' |json_pp
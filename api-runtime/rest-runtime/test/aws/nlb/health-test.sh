@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/nlb/mcb-nlb01/health?connection_name=aws-config01 |json_pp
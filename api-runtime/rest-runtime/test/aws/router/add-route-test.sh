@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/router/rtb-0a1b2c3d4e5f60003/route?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "DestinationCIDR": "192.168.0.0/16", "TargetType": "VM", "TargetId": "i-0a1b2c3d4e5f60004" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/router?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-router01", "VPCId": "vpc-0a1b2c3d4e5f60001", "InternetGateway": true }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/router/rtb-0a1b2c3d4e5f60003?connection_name=aws-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/securitygroup/sg-08799d5f21ada740c/rules?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "SecurityRules": [ {"FromPort": "80", "ToPort" : "80", "IPProtocol" : "tcp", "Direction" : "inbound", "CIDR" : "10.0.0.0/16", "Description" : "web"} ] }' |json_pp
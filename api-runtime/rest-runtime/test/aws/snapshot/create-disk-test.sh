@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/snapshot/snap-0a1b2c3d4e5f60001/disk?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-disk02" }' |json_pp
@@ -0,0 +1,7 @@
+RESTSERVER=localhost
+
+# 디스크 스냅샷
+curl -X POST http://$RESTSERVER:1024/snapshot?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-snapshot01", "SourceType": "DISK", "SourceId": "vol-0a1b2c3d4e5f60001" }' |json_pp
+
+# VM(부트 디스크) 스냅샷
+#curl -X POST http://$RESTSERVER:1024/snapshot?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-snapshot02", "SourceType": "VM", "SourceId": "i-0a1b2c3d4e5f60001" }' |json_pp
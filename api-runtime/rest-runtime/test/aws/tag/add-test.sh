@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/tag?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "ResourceType": "VM", "ResourceId": "i-075d740aaaa410193", "Tag": { "Key": "environment", "Value": "dev" } }' |json_pp
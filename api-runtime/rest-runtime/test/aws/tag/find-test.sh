@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET "http://$RESTSERVER:1024/taggedresource?connection_name=aws-config01&resource_type=VM&keyword=dev" |json_pp
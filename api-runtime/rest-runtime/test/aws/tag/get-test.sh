@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET "http://$RESTSERVER:1024/tag/environment?connection_name=aws-config01&resource_type=VM&resource_id=i-075d740aaaa410193" |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/vpc/vpc-0a1b2c3d4e5f60001/subnet?connection_name=aws-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-subnet02", "CIDR": "10.0.2.0/24", "Zone": "ap-northeast-2c" }' |json_pp
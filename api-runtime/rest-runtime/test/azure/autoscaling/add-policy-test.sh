@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/autoscalinggroup/mcb-asg01/policy?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-policy01", "TargetCPUUtilization": 60 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/autoscalinggroup/mcb-asg01/size?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "DesiredSize": 3, "MinSize": 1, "MaxSize": 5 }' |json_pp
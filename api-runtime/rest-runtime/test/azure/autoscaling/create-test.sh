@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/autoscalinggroup?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-asg01", "VMTemplate": { "ImageId": "/subscriptions/cb592624-b77b-4a8f-bb13-0e5a48cae40f/resourceGroups/CB-GROUP/providers/Microsoft.Compute/images/CB-IMG", "VMSpecId": "Standard_B1ls", "VirtualNetworkId": "mcb-subnet01", "SecurityGroupIds": [ "mcb-sg01" ], "KeyPairName": "mcb-keypair01", "VMUserId": "cb-user" }, "DesiredSize": 2, "MinSize": 1, "MaxSize": 4 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/autoscalinggroup/mcb-asg01/member?connection_name=azure-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/bucket?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-bucket01" }' |json_pp
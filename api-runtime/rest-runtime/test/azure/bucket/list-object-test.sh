@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET "http://$RESTSERVER:1024/bucket/mcb-bucket01/object?connection_name=azure-config01&prefix=test/" |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/cluster/mcb-cluster01/nodegroup?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcbpool02", "VMSpecName": "Standard_D2s_v3", "RootDiskSize": "30", "KeyPairName": "mcb-keypair01", "OnAutoScaling": false, "DesiredNodeSize": 1 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/cluster?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-cluster01", "Version": "1.18.14", "VPCId": "mcb-vpc01", "SubnetIds": [ "mcb-subnet01" ], "SecurityGroupIds": [ "mcb-sg01" ], "NodeGroupList": [ { "Name": "mcbpool01", "VMSpecName": "Standard_D2s_v3", "RootDiskSize": "30", "KeyPairName": "mcb-keypair01", "OnAutoScaling": true, "DesiredNodeSize": 2, "MinNodeSize": 1, "MaxNodeSize": 3 } ] }' |json_pp
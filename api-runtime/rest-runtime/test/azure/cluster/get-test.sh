@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/cluster/mcb-cluster01?connection_name=azure-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/cluster/mcb-cluster01/nodegroup/mcbpool02?connection_name=azure-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/disk?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-disk01", "DiskType": "Standard_LRS", "DiskSize": "10" }' |json_pp
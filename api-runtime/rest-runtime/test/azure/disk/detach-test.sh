@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/disk/mcb-disk01/detach?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "VMId": "mcb-vm01" }' |json_pp
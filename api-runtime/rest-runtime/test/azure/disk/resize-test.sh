@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/disk/mcb-disk01/size?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Size": "20" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/dnszone/cb-spider-test.com/record?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "www", "Type": "A", "TTL": 300, "Values": [ "13.125.43.21" ] }' |json_pp
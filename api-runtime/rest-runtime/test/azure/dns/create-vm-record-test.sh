@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/dnszone/cb-spider-test.com/record?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "vm01", "Type": "A", "VMId": "mcb-vm01", "Wait": true }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE "http://$RESTSERVER:1024/dnszone/cb-spider-test.com/record?connection_name=azure-config01&name=www&type=A" |json_pp
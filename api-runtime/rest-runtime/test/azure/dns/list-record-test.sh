@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/dnszone/cb-spider-test.com/record?connection_name=azure-config01 |json_pp
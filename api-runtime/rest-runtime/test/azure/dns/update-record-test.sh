@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/dnszone/cb-spider-test.com/record?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "www", "Type": "A", "TTL": 600, "Values": [ "13.125.43.21", "13.125.43.22" ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/keypair/import?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "CB-ImportKeyPair", "PublicKey": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGk9ShFkHjo1tmz4W0Pl0J5MRu4lzc3p2pY9JmHUhjlf user@example.com" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/natgateway/mcb-natgw01/association?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "SubnetId": "mcb-subnet02" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/natgateway?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-natgw01", "VPCId": "mcb-vpc01", "SubnetId": "mcb-subnet01" }' |json_pp
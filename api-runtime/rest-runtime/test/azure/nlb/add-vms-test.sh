@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/nlb/mcb-nlb01/vms?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "VMs": [ "mcb-vm02" ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/nlb?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-nlb01", "VPCId": "mcb-vpc01", "Type": "PUBLIC", "Listener": { "Protocol": "TCP", "Port": "80" }, "VMGroup": { "Protocol": "TCP", "Port": "80", "VMs": [ "mcb-vm01" ] }, "HealthChecker": { "Protocol": "TCP", "Port": "80", "Interval": 10, "Timeout": 5, "Threshold": 3 } }' |json_pp
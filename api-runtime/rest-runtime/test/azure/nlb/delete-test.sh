@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/nlb/mcb-nlb01?connection_name=azure-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/router/mcb-router01/route?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "DestinationCIDR": "192.168.0.0/16", "TargetType": "IP", "TargetId": "10.0.1.10" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/router?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-router01", "VPCId": "mcb-vpc01", "InternetGateway": true }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/router/mcb-router01?connection_name=azure-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE "http://$RESTSERVER:1024/router/mcb-router01/route?connection_name=azure-config01&destination=192.168.0.0/16" |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/router/mcb-router01/subnet/mcb-subnet01?connection_name=azure-config01 |json_pp
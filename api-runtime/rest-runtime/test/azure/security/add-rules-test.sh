@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/securitygroup/CB-SecGroup/rules?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "SecurityRules": [ {"FromPort": "80", "ToPort" : "80", "IPProtocol" : "tcp", "Direction" : "inbound", "CIDR" : "10.0.0.0/16", "Priority" : 500} ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/snapshot/mcb-snapshot01/disk?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-disk02" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/tag?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "ResourceType": "VM", "ResourceId": "CBVm", "Tag": { "Key": "environment", "Value": "dev" } }' |json_pp
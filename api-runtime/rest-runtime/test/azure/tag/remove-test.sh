@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE "http://$RESTSERVER:1024/tag/environment?connection_name=azure-config01&resource_type=VM&resource_id=CBVm" |json_pp
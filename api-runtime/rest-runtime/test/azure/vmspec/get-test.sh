@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/vmspec/Standard_B1ls?connection_name=azure-config01 |json_pp
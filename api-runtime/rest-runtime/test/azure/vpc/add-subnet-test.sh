@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/vpc/mcb-vpc01/subnet?connection_name=azure-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-subnet02", "CIDR": "10.0.2.0/24" }' |json_pp
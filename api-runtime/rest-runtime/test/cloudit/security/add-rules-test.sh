@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/securitygroup/6a3bcb31-c172-43ba-a7d0-4ae17dcf74bd/rules?connection_name=cloudit-config01 -H 'Content-Type: application/json' -d '{ "SecurityRules": [ {"FromPort": "80", "ToPort" : "80", "IPProtocol" : "tcp", "Direction" : "inbound", "CIDR" : "10.0.0.0/16"} ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/vmspec/1c38e438-ede9-4df5-8775-2ce791698924?connection_name=cloudit-config01 |json_pp
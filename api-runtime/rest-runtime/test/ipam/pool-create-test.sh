@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/ipam/pool -H 'Content-Type: application/json' -d '{ "PoolName": "cb-pool01", "CIDR": "10.0.0.0/8" }' |json_pp
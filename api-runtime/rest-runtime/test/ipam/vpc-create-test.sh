@@ -0,0 +1,7 @@
+RESTSERVER=localhost
+
+# VPC(/16)와 서브넷(/24)의 CIDR을 cb-pool01에서 자동 할당
+curl -X POST "http://$RESTSERVER:1024/vpc?connection_name=aws-config01&ipam_pool=cb-pool01" -H 'Content-Type: application/json' -d '{ "Name": "mcb-vpc01", "SubnetInfoList": [ { "Name": "mcb-subnet01", "Zone": "ap-northeast-2a" } ] }' |json_pp
+
+# 다른 클라우드의 VPC도 같은 Pool에서 겹치지 않게 할당
+curl -X POST "http://$RESTSERVER:1024/vpc?connection_name=azure-config01&ipam_pool=cb-pool01&vpc_prefix=20&subnet_prefix=26" -H 'Content-Type: application/json' -d '{ "Name": "mcb-vpc02", "SubnetInfoList": [ { "Name": "mcb-subnet01" } ] }' |json_pp
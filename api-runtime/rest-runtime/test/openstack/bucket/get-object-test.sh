@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/bucket/mcb-bucket01/object/test/hello.txt?connection_name=openstack-config01
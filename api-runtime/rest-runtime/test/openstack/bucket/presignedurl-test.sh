@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/bucket/mcb-bucket01/presignedurl?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "ObjectKey": "test/hello.txt", "Method": "GET", "ExpiresSeconds": 3600 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/bucket/mcb-bucket01/object/test/hello.txt?connection_name=openstack-config01 -H 'Content-Type: text/plain' --data-binary 'Hello, CB-Spider!' |json_pp
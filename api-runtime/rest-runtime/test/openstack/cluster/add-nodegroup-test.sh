@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/cluster/3c9f7a2e-5b1d-4e8a-9f6c-0d1e2f3a4b5c/nodegroup?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-nodegroup02", "VMSpecName": "m1.medium", "RootDiskSize": "20", "OnAutoScaling": true, "DesiredNodeSize": 1, "MinNodeSize": 1, "MaxNodeSize": 3 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/cluster?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-cluster01", "Version": "v1.18.16", "VPCId": "5d3e2c1b-7a8f-4e6d-9c0b-1a2b3c4d5e6f", "SubnetIds": [ "6e4f3d2c-8b9a-4f7e-a1c2-2b3c4d5e6f70" ], "SecurityGroupIds": [ "9b8c7d6e-5f4a-4b3c-8d2e-1f0a9b8c7d6e" ], "NodeGroupList": [ { "Name": "default-worker", "ImageName": "fedora-coreos-32", "VMSpecName": "m1.medium", "RootDiskSize": "20", "KeyPairName": "mcb-keypair01", "OnAutoScaling": false, "DesiredNodeSize": 2 } ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/cluster/3c9f7a2e-5b1d-4e8a-9f6c-0d1e2f3a4b5c/nodegroup/7e2d4c6a-1b3f-4a5e-8d9c-2f3e4a5b6c7d/scaling?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "DesiredNodeSize": 2, "MinNodeSize": 1, "MaxNodeSize": 4 }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/cluster/3c9f7a2e-5b1d-4e8a-9f6c-0d1e2f3a4b5c/upgrade?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Version": "v1.19.8" }' |json_pp
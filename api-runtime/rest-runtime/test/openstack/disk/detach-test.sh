@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/disk/2b0c7a5e-9d1f-4a6c-8e3b-6f4d2a1c0e77/detach?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "VMId": "4c2d7f1a-3e5b-4b8c-9a0d-1e2f3a4b5c6d" }' |json_pp
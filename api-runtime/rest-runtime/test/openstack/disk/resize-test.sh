@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/disk/2b0c7a5e-9d1f-4a6c-8e3b-6f4d2a1c0e77/size?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Size": "20" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/dnszone?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "cb-spider-test.com", "Description": "cb-spider test zone" }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/dnszone/5a7e3c1d-2b4f-4e6a-8c9d-0e1f2a3b4c5d?connection_name=openstack-config01 |json_pp
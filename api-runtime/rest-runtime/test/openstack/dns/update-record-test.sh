@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/dnszone/5a7e3c1d-2b4f-4e6a-8c9d-0e1f2a3b4c5d/record?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "www", "Type": "A", "TTL": 600, "Values": [ "13.125.43.21", "13.125.43.22" ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/dnszone/5a7e3c1d-2b4f-4e6a-8c9d-0e1f2a3b4c5d/change/8d2c4e6f-1a3b-4c5d-9e7f-6a5b4c3d2e1f?connection_name=openstack-config01 |json_pp
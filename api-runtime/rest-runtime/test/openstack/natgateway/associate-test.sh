@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X PUT http://$RESTSERVER:1024/natgateway/3c5e7a9b-2d4f-4a6c-8e0b-1f2a3b4c5d6e/association?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "SubnetId": "6e4f3d2c-8b9a-4f7e-a1c2-2b3c4d5e6f70" }' |json_pp
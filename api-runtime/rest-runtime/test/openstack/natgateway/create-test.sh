@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/natgateway?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-natgw01", "VPCId": "5d3e2c1b-7a8f-4e6d-9c0b-1a2b3c4d5e6f", "SubnetId": "6e4f3d2c-8b9a-4f7e-a1c2-2b3c4d5e6f70" }' |json_pp
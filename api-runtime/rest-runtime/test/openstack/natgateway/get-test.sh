@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/natgateway/3c5e7a9b-2d4f-4a6c-8e0b-1f2a3b4c5d6e?connection_name=openstack-config01 |json_pp
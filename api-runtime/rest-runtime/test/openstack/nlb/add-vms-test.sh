@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/nlb/7f5a4e3d-9c0b-4a8f-b2d3-3c4d5e6f7081/vms?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "VMs": [ "9b7c6a5f-1e2d-4cab-d4f5-5e6f708192a3" ] }' |json_pp
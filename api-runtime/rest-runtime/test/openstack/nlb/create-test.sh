@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/nlb?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-nlb01", "VPCId": "5d3e2c1b-7a8f-4e6d-9c0b-1a2b3c4d5e6f", "Type": "PUBLIC", "Listener": { "Protocol": "TCP", "Port": "80" }, "VMGroup": { "Protocol": "TCP", "Port": "80", "VMs": [ "8a6b5f4e-0d1c-4b9a-c3e4-4d5e6f708192" ] }, "HealthChecker": { "Protocol": "TCP", "Port": "80", "Interval": 10, "Timeout": 5, "Threshold": 3 } }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/nlb/7f5a4e3d-9c0b-4a8f-b2d3-3c4d5e6f7081?connection_name=openstack-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/router/8f2e4a6c-1b3d-4e5f-a7b9-c0d1e2f3a4b5/route?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "DestinationCIDR": "192.168.0.0/16", "TargetType": "IP", "TargetId": "10.0.1.10" }' |json_pp
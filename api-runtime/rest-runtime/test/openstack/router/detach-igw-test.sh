@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/router/8f2e4a6c-1b3d-4e5f-a7b9-c0d1e2f3a4b5/internetgateway?connection_name=openstack-config01 |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/snapshot/7e1f0c3a-5b2d-4d9e-8a6f-0c1b2d3e4f5a/disk?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-disk02" }' |json_pp
@@ -0,0 +1,7 @@
+RESTSERVER=localhost
+
+# 디스크 스냅샷
+curl -X POST http://$RESTSERVER:1024/snapshot?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-snapshot01", "SourceType": "DISK", "SourceId": "2b0c7a5e-9d1f-4a6c-8e3b-6f4d2a1c0e77" }' |json_pp
+
+# VM(부트 디스크) 스냅샷
+#curl -X POST http://$RESTSERVER:1024/snapshot?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-snapshot02", "SourceType": "VM", "SourceId": "4c2d7f1a-3e5b-4b8c-9a0d-1e2f3a4b5c6d" }' |json_pp
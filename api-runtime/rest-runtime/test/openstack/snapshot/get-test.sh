@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET http://$RESTSERVER:1024/snapshot/7e1f0c3a-5b2d-4d9e-8a6f-0c1b2d3e4f5a?connection_name=openstack-config01 |json_pp
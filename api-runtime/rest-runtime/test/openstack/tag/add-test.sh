@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/tag?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "ResourceType": "VM", "ResourceId": "f565d481-6209-4932-a422-04195d4215e0", "Tag": { "Key": "environment", "Value": "dev" } }' |json_pp
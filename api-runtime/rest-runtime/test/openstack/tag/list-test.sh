@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X GET "http://$RESTSERVER:1024/tag?connection_name=openstack-config01&resource_type=VM&resource_id=f565d481-6209-4932-a422-04195d4215e0" |json_pp
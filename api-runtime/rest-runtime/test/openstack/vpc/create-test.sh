@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X POST http://$RESTSERVER:1024/vpc?connection_name=openstack-config01 -H 'Content-Type: application/json' -d '{ "Name": "mcb-vpc01", "CIDR": "10.0.0.0/16", "SubnetInfoList": [ { "Name": "mcb-subnet01", "CIDR": "10.0.1.0/24" } ] }' |json_pp
@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/vpc/5d3e2c1b-7a8f-4e6d-9c0b-1a2b3c4d5e6f?connection_name=openstack-config01 |json_pp
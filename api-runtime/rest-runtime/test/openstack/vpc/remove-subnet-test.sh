@@ -0,0 +1,3 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/vpc/5d3e2c1b-7a8f-4e6d-9c0b-1a2b3c4d5e6f/subnet/8f7e6d5c-4b3a-4c2d-8e1f-0a9b8c7d6e5f?connection_name=openstack-config01 |json_pp
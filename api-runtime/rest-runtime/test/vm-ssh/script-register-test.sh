@@ -0,0 +1,30 @@
+RESTSERVER=localhost
+
+# register reusable scripts, the scripts are Go templates:
+#   {{.VM}}: VMInfo of VMId, {{.Connection}}: ConnectionConfigInfo, {{.Vars}}: user variables
+curl -X POST http://$RESTSERVER:1024/script -H 'Content-Type: application/json' -d '{
+    "ScriptName": "hostname",
+    "Shell": "bash",
+    "Description": "print the hostname and the VM info",
+    "Script": [
+        "#!/bin/bash",
+        "echo {{.Vars.greeting}} $(hostname)",
+        "echo VM: {{.VM.Name}} {{.VM.PublicIP}}, Region: {{.Connection.RegionName}}"
+    ]
+}'
+
+curl -X POST http://$RESTSERVER:1024/script -H 'Content-Type: application/json' -d '{
+    "ScriptName": "install-agent",
+    "Shell": "bash",
+    "Description": "install the monitoring agent",
+    "Script": [
+        "#!/bin/bash",
+        "set -e",
+        "curl -sSL {{.Vars.url}} -o /usr/local/bin/farmoni_agent",
+        "chmod +x /usr/local/bin/farmoni_agent",
+        "nohup /usr/local/bin/farmoni_agent --name {{.VM.Name}} $@ > /var/log/farmoni_agent.log 2>&1 &"
+    ]
+}'
+
+curl -X GET http://$RESTSERVER:1024/script
+curl -X GET http://$RESTSERVER:1024/script/install-agent
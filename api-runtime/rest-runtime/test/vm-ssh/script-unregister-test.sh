@@ -0,0 +1,4 @@
+RESTSERVER=localhost
+
+curl -X DELETE http://$RESTSERVER:1024/script/hostname
+curl -X DELETE http://$RESTSERVER:1024/script/install-agent
@@ -0,0 +1,27 @@
+RESTSERVER=localhost
+
+# upload a file
+curl -X POST http://$RESTSERVER:1024/sshrun/upload \
+    -F "UserName=root" \
+    -F "PrivateKey=<$HOME/.ssh/id_rsa" \
+    -F "ServerPort=node12:22" \
+    -F "RemotePath=/tmp/farmoni_agent" \
+    -F "Permissions=0755" \
+    -F "Checksum=true" \
+    -F "TransferId=upload-01" \
+    -F "file=@/root/go/src/farmoni/farmoni_agent/farmoni_agent"
+
+# upload files into a directory with their relative paths
+curl -X POST http://$RESTSERVER:1024/sshrun/upload \
+    -F "UserName=root" \
+    -F "PrivateKey=<$HOME/.ssh/id_rsa" \
+    -F "ServerPort=node12:22" \
+    -F "RemotePath=/tmp/farmoni/" \
+    -F "Owner=root" \
+    -F "Group=root" \
+    -F "UseSFTP=true" \
+    -F "file=@/root/go/src/farmoni/farmoni_agent/farmoni_agent;filename=bin/farmoni_agent" \
+    -F "file=@/root/go/src/farmoni/farmoni_agent/conf/config.yaml;filename=conf/config.yaml"
+
+# progress of the transfer
+curl -X GET http://$RESTSERVER:1024/sshrun/transfer/upload-01
@@ -0,0 +1,10 @@
+RESTSERVER=localhost
+
+# 2 vCPU / 4 GiB, x86_64, best 3 specs of each connection
+curl -X POST http://$RESTSERVER:1024/vmspec/recommend -H 'Content-Type: application/json' -d '{
+    "ConnectionNames": ["aws-config01", "azure-config01", "openstack-config01", "cloudit-config01"],
+    "VCpu": "2",
+    "Mem": "4096",
+    "Arch": "x86_64",
+    "Limit": 3
+}' |json_pp
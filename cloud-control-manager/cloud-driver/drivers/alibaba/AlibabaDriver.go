@@ -14,7 +14,12 @@ import (
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	alicon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/alibaba/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
@@ -36,6 +41,18 @@ func (AlibabaDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.VNicHandler = true
 	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.DiskHandler = true
+	drvCapabilityInfo.SnapshotHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.RouterHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+	drvCapabilityInfo.NLBHandler = true
+	drvCapabilityInfo.ObjectStorageHandler = true
+	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.AutoScalingHandler = true
+	drvCapabilityInfo.TagHandler = true
 
 	return drvCapabilityInfo
 }
@@ -54,6 +71,26 @@ func (driver *AlibabaDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (i
 	if err != nil {
 		return nil, err
 	}
+	SLBClient, err := getSLBClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	OSSClient, err := getOSSClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	CSClient, err := getCSClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	DNSClient, err := getDNSClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	ESSClient, err := getESSClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	iConn := alicon.AlibabaCloudConnection{
 		Region:              connectionInfo.RegionInfo,
@@ -65,6 +102,19 @@ func (driver *AlibabaDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (i
 		VNetClient:          VPCClient,
 		VNicClient:          ESCClient,
 		SubnetClient:        VPCClient,
+		VMSpecClient:        ESCClient,
+		DiskClient:          ESCClient,
+		SnapshotClient:      ESCClient,
+		VPCClient:           VPCClient,
+		RouterClient:        VPCClient,
+		NATGatewayClient:    VPCClient,
+		NLBClient:           SLBClient,
+		ObjectStorageClient: OSSClient,
+		ClusterClient:       CSClient,
+		DNSClient:           DNSClient,
+		AutoScalingClient:   ESSClient,
+		TagClient:           ESCClient,
+		TagVPCClient:        VPCClient,
 	}
 	return &iConn, nil
 }
@@ -123,4 +173,127 @@ func getVPCClient(connectionInfo idrv.ConnectionInfo) (*vpc.Client, error) {
 	return &vpcClient, nil
 }
 
+func getSLBClient(connectionInfo idrv.ConnectionInfo) (*slb.Client, error) {
+
+	// Region Info
+	fmt.Println("AlibabaDriver : getSLBClient() - Region : [" + connectionInfo.RegionInfo.Region + "]")
+
+	// Customize config
+	config := NewConfig().
+		WithEnableAsync(true).
+		WithGoRoutinePoolSize(5).
+		WithMaxTaskQueueSize(1000)
+		// 600*time.Second
+
+	// Create a credential object
+	credential := &credentials.BaseCredential{
+		AccessKeyId:     connectionInfo.CredentialInfo.ClientId,
+		AccessKeySecret: connectionInfo.CredentialInfo.ClientSecret,
+	}
+
+	slbClient, err := slb.NewClientWithOptions(connectionInfo.RegionInfo.Region, config, credential)
+	if err != nil {
+		fmt.Println("Could not create alibaba's slb service client", err)
+		return nil, err
+	}
+
+	return &slbClient, nil
+}
+
+func getOSSClient(connectionInfo idrv.ConnectionInfo) (*oss.Client, error) {
+
+	// OSS는 리전별 Endpoint를 사용 함. (예: oss-cn-hongkong.aliyuncs.com)
+	endpoint := "https://oss-" + connectionInfo.RegionInfo.Region + ".aliyuncs.com"
+	fmt.Println("AlibabaDriver : getOSSClient() - Endpoint : [" + endpoint + "]")
+
+	ossClient, err := oss.New(endpoint, connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret)
+	if err != nil {
+		fmt.Println("Could not create alibaba's oss service client", err)
+		return nil, err
+	}
+
+	return ossClient, nil
+}
+
+func getCSClient(connectionInfo idrv.ConnectionInfo) (*cs.Client, error) {
+
+	// Region Info
+	fmt.Println("AlibabaDriver : getCSClient() - Region : [" + connectionInfo.RegionInfo.Region + "]")
+
+	// Customize config
+	config := NewConfig().
+		WithEnableAsync(true).
+		WithGoRoutinePoolSize(5).
+		WithMaxTaskQueueSize(1000)
+		// 600*time.Second
+
+	// Create a credential object
+	credential := &credentials.BaseCredential{
+		AccessKeyId:     connectionInfo.CredentialInfo.ClientId,
+		AccessKeySecret: connectionInfo.CredentialInfo.ClientSecret,
+	}
+
+	csClient, err := cs.NewClientWithOptions(connectionInfo.RegionInfo.Region, config, credential)
+	if err != nil {
+		fmt.Println("Could not create alibaba's cs service client", err)
+		return nil, err
+	}
+
+	return csClient, nil
+}
+
+func getDNSClient(connectionInfo idrv.ConnectionInfo) (*alidns.Client, error) {
+
+	// Region Info
+	fmt.Println("AlibabaDriver : getDNSClient() - Region : [" + connectionInfo.RegionInfo.Region + "]")
+
+	// Customize config
+	config := NewConfig().
+		WithEnableAsync(true).
+		WithGoRoutinePoolSize(5).
+		WithMaxTaskQueueSize(1000)
+		// 600*time.Second
+
+	// Create a credential object
+	credential := &credentials.BaseCredential{
+		AccessKeyId:     connectionInfo.CredentialInfo.ClientId,
+		AccessKeySecret: connectionInfo.CredentialInfo.ClientSecret,
+	}
+
+	dnsClient, err := alidns.NewClientWithOptions(connectionInfo.RegionInfo.Region, config, credential)
+	if err != nil {
+		fmt.Println("Could not create alibaba's dns service client", err)
+		return nil, err
+	}
+
+	return dnsClient, nil
+}
+
+func getESSClient(connectionInfo idrv.ConnectionInfo) (*ess.Client, error) {
+
+	// Region Info
+	fmt.Println("AlibabaDriver : getESSClient() - Region : [" + connectionInfo.RegionInfo.Region + "]")
+
+	// Customize config
+	config := NewConfig().
+		WithEnableAsync(true).
+		WithGoRoutinePoolSize(5).
+		WithMaxTaskQueueSize(1000)
+		// 600*time.Second
+
+	// Create a credential object
+	credential := &credentials.BaseCredential{
+		AccessKeyId:     connectionInfo.CredentialInfo.ClientId,
+		AccessKeySecret: connectionInfo.CredentialInfo.ClientSecret,
+	}
+
+	essClient, err := ess.NewClientWithOptions(connectionInfo.RegionInfo.Region, config, credential)
+	if err != nil {
+		fmt.Println("Could not create alibaba's ess service client", err)
+		return nil, err
+	}
+
+	return essClient, nil
+}
+
 var TestDriver AlibabaDriver
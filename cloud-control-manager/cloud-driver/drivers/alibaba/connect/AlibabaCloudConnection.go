@@ -11,8 +11,13 @@
 package connect
 
 import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 
 	cblog "github.com/cloud-barista/cb-log"
 	alirs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/alibaba/resources"
@@ -39,6 +44,19 @@ type AlibabaCloudConnection struct {
 	VNetClient          *vpc.Client
 	VNicClient          *ecs.Client
 	SubnetClient        *vpc.Client
+	VMSpecClient        *ecs.Client
+	DiskClient          *ecs.Client
+	SnapshotClient      *ecs.Client
+	VPCClient           *vpc.Client
+	RouterClient        *vpc.Client
+	NATGatewayClient    *vpc.Client
+	NLBClient           *slb.Client
+	ObjectStorageClient *oss.Client
+	ClusterClient       *cs.Client
+	DNSClient           *alidns.Client
+	AutoScalingClient   *ess.Client
+	TagClient           *ecs.Client
+	TagVPCClient        *vpc.Client
 }
 
 func (cloudConn *AlibabaCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
@@ -83,6 +101,78 @@ func (cloudConn *AlibabaCloudConnection) CreateVMHandler() (irs.VMHandler, error
 	return &vmHandler, nil
 }
 
+func (cloudConn *AlibabaCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateVMSpecHandler()!")
+	vmSpecHandler := alirs.AlibabaVMSpecHandler{cloudConn.Region, cloudConn.VMSpecClient}
+	return &vmSpecHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateDiskHandler()!")
+	diskHandler := alirs.AlibabaDiskHandler{cloudConn.Region, cloudConn.DiskClient}
+	return &diskHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateSnapshotHandler() (irs.SnapshotHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateSnapshotHandler()!")
+	snapshotHandler := alirs.AlibabaSnapshotHandler{cloudConn.Region, cloudConn.SnapshotClient}
+	return &snapshotHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateVPCHandler()!")
+	vpcHandler := alirs.AlibabaVPCHandler{cloudConn.Region, cloudConn.VPCClient}
+	return &vpcHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateRouterHandler() (irs.RouterHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateRouterHandler()!")
+	routerHandler := alirs.AlibabaRouterHandler{cloudConn.Region, cloudConn.RouterClient}
+	return &routerHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateNATGatewayHandler()!")
+	natGatewayHandler := alirs.AlibabaNATGatewayHandler{cloudConn.Region, cloudConn.NATGatewayClient}
+	return &natGatewayHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateNLBHandler()!")
+	nlbHandler := alirs.AlibabaNLBHandler{cloudConn.Region, cloudConn.NLBClient, cloudConn.VPCClient}
+	return &nlbHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateObjectStorageHandler() (irs.ObjectStorageHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateObjectStorageHandler()!")
+	storageHandler := alirs.AlibabaObjectStorageHandler{cloudConn.Region, cloudConn.ObjectStorageClient}
+	return &storageHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateClusterHandler()!")
+	clusterHandler := alirs.AlibabaClusterHandler{cloudConn.Region, cloudConn.ClusterClient}
+	return &clusterHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateDNSHandler()!")
+	dnsHandler := alirs.AlibabaDNSHandler{cloudConn.Region, cloudConn.DNSClient}
+	return &dnsHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateAutoScalingHandler() (irs.AutoScalingHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateAutoScalingHandler()!")
+	autoScalingHandler := alirs.AlibabaAutoScalingHandler{cloudConn.Region, cloudConn.AutoScalingClient}
+	return &autoScalingHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateTagHandler()!")
+	tagHandler := alirs.AlibabaTagHandler{cloudConn.Region, cloudConn.TagClient, cloudConn.TagVPCClient}
+	return &tagHandler, nil
+}
+
 func (AlibabaCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
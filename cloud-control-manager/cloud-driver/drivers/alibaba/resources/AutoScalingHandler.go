@@ -0,0 +1,408 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBScalingGroupPageSize     = 50  // max 50
+	CBScalingGroupWaitTime     = 120 // seconds
+	CBScalingGroupCheckTime    = 2   // seconds
+	CBScalingGroupStateActive  = "Active"
+	CBScalingRuleTargetTrack   = "TargetTrackingScalingRule"
+	CBScalingRuleCPUMetricName = "CpuUtilization"
+	CBScalingInstanceBandwidth = 5 // Mbps
+)
+
+// ESS(Elastic Scaling Service)의 Scaling Group을 Auto Scaling Group으로 사용 함. (ID = ScalingGroupId)
+// VM 템플릿은 Scaling Group과 같은 이름의 Scaling Configuration으로 생성하고, VirtualNetworkId는 VSwitchId로 사용 함.
+// Scaling Policy는 평균 CPU 사용률 기준의 Target Tracking Scaling Rule로 매핑 함.
+type AlibabaAutoScalingHandler struct {
+	Region idrv.RegionInfo
+	Client *ess.Client
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) CreateAutoScalingGroup(groupReqInfo irs.AutoScalingGroupReqInfo) (irs.AutoScalingGroupInfo, error) {
+	cblogger.Info("Start CreateAutoScalingGroup : ", groupReqInfo)
+
+	// 1. Scaling Group 생성 (비활성 상태로 생성 됨)
+	request := ess.CreateCreateScalingGroupRequest()
+	request.Scheme = "https"
+	request.ScalingGroupName = groupReqInfo.Name
+	request.VSwitchId = groupReqInfo.VMTemplate.VirtualNetworkId
+	request.MinSize = requests.NewInteger(groupReqInfo.MinSize)
+	request.MaxSize = requests.NewInteger(groupReqInfo.MaxSize)
+	request.DesiredCapacity = requests.NewInteger(groupReqInfo.DesiredSize)
+
+	result, err := asgHandler.Client.CreateScalingGroup(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create ScalingGroup: %s, %v.", groupReqInfo.Name, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	cblogger.Infof("Created ScalingGroup %q %s", result.ScalingGroupId, groupReqInfo.Name)
+
+	// 2. Scaling Configuration 생성
+	vmTemplate := groupReqInfo.VMTemplate
+	configRequest := ess.CreateCreateScalingConfigurationRequest()
+	configRequest.Scheme = "https"
+	configRequest.ScalingGroupId = result.ScalingGroupId
+	configRequest.ScalingConfigurationName = groupReqInfo.Name
+	configRequest.ImageId = vmTemplate.ImageId
+	configRequest.InstanceType = vmTemplate.VMSpecId
+	configRequest.SecurityGroupIds = &vmTemplate.SecurityGroupIds
+	configRequest.KeyPairName = vmTemplate.KeyPairName
+	configRequest.Password = vmTemplate.VMUserPasswd
+	configRequest.InternetChargeType = CBInternetChargeType
+	configRequest.InternetMaxBandwidthOut = requests.NewInteger(CBScalingInstanceBandwidth)
+
+	configResult, err := asgHandler.Client.CreateScalingConfiguration(configRequest)
+	if err != nil {
+		cblogger.Errorf("Unable to create ScalingConfiguration: %s, %v.", groupReqInfo.Name, err)
+		asgHandler.DeleteAutoScalingGroup(result.ScalingGroupId)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	// 3. Scaling Group 활성화 (활성화 후 희망 크기만큼 인스턴스가 생성 됨)
+	enableRequest := ess.CreateEnableScalingGroupRequest()
+	enableRequest.Scheme = "https"
+	enableRequest.ScalingGroupId = result.ScalingGroupId
+	enableRequest.ActiveScalingConfigurationId = configResult.ScalingConfigurationId
+
+	_, err = asgHandler.Client.EnableScalingGroup(enableRequest)
+	if err != nil {
+		cblogger.Errorf("Unable to enable ScalingGroup: %s, %v.", result.ScalingGroupId, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	for i := 0; ; i++ {
+		scalingGroup, err := asgHandler.describeScalingGroup(result.ScalingGroupId)
+		if err != nil {
+			return irs.AutoScalingGroupInfo{}, err
+		}
+		if scalingGroup.LifecycleState == CBScalingGroupStateActive {
+			break
+		}
+		if i >= CBScalingGroupWaitTime/CBScalingGroupCheckTime {
+			return irs.AutoScalingGroupInfo{}, errors.New("ScalingGroup[" + result.ScalingGroupId + "]이 활성화되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBScalingGroupCheckTime)
+	}
+
+	return asgHandler.GetAutoScalingGroup(result.ScalingGroupId)
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) ListAutoScalingGroup() ([]*irs.AutoScalingGroupInfo, error) {
+	cblogger.Debug("Start")
+
+	request := ess.CreateDescribeScalingGroupsRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(CBScalingGroupPageSize)
+
+	var groupInfoList []*irs.AutoScalingGroupInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := asgHandler.Client.DescribeScalingGroups(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get ScalingGroups, %v", err)
+			return nil, err
+		}
+		for _, scalingGroup := range result.ScalingGroups.ScalingGroup {
+			groupInfo, err := asgHandler.mappingAutoScalingGroupInfo(scalingGroup)
+			if err != nil {
+				return nil, err
+			}
+			groupInfoList = append(groupInfoList, &groupInfo)
+		}
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return groupInfoList, nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) GetAutoScalingGroup(groupID string) (irs.AutoScalingGroupInfo, error) {
+	cblogger.Infof("groupID : [%s]", groupID)
+
+	scalingGroup, err := asgHandler.describeScalingGroup(groupID)
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	return asgHandler.mappingAutoScalingGroupInfo(scalingGroup)
+}
+
+// 강제 삭제 옵션으로 인스턴스, Scaling Configuration, Scaling Rule도 함께 삭제 됨.
+func (asgHandler *AlibabaAutoScalingHandler) DeleteAutoScalingGroup(groupID string) (bool, error) {
+	cblogger.Infof("groupID : [%s]", groupID)
+
+	request := ess.CreateDeleteScalingGroupRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = groupID
+	request.ForceDelete = requests.NewBoolean(true)
+
+	_, err := asgHandler.Client.DeleteScalingGroup(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete ScalingGroup: %s, %v.", groupID, err)
+		return false, err
+	}
+	cblogger.Infof("Successfully deleted %q ScalingGroup", groupID)
+	return true, nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) ChangeAutoScalingGroupSize(groupID string, desiredSize int, minSize int, maxSize int) (irs.AutoScalingGroupInfo, error) {
+	cblogger.Infof("groupID : [%s], desiredSize : [%d], minSize : [%d], maxSize : [%d]", groupID, desiredSize, minSize, maxSize)
+
+	request := ess.CreateModifyScalingGroupRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = groupID
+	request.MinSize = requests.NewInteger(minSize)
+	request.MaxSize = requests.NewInteger(maxSize)
+	request.DesiredCapacity = requests.NewInteger(desiredSize)
+
+	_, err := asgHandler.Client.ModifyScalingGroup(request)
+	if err != nil {
+		cblogger.Errorf("Unable to modify ScalingGroup: %s, %v.", groupID, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	return asgHandler.GetAutoScalingGroup(groupID)
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) ListAutoScalingGroupMember(groupID string) ([]*irs.AutoScalingMemberInfo, error) {
+	cblogger.Infof("groupID : [%s]", groupID)
+
+	request := ess.CreateDescribeScalingInstancesRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = groupID
+	request.PageSize = requests.NewInteger(CBScalingGroupPageSize)
+
+	var memberInfoList []*irs.AutoScalingMemberInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := asgHandler.Client.DescribeScalingInstances(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get ScalingInstances: %s, %v.", groupID, err)
+			return nil, err
+		}
+		for _, instance := range result.ScalingInstances.ScalingInstance {
+			memberInfo := irs.AutoScalingMemberInfo{
+				VMId:   instance.InstanceId,
+				Status: getScalingInstanceStatus(instance),
+				Zone:   instance.ZoneId,
+				KeyValueList: []irs.KeyValue{
+					{Key: "LifecycleState", Value: instance.LifecycleState},
+					{Key: "HealthStatus", Value: instance.HealthStatus},
+					{Key: "CreationType", Value: instance.CreationType},
+				},
+			}
+			memberInfoList = append(memberInfoList, &memberInfo)
+		}
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return memberInfoList, nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) AddScalingPolicy(groupID string, policyReqInfo irs.ScalingPolicyReqInfo) (irs.ScalingPolicyInfo, error) {
+	cblogger.Infof("groupID : [%s], policyReqInfo : %v", groupID, policyReqInfo)
+
+	request := ess.CreateCreateScalingRuleRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = groupID
+	request.ScalingRuleName = policyReqInfo.Name
+	request.ScalingRuleType = CBScalingRuleTargetTrack
+	request.MetricName = CBScalingRuleCPUMetricName
+	request.TargetValue = requests.NewFloat(policyReqInfo.TargetCPUUtilization)
+
+	result, err := asgHandler.Client.CreateScalingRule(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create ScalingRule: %s, %v.", policyReqInfo.Name, err)
+		return irs.ScalingPolicyInfo{}, err
+	}
+	cblogger.Infof("Created ScalingRule %q %s", result.ScalingRuleId, policyReqInfo.Name)
+
+	scalingRule, err := asgHandler.describeScalingRule(groupID, policyReqInfo.Name)
+	if err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+	return mappingScalingPolicyInfo(scalingRule), nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) ListScalingPolicy(groupID string) ([]*irs.ScalingPolicyInfo, error) {
+	cblogger.Infof("groupID : [%s]", groupID)
+
+	scalingRuleList, err := asgHandler.describeScalingRules(groupID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policyInfoList []*irs.ScalingPolicyInfo
+	for _, scalingRule := range scalingRuleList {
+		policyInfo := mappingScalingPolicyInfo(scalingRule)
+		policyInfoList = append(policyInfoList, &policyInfo)
+	}
+	return policyInfoList, nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) RemoveScalingPolicy(groupID string, policyName string) (bool, error) {
+	cblogger.Infof("groupID : [%s], policyName : [%s]", groupID, policyName)
+
+	scalingRule, err := asgHandler.describeScalingRule(groupID, policyName)
+	if err != nil {
+		return false, err
+	}
+
+	request := ess.CreateDeleteScalingRuleRequest()
+	request.Scheme = "https"
+	request.ScalingRuleId = scalingRule.ScalingRuleId
+
+	_, err = asgHandler.Client.DeleteScalingRule(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete ScalingRule: %s, %v.", policyName, err)
+		return false, err
+	}
+	cblogger.Infof("Successfully deleted %q ScalingRule", policyName)
+	return true, nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) describeScalingGroup(groupID string) (ess.ScalingGroup, error) {
+	request := ess.CreateDescribeScalingGroupsRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = &[]string{groupID}
+
+	result, err := asgHandler.Client.DescribeScalingGroups(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get ScalingGroup: %s, %v.", groupID, err)
+		return ess.ScalingGroup{}, err
+	}
+	if len(result.ScalingGroups.ScalingGroup) < 1 {
+		return ess.ScalingGroup{}, errors.New("ScalingGroup[" + groupID + "] 정보가 존재하지 않습니다.")
+	}
+	return result.ScalingGroups.ScalingGroup[0], nil
+}
+
+// Target Tracking Scaling Rule만 Scaling Policy로 조회 함. (ruleNames가 nil이면 전체 조회)
+func (asgHandler *AlibabaAutoScalingHandler) describeScalingRules(groupID string, ruleNames *[]string) ([]ess.ScalingRule, error) {
+	request := ess.CreateDescribeScalingRulesRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = groupID
+	request.ScalingRuleType = CBScalingRuleTargetTrack
+	request.ScalingRuleName = ruleNames
+	request.PageSize = requests.NewInteger(CBScalingGroupPageSize)
+
+	var scalingRuleList []ess.ScalingRule
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := asgHandler.Client.DescribeScalingRules(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get ScalingRules: %s, %v.", groupID, err)
+			return nil, err
+		}
+		scalingRuleList = append(scalingRuleList, result.ScalingRules.ScalingRule...)
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return scalingRuleList, nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) describeScalingRule(groupID string, ruleName string) (ess.ScalingRule, error) {
+	scalingRuleList, err := asgHandler.describeScalingRules(groupID, &[]string{ruleName})
+	if err != nil {
+		return ess.ScalingRule{}, err
+	}
+	if len(scalingRuleList) < 1 {
+		return ess.ScalingRule{}, errors.New("ScalingRule[" + ruleName + "] 정보가 존재하지 않습니다.")
+	}
+	return scalingRuleList[0], nil
+}
+
+func (asgHandler *AlibabaAutoScalingHandler) mappingAutoScalingGroupInfo(scalingGroup ess.ScalingGroup) (irs.AutoScalingGroupInfo, error) {
+	groupInfo := irs.AutoScalingGroupInfo{
+		Id:          scalingGroup.ScalingGroupId,
+		Name:        scalingGroup.ScalingGroupName,
+		DesiredSize: scalingGroup.DesiredCapacity,
+		MinSize:     scalingGroup.MinSize,
+		MaxSize:     scalingGroup.MaxSize,
+		KeyValueList: []irs.KeyValue{
+			{Key: "VpcId", Value: scalingGroup.VpcId},
+			{Key: "LifecycleState", Value: scalingGroup.LifecycleState},
+			{Key: "TotalCapacity", Value: strconv.Itoa(scalingGroup.TotalCapacity)},
+			{Key: "CreationTime", Value: scalingGroup.CreationTime},
+		},
+	}
+	groupInfo.VMTemplate.VirtualNetworkId = scalingGroup.VSwitchId
+	if groupInfo.VMTemplate.VirtualNetworkId == "" && len(scalingGroup.VSwitchIds.VSwitchId) > 0 {
+		groupInfo.VMTemplate.VirtualNetworkId = scalingGroup.VSwitchIds.VSwitchId[0]
+	}
+	if scalingGroup.ActiveScalingConfigurationId == "" {
+		return groupInfo, nil
+	}
+
+	request := ess.CreateDescribeScalingConfigurationsRequest()
+	request.Scheme = "https"
+	request.ScalingGroupId = scalingGroup.ScalingGroupId
+	request.ScalingConfigurationId = &[]string{scalingGroup.ActiveScalingConfigurationId}
+
+	result, err := asgHandler.Client.DescribeScalingConfigurations(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get ScalingConfiguration: %s, %v.", scalingGroup.ActiveScalingConfigurationId, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	for _, config := range result.ScalingConfigurations.ScalingConfiguration {
+		groupInfo.VMTemplate.ImageId = config.ImageId
+		groupInfo.VMTemplate.VMSpecId = config.InstanceType
+		groupInfo.VMTemplate.KeyPairName = config.KeyPairName
+		groupInfo.VMTemplate.SecurityGroupIds = config.SecurityGroupIds.SecurityGroupId
+		if len(groupInfo.VMTemplate.SecurityGroupIds) == 0 && config.SecurityGroupId != "" {
+			groupInfo.VMTemplate.SecurityGroupIds = []string{config.SecurityGroupId}
+		}
+	}
+	return groupInfo, nil
+}
+
+func mappingScalingPolicyInfo(scalingRule ess.ScalingRule) irs.ScalingPolicyInfo {
+	return irs.ScalingPolicyInfo{
+		Id:                   scalingRule.ScalingRuleId,
+		Name:                 scalingRule.ScalingRuleName,
+		TargetCPUUtilization: scalingRule.TargetValue,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ScalingRuleType", Value: scalingRule.ScalingRuleType},
+			{Key: "MetricName", Value: scalingRule.MetricName},
+		},
+	}
+}
+
+// LifecycleState: Pending, Pending:Wait, InService, Protected, Standby, Removing, Removing:Wait
+func getScalingInstanceStatus(instance ess.ScalingInstance) irs.AutoScalingMemberStatus {
+	if instance.HealthStatus == "Unhealthy" {
+		return irs.AutoScalingMemberFailed
+	}
+	switch instance.LifecycleState {
+	case "InService", "Protected", "Standby":
+		return irs.AutoScalingMemberInService
+	case "Removing", "Removing:Wait":
+		return irs.AutoScalingMemberTerminating
+	default:
+		return irs.AutoScalingMemberPending
+	}
+}
@@ -0,0 +1,596 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBClusterType          = "ManagedKubernetes"
+	CBClusterContainerCidr = "172.20.0.0/16"
+	CBClusterServiceCidr   = "172.21.0.0/20"
+	CBClusterDiskCategory  = "cloud_efficiency"
+	CBClusterPageSize      = 50
+	CBClusterWaitTime      = 1800 // seconds
+	CBClusterCheckTime     = 10   // seconds
+	CBClusterStateRunning  = "running"
+	CBClusterNodesPageSize = "100"
+	CBClusterContentType   = "application/json"
+)
+
+// ACK(Container Service for Kubernetes) Managed Kubernetes 클러스터를 처리 함. (클러스터 ID = ClusterId, 노드 그룹 ID = NodepoolId)
+// CS API는 ROA 방식이므로 요청/응답 Body를 JSON으로 직접 구성하고 파싱 함.
+type AlibabaClusterHandler struct {
+	Region idrv.RegionInfo
+	Client *cs.Client
+}
+
+// DescribeClusterDetail, DescribeClustersV1 응답의 클러스터 항목
+type ackCluster struct {
+	ClusterId       string `json:"cluster_id"`
+	Name            string `json:"name"`
+	State           string `json:"state"`
+	CurrentVersion  string `json:"current_version"`
+	VpcId           string `json:"vpc_id"`
+	VSwitchId       string `json:"vswitch_id"`
+	SecurityGroupId string `json:"security_group_id"`
+	Created         string `json:"created"`
+	MasterUrl       string `json:"master_url"`
+	ClusterType     string `json:"cluster_type"`
+}
+
+type ackClusterList struct {
+	Clusters []ackCluster `json:"clusters"`
+	PageInfo struct {
+		TotalCount int `json:"total_count"`
+	} `json:"page_info"`
+}
+
+// CreateClusterNodePool 요청 및 DescribeClusterNodePools 응답의 노드 풀 항목
+type ackNodePool struct {
+	NodePoolInfo *ackNodePoolInfo    `json:"nodepool_info,omitempty"`
+	Status       *ackNodePoolStatus  `json:"status,omitempty"`
+	ScalingGroup *ackScalingGroup    `json:"scaling_group,omitempty"`
+	AutoScaling  *ackNodePoolScaling `json:"auto_scaling,omitempty"`
+}
+
+type ackNodePoolInfo struct {
+	NodePoolId string `json:"nodepool_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+type ackNodePoolStatus struct {
+	State      string `json:"state"`
+	TotalNodes int    `json:"total_nodes"`
+}
+
+type ackScalingGroup struct {
+	VSwitchIds         []string `json:"vswitch_ids,omitempty"`
+	InstanceTypes      []string `json:"instance_types,omitempty"`
+	ImageId            string   `json:"image_id,omitempty"`
+	SystemDiskCategory string   `json:"system_disk_category,omitempty"`
+	SystemDiskSize     int      `json:"system_disk_size,omitempty"`
+	KeyPair            string   `json:"key_pair,omitempty"`
+	SecurityGroupId    string   `json:"security_group_id,omitempty"`
+	DesiredSize        *int     `json:"desired_size,omitempty"`
+}
+
+type ackNodePoolScaling struct {
+	Enable       bool `json:"enable"`
+	MinInstances int  `json:"min_instances"`
+	MaxInstances int  `json:"max_instances"`
+}
+
+type ackNodePoolList struct {
+	NodePools []ackNodePool `json:"nodepools"`
+}
+
+func (clusterHandler *AlibabaClusterHandler) CreateCluster(clusterReqInfo irs.ClusterReqInfo) (irs.ClusterInfo, error) {
+	cblogger.Info("Start CreateCluster : ", clusterReqInfo)
+
+	if len(clusterReqInfo.SubnetIds) == 0 {
+		return irs.ClusterInfo{}, errors.New("ACK 클러스터 생성을 위한 VSwitch(Subnet) 정보가 없습니다.")
+	}
+
+	// 노드는 노드 풀로 생성하므로 클러스터는 Worker 노드 없이 생성 함.
+	body := map[string]interface{}{
+		"name":                   clusterReqInfo.Name,
+		"cluster_type":           CBClusterType,
+		"region_id":              clusterHandler.Region.Region,
+		"vpcid":                  clusterReqInfo.VPCId,
+		"vswitch_ids":            clusterReqInfo.SubnetIds,
+		"container_cidr":         CBClusterContainerCidr,
+		"service_cidr":           CBClusterServiceCidr,
+		"snat_entry":             false,
+		"endpoint_public_access": true,
+		"num_of_nodes":           0,
+	}
+	if clusterReqInfo.Version != "" {
+		body["kubernetes_version"] = clusterReqInfo.Version
+	}
+	if len(clusterReqInfo.SecurityGroupIds) != 0 {
+		body["security_group_id"] = clusterReqInfo.SecurityGroupIds[0]
+	}
+
+	request := cs.CreateCreateClusterRequest()
+	if err := setJSONContent(request.RoaRequest, body); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	result, err := clusterHandler.Client.CreateCluster(request)
+	if err != nil {
+		cblogger.Errorf("ACK 클러스터 생성 실패 : [%s] - %v", clusterReqInfo.Name, err)
+		return irs.ClusterInfo{}, err
+	}
+	cblogger.Infof("ACK 클러스터 생성 요청 완료 - ClusterId : [%s], TaskId : [%s]", result.ClusterId, result.TaskId)
+
+	// 노드 풀은 클러스터가 running 상태가 되어야 생성할 수 있음.
+	if err := clusterHandler.waitForClusterState(result.ClusterId, CBClusterStateRunning); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	for _, nodeGroupReqInfo := range clusterReqInfo.NodeGroupList {
+		_, err := clusterHandler.AddNodeGroup(result.ClusterId, nodeGroupReqInfo)
+		if err != nil {
+			return irs.ClusterInfo{}, err
+		}
+	}
+
+	return clusterHandler.GetCluster(result.ClusterId)
+}
+
+func (clusterHandler *AlibabaClusterHandler) ListCluster() ([]*irs.ClusterInfo, error) {
+	cblogger.Info("Start ListCluster")
+
+	var clusterList []*irs.ClusterInfo
+	for pageNumber := 1; ; pageNumber++ {
+		request := cs.CreateDescribeClustersV1Request()
+		request.ClusterType = CBClusterType
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBClusterPageSize)
+
+		response, err := clusterHandler.Client.DescribeClustersV1(request)
+		if err != nil {
+			cblogger.Errorf("ACK 클러스터 목록 조회 실패 - %v", err)
+			return nil, err
+		}
+		var result ackClusterList
+		if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+
+		for _, cluster := range result.Clusters {
+			clusterInfo, err := clusterHandler.setterCluster(cluster)
+			if err != nil {
+				return nil, err
+			}
+			clusterList = append(clusterList, clusterInfo)
+		}
+		if len(result.Clusters) < CBClusterPageSize || pageNumber*CBClusterPageSize >= result.PageInfo.TotalCount {
+			break
+		}
+	}
+
+	return clusterList, nil
+}
+
+func (clusterHandler *AlibabaClusterHandler) GetCluster(clusterID string) (irs.ClusterInfo, error) {
+	cblogger.Infof("Start GetCluster : [%s]", clusterID)
+
+	cluster, err := clusterHandler.describeCluster(clusterID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	clusterInfo, err := clusterHandler.setterCluster(cluster)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	return *clusterInfo, nil
+}
+
+// 클러스터를 삭제하면 노드 풀과 노드(ECS)도 함께 삭제 됨.
+func (clusterHandler *AlibabaClusterHandler) DeleteCluster(clusterID string) (bool, error) {
+	cblogger.Infof("Start DeleteCluster : [%s]", clusterID)
+
+	request := cs.CreateDeleteClusterRequest()
+	request.ClusterId = clusterID
+	_, err := clusterHandler.Client.DeleteCluster(request)
+	if err != nil {
+		cblogger.Errorf("ACK 클러스터 삭제 실패 : [%s] - %v", clusterID, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// 노드 풀은 클러스터의 VSwitch와 Security Group을 사용 함.
+func (clusterHandler *AlibabaClusterHandler) AddNodeGroup(clusterID string, nodeGroupReqInfo irs.NodeGroupReqInfo) (irs.NodeGroupInfo, error) {
+	cblogger.Infof("Start AddNodeGroup : [%s] [%v]", clusterID, nodeGroupReqInfo)
+
+	cluster, err := clusterHandler.describeCluster(clusterID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	desiredSize := nodeGroupReqInfo.DesiredNodeSize
+	nodePool := ackNodePool{
+		NodePoolInfo: &ackNodePoolInfo{
+			Name: nodeGroupReqInfo.Name,
+		},
+		ScalingGroup: &ackScalingGroup{
+			VSwitchIds:         strings.Split(cluster.VSwitchId, ","),
+			InstanceTypes:      []string{nodeGroupReqInfo.VMSpecName},
+			ImageId:            nodeGroupReqInfo.ImageName,
+			SystemDiskCategory: CBClusterDiskCategory,
+			KeyPair:            nodeGroupReqInfo.KeyPairName,
+			SecurityGroupId:    cluster.SecurityGroupId,
+			DesiredSize:        &desiredSize,
+		},
+	}
+	if nodeGroupReqInfo.RootDiskSize != "" {
+		diskSize, err := strconv.Atoi(nodeGroupReqInfo.RootDiskSize)
+		if err != nil {
+			return irs.NodeGroupInfo{}, errors.New("RootDiskSize 정보가 올바르지 않습니다. : " + nodeGroupReqInfo.RootDiskSize)
+		}
+		nodePool.ScalingGroup.SystemDiskSize = diskSize
+	}
+	// Auto Scaling을 사용하는 노드 풀은 desired_size를 지정할 수 없음.
+	if nodeGroupReqInfo.OnAutoScaling {
+		nodePool.ScalingGroup.DesiredSize = nil
+		nodePool.AutoScaling = &ackNodePoolScaling{
+			Enable:       true,
+			MinInstances: nodeGroupReqInfo.MinNodeSize,
+			MaxInstances: nodeGroupReqInfo.MaxNodeSize,
+		}
+	}
+
+	request := cs.CreateCreateClusterNodePoolRequest()
+	request.ClusterId = clusterID
+	if err := setJSONContent(request.RoaRequest, nodePool); err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	result, err := clusterHandler.Client.CreateClusterNodePool(request)
+	if err != nil {
+		cblogger.Errorf("노드 풀 생성 실패 : [%s] [%s] - %v", clusterID, nodeGroupReqInfo.Name, err)
+		return irs.NodeGroupInfo{}, err
+	}
+	cblogger.Infof("노드 풀 생성 요청 완료 - NodepoolId : [%s]", result.NodepoolId)
+
+	return clusterHandler.waitForNodeGroupActive(clusterID, result.NodepoolId)
+}
+
+func (clusterHandler *AlibabaClusterHandler) ListNodeGroup(clusterID string) ([]*irs.NodeGroupInfo, error) {
+	cblogger.Infof("Start ListNodeGroup : [%s]", clusterID)
+
+	request := cs.CreateDescribeClusterNodePoolsRequest()
+	request.ClusterId = clusterID
+	response, err := clusterHandler.Client.DescribeClusterNodePools(request)
+	if err != nil {
+		cblogger.Errorf("노드 풀 목록 조회 실패 : [%s] - %v", clusterID, err)
+		return nil, err
+	}
+	var result ackNodePoolList
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var nodeGroupList []*irs.NodeGroupInfo
+	for _, nodePool := range result.NodePools {
+		nodeGroupInfo, err := clusterHandler.setterNodeGroup(clusterID, nodePool)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupList = append(nodeGroupList, nodeGroupInfo)
+	}
+	return nodeGroupList, nil
+}
+
+func (clusterHandler *AlibabaClusterHandler) RemoveNodeGroup(clusterID string, nodeGroupID string) (bool, error) {
+	cblogger.Infof("Start RemoveNodeGroup : [%s] [%s]", clusterID, nodeGroupID)
+
+	request := cs.CreateDeleteClusterNodepoolRequest()
+	request.ClusterId = clusterID
+	request.NodepoolId = nodeGroupID
+	_, err := clusterHandler.Client.DeleteClusterNodepool(request)
+	if err != nil {
+		cblogger.Errorf("노드 풀 삭제 실패 : [%s] [%s] - %v", clusterID, nodeGroupID, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Min/Max가 다르면 Auto Scaling을 사용하고, 같으면 desired_size로 노드 수를 고정 함.
+func (clusterHandler *AlibabaClusterHandler) ChangeNodeGroupScaling(clusterID string, nodeGroupID string, desiredNodeSize int, minNodeSize int, maxNodeSize int) (irs.NodeGroupInfo, error) {
+	cblogger.Infof("Start ChangeNodeGroupScaling : [%s] [%s] desired[%d] min[%d] max[%d]", clusterID, nodeGroupID, desiredNodeSize, minNodeSize, maxNodeSize)
+
+	nodePool := ackNodePool{
+		ScalingGroup: &ackScalingGroup{},
+		AutoScaling: &ackNodePoolScaling{
+			Enable: false,
+		},
+	}
+	if minNodeSize != maxNodeSize {
+		nodePool.AutoScaling.Enable = true
+		nodePool.AutoScaling.MinInstances = minNodeSize
+		nodePool.AutoScaling.MaxInstances = maxNodeSize
+	} else {
+		nodePool.ScalingGroup.DesiredSize = &desiredNodeSize
+	}
+
+	request := cs.CreateModifyClusterNodePoolRequest()
+	request.ClusterId = clusterID
+	request.NodepoolId = nodeGroupID
+	if err := setJSONContent(request.RoaRequest, nodePool); err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	_, err := clusterHandler.Client.ModifyClusterNodePool(request)
+	if err != nil {
+		cblogger.Errorf("노드 풀 변경 실패 : [%s] [%s] - %v", clusterID, nodeGroupID, err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.waitForNodeGroupActive(clusterID, nodeGroupID)
+}
+
+// Control Plane의 버전을 변경 함. (노드 풀은 콘솔 또는 별도 API로 업그레이드 필요)
+func (clusterHandler *AlibabaClusterHandler) UpgradeCluster(clusterID string, newVersion string) (irs.ClusterInfo, error) {
+	cblogger.Infof("Start UpgradeCluster : [%s] [%s]", clusterID, newVersion)
+
+	request := cs.CreateUpgradeClusterRequest()
+	request.ClusterId = clusterID
+	if err := setJSONContent(request.RoaRequest, map[string]interface{}{"next_version": newVersion}); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	_, err := clusterHandler.Client.UpgradeCluster(request)
+	if err != nil {
+		cblogger.Errorf("ACK 클러스터 업그레이드 실패 : [%s] - %v", clusterID, err)
+		return irs.ClusterInfo{}, err
+	}
+
+	if err := clusterHandler.waitForClusterState(clusterID, CBClusterStateRunning); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	return clusterHandler.GetCluster(clusterID)
+}
+
+func (clusterHandler *AlibabaClusterHandler) GetKubeConfig(clusterID string) (string, error) {
+	cblogger.Infof("Start GetKubeConfig : [%s]", clusterID)
+
+	request := cs.CreateDescribeClusterUserKubeconfigRequest()
+	request.ClusterId = clusterID
+	result, err := clusterHandler.Client.DescribeClusterUserKubeconfig(request)
+	if err != nil {
+		cblogger.Errorf("kubeconfig 조회 실패 : [%s] - %v", clusterID, err)
+		return "", err
+	}
+
+	return result.Config, nil
+}
+
+func (clusterHandler *AlibabaClusterHandler) setterCluster(cluster ackCluster) (*irs.ClusterInfo, error) {
+	clusterInfo := &irs.ClusterInfo{
+		Id:          cluster.ClusterId,
+		Name:        cluster.Name,
+		Version:     cluster.CurrentVersion,
+		VPCId:       cluster.VpcId,
+		Status:      getClusterStatus(cluster.State),
+		CreatedTime: cluster.Created,
+		KeyValueList: []irs.KeyValue{
+			{Key: "State", Value: cluster.State},
+			{Key: "ClusterType", Value: cluster.ClusterType},
+		},
+	}
+	if cluster.VSwitchId != "" {
+		clusterInfo.SubnetIds = strings.Split(cluster.VSwitchId, ",")
+	}
+	if cluster.SecurityGroupId != "" {
+		clusterInfo.SecurityGroupIds = []string{cluster.SecurityGroupId}
+	}
+	// master_url은 {"api_server_endpoint":"https://...:6443", ...} 형식의 JSON 문자열
+	if cluster.MasterUrl != "" {
+		var masterUrl map[string]string
+		if err := json.Unmarshal([]byte(cluster.MasterUrl), &masterUrl); err == nil {
+			clusterInfo.Endpoint = masterUrl["api_server_endpoint"]
+		}
+	}
+
+	// 생성 중인 클러스터는 노드 풀을 조회할 수 없음.
+	if cluster.State == CBClusterStateRunning || cluster.State == "updating" || cluster.State == "scaling" {
+		nodeGroupList, err := clusterHandler.ListNodeGroup(cluster.ClusterId)
+		if err != nil {
+			return nil, err
+		}
+		for _, nodeGroupInfo := range nodeGroupList {
+			clusterInfo.NodeGroupList = append(clusterInfo.NodeGroupList, *nodeGroupInfo)
+		}
+	}
+	return clusterInfo, nil
+}
+
+func (clusterHandler *AlibabaClusterHandler) setterNodeGroup(clusterID string, nodePool ackNodePool) (*irs.NodeGroupInfo, error) {
+	nodeGroupInfo := &irs.NodeGroupInfo{}
+	if nodePool.NodePoolInfo != nil {
+		nodeGroupInfo.Id = nodePool.NodePoolInfo.NodePoolId
+		nodeGroupInfo.Name = nodePool.NodePoolInfo.Name
+	}
+	if nodePool.Status != nil {
+		nodeGroupInfo.Status = getNodeGroupStatus(nodePool.Status.State)
+		nodeGroupInfo.KeyValueList = append(nodeGroupInfo.KeyValueList, irs.KeyValue{Key: "State", Value: nodePool.Status.State})
+	}
+	if nodePool.ScalingGroup != nil {
+		if len(nodePool.ScalingGroup.InstanceTypes) > 0 {
+			nodeGroupInfo.VMSpecName = nodePool.ScalingGroup.InstanceTypes[0]
+		}
+		nodeGroupInfo.ImageName = nodePool.ScalingGroup.ImageId
+		nodeGroupInfo.KeyPairName = nodePool.ScalingGroup.KeyPair
+		if nodePool.ScalingGroup.SystemDiskSize > 0 {
+			nodeGroupInfo.RootDiskSize = strconv.Itoa(nodePool.ScalingGroup.SystemDiskSize)
+		}
+		if nodePool.ScalingGroup.DesiredSize != nil {
+			nodeGroupInfo.DesiredNodeSize = *nodePool.ScalingGroup.DesiredSize
+		}
+	}
+	if nodePool.AutoScaling != nil && nodePool.AutoScaling.Enable {
+		nodeGroupInfo.OnAutoScaling = true
+		nodeGroupInfo.MinNodeSize = nodePool.AutoScaling.MinInstances
+		nodeGroupInfo.MaxNodeSize = nodePool.AutoScaling.MaxInstances
+	}
+
+	nodes, err := clusterHandler.describeNodes(clusterID, nodeGroupInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroupInfo.Nodes = nodes
+	if nodeGroupInfo.OnAutoScaling {
+		nodeGroupInfo.DesiredNodeSize = len(nodes)
+	} else {
+		nodeGroupInfo.MinNodeSize = nodeGroupInfo.DesiredNodeSize
+		nodeGroupInfo.MaxNodeSize = nodeGroupInfo.DesiredNodeSize
+	}
+	return nodeGroupInfo, nil
+}
+
+func (clusterHandler *AlibabaClusterHandler) describeCluster(clusterID string) (ackCluster, error) {
+	request := cs.CreateDescribeClusterDetailRequest()
+	request.ClusterId = clusterID
+	response, err := clusterHandler.Client.DescribeClusterDetail(request)
+	if err != nil {
+		cblogger.Errorf("ACK 클러스터 조회 실패 : [%s] - %v", clusterID, err)
+		return ackCluster{}, err
+	}
+
+	var cluster ackCluster
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &cluster); err != nil {
+		cblogger.Error(err)
+		return ackCluster{}, err
+	}
+	return cluster, nil
+}
+
+// 노드 풀에 속한 노드의 ECS Instance ID 목록을 조회 함.
+func (clusterHandler *AlibabaClusterHandler) describeNodes(clusterID string, nodeGroupID string) ([]string, error) {
+	request := cs.CreateDescribeClusterNodesRequest()
+	request.ClusterId = clusterID
+	request.NodepoolId = nodeGroupID
+	request.PageSize = CBClusterNodesPageSize
+	result, err := clusterHandler.Client.DescribeClusterNodes(request)
+	if err != nil {
+		cblogger.Errorf("노드 목록 조회 실패 : [%s] [%s] - %v", clusterID, nodeGroupID, err)
+		return nil, err
+	}
+
+	var nodes []string
+	for _, node := range result.Nodes {
+		nodes = append(nodes, node.InstanceId)
+	}
+	return nodes, nil
+}
+
+// 클러스터의 상태가 state가 될 때까지 대기 함.
+func (clusterHandler *AlibabaClusterHandler) waitForClusterState(clusterID string, state string) error {
+	for i := 0; ; i++ {
+		cluster, err := clusterHandler.describeCluster(clusterID)
+		if err != nil {
+			return err
+		}
+		cblogger.Infof("==> ACK 클러스터 [%s] 상태 : [%s]", clusterID, cluster.State)
+		if cluster.State == state {
+			return nil
+		}
+		if cluster.State == "failed" {
+			return errors.New("ACK 클러스터[" + clusterID + "]의 작업이 실패했습니다.")
+		}
+		if i >= CBClusterWaitTime/CBClusterCheckTime {
+			return errors.New("ACK 클러스터[" + clusterID + "]가 " + state + " 상태가 되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBClusterCheckTime)
+	}
+}
+
+// 노드 풀이 active 상태가 될 때까지 대기 함.
+func (clusterHandler *AlibabaClusterHandler) waitForNodeGroupActive(clusterID string, nodeGroupID string) (irs.NodeGroupInfo, error) {
+	for i := 0; ; i++ {
+		nodeGroupList, err := clusterHandler.ListNodeGroup(clusterID)
+		if err != nil {
+			return irs.NodeGroupInfo{}, err
+		}
+		for _, nodeGroupInfo := range nodeGroupList {
+			if nodeGroupInfo.Id != nodeGroupID {
+				continue
+			}
+			if nodeGroupInfo.Status == irs.ClusterActive {
+				return *nodeGroupInfo, nil
+			}
+			if nodeGroupInfo.Status == irs.ClusterFailed {
+				return irs.NodeGroupInfo{}, errors.New("노드 풀[" + nodeGroupID + "]의 작업이 실패했습니다.")
+			}
+		}
+		if i >= CBClusterWaitTime/CBClusterCheckTime {
+			return irs.NodeGroupInfo{}, errors.New("노드 풀[" + nodeGroupID + "]이 active 상태가 되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBClusterCheckTime)
+	}
+}
+
+// ROA 요청의 Body를 JSON으로 설정 함.
+func setJSONContent(request *requests.RoaRequest, body interface{}) error {
+	content, err := json.Marshal(body)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	request.SetContent(content)
+	request.SetContentType(CBClusterContentType)
+	return nil
+}
+
+func getClusterStatus(state string) irs.ClusterStatus {
+	switch state {
+	case "initial":
+		return irs.ClusterCreating
+	case "running":
+		return irs.ClusterActive
+	case "updating", "upgrading", "scaling", "waiting":
+		return irs.ClusterUpdating
+	case "deleting", "removing":
+		return irs.ClusterDeleting
+	default:
+		return irs.ClusterFailed
+	}
+}
+
+func getNodeGroupStatus(state string) irs.ClusterStatus {
+	switch state {
+	case "initial":
+		return irs.ClusterCreating
+	case "active":
+		return irs.ClusterActive
+	case "updating", "scaling":
+		return irs.ClusterUpdating
+	case "deleting", "removing":
+		return irs.ClusterDeleting
+	default:
+		return irs.ClusterFailed
+	}
+}
@@ -0,0 +1,382 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBDNSDefaultTTL     = 600 // seconds, Alibaba Cloud DNS 무료 버전의 최소 TTL
+	CBDNSPageSize       = 100 // max 100
+	CBDNSWaitTime       = 120 // seconds
+	CBDNSCheckTime      = 2   // seconds
+	CBDNSRecordEnable   = "ENABLE"
+	CBDNSChangeIdSplit  = ","
+	CBDNSRecordLineType = "default"
+)
+
+// Alibaba Cloud DNS의 Domain을 DNS Zone으로 처리 함. (Zone ID = 도메인 이름)
+// Alibaba Cloud DNS는 값 하나가 레코드 하나(RecordId)이므로 같은 RR + Type의 레코드들을 하나의 Record Set으로 묶어서 처리 하며,
+// Change ID는 변경된 레코드들의 RecordId를 ','로 연결한 값을 사용 함.
+type AlibabaDNSHandler struct {
+	Region idrv.RegionInfo
+	Client *alidns.Client
+}
+
+func (dnsHandler *AlibabaDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	cblogger.Info("Start CreateZone : ", zoneReqInfo)
+
+	domainName := strings.TrimSuffix(zoneReqInfo.Name, ".")
+
+	request := alidns.CreateAddDomainRequest()
+	request.Scheme = "https"
+	request.DomainName = domainName
+
+	result, err := dnsHandler.Client.AddDomain(request)
+	if err != nil {
+		cblogger.Errorf("Unable to add Domain: %s, %v.", domainName, err)
+		return irs.DNSZoneInfo{}, err
+	}
+	cblogger.Infof("Created Domain %q %s", result.DomainId, result.DomainName)
+
+	if zoneReqInfo.Description != "" {
+		remarkRequest := alidns.CreateUpdateDomainRemarkRequest()
+		remarkRequest.Scheme = "https"
+		remarkRequest.DomainName = result.DomainName
+		remarkRequest.Remark = zoneReqInfo.Description
+
+		_, err := dnsHandler.Client.UpdateDomainRemark(remarkRequest)
+		if err != nil {
+			cblogger.Errorf("Unable to update Domain Remark: %s, %v.", result.DomainName, err)
+			return irs.DNSZoneInfo{}, err
+		}
+	}
+
+	return dnsHandler.GetZone(result.DomainName)
+}
+
+func (dnsHandler *AlibabaDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	cblogger.Debug("Start")
+
+	request := alidns.CreateDescribeDomainsRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(CBDNSPageSize)
+
+	var zoneInfoList []*irs.DNSZoneInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := dnsHandler.Client.DescribeDomains(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get Domains, %v", err)
+			return nil, err
+		}
+		for _, domain := range result.Domains.Domain {
+			zoneInfoList = append(zoneInfoList, &irs.DNSZoneInfo{
+				Id:          domain.DomainName,
+				Name:        domain.DomainName,
+				Description: domain.Remark,
+				NameServers: domain.DnsServers.DnsServer,
+				KeyValueList: []irs.KeyValue{
+					{Key: "DomainId", Value: domain.DomainId},
+					{Key: "VersionCode", Value: domain.VersionCode},
+					{Key: "CreateTime", Value: domain.CreateTime},
+				},
+			})
+		}
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return zoneInfoList, nil
+}
+
+func (dnsHandler *AlibabaDNSHandler) GetZone(zoneID string) (irs.DNSZoneInfo, error) {
+	cblogger.Infof("zoneID : [%s]", zoneID)
+
+	request := alidns.CreateDescribeDomainInfoRequest()
+	request.Scheme = "https"
+	request.DomainName = zoneID
+
+	result, err := dnsHandler.Client.DescribeDomainInfo(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get Domain: %s, %v.", zoneID, err)
+		return irs.DNSZoneInfo{}, err
+	}
+
+	zoneInfo := irs.DNSZoneInfo{
+		Id:          result.DomainName,
+		Name:        result.DomainName,
+		Description: result.Remark,
+		NameServers: result.DnsServers.DnsServer,
+		KeyValueList: []irs.KeyValue{
+			{Key: "DomainId", Value: result.DomainId},
+			{Key: "VersionCode", Value: result.VersionCode},
+			{Key: "CreateTime", Value: result.CreateTime},
+		},
+	}
+	return zoneInfo, nil
+}
+
+// Domain을 삭제하면 Domain의 모든 레코드도 함께 삭제됨
+func (dnsHandler *AlibabaDNSHandler) DeleteZone(zoneID string) (bool, error) {
+	cblogger.Infof("zoneID : [%s]", zoneID)
+
+	request := alidns.CreateDeleteDomainRequest()
+	request.Scheme = "https"
+	request.DomainName = zoneID
+
+	_, err := dnsHandler.Client.DeleteDomain(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete Domain: %s, %v.", zoneID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *AlibabaDNSHandler) CreateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	cblogger.Info("Start CreateRecord : ", recordReqInfo)
+
+	if err := checkDNSRecordReqInfo(recordReqInfo); err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	recordList, err := dnsHandler.describeDomainRecords(zoneID, getDNSRecordRR(recordReqInfo.Name), recordReqInfo.Type)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	if len(recordList) > 0 {
+		return irs.DNSRecordInfo{}, errors.New("이미 존재하는 레코드입니다. : " + recordReqInfo.Name + " " + string(recordReqInfo.Type))
+	}
+
+	return dnsHandler.addDomainRecords(zoneID, recordReqInfo)
+}
+
+func (dnsHandler *AlibabaDNSHandler) ListRecord(zoneID string) ([]*irs.DNSRecordInfo, error) {
+	cblogger.Infof("zoneID : [%s]", zoneID)
+
+	recordList, err := dnsHandler.describeDomainRecords(zoneID, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	// RR + Type 별로 Record Set을 구성 (조회 순서 유지)
+	var recordInfoList []*irs.DNSRecordInfo
+	recordSetMap := map[string]*irs.DNSRecordInfo{}
+	for _, record := range recordList {
+		switch irs.DNSRecordType(record.Type) {
+		case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordCNAME, irs.DNSRecordTXT:
+		default:
+			continue
+		}
+		key := record.RR + " " + record.Type
+		recordInfo, ok := recordSetMap[key]
+		if !ok {
+			recordInfo = &irs.DNSRecordInfo{
+				Name: record.RR,
+				Type: irs.DNSRecordType(record.Type),
+				TTL:  record.TTL,
+			}
+			recordSetMap[key] = recordInfo
+			recordInfoList = append(recordInfoList, recordInfo)
+		}
+		appendDomainRecord(recordInfo, record)
+	}
+	return recordInfoList, nil
+}
+
+// 기존 레코드들을 삭제하고 요청된 값으로 레코드들을 다시 추가 함.
+func (dnsHandler *AlibabaDNSHandler) UpdateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	cblogger.Info("Start UpdateRecord : ", recordReqInfo)
+
+	if err := checkDNSRecordReqInfo(recordReqInfo); err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	if _, err := dnsHandler.DeleteRecord(zoneID, recordReqInfo.Name, recordReqInfo.Type); err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	return dnsHandler.addDomainRecords(zoneID, recordReqInfo)
+}
+
+func (dnsHandler *AlibabaDNSHandler) DeleteRecord(zoneID string, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	cblogger.Infof("zoneID : [%s], recordName : [%s], recordType : [%s]", zoneID, recordName, recordType)
+
+	rr := getDNSRecordRR(recordName)
+	recordList, err := dnsHandler.describeDomainRecords(zoneID, rr, recordType)
+	if err != nil {
+		return false, err
+	}
+	if len(recordList) == 0 {
+		return false, errors.New("존재하지 않는 레코드입니다. : " + recordName + " " + string(recordType))
+	}
+
+	request := alidns.CreateDeleteSubDomainRecordsRequest()
+	request.Scheme = "https"
+	request.DomainName = zoneID
+	request.RR = rr
+	request.Type = string(recordType)
+
+	_, err = dnsHandler.Client.DeleteSubDomainRecords(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete Domain Records: %s %s, %v.", rr, recordType, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// 변경된 레코드들이 모두 ENABLE 상태가 될 때까지 대기
+func (dnsHandler *AlibabaDNSHandler) WaitForDNSChange(zoneID string, changeID string) (irs.DNSChangeStatus, error) {
+	cblogger.Infof("zoneID : [%s], changeID : [%s]", zoneID, changeID)
+
+	for _, recordId := range strings.Split(changeID, CBDNSChangeIdSplit) {
+		request := alidns.CreateDescribeDomainRecordInfoRequest()
+		request.Scheme = "https"
+		request.RecordId = recordId
+
+		for i := 0; ; i++ {
+			result, err := dnsHandler.Client.DescribeDomainRecordInfo(request)
+			if err != nil {
+				cblogger.Errorf("Unable to get Domain Record: %s, %v.", recordId, err)
+				return irs.DNSChangePending, err
+			}
+			if result.Status == CBDNSRecordEnable {
+				break
+			}
+			if i >= CBDNSWaitTime/CBDNSCheckTime {
+				return irs.DNSChangePending, errors.New("레코드[" + recordId + "]가 " + CBDNSRecordEnable + " 상태가 되지 않았습니다.")
+			}
+			time.Sleep(time.Second * CBDNSCheckTime)
+		}
+	}
+	return irs.DNSChangeInSync, nil
+}
+
+func (dnsHandler *AlibabaDNSHandler) addDomainRecords(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	ttl := recordReqInfo.TTL
+	if ttl == 0 {
+		ttl = CBDNSDefaultTTL
+	}
+
+	recordInfo := irs.DNSRecordInfo{
+		Name: getDNSRecordRR(recordReqInfo.Name),
+		Type: recordReqInfo.Type,
+		TTL:  ttl,
+	}
+	var recordIdList []string
+	for _, value := range recordReqInfo.Values {
+		request := alidns.CreateAddDomainRecordRequest()
+		request.Scheme = "https"
+		request.DomainName = zoneID
+		request.RR = recordInfo.Name
+		request.Type = string(recordReqInfo.Type)
+		request.Value = value
+		request.Line = CBDNSRecordLineType
+		request.TTL = requests.NewInteger(int(ttl))
+
+		result, err := dnsHandler.Client.AddDomainRecord(request)
+		if err != nil {
+			cblogger.Errorf("Unable to add Domain Record: %s %s %s, %v.", recordInfo.Name, recordReqInfo.Type, value, err)
+			return irs.DNSRecordInfo{}, err
+		}
+		recordInfo.Values = append(recordInfo.Values, value)
+		recordIdList = append(recordIdList, result.RecordId)
+	}
+	recordInfo.ChangeId = strings.Join(recordIdList, CBDNSChangeIdSplit)
+	recordInfo.ChangeStatus = irs.DNSChangePending
+	recordInfo.KeyValueList = []irs.KeyValue{{Key: "RecordId", Value: recordInfo.ChangeId}}
+	return recordInfo, nil
+}
+
+// rr, recordType이 빈 값이면 전체 레코드를 조회 함.
+func (dnsHandler *AlibabaDNSHandler) describeDomainRecords(zoneID string, rr string, recordType irs.DNSRecordType) ([]alidns.Record, error) {
+	request := alidns.CreateDescribeDomainRecordsRequest()
+	request.Scheme = "https"
+	request.DomainName = zoneID
+	request.PageSize = requests.NewInteger(CBDNSPageSize)
+	if rr != "" {
+		request.RRKeyWord = rr
+		request.SearchMode = "EXACT"
+	}
+	if recordType != "" {
+		request.Type = string(recordType)
+	}
+
+	var recordList []alidns.Record
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := dnsHandler.Client.DescribeDomainRecords(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get Domain Records: %s, %v.", zoneID, err)
+			return nil, err
+		}
+		for _, record := range result.DomainRecords.Record {
+			// RRKeyWord는 부분 일치 검색도 허용하므로 RR을 다시 확인
+			if rr != "" && record.RR != rr {
+				continue
+			}
+			recordList = append(recordList, record)
+		}
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return recordList, nil
+}
+
+func appendDomainRecord(recordInfo *irs.DNSRecordInfo, record alidns.Record) {
+	recordInfo.Values = append(recordInfo.Values, record.Value)
+	if recordInfo.ChangeId == "" {
+		recordInfo.ChangeId = record.RecordId
+	} else {
+		recordInfo.ChangeId = recordInfo.ChangeId + CBDNSChangeIdSplit + record.RecordId
+	}
+	if record.Status == CBDNSRecordEnable && recordInfo.ChangeStatus != irs.DNSChangePending {
+		recordInfo.ChangeStatus = irs.DNSChangeInSync
+	} else {
+		recordInfo.ChangeStatus = irs.DNSChangePending
+	}
+	recordInfo.KeyValueList = []irs.KeyValue{{Key: "RecordId", Value: recordInfo.ChangeId}}
+}
+
+func checkDNSRecordReqInfo(recordReqInfo irs.DNSRecordReqInfo) error {
+	if len(recordReqInfo.Values) == 0 {
+		return errors.New("레코드 값(Values) 정보가 필요합니다.")
+	}
+	switch recordReqInfo.Type {
+	case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordTXT:
+	case irs.DNSRecordCNAME:
+		if len(recordReqInfo.Values) != 1 {
+			return errors.New("CNAME 레코드는 하나의 값만 가질 수 있습니다.")
+		}
+	default:
+		return errors.New("지원하지 않는 레코드 Type입니다. : " + string(recordReqInfo.Type))
+	}
+	return nil
+}
+
+// Zone 자체의 레코드는 RR이 "@"
+func getDNSRecordRR(recordName string) string {
+	if recordName == "" {
+		return irs.DNSZoneApex
+	}
+	return recordName
+}
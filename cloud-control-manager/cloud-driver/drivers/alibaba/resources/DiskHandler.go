@@ -0,0 +1,262 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBDiskWaitTime  = 300 // seconds
+	CBDiskCheckTime = 3   // seconds
+	CBDiskPageSize  = 50  // max 100
+)
+
+type AlibabaDiskHandler struct {
+	Region idrv.RegionInfo
+	Client *ecs.Client
+}
+
+// Zone 정보가 없으면 연결 정보의 Zone에 생성 함.
+func (diskHandler *AlibabaDiskHandler) CreateDisk(diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	cblogger.Info("Start CreateDisk : ", diskReqInfo)
+
+	if err := checkUserTagList(diskReqInfo.TagList); err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	request := ecs.CreateCreateDiskRequest()
+	request.Scheme = "https"
+
+	request.DiskName = diskReqInfo.Name
+	request.Size = requests.Integer(diskReqInfo.DiskSize)
+	request.DiskCategory = diskReqInfo.DiskType // ex) cloud_efficiency, cloud_ssd, "": cloud
+	request.ZoneId = diskReqInfo.Zone
+	if request.ZoneId == "" {
+		request.ZoneId = diskHandler.Region.Zone
+	}
+	if len(diskReqInfo.TagList) > 0 {
+		var tags []ecs.CreateDiskTag
+		for _, tag := range diskReqInfo.TagList {
+			tags = append(tags, ecs.CreateDiskTag{Key: tag.Key, Value: tag.Value})
+		}
+		request.Tag = &tags
+	}
+
+	result, err := diskHandler.Client.CreateDisk(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create Disk: %s, %v.", diskReqInfo.Name, err)
+		return irs.DiskInfo{}, err
+	}
+	cblogger.Infof("Created Disk %q %s", result.DiskId, diskReqInfo.Name)
+
+	disk, err := diskHandler.waitForStatus(result.DiskId, "Available")
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return ExtractDiskInfo(disk), nil
+}
+
+func (diskHandler *AlibabaDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
+	cblogger.Debug("Start")
+
+	var diskInfoList []*irs.DiskInfo
+	for pageNumber := 1; ; pageNumber++ {
+		request := ecs.CreateDescribeDisksRequest()
+		request.Scheme = "https"
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBDiskPageSize)
+
+		result, err := diskHandler.Client.DescribeDisks(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get Disks, %v", err)
+			return nil, err
+		}
+		for _, disk := range result.Disks.Disk {
+			diskInfo := ExtractDiskInfo(disk)
+			diskInfoList = append(diskInfoList, &diskInfo)
+		}
+		if pageNumber*CBDiskPageSize >= result.TotalCount {
+			break
+		}
+	}
+	return diskInfoList, nil
+}
+
+func (diskHandler *AlibabaDiskHandler) GetDisk(diskID string) (irs.DiskInfo, error) {
+	cblogger.Infof("diskID : [%s]", diskID)
+
+	disk, err := diskHandler.describeDisk(diskID)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return ExtractDiskInfo(disk), nil
+}
+
+// 연결된 VM이 실행 중이면 online, 아니면 offline(VM 재시작 후 적용)으로 변경 함.
+func (diskHandler *AlibabaDiskHandler) ChangeDiskSize(diskID string, size string) (bool, error) {
+	cblogger.Infof("diskID : [%s], size : [%s]", diskID, size)
+
+	disk, err := diskHandler.describeDisk(diskID)
+	if err != nil {
+		return false, err
+	}
+
+	request := ecs.CreateResizeDiskRequest()
+	request.Scheme = "https"
+	request.DiskId = diskID
+	request.NewSize = requests.Integer(size)
+	if disk.Status == "In_use" {
+		request.Type = "online"
+	} else {
+		request.Type = "offline"
+	}
+
+	_, err = diskHandler.Client.ResizeDisk(request)
+	if err != nil {
+		cblogger.Errorf("Unable to resize Disk: %s, %v.", diskID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AlibabaDiskHandler) DeleteDisk(diskID string) (bool, error) {
+	cblogger.Infof("diskID : [%s]", diskID)
+
+	request := ecs.CreateDeleteDiskRequest()
+	request.Scheme = "https"
+	request.DiskId = diskID
+
+	_, err := diskHandler.Client.DeleteDisk(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete Disk: %s, %v.", diskID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AlibabaDiskHandler) AttachDisk(diskID string, vmID string) (irs.DiskInfo, error) {
+	cblogger.Infof("diskID : [%s], vmID : [%s]", diskID, vmID)
+
+	request := ecs.CreateAttachDiskRequest()
+	request.Scheme = "https"
+	request.DiskId = diskID
+	request.InstanceId = vmID
+
+	_, err := diskHandler.Client.AttachDisk(request)
+	if err != nil {
+		cblogger.Errorf("Unable to attach Disk: %s, %v.", diskID, err)
+		return irs.DiskInfo{}, err
+	}
+
+	disk, err := diskHandler.waitForStatus(diskID, "In_use")
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return ExtractDiskInfo(disk), nil
+}
+
+func (diskHandler *AlibabaDiskHandler) DetachDisk(diskID string, vmID string) (bool, error) {
+	cblogger.Infof("diskID : [%s], vmID : [%s]", diskID, vmID)
+
+	request := ecs.CreateDetachDiskRequest()
+	request.Scheme = "https"
+	request.DiskId = diskID
+	request.InstanceId = vmID
+
+	_, err := diskHandler.Client.DetachDisk(request)
+	if err != nil {
+		cblogger.Errorf("Unable to detach Disk: %s, %v.", diskID, err)
+		return false, err
+	}
+
+	if _, err := diskHandler.waitForStatus(diskID, "Available"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AlibabaDiskHandler) describeDisk(diskID string) (ecs.Disk, error) {
+	request := ecs.CreateDescribeDisksRequest()
+	request.Scheme = "https"
+	request.DiskIds = "[\"" + diskID + "\"]"
+
+	result, err := diskHandler.Client.DescribeDisks(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get Disk: %s, %v.", diskID, err)
+		return ecs.Disk{}, err
+	}
+	if len(result.Disks.Disk) < 1 {
+		return ecs.Disk{}, errors.New("Disk[" + diskID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.Disks.Disk[0], nil
+}
+
+// Disk의 상태가 status가 될 때까지 대기 함.
+func (diskHandler *AlibabaDiskHandler) waitForStatus(diskID string, status string) (ecs.Disk, error) {
+	for i := 0; i < CBDiskWaitTime/CBDiskCheckTime; i++ {
+		disk, err := diskHandler.describeDisk(diskID)
+		if err != nil {
+			return ecs.Disk{}, err
+		}
+		if disk.Status == status {
+			return disk, nil
+		}
+		time.Sleep(time.Second * CBDiskCheckTime)
+	}
+	return ecs.Disk{}, errors.New("Disk[" + diskID + "]가 " + status + " 상태가 되지 않았습니다.")
+}
+
+// Disk에서 DiskInfo 정보를 추출함
+func ExtractDiskInfo(disk ecs.Disk) irs.DiskInfo {
+	diskInfo := irs.DiskInfo{
+		Id:        disk.DiskId,
+		Name:      disk.DiskName,
+		DiskType:  disk.Category,
+		DiskSize:  strconv.Itoa(disk.Size),
+		Zone:      disk.ZoneId,
+		OwnerVMId: disk.InstanceId,
+	}
+	// ex) 2019-11-20T08:01Z
+	if createdTime, err := time.Parse("2006-01-02T15:04Z", disk.CreationTime); err == nil {
+		diskInfo.CreatedTime = createdTime
+	}
+
+	// Status : In_use | Available | Attaching | Detaching | Creating | ReIniting
+	switch disk.Status {
+	case "Creating", "ReIniting":
+		diskInfo.Status = irs.DiskCreating
+	case "Available", "Detaching":
+		diskInfo.Status = irs.DiskAvailable
+	case "In_use", "Attaching":
+		diskInfo.Status = irs.DiskAttached
+	default:
+		diskInfo.Status = irs.DiskError
+	}
+
+	diskInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Status", Value: disk.Status},
+		{Key: "Type", Value: disk.Type}, // system | data
+		{Key: "Device", Value: disk.Device},
+		{Key: "DiskChargeType", Value: disk.DiskChargeType},
+	}
+	if disk.SourceSnapshotId != "" {
+		diskInfo.KeyValueList = append(diskInfo.KeyValueList, irs.KeyValue{Key: "SourceSnapshotId", Value: disk.SourceSnapshotId})
+	}
+	return diskInfo
+}
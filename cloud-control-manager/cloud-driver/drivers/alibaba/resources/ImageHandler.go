@@ -11,14 +11,15 @@
 package resources
 
 import (
-	"github.com/davecgh/go-spew/spew"
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
-	"reflect"
+	"errors"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
-	"strings"
 )
 
 type AlibabaImageHandler struct {
@@ -26,183 +27,189 @@ type AlibabaImageHandler struct {
 	Client *ecs.Client
 }
 
+// 생성 완료를 기다리지 않으므로 GetImage로 상태(Progress)를 확인해야 함.
 func (imageHandler *AlibabaImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
 	cblogger.Info("Start CreateImage : ", imageReqInfo)
-	imageIdArr := strings.Split(imageReqInfo.Id, ":")
-	
+
 	request := ecs.CreateCreateImageRequest()
 	request.Scheme = "https"
-	
+
 	// 필수 Req Name
 	request.ImageName = imageReqInfo.Name // ImageName
+	request.Description = imageReqInfo.Description
 	request.Tag = &[]ecs.CreateImageTag{ // Default Hidden Tags Info
 		{
-		  Key: CBMetaDefaultTagName, // "cbCat",
-		  Value: CBMetaDefaultTagValue, // "cbAlibaba",
+			Key:   CBMetaDefaultTagName,  // "cbCat",
+			Value: CBMetaDefaultTagValue, // "cbAlibaba",
 		},
-	  }
+	}
 
 	// 요청 매개 변수의 우선 순위는 InstanceId, DiskDeviceMapping, SnapshotId 순서
-
-	// Case1 - 인스턴스 ID (InstanceId)를 지정하여 사용자 지정 이미지를 생성
-	// 향후 추가를 고려, for create Case 1 (InstanceId)
-	// request.InstanceId = imageReqInfo.InstanceId // "i-t4n98732cvvbbhhbsd4r"
-
-	// >>>> Case2 - 시스템 디스크 또는 스냅 샷 (SnapshotId) 중 하나를 지정하여 사용자 정의 이미지를 생성
-	// for create Case 2 (SnapshotId)
-	request.SnapshotId = imageReqInfo.Id // SnapshotId
+	if imageReqInfo.SourceVMId != "" {
+		// Case1 - 인스턴스 ID (InstanceId)를 지정하여 사용자 지정 이미지를 생성 (실행 중인 인스턴스도 가능)
+		request.InstanceId = imageReqInfo.SourceVMId // "i-t4n98732cvvbbhhbsd4r"
+	} else {
+		// Case2 - 시스템 디스크의 스냅 샷 (SnapshotId)을 지정하여 사용자 정의 이미지를 생성
+		request.SnapshotId = imageReqInfo.Id // SnapshotId
+	}
 
 	// Case3 - 여러 디스크의 스냅 샷을 이미지 템플릿으로 결합하려는 경우 DiskDeviceMapping을 지정하여 사용자 지정 이미지를 만들 수 있습니다.
 	// 향후 추가를 고려, for create Case 3 (DiskDeviceMapping)
-	// request.DiskDeviceMapping = &[]ecs.CreateImageDiskDeviceMapping{
-	// 	{
-	// 	  Size: imageReqInfo.DiskDevice[0].Size, // "20",
-	// 	  SnapshotId: imageReqInfo.DiskDevice[0].Id, // "s-t4nhjof9caedzwd4929k",
-	// 	  Device: imageReqInfo.DiskDevice[0].Device, // "/dev/xvda",
-	// 	  DiskType: imageReqInfo.DiskDevice[0].DiskType, // "system",
-	// 	},
-	// 	{
-	// 	  Size: imageReqInfo.DiskDevice[1].Size, // "20",
-	// 	  SnapshotId: imageReqInfo.DiskDevice[1].Id, // "s-t4nhjof9caedzwd4929l",
-	// 	  Device: imageReqInfo.DiskDevice[1].Device, // "/dev/xvdb",
-	// 	  DiskType: imageReqInfo.DiskDevice[1].DiskType, // "data",
-	// 	},
-	//   }
-
-	// 추가 옵션 Req
-	// request.Description = imageReqInfo.Description // "cb custom img01"
-	// request.Platform = imageReqInfo.Platform // "Ubuntu"
-	// request.Architecture = imageReqInfo.Architecture // "x86_64"
-	// request.OSType = imageReqInfo.OSType // OSType "linux"
-
-	// Check Image Exists
-	
 
 	// Creates a new custom Image with the given name
 	result, err := imageHandler.Client.CreateImage(request)
 	if err != nil {
-		// if aerr, ok := err.(errors.Error); ok && aerr.Code() == "InvalidKeyPair.Duplicate" {
-		// 	cblogger.Errorf("Image %q already exists.", imageReqInfo.ImageName)
-		// 	return irs.ImageReqInfo{}, err
-		// }
 		cblogger.Errorf("Unable to create Image: %s, %v.", imageReqInfo.Name, err)
 		return irs.ImageInfo{}, err
 	}
-
-	cblogger.Infof("Created Image %q %s\n %s\n", result.ImageId, imageReqInfo.Name, result.RequestId)
-	spew.Dump(result)
-	
-	ImageInfo := irs.ImageInfo{
-		Id:          *result.ImageId,
-		Name:        *imageReqInfo.ImageName,
-	}
+	cblogger.Infof("Created Image %q %s", result.ImageId, imageReqInfo.Name)
 
 	// 생성된 Image 정보 획득 후, Image 정보 리턴
-	imageInfo, err := imageHandler.GetImage(imageReqInfo.Name)
-	if err != nil {
-		return irs.ImageInfo{}, err
-	}
-
-	return imageInfo, nil
+	return imageHandler.GetImage(result.ImageId)
 }
 
 func (imageHandler *AlibabaImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 	cblogger.Debug("Start")
-	var imageInfoList []*irs.ImageInfo
 
-    request := ecs.CreateDescribeImagesRequest()
+	request := ecs.CreateDescribeImagesRequest()
 	request.Scheme = "https"
-	
 	request.Status = "Available"
 	request.ActionType = "*"
-	if CBPageOn {
-		request.PageNumber = requests.NewInteger(CBPageNumber)
-		request.PageSize = requests.NewInteger(CBPageSize)
-	}
 
-	result, err := imageHandler.Client.DescribeImages(request)
-	//spew.Dump(result)	//출력 정보가 너무 많아서 생략
-	if err != nil {
-		// if aerr, ok := err.(errors.Error); ok {
-		// 	switch aerr.Code() {
-		// 	default:
-		// 		cblogger.Error(aerr.Error())
-		// 	}
-		// } else {
-		// 	// Print the error, cast err to awserr.Error to get the Code and
-		// 	// Message from an error.
-		// 	cblogger.Error(err.Error())
-		// }
-		cblogger.Errorf("Unable to get Images, %v", err)
-		return nil, err
+	return imageHandler.describeImages(request)
+}
+
+// 계정이 소유한 사용자 지정 이미지만 조회 함. (생성 중인 이미지 포함)
+func (imageHandler *AlibabaImageHandler) ListMyImage() ([]*irs.ImageInfo, error) {
+	cblogger.Debug("Start")
+
+	request := ecs.CreateDescribeImagesRequest()
+	request.Scheme = "https"
+	request.ImageOwnerAlias = "self"
+	request.Status = "Creating,Waiting,Available,UnAvailable,CreateFailed"
+
+	return imageHandler.describeImages(request)
+}
+
+// 필터를 DescribeImages의 요청 파라미터로 변환하여 조회 함.
+// ImageName은 부분 일치 검색이므로 OS 정보로 이미지 이름의 앞 부분을 지정 함. ex) ubuntu_22_04_x64_20G_alibase_20221011.vhd
+func (imageHandler *AlibabaImageHandler) ListImageByFilter(filterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	cblogger.Infof("filterInfo : [%v]", filterInfo)
+
+	request := ecs.CreateDescribeImagesRequest()
+	request.Scheme = "https"
+	request.Status = "Available"
+	request.ActionType = "*"
+
+	osFamily := strings.ToLower(filterInfo.OSFamily)
+	version := strings.Replace(filterInfo.OSVersion, ".", "_", -1)
+	switch osFamily {
+	case "":
+	case "windows":
+		// ex) win2019_1809_x64_dtc_en-us_40G_alibase_20221012.vhd
+		request.OSType = "windows"
+		request.ImageName = "win" + version
+	default:
+		request.OSType = "linux"
+		request.ImageName = osFamily
+		if version != "" {
+			request.ImageName += "_" + version
+		}
 	}
 
-	//cnt := 0
-	for _, cur := range result.Images.Image {
-		cblogger.Infof("[%s] Image 정보 처리", *cur.ImageId)
-		imageInfo := ExtractImageDescribeInfo(cur)
-		imageInfoList = append(imageInfoList, &imageInfo)
-		/*
-			cnt++
-			if cnt > 20 {
-				break
-			}
-		*/
+	// ImageOwnerAlias : system | self | others | marketplace
+	request.ImageOwnerAlias = filterInfo.Owner
+	// Architecture : i386 | x86_64 | arm64
+	request.Architecture = strings.ToLower(filterInfo.Architecture)
+
+	// 생성 기간 : yyyy-MM-ddTHH:mmZ (UTC)
+	var filters []ecs.DescribeImagesFilter
+	if createdTime, err := time.Parse(time.RFC3339, filterInfo.CreatedAfter); err == nil {
+		filters = append(filters, ecs.DescribeImagesFilter{Key: "CreationStartTime", Value: createdTime.UTC().Format("2006-01-02T15:04Z")})
+	}
+	if createdTime, err := time.Parse(time.RFC3339, filterInfo.CreatedBefore); err == nil {
+		filters = append(filters, ecs.DescribeImagesFilter{Key: "CreationEndTime", Value: createdTime.UTC().Format("2006-01-02T15:04Z")})
+	}
+	if len(filters) > 0 {
+		request.Filter = &filters
 	}
 
-	//spew.Dump(imageInfoList)
+	return imageHandler.describeImages(request)
+}
+
+func (imageHandler *AlibabaImageHandler) describeImages(request *ecs.DescribeImagesRequest) ([]*irs.ImageInfo, error) {
+	var imageInfoList []*irs.ImageInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBPageSize)
+
+		result, err := imageHandler.Client.DescribeImages(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get Images, %v", err)
+			return nil, err
+		}
+		for _, cur := range result.Images.Image {
+			imageInfo := ExtractImageDescribeInfo(cur)
+			imageInfoList = append(imageInfoList, &imageInfo)
+		}
+
+		// CBPageOn이 false이면 첫 페이지만 조회 함.
+		if !CBPageOn || pageNumber*CBPageSize >= result.TotalCount {
+			break
+		}
+	}
 	return imageInfoList, nil
 }
 
 //Image 정보를 추출함
-func ExtractImageDescribeInfo(image *ecs.Image) irs.ImageInfo {
-	//spew.Dump(image)
+func ExtractImageDescribeInfo(image ecs.Image) irs.ImageInfo {
 	imageInfo := irs.ImageInfo{
-		Id:     *image.ImageId,
-		Name:   *image.ImageName,
-		Status: *image.Status,
+		Id:      image.ImageId,
+		Name:    image.ImageName,
+		GuestOS: image.OSNameEn,
+	}
+
+	// Status : Creating | Waiting | Available | UnAvailable | CreateFailed
+	switch image.Status {
+	case "Creating", "Waiting":
+		imageInfo.Status = irs.ImageCreating
+	case "Available":
+		imageInfo.Status = irs.ImageAvailable
+	default:
+		imageInfo.Status = irs.ImageUnavailable
 	}
 
 	keyValueList := []irs.KeyValue{
-		{Key: "CreationTime", Value: *image.CreationTime},
-		{Key: "Architecture", Value: *image.Architecture},
-
-		{Key: "OSNameEn", Value: *image.OSNameEn},
-		{Key: "ProductCode", Value: *image.ProductCode},
-		{Key: "OSType", Value: *image.OSType},
-		{Key: "OSName", Value: *image.OSName},
-		{Key: "Progress", Value: *image.Progress},
-		{Key: "IsSupportCloudinit", Value: strconv.FormatBool(*image.IsSupportCloudinit)},
-		{Key: "Usage", Value: *image.Usage},
-		{Key: "ImageVersion", Value: *image.ImageVersion},
-		{Key: "IsSupportIoOptimized", Value: strconv.FormatBool(*image.IsSupportIoOptimized)},
-		{Key: "IsSelfShared", Value: *image.IsSelfShared},
-		{Key: "IsCopied", Value: strconv.FormatBool(*image.IsCopied)},
-		{Key: "IsSubscribed", Value: strconv.FormatBool(*image.IsSubscribed)},
-		{Key: "Platform", Value: *image.Platform},
-		{Key: "Size", Value: *image.Size},
-
-		// {Key: "ImageOwnerId", Value: *image.ImageOwnerId},		
-		// {Key: "ImageType", Value: *image.ImageType},
-		// {Key: "ImageLocation", Value: *image.ImageLocation},
-		// {Key: "VirtualizationType", Value: *image.VirtualizationType},
-		// {Key: "Public", Value: strconv.FormatBool(*image.Public)},
+		{Key: "Status", Value: image.Status},
+		{Key: "Progress", Value: image.Progress},
+		{Key: "CreationTime", Value: image.CreationTime},
+		{Key: "Architecture", Value: image.Architecture},
+		{Key: "ImageOwnerAlias", Value: image.ImageOwnerAlias},
+
+		{Key: "OSNameEn", Value: image.OSNameEn},
+		{Key: "ProductCode", Value: image.ProductCode},
+		{Key: "OSType", Value: image.OSType},
+		{Key: "OSName", Value: image.OSName},
+		{Key: "IsSupportCloudinit", Value: strconv.FormatBool(image.IsSupportCloudinit)},
+		{Key: "Usage", Value: image.Usage},
+		{Key: "ImageVersion", Value: image.ImageVersion},
+		{Key: "IsSupportIoOptimized", Value: strconv.FormatBool(image.IsSupportIoOptimized)},
+		{Key: "IsSelfShared", Value: image.IsSelfShared},
+		{Key: "IsCopied", Value: strconv.FormatBool(image.IsCopied)},
+		{Key: "IsSubscribed", Value: strconv.FormatBool(image.IsSubscribed)},
+		{Key: "Platform", Value: image.Platform},
+		{Key: "Size", Value: strconv.Itoa(image.Size)},
+	}
+
+	// ex) 2019-11-20T08:01:20Z
+	if createdTime, err := time.Parse(time.RFC3339, image.CreationTime); err == nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: irs.ImageCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
 	}
 
 	// 일부 이미지들은 아래 정보가 없어서 예외 처리 함.
-	if !reflect.ValueOf(image.Description).IsNil() {
-		keyValueList = append(keyValueList, irs.KeyValue{Key: "Description", Value: *image.Description})
+	if image.Description != "" {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "Description", Value: image.Description})
 	}
-	// if !reflect.ValueOf(image.ImageOwnerAlias).IsNil() {
-	// 	keyValueList = append(keyValueList, irs.KeyValue{Key: "ImageOwnerAlias", Value: *image.ImageOwnerAlias})
-	// }
-	// if !reflect.ValueOf(image.RootDeviceName).IsNil() {
-	// 	keyValueList = append(keyValueList, irs.KeyValue{Key: "RootDeviceName", Value: *image.RootDeviceName})
-	// 	keyValueList = append(keyValueList, irs.KeyValue{Key: "RootDeviceType", Value: *image.RootDeviceType})
-	// }
-	// if !reflect.ValueOf(image.EnaSupport).IsNil() {
-	// 	keyValueList = append(keyValueList, irs.KeyValue{Key: "EnaSupport", Value: strconv.FormatBool(*image.EnaSupport)})
-	// }
 
 	imageInfo.KeyValueList = keyValueList
 
@@ -210,35 +217,24 @@ func ExtractImageDescribeInfo(image *ecs.Image) irs.ImageInfo {
 }
 
 func (imageHandler *AlibabaImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
-	cblogger.Infof("imageID : ", imageID)
+	cblogger.Infof("imageID : [%s]", imageID)
 
 	request := ecs.CreateDescribeImagesRequest()
 	request.Scheme = "https"
-	
-	// request.Status = "Available"
-	// request.ActionType = "*"
-
 	request.ImageId = imageID
+	// 생성 중인 이미지도 조회하기 위해 모든 상태를 지정
+	request.Status = "Creating,Waiting,Available,UnAvailable,CreateFailed"
 
 	result, err := imageHandler.Client.DescribeImages(request)
-	//spew.Dump(result)
-	cblogger.Info(result)
 	if err != nil {
-		// if aerr, ok := err.(errors.Error); ok {
-		// 	switch aerr.Code() {
-		// 	default:
-		// 		cblogger.Error(aerr.Error())
-		// 	}
-		// } else {
-		// 	// Print the error, cast err to awserr.Error to get the Code and
-		// 	// Message from an error.
-		// 	cblogger.Error(err.Error())
-		// }
 		cblogger.Errorf("Unable to get Images, %v", err)
 		return irs.ImageInfo{}, err
 	}
+	if len(result.Images.Image) < 1 {
+		return irs.ImageInfo{}, errors.New("Image[" + imageID + "] 정보를 찾을 수 없습니다.")
+	}
 
-	imageInfo := ExtractImageDescribeInfo(result.Images[0])
+	imageInfo := ExtractImageDescribeInfo(result.Images.Image[0])
 
 	return imageInfo, nil
 }
@@ -248,23 +244,19 @@ func (imageHandler *AlibabaImageHandler) DeleteImage(imageID string) (bool, erro
 	// Delete the Image by Id
 
 	request := ecs.CreateDeleteImageRequest()
-    request.Scheme = "https"
-	
-	request.ImageId = to.StringPtr(ImageId)
+	request.Scheme = "https"
+
+	request.ImageId = imageID
 	// 추가 옵션 Req
 	// request.Force = requests.NewBoolean(true)
 
-	result, err := imageHandler.client.DeleteImage(request)
+	_, err := imageHandler.Client.DeleteImage(request)
 	if err != nil {
-		// if aerr, ok := err.(errors.Error); ok && aerr.Code() == "InvalidKeyPair.Duplicate" {
-		// 	cblogger.Error("Image %q does not exist.", keyPairName)
-		// 	return false, err
-		// }
-		cblogger.Errorf("Unable to delete Image: %s, %v.", ImageId, err)
+		cblogger.Errorf("Unable to delete Image: %s, %v.", imageID, err)
 		return false, err
 	}
 
-	cblogger.Infof("Successfully deleted %q Image\n", ImageId)
+	cblogger.Infof("Successfully deleted %q Image", imageID)
 
 	return true, nil
 }
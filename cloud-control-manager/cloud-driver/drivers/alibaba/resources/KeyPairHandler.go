@@ -11,7 +11,10 @@
 package resources
 
 import (
+	"errors"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"golang.org/x/crypto/ssh"
 
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -99,6 +102,50 @@ func (keyPairHandler *AlibabaKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPai
 	return keyPairInfo, nil
 }
 
+// 기존 OpenSSH 공개키(ssh-rsa, ssh-ed25519)를 키페어로 등록 함.
+func (keyPairHandler *AlibabaKeyPairHandler) ImportKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	cblogger.Info("Start ImportKey() : ", keyPairReqInfo)
+
+	publicKey, err := parseImportPublicKey(keyPairReqInfo.PublicKey)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.KeyPairInfo{}, err
+	}
+
+	request := ecs.CreateImportKeyPairRequest()
+	request.Scheme = "https"
+
+	request.KeyPairName = keyPairReqInfo.Name
+	request.PublicKeyBody = string(ssh.MarshalAuthorizedKey(publicKey))
+
+	result, err := keyPairHandler.Client.ImportKeyPair(request)
+	if err != nil {
+		cblogger.Errorf("Unable to import key pair: %s, %v.", keyPairReqInfo.Name, err)
+		return irs.KeyPairInfo{}, err
+	}
+
+	cblogger.Infof("Imported key pair %q %s", result.KeyPairName, result.KeyPairFingerPrint)
+	keyPairInfo := irs.KeyPairInfo{
+		Name:        result.KeyPairName,
+		Fingerprint: result.KeyPairFingerPrint,
+		PublicKey:   request.PublicKeyBody,
+	}
+
+	return keyPairInfo, nil
+}
+
+// OpenSSH 형식 공개키 검증 (ssh-rsa, ssh-ed25519만 지원)
+func parseImportPublicKey(publicKeyString string) (ssh.PublicKey, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKeyString))
+	if err != nil {
+		return nil, errors.New("invalid OpenSSH public key: " + err.Error())
+	}
+	if publicKey.Type() != ssh.KeyAlgoRSA && publicKey.Type() != ssh.KeyAlgoED25519 {
+		return nil, errors.New("unsupported public key type: " + publicKey.Type())
+	}
+	return publicKey, nil
+}
+
 // 혼선을 피하기 위해 keyPairID 대신 keyPairName으로 변경 함.
 func (keyPairHandler *AlibabaKeyPairHandler) GetKey(keyPairName string) (irs.KeyPairInfo, error) {
 	//keyPairID := keyPairName
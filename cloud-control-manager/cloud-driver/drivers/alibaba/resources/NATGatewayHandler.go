@@ -0,0 +1,408 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBNatType               = "Enhanced"
+	CBNATInternetChargeType = "PayByLcu"
+	CBNATGatewayPageSize    = 50 // max 50
+)
+
+// VSwitch에 NAT Gateway를 생성하고 EIP를 연결 함.
+// 서브넷 연결은 SNAT Entry(SourceVSwitchId), 라우팅 테이블 연결은 0.0.0.0/0 Route Entry(NextHopType NatGateway)로 매핑 함.
+type AlibabaNATGatewayHandler struct {
+	Region idrv.RegionInfo
+	Client *vpc.Client
+}
+
+func (natHandler *AlibabaNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayReqInfo) (irs.NATGatewayInfo, error) {
+	cblogger.Info("Start CreateNATGateway : ", natGatewayReqInfo)
+
+	if natGatewayReqInfo.SubnetId == "" {
+		return irs.NATGatewayInfo{}, errors.New("NAT Gateway를 생성할 VSwitch 정보가 필요합니다.")
+	}
+
+	request := vpc.CreateCreateNatGatewayRequest()
+	request.Scheme = "https"
+	request.VpcId = natGatewayReqInfo.VPCId
+	request.VSwitchId = natGatewayReqInfo.SubnetId
+	request.Name = natGatewayReqInfo.Name
+	request.NatType = CBNatType
+	request.InternetChargeType = CBNATInternetChargeType
+	// 0.0.0.0/0 Route는 AssociateNATGateway로 명시적으로 추가 함.
+	request.IsCreateDefaultRoute = requests.NewBoolean(false)
+
+	result, err := natHandler.Client.CreateNatGateway(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create NatGateway: %s, %v.", natGatewayReqInfo.Name, err)
+		return irs.NATGatewayInfo{}, err
+	}
+	cblogger.Infof("Created NatGateway %q %s", result.NatGatewayId, natGatewayReqInfo.Name)
+
+	if _, err := natHandler.waitForNATGatewayStatus(result.NatGatewayId, "Available"); err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// NAT Gateway에 EIP 할당
+	allocRequest := vpc.CreateAllocateEipAddressRequest()
+	allocRequest.Scheme = "https"
+	allocRequest.InternetChargeType = CBInternetChargeType
+	allocRequest.Bandwidth = CBBandwidth
+
+	allocRes, err := natHandler.Client.AllocateEipAddress(allocRequest)
+	if err != nil {
+		cblogger.Errorf("Unable to allocate IP address, %v", err)
+		return irs.NATGatewayInfo{}, err
+	}
+	cblogger.Infof("EIP 생성 성공 - Public IP : [%s], Allocation Id : [%s]", allocRes.EipAddress, allocRes.AllocationId)
+
+	assocRequest := vpc.CreateAssociateEipAddressRequest()
+	assocRequest.Scheme = "https"
+	assocRequest.AllocationId = allocRes.AllocationId
+	assocRequest.InstanceId = result.NatGatewayId
+	assocRequest.InstanceType = "Nat"
+
+	_, err = natHandler.Client.AssociateEipAddress(assocRequest)
+	if err != nil {
+		cblogger.Errorf("Unable to associate EIP with NatGateway: %s, %v.", result.NatGatewayId, err)
+		natHandler.releaseEipAddress(allocRes.AllocationId)
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// EIP 연결이 완료되어야 SNAT Entry를 생성할 수 있음.
+	for i := 0; ; i++ {
+		natGateway, err := natHandler.describeNatGateway(result.NatGatewayId)
+		if err != nil {
+			return irs.NATGatewayInfo{}, err
+		}
+		if len(natGateway.IpLists.IpList) > 0 {
+			break
+		}
+		if i >= CBVPCWaitTime/CBVPCCheckTime {
+			return irs.NATGatewayInfo{}, errors.New("NatGateway[" + result.NatGatewayId + "]에 EIP가 연결되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+
+	return natHandler.GetNATGateway(result.NatGatewayId)
+}
+
+func (natHandler *AlibabaNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	cblogger.Debug("Start")
+
+	request := vpc.CreateDescribeNatGatewaysRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(CBNATGatewayPageSize)
+
+	var natGatewayInfoList []*irs.NATGatewayInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := natHandler.Client.DescribeNatGateways(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get NatGateways, %v", err)
+			return nil, err
+		}
+		for _, natGateway := range result.NatGateways.NatGateway {
+			natGatewayInfo, err := natHandler.extractNATGatewayInfo(natGateway)
+			if err != nil {
+				return nil, err
+			}
+			natGatewayInfoList = append(natGatewayInfoList, &natGatewayInfo)
+		}
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return natGatewayInfoList, nil
+}
+
+func (natHandler *AlibabaNATGatewayHandler) GetNATGateway(natGatewayID string) (irs.NATGatewayInfo, error) {
+	cblogger.Infof("natGatewayID : [%s]", natGatewayID)
+
+	natGateway, err := natHandler.describeNatGateway(natGatewayID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.extractNATGatewayInfo(natGateway)
+}
+
+// Force 옵션으로 SNAT Entry와 EIP 연결을 함께 삭제하고, NAT Gateway 삭제가 완료되면 EIP를 해제 함.
+func (natHandler *AlibabaNATGatewayHandler) DeleteNATGateway(natGatewayID string) (bool, error) {
+	cblogger.Infof("natGatewayID : [%s]", natGatewayID)
+
+	natGateway, err := natHandler.describeNatGateway(natGatewayID)
+	if err != nil {
+		return false, err
+	}
+
+	request := vpc.CreateDeleteNatGatewayRequest()
+	request.Scheme = "https"
+	request.NatGatewayId = natGatewayID
+	request.Force = requests.NewBoolean(true)
+
+	_, err = natHandler.Client.DeleteNatGateway(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete NatGateway: %s, %v.", natGatewayID, err)
+		return false, err
+	}
+
+	for i := 0; ; i++ {
+		if _, err := natHandler.describeNatGateway(natGatewayID); err != nil {
+			break
+		}
+		if i >= CBVPCWaitTime/CBVPCCheckTime {
+			return false, errors.New("NatGateway[" + natGatewayID + "]가 삭제되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+	cblogger.Infof("Successfully deleted %q NatGateway", natGatewayID)
+
+	for _, ip := range natGateway.IpLists.IpList {
+		natHandler.releaseEipAddress(ip.AllocationId)
+	}
+	return true, nil
+}
+
+func (natHandler *AlibabaNATGatewayHandler) AssociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (irs.NATGatewayInfo, error) {
+	cblogger.Infof("natGatewayID : [%s], associationInfo : [%v]", natGatewayID, associationInfo)
+
+	if associationInfo.RouterId == "" && associationInfo.SubnetId == "" {
+		return irs.NATGatewayInfo{}, errors.New("NAT Gateway와 연결할 라우팅 테이블 또는 VSwitch 정보가 필요합니다.")
+	}
+
+	if associationInfo.RouterId != "" {
+		routerHandler := AlibabaRouterHandler{natHandler.Region, natHandler.Client}
+		_, err := routerHandler.AddRoute(associationInfo.RouterId, irs.RouteInfo{
+			DestinationCIDR: CBInternetGatewayCIDR,
+			TargetType:      irs.RouteTargetNATGateway,
+			TargetId:        natGatewayID,
+		})
+		if err != nil {
+			return irs.NATGatewayInfo{}, err
+		}
+	}
+
+	if associationInfo.SubnetId != "" {
+		natGateway, err := natHandler.describeNatGateway(natGatewayID)
+		if err != nil {
+			return irs.NATGatewayInfo{}, err
+		}
+		if len(natGateway.SnatTableIds.SnatTableId) < 1 || len(natGateway.IpLists.IpList) < 1 {
+			return irs.NATGatewayInfo{}, errors.New("NatGateway[" + natGatewayID + "]의 SNAT Table 또는 EIP 정보가 없습니다.")
+		}
+
+		request := vpc.CreateCreateSnatEntryRequest()
+		request.Scheme = "https"
+		request.SnatTableId = natGateway.SnatTableIds.SnatTableId[0]
+		request.SourceVSwitchId = associationInfo.SubnetId
+		request.SnatIp = natGateway.IpLists.IpList[0].IpAddress
+
+		result, err := natHandler.Client.CreateSnatEntry(request)
+		if err != nil {
+			cblogger.Errorf("Unable to create SnatEntry: %s, %v.", associationInfo.SubnetId, err)
+			return irs.NATGatewayInfo{}, err
+		}
+		cblogger.Infof("Created SnatEntry %q %s", result.SnatEntryId, associationInfo.SubnetId)
+
+		// SNAT Table은 SNAT Entry가 Available 상태일 때만 변경할 수 있음.
+		for i := 0; ; i++ {
+			snatEntryList, err := natHandler.describeSnatTableEntries(natGateway)
+			if err != nil {
+				return irs.NATGatewayInfo{}, err
+			}
+			available := false
+			for _, snatEntry := range snatEntryList {
+				if snatEntry.SnatEntryId == result.SnatEntryId && snatEntry.Status == "Available" {
+					available = true
+				}
+			}
+			if available {
+				break
+			}
+			if i >= CBVPCWaitTime/CBVPCCheckTime {
+				return irs.NATGatewayInfo{}, errors.New("SnatEntry[" + result.SnatEntryId + "]가 Available 상태가 되지 않았습니다.")
+			}
+			time.Sleep(time.Second * CBVPCCheckTime)
+		}
+	}
+
+	return natHandler.GetNATGateway(natGatewayID)
+}
+
+func (natHandler *AlibabaNATGatewayHandler) DisassociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (bool, error) {
+	cblogger.Infof("natGatewayID : [%s], associationInfo : [%v]", natGatewayID, associationInfo)
+
+	if associationInfo.RouterId == "" && associationInfo.SubnetId == "" {
+		return false, errors.New("NAT Gateway와 연결을 해제할 라우팅 테이블 또는 VSwitch 정보가 필요합니다.")
+	}
+
+	if associationInfo.RouterId != "" {
+		routerHandler := AlibabaRouterHandler{natHandler.Region, natHandler.Client}
+		routeEntryList, err := routerHandler.describeRouteEntries(associationInfo.RouterId)
+		if err != nil {
+			return false, err
+		}
+		found := false
+		for _, routeEntry := range routeEntryList {
+			if routeEntry.NextHopType == "NatGateway" && routeEntry.InstanceId == natGatewayID {
+				found = true
+				if _, err := routerHandler.RemoveRoute(associationInfo.RouterId, routeEntry.DestinationCidrBlock); err != nil {
+					return false, err
+				}
+			}
+		}
+		if !found {
+			return false, errors.New("RouteTable[" + associationInfo.RouterId + "]에 NatGateway[" + natGatewayID + "]로의 Route Entry가 없습니다.")
+		}
+	}
+
+	if associationInfo.SubnetId != "" {
+		natGateway, err := natHandler.describeNatGateway(natGatewayID)
+		if err != nil {
+			return false, err
+		}
+		snatEntryList, err := natHandler.describeSnatTableEntries(natGateway)
+		if err != nil {
+			return false, err
+		}
+		found := false
+		for _, snatEntry := range snatEntryList {
+			if snatEntry.SourceVSwitchId != associationInfo.SubnetId {
+				continue
+			}
+			found = true
+
+			request := vpc.CreateDeleteSnatEntryRequest()
+			request.Scheme = "https"
+			request.SnatTableId = snatEntry.SnatTableId
+			request.SnatEntryId = snatEntry.SnatEntryId
+
+			_, err := natHandler.Client.DeleteSnatEntry(request)
+			if err != nil {
+				cblogger.Errorf("Unable to delete SnatEntry: %s, %v.", snatEntry.SnatEntryId, err)
+				return false, err
+			}
+		}
+		if !found {
+			return false, errors.New("VSwitch[" + associationInfo.SubnetId + "]는 NatGateway[" + natGatewayID + "]와 연결되어 있지 않습니다.")
+		}
+	}
+	return true, nil
+}
+
+func (natHandler *AlibabaNATGatewayHandler) describeNatGateway(natGatewayID string) (vpc.NatGateway, error) {
+	request := vpc.CreateDescribeNatGatewaysRequest()
+	request.Scheme = "https"
+	request.NatGatewayId = natGatewayID
+
+	result, err := natHandler.Client.DescribeNatGateways(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get NatGateway: %s, %v.", natGatewayID, err)
+		return vpc.NatGateway{}, err
+	}
+	if len(result.NatGateways.NatGateway) < 1 {
+		return vpc.NatGateway{}, errors.New("NatGateway[" + natGatewayID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.NatGateways.NatGateway[0], nil
+}
+
+func (natHandler *AlibabaNATGatewayHandler) describeSnatTableEntries(natGateway vpc.NatGateway) ([]vpc.SnatTableEntry, error) {
+	var snatEntryList []vpc.SnatTableEntry
+	for _, snatTableID := range natGateway.SnatTableIds.SnatTableId {
+		request := vpc.CreateDescribeSnatTableEntriesRequest()
+		request.Scheme = "https"
+		request.SnatTableId = snatTableID
+		request.PageSize = requests.NewInteger(CBNATGatewayPageSize)
+
+		for pageNumber := CBPageNumber; ; pageNumber++ {
+			request.PageNumber = requests.NewInteger(pageNumber)
+
+			result, err := natHandler.Client.DescribeSnatTableEntries(request)
+			if err != nil {
+				cblogger.Errorf("Unable to get SnatTableEntries: %s, %v.", snatTableID, err)
+				return nil, err
+			}
+			snatEntryList = append(snatEntryList, result.SnatTableEntries.SnatTableEntry...)
+			if result.PageNumber*result.PageSize >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return snatEntryList, nil
+}
+
+// NAT Gateway의 상태가 status가 될 때까지 대기 함.
+func (natHandler *AlibabaNATGatewayHandler) waitForNATGatewayStatus(natGatewayID string, status string) (vpc.NatGateway, error) {
+	for i := 0; i < CBVPCWaitTime/CBVPCCheckTime; i++ {
+		natGateway, err := natHandler.describeNatGateway(natGatewayID)
+		if err != nil {
+			return vpc.NatGateway{}, err
+		}
+		if natGateway.Status == status {
+			return natGateway, nil
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+	return vpc.NatGateway{}, errors.New("NatGateway[" + natGatewayID + "]가 " + status + " 상태가 되지 않았습니다.")
+}
+
+func (natHandler *AlibabaNATGatewayHandler) releaseEipAddress(allocationId string) {
+	request := vpc.CreateReleaseEipAddressRequest()
+	request.Scheme = "https"
+	request.AllocationId = allocationId
+
+	_, err := natHandler.Client.ReleaseEipAddress(request)
+	if err != nil {
+		cblogger.Errorf("Unable to release EIP: %s, %v.", allocationId, err)
+	}
+}
+
+// NAT Gateway와 EIP, SNAT Entry(연결된 VSwitch) 정보를 추출함
+func (natHandler *AlibabaNATGatewayHandler) extractNATGatewayInfo(natGateway vpc.NatGateway) (irs.NATGatewayInfo, error) {
+	natGatewayInfo := irs.NATGatewayInfo{
+		Id:       natGateway.NatGatewayId,
+		Name:     natGateway.Name,
+		VPCId:    natGateway.VpcId,
+		SubnetId: natGateway.NatGatewayPrivateInfo.VswitchId,
+		Status:   natGateway.Status,
+		KeyValueList: []irs.KeyValue{
+			{Key: "NatType", Value: natGateway.NatType},
+			{Key: "Spec", Value: natGateway.Spec},
+			{Key: "CreationTime", Value: natGateway.CreationTime},
+		},
+	}
+	for _, ip := range natGateway.IpLists.IpList {
+		natGatewayInfo.PublicIP = ip.IpAddress
+		natGatewayInfo.KeyValueList = append(natGatewayInfo.KeyValueList, irs.KeyValue{Key: "AllocationId", Value: ip.AllocationId})
+	}
+
+	snatEntryList, err := natHandler.describeSnatTableEntries(natGateway)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	for _, snatEntry := range snatEntryList {
+		if snatEntry.SourceVSwitchId != "" {
+			natGatewayInfo.SubnetIdList = append(natGatewayInfo.SubnetIdList, snatEntry.SourceVSwitchId)
+		}
+	}
+	return natGatewayInfo, nil
+}
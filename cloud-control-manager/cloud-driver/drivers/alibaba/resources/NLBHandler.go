@@ -0,0 +1,414 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBNLBSpec                        = "slb.s1.small"
+	CBNLBInternetChargeType          = "paybytraffic"
+	CBNLBAddressTypeInternet         = "internet"
+	CBNLBAddressTypeIntranet         = "intranet"
+	CBNLBBackendServerWeight         = "100"
+	CBNLBHealthyStatus               = "normal"
+	CBNLBPageSize                    = 50 // max 100
+	CBNLBDefaultHealthCheckInterval  = 10 // seconds
+	CBNLBDefaultHealthCheckTimeout   = 5  // seconds
+	CBNLBDefaultHealthCheckThreshold = 3
+)
+
+// SLB(Server Load Balancer)를 NLB로 사용 함. (NLB ID = LoadBalancerId)
+// Listener는 TCP/UDP Listener 1개, VM 그룹은 기본 Backend Server 목록(ServerId = ECS Instance Id)으로 매핑 함.
+type AlibabaNLBHandler struct {
+	Region    idrv.RegionInfo
+	Client    *slb.Client
+	VPCClient *vpc.Client
+}
+
+// AddBackendServers/RemoveBackendServers의 BackendServers JSON 항목
+type backendServer struct {
+	ServerId string `json:"ServerId"`
+	Weight   string `json:"Weight,omitempty"`
+}
+
+func (nlbHandler *AlibabaNLBHandler) CreateNLB(nlbReqInfo irs.NLBReqInfo) (irs.NLBInfo, error) {
+	cblogger.Info("Start CreateNLB : ", nlbReqInfo)
+
+	listenerPort, err := strconv.Atoi(nlbReqInfo.Listener.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("Listener 포트 정보가 올바르지 않습니다. : " + nlbReqInfo.Listener.Port)
+	}
+	vmGroupPort, err := strconv.Atoi(nlbReqInfo.VMGroup.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("VM 그룹 포트 정보가 올바르지 않습니다. : " + nlbReqInfo.VMGroup.Port)
+	}
+
+	// SLB는 VPC의 첫번째 VSwitch에 생성 함.
+	vpcHandler := AlibabaVPCHandler{nlbHandler.Region, nlbHandler.VPCClient}
+	vSwitchList, err := vpcHandler.describeVSwitches(nlbReqInfo.VPCId)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if len(vSwitchList) < 1 {
+		return irs.NLBInfo{}, errors.New("VPC[" + nlbReqInfo.VPCId + "]에 VSwitch가 없습니다.")
+	}
+
+	request := slb.CreateCreateLoadBalancerRequest()
+	request.Scheme = "https"
+	request.LoadBalancerName = nlbReqInfo.Name
+	request.LoadBalancerSpec = CBNLBSpec
+	request.VpcId = nlbReqInfo.VPCId
+	request.VSwitchId = vSwitchList[0].VSwitchId
+	request.AddressType = CBNLBAddressTypeIntranet
+	if nlbReqInfo.Type != irs.NLBInternal {
+		request.AddressType = CBNLBAddressTypeInternet
+		request.InternetChargeType = CBNLBInternetChargeType
+	}
+
+	result, err := nlbHandler.Client.CreateLoadBalancer(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create LoadBalancer: %s, %v.", nlbReqInfo.Name, err)
+		return irs.NLBInfo{}, err
+	}
+	cblogger.Infof("Created LoadBalancer %q %s", result.LoadBalancerId, nlbReqInfo.Name)
+
+	if err := nlbHandler.createListener(result.LoadBalancerId, listenerPort, vmGroupPort, nlbReqInfo); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	if len(nlbReqInfo.VMGroup.VMs) > 0 {
+		if _, err := nlbHandler.AddVMs(result.LoadBalancerId, nlbReqInfo.VMGroup.VMs); err != nil {
+			return irs.NLBInfo{}, err
+		}
+	}
+	return nlbHandler.GetNLB(result.LoadBalancerId)
+}
+
+func (nlbHandler *AlibabaNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
+	cblogger.Debug("Start")
+
+	request := slb.CreateDescribeLoadBalancersRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(CBNLBPageSize)
+
+	var nlbInfoList []*irs.NLBInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		result, err := nlbHandler.Client.DescribeLoadBalancers(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get LoadBalancers, %v", err)
+			return nil, err
+		}
+		for _, loadBalancer := range result.LoadBalancers.LoadBalancer {
+			nlbInfo, err := nlbHandler.GetNLB(loadBalancer.LoadBalancerId)
+			if err != nil {
+				return nil, err
+			}
+			nlbInfoList = append(nlbInfoList, &nlbInfo)
+		}
+		if result.PageNumber*result.PageSize >= result.TotalCount {
+			break
+		}
+	}
+	return nlbInfoList, nil
+}
+
+func (nlbHandler *AlibabaNLBHandler) GetNLB(nlbID string) (irs.NLBInfo, error) {
+	cblogger.Infof("nlbID : [%s]", nlbID)
+
+	loadBalancer, err := nlbHandler.describeLoadBalancerAttribute(nlbID)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	nlbInfo := irs.NLBInfo{
+		Id:          loadBalancer.LoadBalancerId,
+		Name:        loadBalancer.LoadBalancerName,
+		VPCId:       loadBalancer.VpcId,
+		Type:        irs.NLBPublic,
+		CreatedTime: loadBalancer.CreateTime,
+		Listener: irs.ListenerInfo{
+			IP: loadBalancer.Address,
+		},
+		KeyValueList: []irs.KeyValue{
+			{Key: "VSwitchId", Value: loadBalancer.VSwitchId},
+			{Key: "AddressType", Value: loadBalancer.AddressType},
+			{Key: "LoadBalancerSpec", Value: loadBalancer.LoadBalancerSpec},
+			{Key: "LoadBalancerStatus", Value: loadBalancer.LoadBalancerStatus},
+		},
+	}
+	if loadBalancer.AddressType == CBNLBAddressTypeIntranet {
+		nlbInfo.Type = irs.NLBInternal
+	}
+	for _, server := range loadBalancer.BackendServers.BackendServer {
+		nlbInfo.VMGroup.VMs = append(nlbInfo.VMGroup.VMs, server.ServerId)
+	}
+
+	for _, listener := range loadBalancer.ListenerPortsAndProtocol.ListenerPortAndProtocol {
+		nlbInfo.Listener.Protocol = strings.ToUpper(listener.ListenerProtocol)
+		nlbInfo.Listener.Port = strconv.Itoa(listener.ListenerPort)
+		nlbInfo.VMGroup.Protocol = strings.ToUpper(listener.ListenerProtocol)
+
+		if err := nlbHandler.setListenerAttribute(nlbID, listener.ListenerProtocol, listener.ListenerPort, &nlbInfo); err != nil {
+			return irs.NLBInfo{}, err
+		}
+	}
+	return nlbInfo, nil
+}
+
+// SLB를 삭제하면 Listener와 Backend Server 설정도 함께 삭제 됨.
+func (nlbHandler *AlibabaNLBHandler) DeleteNLB(nlbID string) (bool, error) {
+	cblogger.Infof("nlbID : [%s]", nlbID)
+
+	request := slb.CreateDeleteLoadBalancerRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = nlbID
+
+	_, err := nlbHandler.Client.DeleteLoadBalancer(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete LoadBalancer: %s, %v.", nlbID, err)
+		return false, err
+	}
+	cblogger.Infof("Successfully deleted %q LoadBalancer", nlbID)
+	return true, nil
+}
+
+func (nlbHandler *AlibabaNLBHandler) AddVMs(nlbID string, vmIDs []string) (irs.VMGroupInfo, error) {
+	cblogger.Infof("nlbID : [%s], vmIDs : %v", nlbID, vmIDs)
+
+	var serverList []backendServer
+	for _, vmID := range vmIDs {
+		serverList = append(serverList, backendServer{ServerId: vmID, Weight: CBNLBBackendServerWeight})
+	}
+	servers, err := json.Marshal(serverList)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+
+	request := slb.CreateAddBackendServersRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = nlbID
+	request.BackendServers = string(servers)
+
+	_, err = nlbHandler.Client.AddBackendServers(request)
+	if err != nil {
+		cblogger.Errorf("Unable to add BackendServers to LoadBalancer: %s, %v.", nlbID, err)
+		return irs.VMGroupInfo{}, err
+	}
+
+	nlbInfo, err := nlbHandler.GetNLB(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	return nlbInfo.VMGroup, nil
+}
+
+func (nlbHandler *AlibabaNLBHandler) RemoveVMs(nlbID string, vmIDs []string) (bool, error) {
+	cblogger.Infof("nlbID : [%s], vmIDs : %v", nlbID, vmIDs)
+
+	var serverList []backendServer
+	for _, vmID := range vmIDs {
+		serverList = append(serverList, backendServer{ServerId: vmID})
+	}
+	servers, err := json.Marshal(serverList)
+	if err != nil {
+		return false, err
+	}
+
+	request := slb.CreateRemoveBackendServersRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = nlbID
+	request.BackendServers = string(servers)
+
+	_, err = nlbHandler.Client.RemoveBackendServers(request)
+	if err != nil {
+		cblogger.Errorf("Unable to remove BackendServers from LoadBalancer: %s, %v.", nlbID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ServerHealthStatus : normal, abnormal, unavailable
+func (nlbHandler *AlibabaNLBHandler) GetVMGroupHealthInfo(nlbID string) (irs.HealthInfo, error) {
+	cblogger.Infof("nlbID : [%s]", nlbID)
+
+	request := slb.CreateDescribeHealthStatusRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = nlbID
+
+	result, err := nlbHandler.Client.DescribeHealthStatus(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get HealthStatus of LoadBalancer: %s, %v.", nlbID, err)
+		return irs.HealthInfo{}, err
+	}
+
+	healthInfo := irs.HealthInfo{}
+	for _, server := range result.BackendServers.BackendServer {
+		healthInfo.AllVMs = append(healthInfo.AllVMs, server.ServerId)
+		if server.ServerHealthStatus == CBNLBHealthyStatus {
+			healthInfo.HealthyVMs = append(healthInfo.HealthyVMs, server.ServerId)
+		} else {
+			healthInfo.UnHealthyVMs = append(healthInfo.UnHealthyVMs, server.ServerId)
+		}
+	}
+	return healthInfo, nil
+}
+
+// Listener는 생성 후 Start 해야 트래픽을 전달 함.
+func (nlbHandler *AlibabaNLBHandler) createListener(nlbID string, listenerPort int, vmGroupPort int, nlbReqInfo irs.NLBReqInfo) error {
+	interval := CBNLBDefaultHealthCheckInterval
+	if nlbReqInfo.HealthChecker.Interval > 0 {
+		interval = nlbReqInfo.HealthChecker.Interval
+	}
+	timeout := CBNLBDefaultHealthCheckTimeout
+	if nlbReqInfo.HealthChecker.Timeout > 0 {
+		timeout = nlbReqInfo.HealthChecker.Timeout
+	}
+	threshold := CBNLBDefaultHealthCheckThreshold
+	if nlbReqInfo.HealthChecker.Threshold > 0 {
+		threshold = nlbReqInfo.HealthChecker.Threshold
+	}
+	healthCheckPort := vmGroupPort
+	if nlbReqInfo.HealthChecker.Port != "" {
+		port, err := strconv.Atoi(nlbReqInfo.HealthChecker.Port)
+		if err != nil {
+			return errors.New("HealthChecker 포트 정보가 올바르지 않습니다. : " + nlbReqInfo.HealthChecker.Port)
+		}
+		healthCheckPort = port
+	}
+
+	protocol := "tcp"
+	if strings.ToUpper(nlbReqInfo.Listener.Protocol) == "UDP" {
+		protocol = "udp"
+
+		request := slb.CreateCreateLoadBalancerUDPListenerRequest()
+		request.Scheme = "https"
+		request.LoadBalancerId = nlbID
+		request.ListenerPort = requests.NewInteger(listenerPort)
+		request.BackendServerPort = requests.NewInteger(vmGroupPort)
+		request.Bandwidth = requests.NewInteger(-1)
+		request.HealthCheckConnectPort = requests.NewInteger(healthCheckPort)
+		request.HealthCheckInterval = requests.NewInteger(interval)
+		request.HealthCheckConnectTimeout = requests.NewInteger(timeout)
+		request.HealthyThreshold = requests.NewInteger(threshold)
+		request.UnhealthyThreshold = requests.NewInteger(threshold)
+
+		if _, err := nlbHandler.Client.CreateLoadBalancerUDPListener(request); err != nil {
+			cblogger.Errorf("Unable to create UDP Listener of LoadBalancer: %s, %v.", nlbID, err)
+			return err
+		}
+	} else {
+		request := slb.CreateCreateLoadBalancerTCPListenerRequest()
+		request.Scheme = "https"
+		request.LoadBalancerId = nlbID
+		request.ListenerPort = requests.NewInteger(listenerPort)
+		request.BackendServerPort = requests.NewInteger(vmGroupPort)
+		request.Bandwidth = requests.NewInteger(-1)
+		request.HealthCheckType = "tcp"
+		if strings.ToUpper(nlbReqInfo.HealthChecker.Protocol) == "HTTP" {
+			request.HealthCheckType = "http"
+		}
+		request.HealthCheckConnectPort = requests.NewInteger(healthCheckPort)
+		request.HealthCheckInterval = requests.NewInteger(interval)
+		request.HealthCheckConnectTimeout = requests.NewInteger(timeout)
+		request.HealthyThreshold = requests.NewInteger(threshold)
+		request.UnhealthyThreshold = requests.NewInteger(threshold)
+
+		if _, err := nlbHandler.Client.CreateLoadBalancerTCPListener(request); err != nil {
+			cblogger.Errorf("Unable to create TCP Listener of LoadBalancer: %s, %v.", nlbID, err)
+			return err
+		}
+	}
+
+	startRequest := slb.CreateStartLoadBalancerListenerRequest()
+	startRequest.Scheme = "https"
+	startRequest.LoadBalancerId = nlbID
+	startRequest.ListenerPort = requests.NewInteger(listenerPort)
+	startRequest.ListenerProtocol = protocol
+
+	if _, err := nlbHandler.Client.StartLoadBalancerListener(startRequest); err != nil {
+		cblogger.Errorf("Unable to start Listener of LoadBalancer: %s, %v.", nlbID, err)
+		return err
+	}
+	return nil
+}
+
+func (nlbHandler *AlibabaNLBHandler) describeLoadBalancerAttribute(nlbID string) (*slb.DescribeLoadBalancerAttributeResponse, error) {
+	request := slb.CreateDescribeLoadBalancerAttributeRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = nlbID
+
+	result, err := nlbHandler.Client.DescribeLoadBalancerAttribute(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get LoadBalancer: %s, %v.", nlbID, err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// Listener 프로토콜에 따라 TCP/UDP Listener 속성에서 VM 그룹 포트와 HealthChecker 정보를 조회 함.
+func (nlbHandler *AlibabaNLBHandler) setListenerAttribute(nlbID string, protocol string, listenerPort int, nlbInfo *irs.NLBInfo) error {
+	switch strings.ToLower(protocol) {
+	case "tcp":
+		request := slb.CreateDescribeLoadBalancerTCPListenerAttributeRequest()
+		request.Scheme = "https"
+		request.LoadBalancerId = nlbID
+		request.ListenerPort = requests.NewInteger(listenerPort)
+
+		result, err := nlbHandler.Client.DescribeLoadBalancerTCPListenerAttribute(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get TCP Listener of LoadBalancer: %s, %v.", nlbID, err)
+			return err
+		}
+		nlbInfo.VMGroup.Port = strconv.Itoa(result.BackendServerPort)
+		nlbInfo.HealthChecker = irs.HealthCheckerInfo{
+			Protocol:     strings.ToUpper(result.HealthCheckType),
+			Port:         strconv.Itoa(result.HealthCheckConnectPort),
+			Interval:     result.HealthCheckInterval,
+			Timeout:      result.HealthCheckConnectTimeout,
+			Threshold:    result.HealthyThreshold,
+			KeyValueList: []irs.KeyValue{{Key: "Status", Value: result.Status}},
+		}
+	case "udp":
+		request := slb.CreateDescribeLoadBalancerUDPListenerAttributeRequest()
+		request.Scheme = "https"
+		request.LoadBalancerId = nlbID
+		request.ListenerPort = requests.NewInteger(listenerPort)
+
+		result, err := nlbHandler.Client.DescribeLoadBalancerUDPListenerAttribute(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get UDP Listener of LoadBalancer: %s, %v.", nlbID, err)
+			return err
+		}
+		nlbInfo.VMGroup.Port = strconv.Itoa(result.BackendServerPort)
+		nlbInfo.HealthChecker = irs.HealthCheckerInfo{
+			Protocol:     "UDP",
+			Port:         strconv.Itoa(result.HealthCheckConnectPort),
+			Interval:     result.HealthCheckInterval,
+			Timeout:      result.HealthCheckConnectTimeout,
+			Threshold:    result.HealthyThreshold,
+			KeyValueList: []irs.KeyValue{{Key: "Status", Value: result.Status}},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// OSS(Object Storage Service)의 Bucket과 Object를 처리 함.
+// OSS는 OpenAPI(RPC) SDK가 아닌 별도의 OSS SDK를 사용하며, Endpoint는 리전별로 다름.
+type AlibabaObjectStorageHandler struct {
+	Region idrv.RegionInfo
+	Client *oss.Client
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) CreateBucket(bucketName string) (irs.BucketInfo, error) {
+	cblogger.Infof("CreateBucket : [%s]", bucketName)
+
+	err := storageHandler.Client.CreateBucket(bucketName, oss.ACL(oss.ACLPrivate))
+	if err != nil {
+		cblogger.Errorf("Bucket 생성 실패 : [%s] - %v", bucketName, err)
+		return irs.BucketInfo{}, err
+	}
+
+	bucketList, err := storageHandler.ListBucket()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	for _, bucketInfo := range bucketList {
+		if bucketInfo.Name == bucketName {
+			return *bucketInfo, nil
+		}
+	}
+	return irs.BucketInfo{}, errors.New("생성된 Bucket 정보를 찾을 수 없습니다. : " + bucketName)
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	cblogger.Info("ListBucket")
+
+	var bucketList []*irs.BucketInfo
+	marker := ""
+	for {
+		result, err := storageHandler.Client.ListBuckets(oss.Marker(marker))
+		if err != nil {
+			cblogger.Errorf("Bucket 목록 조회 실패 - %v", err)
+			return nil, err
+		}
+		for _, bucket := range result.Buckets {
+			bucketList = append(bucketList, &irs.BucketInfo{
+				Name: bucket.Name,
+				// Location은 "oss-cn-hangzhou" 형식
+				Region:      strings.TrimPrefix(bucket.Location, "oss-"),
+				CreatedTime: bucket.CreationDate.Format(time.RFC3339),
+				KeyValueList: []irs.KeyValue{
+					{Key: "Location", Value: bucket.Location},
+					{Key: "StorageClass", Value: bucket.StorageClass},
+				},
+			})
+		}
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	return bucketList, nil
+}
+
+// OSS는 비어 있는 Bucket만 삭제할 수 있음.
+func (storageHandler *AlibabaObjectStorageHandler) DeleteBucket(bucketName string) (bool, error) {
+	cblogger.Infof("DeleteBucket : [%s]", bucketName)
+
+	err := storageHandler.Client.DeleteBucket(bucketName)
+	if err != nil {
+		cblogger.Errorf("Bucket 삭제 실패 : [%s] - %v", bucketName, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) PutObject(bucketName string, objectKey string, body io.Reader, contentType string) (irs.ObjectInfo, error) {
+	cblogger.Infof("PutObject : [%s] [%s]", bucketName, objectKey)
+
+	bucket, err := storageHandler.Client.Bucket(bucketName)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+
+	var options []oss.Option
+	if contentType != "" {
+		options = append(options, oss.ContentType(contentType))
+	}
+	err = bucket.PutObject(objectKey, body, options...)
+	if err != nil {
+		cblogger.Errorf("Object 업로드 실패 : [%s] [%s] - %v", bucketName, objectKey, err)
+		return irs.ObjectInfo{}, err
+	}
+
+	return storageHandler.getObjectMeta(bucket, objectKey)
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) GetObject(bucketName string, objectKey string) (io.ReadCloser, irs.ObjectInfo, error) {
+	cblogger.Infof("GetObject : [%s] [%s]", bucketName, objectKey)
+
+	bucket, err := storageHandler.Client.Bucket(bucketName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	objectInfo, err := storageHandler.getObjectMeta(bucket, objectKey)
+	if err != nil {
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	body, err := bucket.GetObject(objectKey)
+	if err != nil {
+		cblogger.Errorf("Object 다운로드 실패 : [%s] [%s] - %v", bucketName, objectKey, err)
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	return body, objectInfo, nil
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) ListObject(bucketName string, prefix string) ([]*irs.ObjectInfo, error) {
+	cblogger.Infof("ListObject : [%s] prefix[%s]", bucketName, prefix)
+
+	bucket, err := storageHandler.Client.Bucket(bucketName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var objectList []*irs.ObjectInfo
+	marker := ""
+	for {
+		result, err := bucket.ListObjects(oss.Prefix(prefix), oss.Marker(marker))
+		if err != nil {
+			cblogger.Errorf("Object 목록 조회 실패 : [%s] - %v", bucketName, err)
+			return nil, err
+		}
+		for _, object := range result.Objects {
+			objectList = append(objectList, &irs.ObjectInfo{
+				Key:          object.Key,
+				Size:         object.Size,
+				ETag:         strings.Trim(object.ETag, "\""),
+				LastModified: object.LastModified.Format(time.RFC3339),
+				StorageClass: object.StorageClass,
+			})
+		}
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	return objectList, nil
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) DeleteObject(bucketName string, objectKey string) (bool, error) {
+	cblogger.Infof("DeleteObject : [%s] [%s]", bucketName, objectKey)
+
+	bucket, err := storageHandler.Client.Bucket(bucketName)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	err = bucket.DeleteObject(objectKey)
+	if err != nil {
+		cblogger.Errorf("Object 삭제 실패 : [%s] [%s] - %v", bucketName, objectKey, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) GetPresignedURL(bucketName string, objectKey string, method string, expiresSeconds int) (string, error) {
+	cblogger.Infof("GetPresignedURL : [%s] [%s] [%s] [%d]", bucketName, objectKey, method, expiresSeconds)
+
+	var httpMethod oss.HTTPMethod
+	switch strings.ToUpper(method) {
+	case irs.PresignedURLGet:
+		httpMethod = oss.HTTPGet
+	case irs.PresignedURLPut:
+		httpMethod = oss.HTTPPut
+	default:
+		return "", errors.New("지원하지 않는 Method 입니다. (GET 또는 PUT) : " + method)
+	}
+
+	bucket, err := storageHandler.Client.Bucket(bucketName)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	signedURL, err := bucket.SignURL(objectKey, httpMethod, int64(expiresSeconds))
+	if err != nil {
+		cblogger.Errorf("Presigned URL 생성 실패 : [%s] [%s] - %v", bucketName, objectKey, err)
+		return "", err
+	}
+
+	return signedURL, nil
+}
+
+func (storageHandler *AlibabaObjectStorageHandler) getObjectMeta(bucket *oss.Bucket, objectKey string) (irs.ObjectInfo, error) {
+	header, err := bucket.GetObjectDetailedMeta(objectKey)
+	if err != nil {
+		cblogger.Errorf("Object 정보 조회 실패 : [%s] - %v", objectKey, err)
+		return irs.ObjectInfo{}, err
+	}
+
+	objectInfo := irs.ObjectInfo{
+		Key:          objectKey,
+		ETag:         strings.Trim(header.Get(oss.HTTPHeaderEtag), "\""),
+		LastModified: header.Get(oss.HTTPHeaderLastModified),
+		StorageClass: header.Get(oss.HTTPHeaderOssStorageClass),
+		ContentType:  header.Get(oss.HTTPHeaderContentType),
+	}
+	if size, err := strconv.ParseInt(header.Get(oss.HTTPHeaderContentLength), 10, 64); err == nil {
+		objectInfo.Size = size
+	}
+
+	return objectInfo, nil
+}
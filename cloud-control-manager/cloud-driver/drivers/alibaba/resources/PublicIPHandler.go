@@ -30,6 +30,10 @@ type AlibabaPublicIPHandler struct {
 func (publicIpHandler *AlibabaPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
 	cblogger.Info("Start : ", publicIPReqInfo)
 
+	if err := checkUserTagList(publicIPReqInfo.TagList); err != nil {
+		return irs.PublicIPInfo{}, err
+	}
+
 	request := vpc.CreateAllocateEipAddressRequest()
 	request.Scheme = "https"
 
@@ -50,6 +54,13 @@ func (publicIpHandler *AlibabaPublicIPHandler) CreatePublicIP(publicIPReqInfo ir
 	spew.Dump(allocRes)
 	cblogger.Infof("EIP 생성 성공 - Public IP : [%s], Allocation Id : [%s]", *allocRes.EipAddress, *allocRes.AllocationId)
 
+	// AllocateEipAddress는 Tag를 전달할 수 없어서 할당 직후 사용자 Tag를 설정 함. (실패 시 EIP 반환)
+	tagHandler := AlibabaTagHandler{Region: publicIpHandler.Region, VPCClient: publicIpHandler.Client}
+	if errTag := addUserTagList(tagHandler, irs.RSTypePublicIP, allocRes.AllocationId, publicIPReqInfo.TagList); errTag != nil {
+		publicIpHandler.DeletePublicIP(allocRes.AllocationId)
+		return irs.PublicIPInfo{}, errTag
+	}
+
 	// Tag에 Name 설정
 	// cblogger.Info("Name 설정 ", publicIPReqInfo.Name)
 	// _, errtag := publicIpHandler.Client.CreateTags(&ec2.CreateTagsInput{
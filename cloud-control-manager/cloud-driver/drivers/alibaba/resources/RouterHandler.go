@@ -0,0 +1,421 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBInternetGatewayCIDR = "0.0.0.0/0"
+	CBRouteEntryPageSize  = 100 // max 100
+)
+
+// Router는 VPC의 Custom Route Table, Internet Gateway는 IPv4 Gateway로 매핑 함.
+type AlibabaRouterHandler struct {
+	Region idrv.RegionInfo
+	Client *vpc.Client
+}
+
+func (routerHandler *AlibabaRouterHandler) CreateRouter(routerReqInfo irs.RouterReqInfo) (irs.RouterInfo, error) {
+	cblogger.Info("Start CreateRouter : ", routerReqInfo)
+
+	request := vpc.CreateCreateRouteTableRequest()
+	request.Scheme = "https"
+	request.VpcId = routerReqInfo.VPCId
+	request.RouteTableName = routerReqInfo.Name
+
+	result, err := routerHandler.Client.CreateRouteTable(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create RouteTable: %s, %v.", routerReqInfo.Name, err)
+		return irs.RouterInfo{}, err
+	}
+	cblogger.Infof("Created RouteTable %q %s", result.RouteTableId, routerReqInfo.Name)
+
+	if _, err := routerHandler.waitForRouterStatus(result.RouteTableId, "Available"); err != nil {
+		return irs.RouterInfo{}, err
+	}
+
+	if routerReqInfo.InternetGateway {
+		return routerHandler.AttachInternetGateway(result.RouteTableId)
+	}
+	return routerHandler.GetRouter(result.RouteTableId)
+}
+
+func (routerHandler *AlibabaRouterHandler) ListRouter() ([]*irs.RouterInfo, error) {
+	cblogger.Debug("Start")
+
+	request := vpc.CreateDescribeRouteTableListRequest()
+	request.Scheme = "https"
+
+	var routerInfoList []*irs.RouterInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBDiskPageSize)
+
+		result, err := routerHandler.Client.DescribeRouteTableList(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get RouteTables, %v", err)
+			return nil, err
+		}
+		for _, cur := range result.RouterTableList.RouterTableListType {
+			routerInfo, err := routerHandler.extractRouterInfo(cur)
+			if err != nil {
+				return nil, err
+			}
+			routerInfoList = append(routerInfoList, &routerInfo)
+		}
+		if pageNumber*CBDiskPageSize >= result.TotalCount {
+			break
+		}
+	}
+	return routerInfoList, nil
+}
+
+func (routerHandler *AlibabaRouterHandler) GetRouter(routerID string) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s]", routerID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.extractRouterInfo(routeTable)
+}
+
+// 연결된 VSwitch가 있으면 삭제되지 않으므로 연결을 먼저 해제 함.
+func (routerHandler *AlibabaRouterHandler) DeleteRouter(routerID string) (bool, error) {
+	cblogger.Infof("routerID : [%s]", routerID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return false, err
+	}
+	for _, vSwitchID := range routeTable.VSwitchIds.VSwitchId {
+		if _, err := routerHandler.DeleteInterface(routerID, vSwitchID); err != nil {
+			return false, err
+		}
+	}
+
+	request := vpc.CreateDeleteRouteTableRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+
+	_, err = routerHandler.Client.DeleteRouteTable(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete RouteTable: %s, %v.", routerID, err)
+		return false, err
+	}
+	cblogger.Infof("Successfully deleted %q RouteTable", routerID)
+	return true, nil
+}
+
+// Next Hop은 VM(Instance), NAT Gateway, Internet Gateway(IPv4 Gateway)를 지원 함.
+func (routerHandler *AlibabaRouterHandler) AddRoute(routerID string, routeInfo irs.RouteInfo) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s], routeInfo : [%v]", routerID, routeInfo)
+
+	request := vpc.CreateCreateRouteEntryRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+	request.DestinationCidrBlock = routeInfo.DestinationCIDR
+	request.NextHopId = routeInfo.TargetId
+
+	switch routeInfo.TargetType {
+	case irs.RouteTargetVM:
+		request.NextHopType = "Instance"
+	case irs.RouteTargetNATGateway:
+		request.NextHopType = "NatGateway"
+	case irs.RouteTargetInternetGateway:
+		request.NextHopType = "Ipv4Gateway"
+		if request.NextHopId == "" {
+			routeTable, err := routerHandler.describeRouteTable(routerID)
+			if err != nil {
+				return irs.RouterInfo{}, err
+			}
+			ipv4Gateway, err := routerHandler.getIpv4Gateway(routeTable.VpcId)
+			if err != nil {
+				return irs.RouterInfo{}, err
+			}
+			if ipv4Gateway == nil {
+				return irs.RouterInfo{}, errors.New("VPC[" + routeTable.VpcId + "]에 IPv4 Gateway가 없습니다.")
+			}
+			request.NextHopId = ipv4Gateway.Ipv4GatewayId
+		}
+	default:
+		return irs.RouterInfo{}, errors.New("Alibaba에서 지원하지 않는 Route Target Type입니다. : " + string(routeInfo.TargetType))
+	}
+
+	result, err := routerHandler.Client.CreateRouteEntry(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create RouteEntry: %s, %v.", routeInfo.DestinationCIDR, err)
+		return irs.RouterInfo{}, err
+	}
+	cblogger.Infof("Created RouteEntry %q %s", result.RouteEntryId, routeInfo.DestinationCIDR)
+
+	// Route Table은 Route Entry가 Available 상태일 때만 변경할 수 있음.
+	for i := 0; ; i++ {
+		routeEntryList, err := routerHandler.describeRouteEntries(routerID)
+		if err != nil {
+			return irs.RouterInfo{}, err
+		}
+		available := false
+		for _, routeEntry := range routeEntryList {
+			if routeEntry.RouteEntryId == result.RouteEntryId && routeEntry.Status == "Available" {
+				available = true
+			}
+		}
+		if available {
+			break
+		}
+		if i >= CBVPCWaitTime/CBVPCCheckTime {
+			return irs.RouterInfo{}, errors.New("RouteEntry[" + result.RouteEntryId + "]가 Available 상태가 되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+
+	return routerHandler.GetRouter(routerID)
+}
+
+func (routerHandler *AlibabaRouterHandler) RemoveRoute(routerID string, destinationCIDR string) (bool, error) {
+	cblogger.Infof("routerID : [%s], destinationCIDR : [%s]", routerID, destinationCIDR)
+
+	request := vpc.CreateDeleteRouteEntryRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+	request.DestinationCidrBlock = destinationCIDR
+
+	_, err := routerHandler.Client.DeleteRouteEntry(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete RouteEntry: %s, %v.", destinationCIDR, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (routerHandler *AlibabaRouterHandler) AddInterface(routerID string, subnetID string) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s], subnetID : [%s]", routerID, subnetID)
+
+	request := vpc.CreateAssociateRouteTableRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+	request.VSwitchId = subnetID
+
+	_, err := routerHandler.Client.AssociateRouteTable(request)
+	if err != nil {
+		cblogger.Errorf("Unable to associate RouteTable with VSwitch: %s, %v.", subnetID, err)
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.GetRouter(routerID)
+}
+
+func (routerHandler *AlibabaRouterHandler) DeleteInterface(routerID string, subnetID string) (bool, error) {
+	cblogger.Infof("routerID : [%s], subnetID : [%s]", routerID, subnetID)
+
+	request := vpc.CreateUnassociateRouteTableRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+	request.VSwitchId = subnetID
+
+	_, err := routerHandler.Client.UnassociateRouteTable(request)
+	if err != nil {
+		cblogger.Errorf("Unable to unassociate RouteTable with VSwitch: %s, %v.", subnetID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// VPC의 IPv4 Gateway가 없으면 생성/활성화한 후 0.0.0.0/0 Route를 추가 함.
+func (routerHandler *AlibabaRouterHandler) AttachInternetGateway(routerID string) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s]", routerID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	ipv4Gateway, err := routerHandler.getIpv4Gateway(routeTable.VpcId)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+
+	var ipv4GatewayID string
+	if ipv4Gateway != nil {
+		ipv4GatewayID = ipv4Gateway.Ipv4GatewayId
+	} else {
+		request := vpc.CreateCreateIpv4GatewayRequest()
+		request.Scheme = "https"
+		request.VpcId = routeTable.VpcId
+
+		result, err := routerHandler.Client.CreateIpv4Gateway(request)
+		if err != nil {
+			cblogger.Errorf("Unable to create Ipv4Gateway: %s, %v.", routeTable.VpcId, err)
+			return irs.RouterInfo{}, err
+		}
+		ipv4GatewayID = result.Ipv4GatewayId
+		cblogger.Infof("Created Ipv4Gateway %q", ipv4GatewayID)
+
+		// IPv4 Gateway는 Created 상태일 때만 활성화할 수 있음.
+		for i := 0; ; i++ {
+			ipv4Gateway, err = routerHandler.getIpv4Gateway(routeTable.VpcId)
+			if err != nil {
+				return irs.RouterInfo{}, err
+			}
+			if ipv4Gateway != nil && ipv4Gateway.Status == "Created" {
+				break
+			}
+			if i >= CBVPCWaitTime/CBVPCCheckTime {
+				return irs.RouterInfo{}, errors.New("Ipv4Gateway[" + ipv4GatewayID + "]가 Created 상태가 되지 않았습니다.")
+			}
+			time.Sleep(time.Second * CBVPCCheckTime)
+		}
+	}
+
+	if !ipv4Gateway.Enabled {
+		request := vpc.CreateEnableVpcIpv4GatewayRequest()
+		request.Scheme = "https"
+		request.Ipv4GatewayId = ipv4GatewayID
+		request.RouteTableList = &[]string{routerID}
+
+		_, err := routerHandler.Client.EnableVpcIpv4Gateway(request)
+		if err != nil {
+			cblogger.Errorf("Unable to enable Ipv4Gateway: %s, %v.", ipv4GatewayID, err)
+			return irs.RouterInfo{}, err
+		}
+	}
+
+	return routerHandler.AddRoute(routerID, irs.RouteInfo{
+		DestinationCIDR: CBInternetGatewayCIDR,
+		TargetType:      irs.RouteTargetInternetGateway,
+		TargetId:        ipv4GatewayID,
+	})
+}
+
+// IPv4 Gateway는 VPC의 다른 Route Table에서 사용할 수 있으므로 0.0.0.0/0 Route만 삭제 함.
+func (routerHandler *AlibabaRouterHandler) DetachInternetGateway(routerID string) (bool, error) {
+	return routerHandler.RemoveRoute(routerID, CBInternetGatewayCIDR)
+}
+
+func (routerHandler *AlibabaRouterHandler) describeRouteTable(routerID string) (vpc.RouterTableListType, error) {
+	request := vpc.CreateDescribeRouteTableListRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+
+	result, err := routerHandler.Client.DescribeRouteTableList(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get RouteTable: %s, %v.", routerID, err)
+		return vpc.RouterTableListType{}, err
+	}
+	if len(result.RouterTableList.RouterTableListType) < 1 {
+		return vpc.RouterTableListType{}, errors.New("RouteTable[" + routerID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.RouterTableList.RouterTableListType[0], nil
+}
+
+func (routerHandler *AlibabaRouterHandler) describeRouteEntries(routerID string) ([]vpc.RouteEntry, error) {
+	request := vpc.CreateDescribeRouteEntryListRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routerID
+	request.MaxResult = requests.NewInteger(CBRouteEntryPageSize)
+
+	var routeEntryList []vpc.RouteEntry
+	for {
+		result, err := routerHandler.Client.DescribeRouteEntryList(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get RouteEntries, %v", err)
+			return nil, err
+		}
+		routeEntryList = append(routeEntryList, result.RouteEntrys.RouteEntry...)
+		if result.NextToken == "" {
+			break
+		}
+		request.NextToken = result.NextToken
+	}
+	return routeEntryList, nil
+}
+
+// VPC의 IPv4 Gateway (VPC 당 1개, 없으면 nil)
+func (routerHandler *AlibabaRouterHandler) getIpv4Gateway(vpcID string) (*vpc.Ipv4GatewayModelsItem, error) {
+	request := vpc.CreateListIpv4GatewaysRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcID
+
+	result, err := routerHandler.Client.ListIpv4Gateways(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get Ipv4Gateways, %v", err)
+		return nil, err
+	}
+	if len(result.Ipv4GatewayModels) < 1 {
+		return nil, nil
+	}
+	return &result.Ipv4GatewayModels[0], nil
+}
+
+// Route Table의 상태가 status가 될 때까지 대기 함.
+func (routerHandler *AlibabaRouterHandler) waitForRouterStatus(routerID string, status string) (vpc.RouterTableListType, error) {
+	for i := 0; i < CBVPCWaitTime/CBVPCCheckTime; i++ {
+		routeTable, err := routerHandler.describeRouteTable(routerID)
+		if err != nil {
+			return vpc.RouterTableListType{}, err
+		}
+		if routeTable.Status == status {
+			return routeTable, nil
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+	return vpc.RouterTableListType{}, errors.New("RouteTable[" + routerID + "]가 " + status + " 상태가 되지 않았습니다.")
+}
+
+// Route Table과 Route Entry, 연결된 VSwitch 정보를 추출함
+func (routerHandler *AlibabaRouterHandler) extractRouterInfo(routeTable vpc.RouterTableListType) (irs.RouterInfo, error) {
+	result := irs.RouterInfo{
+		Id:           routeTable.RouteTableId,
+		Name:         routeTable.RouteTableName,
+		VPCId:        routeTable.VpcId,
+		SubnetIdList: routeTable.VSwitchIds.VSwitchId,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: routeTable.Status},
+			{Key: "RouteTableType", Value: routeTable.RouteTableType},
+			{Key: "RouterId", Value: routeTable.RouterId},
+			{Key: "CreationTime", Value: routeTable.CreationTime},
+		},
+	}
+
+	routeEntryList, err := routerHandler.describeRouteEntries(routeTable.RouteTableId)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	for _, routeEntry := range routeEntryList {
+		routeInfo := irs.RouteInfo{
+			DestinationCIDR: routeEntry.DestinationCidrBlock,
+			TargetType:      irs.RouteTargetOther,
+			TargetId:        routeEntry.InstanceId,
+		}
+		switch {
+		case routeEntry.Type == "System":
+			routeInfo.TargetType = irs.RouteTargetLocal
+		case routeEntry.NextHopType == "Instance":
+			routeInfo.TargetType = irs.RouteTargetVM
+		case routeEntry.NextHopType == "NatGateway":
+			routeInfo.TargetType = irs.RouteTargetNATGateway
+		case routeEntry.NextHopType == "Ipv4Gateway":
+			routeInfo.TargetType = irs.RouteTargetInternetGateway
+			if routeEntry.DestinationCidrBlock == CBInternetGatewayCIDR {
+				result.InternetGateway = true
+			}
+		}
+		result.RouteList = append(result.RouteList, routeInfo)
+	}
+	return result, nil
+}
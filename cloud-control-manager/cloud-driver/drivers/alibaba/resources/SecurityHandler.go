@@ -12,6 +12,8 @@ package resources
 
 import (
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -31,6 +33,10 @@ func (securityHandler *AlibabaSecurityHandler) CreateSecurity(securityReqInfo ir
 	cblogger.Infof("securityReqInfo : ", securityReqInfo)
 	spew.Dump(securityReqInfo)
 
+	if err := checkUserTagList(securityReqInfo.TagList); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	request := ecs.CreateCreateSecurityGroupRequest()
     request.Scheme = "https"
 
@@ -48,6 +54,13 @@ func (securityHandler *AlibabaSecurityHandler) CreateSecurity(securityReqInfo ir
 	request.Description = securityReqInfo.Name
  	request.SecurityGroupName = securityReqInfo.Name
 	request.VpcId = vpcId // "vpc-t4nevljk4rfqxa9n92vh7"
+	if len(securityReqInfo.TagList) > 0 {
+		var tags []ecs.CreateSecurityGroupTag
+		for _, tag := range securityReqInfo.TagList {
+			tags = append(tags, ecs.CreateSecurityGroupTag{Key: tag.Key, Value: tag.Value})
+		}
+		request.Tag = &tags
+	}
 
 	cblogger.Debugf("보안 그룹 생성 요청 정보", request)
 
@@ -330,7 +343,7 @@ func ExtractIpPermissions(ipPermissions []*ecs.Permission) []*irs.SecurityRuleIn
 		for _, ipv4 := range ip.IpRanges {
 			cblogger.Info("Inbound/Outbound 정보 조회 : ", *ip.IpProtocol)
 			securityRuleInfo := new(irs.SecurityRuleInfo)
-			securityRuleInfo.Cidr = *ipv4.CidrIp
+			securityRuleInfo.CIDR = *ipv4.CidrIp
 
 			ExtractIpPermissionCommon(ip, securityRuleInfo)
 			results = append(results, securityRuleInfo)
@@ -339,7 +352,7 @@ func ExtractIpPermissions(ipPermissions []*ecs.Permission) []*irs.SecurityRuleIn
 		//ipv6 처리
 		for _, ipv6 := range ip.Ipv6Ranges {
 			securityRuleInfo := new(irs.SecurityRuleInfo)
-			securityRuleInfo.Cidr = *ipv6.CidrIpv6
+			securityRuleInfo.CIDR = *ipv6.CidrIpv6
 
 			ExtractIpPermissionCommon(ip, securityRuleInfo)
 			results = append(results, securityRuleInfo)
@@ -348,7 +361,7 @@ func ExtractIpPermissions(ipPermissions []*ecs.Permission) []*irs.SecurityRuleIn
 		//ELB나 보안그룹 참조 방식 처리
 		for _, userIdGroup := range ip.UserIdGroupPairs {
 			securityRuleInfo := new(irs.SecurityRuleInfo)
-			securityRuleInfo.Cidr = *userIdGroup.GroupId
+			securityRuleInfo.SourceSecurityGroupId = *userIdGroup.GroupId
 			// *userIdGroup.GroupName / *userIdGroup.UserId
 
 			ExtractIpPermissionCommon(ip, securityRuleInfo)
@@ -357,12 +370,12 @@ func ExtractIpPermissions(ipPermissions []*ecs.Permission) []*irs.SecurityRuleIn
 
 		/*  @TODO : 미지원 방식 체크 로직 추가 여부 결정해야 함.
 		if !reflect.ValueOf(ip.IpRanges).IsNil() {
-			securityRuleInfo.Cidr = *ip.IpRanges[0].CidrIp
+			securityRuleInfo.CIDR = *ip.IpRanges[0].CidrIp
 		} else {
 			//ELB나 다른 보안그룹 참조처럼 IpRanges가 없고 UserIdGroupPairs가 있는 경우 처리
 			//https://docs.aws.amazon.com/ko_kr/elasticloadbalancing/latest/classic/elb-security-groups.html
 			if !reflect.ValueOf(ip.UserIdGroupPairs).IsNil() {
-				securityRuleInfo.Cidr = *ip.UserIdGroupPairs[0].GroupId
+				securityRuleInfo.SourceSecurityGroupId = *ip.UserIdGroupPairs[0].GroupId
 			} else {
 				cblogger.Error("미지원 보안 그룹 형태 발견 - 구조 파악 필요 ", ip)
 			}
@@ -395,12 +408,12 @@ func _ExtractIpPermissions(ipPermissions []*ecs.Permission) []*irs.SecurityRuleI
 		securityRuleInfo.IPProtocol = *ip.IpProtocol
 
 		if !reflect.ValueOf(ip.IpRanges).IsNil() {
-			securityRuleInfo.Cidr = *ip.IpRanges[0].CidrIp
+			securityRuleInfo.CIDR = *ip.IpRanges[0].CidrIp
 		} else {
 			//ELB나 다른 보안그룹 참조처럼 IpRanges가 없고 UserIdGroupPairs가 있는 경우 처리
 			//https://docs.aws.amazon.com/ko_kr/elasticloadbalancing/latest/classic/elb-security-groups.html
 			if !reflect.ValueOf(ip.UserIdGroupPairs).IsNil() {
-				securityRuleInfo.Cidr = *ip.UserIdGroupPairs[0].GroupId
+				securityRuleInfo.SourceSecurityGroupId = *ip.UserIdGroupPairs[0].GroupId
 			} else {
 				cblogger.Error("미지원 보안 그룹 형태 발견 - 구조 파악 필요 ", ip)
 			}
@@ -437,3 +450,139 @@ func (securityHandler *AlibabaSecurityHandler) DeleteSecurity(securityID string)
 
 	return true, nil
 }
+
+//보안 그룹에 Rule 추가 (Direction이 outbound/egress이면 Egress Rule)
+func (securityHandler *AlibabaSecurityHandler) AddRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	cblogger.Infof("securityID : [%s]", securityID)
+	if securityRules == nil {
+		return securityHandler.GetSecurity(securityID)
+	}
+
+	for _, securityRule := range *securityRules {
+		portRange := getSecurityRulePortRange(securityRule)
+		priority := ""
+		if securityRule.Priority > 0 {
+			priority = strconv.Itoa(securityRule.Priority)
+		}
+
+		if isEgressSecurityRule(securityRule) {
+			request := ecs.CreateAuthorizeSecurityGroupEgressRequest()
+			request.Scheme = "https"
+			request.SecurityGroupId = securityID
+			request.IpProtocol = securityRule.IPProtocol
+			request.PortRange = portRange
+			request.Priority = priority
+			request.Description = securityRule.Description
+			if securityRule.SourceSecurityGroupId != "" {
+				request.DestGroupId = securityRule.SourceSecurityGroupId
+			} else {
+				request.DestCidrIp = getSecurityRuleCIDR(securityRule)
+			}
+
+			_, err := securityHandler.Client.AuthorizeSecurityGroupEgress(request)
+			if err != nil {
+				cblogger.Errorf("Unable to create security group rule egress %s %s, %v", securityRule.IPProtocol, portRange, err)
+				return irs.SecurityInfo{}, err
+			}
+		} else {
+			request := ecs.CreateAuthorizeSecurityGroupRequest()
+			request.Scheme = "https"
+			request.SecurityGroupId = securityID
+			request.IpProtocol = securityRule.IPProtocol
+			request.PortRange = portRange
+			request.Priority = priority
+			request.Description = securityRule.Description
+			if securityRule.SourceSecurityGroupId != "" {
+				request.SourceGroupId = securityRule.SourceSecurityGroupId
+			} else {
+				request.SourceCidrIp = getSecurityRuleCIDR(securityRule)
+			}
+
+			_, err := securityHandler.Client.AuthorizeSecurityGroup(request)
+			if err != nil {
+				cblogger.Errorf("Unable to create security group rule ingress %s %s, %v", securityRule.IPProtocol, portRange, err)
+				return irs.SecurityInfo{}, err
+			}
+		}
+	}
+
+	return securityHandler.GetSecurity(securityID)
+}
+
+//보안 그룹에서 Rule 삭제 (Direction, 프로토콜, 포트, CIDR 또는 Source 보안 그룹이 일치하는 Rule)
+func (securityHandler *AlibabaSecurityHandler) RemoveRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	cblogger.Infof("securityID : [%s]", securityID)
+	if securityRules == nil {
+		return true, nil
+	}
+
+	for _, securityRule := range *securityRules {
+		portRange := getSecurityRulePortRange(securityRule)
+
+		if isEgressSecurityRule(securityRule) {
+			request := ecs.CreateRevokeSecurityGroupEgressRequest()
+			request.Scheme = "https"
+			request.SecurityGroupId = securityID
+			request.IpProtocol = securityRule.IPProtocol
+			request.PortRange = portRange
+			if securityRule.SourceSecurityGroupId != "" {
+				request.DestGroupId = securityRule.SourceSecurityGroupId
+			} else {
+				request.DestCidrIp = getSecurityRuleCIDR(securityRule)
+			}
+
+			_, err := securityHandler.Client.RevokeSecurityGroupEgress(request)
+			if err != nil {
+				cblogger.Errorf("Unable to revoke security group rule egress %s %s, %v", securityRule.IPProtocol, portRange, err)
+				return false, err
+			}
+		} else {
+			request := ecs.CreateRevokeSecurityGroupRequest()
+			request.Scheme = "https"
+			request.SecurityGroupId = securityID
+			request.IpProtocol = securityRule.IPProtocol
+			request.PortRange = portRange
+			if securityRule.SourceSecurityGroupId != "" {
+				request.SourceGroupId = securityRule.SourceSecurityGroupId
+			} else {
+				request.SourceCidrIp = getSecurityRuleCIDR(securityRule)
+			}
+
+			_, err := securityHandler.Client.RevokeSecurityGroup(request)
+			if err != nil {
+				cblogger.Errorf("Unable to revoke security group rule ingress %s %s, %v", securityRule.IPProtocol, portRange, err)
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func isEgressSecurityRule(securityRule irs.SecurityRuleInfo) bool {
+	direction := strings.ToLower(securityRule.Direction)
+	return direction == "outbound" || direction == "egress"
+}
+
+//CIDR 생략 시 0.0.0.0/0
+func getSecurityRuleCIDR(securityRule irs.SecurityRuleInfo) string {
+	if securityRule.CIDR == "" {
+		return "0.0.0.0/0"
+	}
+	return securityRule.CIDR
+}
+
+//PortRange 형식 : "1/200", 포트 생략 시(icmp, all 등) "-1/-1"
+func getSecurityRulePortRange(securityRule irs.SecurityRuleInfo) string {
+	fromPort, toPort := securityRule.FromPort, securityRule.ToPort
+	if fromPort == "" {
+		fromPort = toPort
+	}
+	if fromPort == "" {
+		return "-1/-1"
+	}
+	if toPort == "" {
+		toPort = fromPort
+	}
+	return fromPort + "/" + toPort
+}
@@ -0,0 +1,243 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBSnapshotWaitTime = 1800 // seconds, 스냅샷은 디스크 크기에 따라 오래 걸림
+)
+
+type AlibabaSnapshotHandler struct {
+	Region idrv.RegionInfo
+	Client *ecs.Client
+}
+
+// VM 스냅샷은 VM의 시스템 디스크 스냅샷으로 생성하며, 원본 VM ID는 태그로 보관 함.
+func (snapshotHandler *AlibabaSnapshotHandler) CreateSnapshot(snapshotReqInfo irs.SnapshotReqInfo) (irs.SnapshotInfo, error) {
+	cblogger.Info("Start CreateSnapshot : ", snapshotReqInfo)
+
+	diskID, err := snapshotHandler.getSourceDiskID(snapshotReqInfo)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+
+	request := ecs.CreateCreateSnapshotRequest()
+	request.Scheme = "https"
+	request.DiskId = diskID
+	request.SnapshotName = snapshotReqInfo.Name
+	if snapshotReqInfo.SourceType == irs.SnapshotSourceVM {
+		request.Tag = &[]ecs.CreateSnapshotTag{
+			{Key: irs.SnapshotSourceVMKey, Value: snapshotReqInfo.SourceId},
+		}
+	}
+
+	result, err := snapshotHandler.Client.CreateSnapshot(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create Snapshot: %s, %v.", snapshotReqInfo.Name, err)
+		return irs.SnapshotInfo{}, err
+	}
+	cblogger.Infof("Created Snapshot %q %s", result.SnapshotId, snapshotReqInfo.Name)
+
+	// Status : progressing | accomplished | failed
+	for i := 0; i < CBSnapshotWaitTime/CBDiskCheckTime; i++ {
+		snapshot, err := snapshotHandler.describeSnapshot(result.SnapshotId)
+		if err != nil {
+			return irs.SnapshotInfo{}, err
+		}
+		if snapshot.Status == "accomplished" {
+			return ExtractSnapshotInfo(snapshot), nil
+		}
+		if snapshot.Status == "failed" {
+			return irs.SnapshotInfo{}, errors.New("Snapshot[" + result.SnapshotId + "] 생성에 실패했습니다.")
+		}
+		time.Sleep(time.Second * CBDiskCheckTime)
+	}
+	return irs.SnapshotInfo{}, errors.New("Snapshot[" + result.SnapshotId + "]가 생성되지 않았습니다.")
+}
+
+func (snapshotHandler *AlibabaSnapshotHandler) ListSnapshot() ([]*irs.SnapshotInfo, error) {
+	cblogger.Debug("Start")
+
+	var snapshotInfoList []*irs.SnapshotInfo
+	for pageNumber := 1; ; pageNumber++ {
+		request := ecs.CreateDescribeSnapshotsRequest()
+		request.Scheme = "https"
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBDiskPageSize)
+
+		result, err := snapshotHandler.Client.DescribeSnapshots(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get Snapshots, %v", err)
+			return nil, err
+		}
+		for _, snapshot := range result.Snapshots.Snapshot {
+			snapshotInfo := ExtractSnapshotInfo(snapshot)
+			snapshotInfoList = append(snapshotInfoList, &snapshotInfo)
+		}
+		if pageNumber*CBDiskPageSize >= result.TotalCount {
+			break
+		}
+	}
+	return snapshotInfoList, nil
+}
+
+func (snapshotHandler *AlibabaSnapshotHandler) GetSnapshot(snapshotID string) (irs.SnapshotInfo, error) {
+	cblogger.Infof("snapshotID : [%s]", snapshotID)
+
+	snapshot, err := snapshotHandler.describeSnapshot(snapshotID)
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+	return ExtractSnapshotInfo(snapshot), nil
+}
+
+func (snapshotHandler *AlibabaSnapshotHandler) DeleteSnapshot(snapshotID string) (bool, error) {
+	cblogger.Infof("snapshotID : [%s]", snapshotID)
+
+	request := ecs.CreateDeleteSnapshotRequest()
+	request.Scheme = "https"
+	request.SnapshotId = snapshotID
+
+	_, err := snapshotHandler.Client.DeleteSnapshot(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete Snapshot: %s, %v.", snapshotID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (snapshotHandler *AlibabaSnapshotHandler) CreateDiskFromSnapshot(snapshotID string, diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	cblogger.Infof("snapshotID : [%s], diskReqInfo : [%v]", snapshotID, diskReqInfo)
+
+	if err := checkUserTagList(diskReqInfo.TagList); err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	request := ecs.CreateCreateDiskRequest()
+	request.Scheme = "https"
+	request.SnapshotId = snapshotID
+	request.DiskName = diskReqInfo.Name
+	request.DiskCategory = diskReqInfo.DiskType
+	if diskReqInfo.DiskSize != "" {
+		request.Size = requests.Integer(diskReqInfo.DiskSize)
+	}
+	request.ZoneId = diskReqInfo.Zone
+	if request.ZoneId == "" {
+		request.ZoneId = snapshotHandler.Region.Zone
+	}
+	if len(diskReqInfo.TagList) > 0 {
+		var tags []ecs.CreateDiskTag
+		for _, tag := range diskReqInfo.TagList {
+			tags = append(tags, ecs.CreateDiskTag{Key: tag.Key, Value: tag.Value})
+		}
+		request.Tag = &tags
+	}
+
+	result, err := snapshotHandler.Client.CreateDisk(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create Disk: %s, %v.", diskReqInfo.Name, err)
+		return irs.DiskInfo{}, err
+	}
+
+	diskHandler := AlibabaDiskHandler{snapshotHandler.Region, snapshotHandler.Client}
+	disk, err := diskHandler.waitForStatus(result.DiskId, "Available")
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return ExtractDiskInfo(disk), nil
+}
+
+func (snapshotHandler *AlibabaSnapshotHandler) describeSnapshot(snapshotID string) (ecs.Snapshot, error) {
+	request := ecs.CreateDescribeSnapshotsRequest()
+	request.Scheme = "https"
+	request.SnapshotIds = "[\"" + snapshotID + "\"]"
+
+	result, err := snapshotHandler.Client.DescribeSnapshots(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get Snapshot: %s, %v.", snapshotID, err)
+		return ecs.Snapshot{}, err
+	}
+	if len(result.Snapshots.Snapshot) < 1 {
+		return ecs.Snapshot{}, errors.New("Snapshot[" + snapshotID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.Snapshots.Snapshot[0], nil
+}
+
+// 스냅샷 대상 디스크 ID (VM인 경우 시스템 디스크)
+func (snapshotHandler *AlibabaSnapshotHandler) getSourceDiskID(snapshotReqInfo irs.SnapshotReqInfo) (string, error) {
+	switch snapshotReqInfo.SourceType {
+	case irs.SnapshotSourceDisk, "":
+		return snapshotReqInfo.SourceId, nil
+	case irs.SnapshotSourceVM:
+	default:
+		return "", errors.New("SourceType[" + string(snapshotReqInfo.SourceType) + "] 값이 올바르지 않습니다.")
+	}
+
+	request := ecs.CreateDescribeDisksRequest()
+	request.Scheme = "https"
+	request.InstanceId = snapshotReqInfo.SourceId
+	request.DiskType = "system"
+
+	result, err := snapshotHandler.Client.DescribeDisks(request)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Disks.Disk) < 1 {
+		return "", errors.New("VM[" + snapshotReqInfo.SourceId + "]의 시스템 디스크를 찾을 수 없습니다.")
+	}
+	return result.Disks.Disk[0].DiskId, nil
+}
+
+// Snapshot에서 SnapshotInfo 정보를 추출함
+func ExtractSnapshotInfo(snapshot ecs.Snapshot) irs.SnapshotInfo {
+	snapshotInfo := irs.SnapshotInfo{
+		Id:       snapshot.SnapshotId,
+		Name:     snapshot.SnapshotName,
+		DiskSize: snapshot.SourceDiskSize,
+	}
+
+	// Status : progressing | accomplished | failed
+	switch snapshot.Status {
+	case "progressing":
+		snapshotInfo.Status = irs.SnapshotCreating
+	case "accomplished":
+		snapshotInfo.Status = irs.SnapshotAvailable
+	default:
+		snapshotInfo.Status = irs.SnapshotError
+	}
+
+	snapshotInfo.KeyValueList = []irs.KeyValue{
+		{Key: irs.SnapshotSourceDiskKey, Value: snapshot.SourceDiskId},
+	}
+	for _, tag := range snapshot.Tags.Tag {
+		if tag.TagKey == irs.SnapshotSourceVMKey {
+			snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: irs.SnapshotSourceVMKey, Value: tag.TagValue})
+		}
+	}
+	// ex) 2019-11-20T08:01Z
+	if createdTime, err := time.Parse("2006-01-02T15:04Z", snapshot.CreationTime); err == nil {
+		snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: irs.SnapshotCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
+	}
+	snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: "Status", Value: snapshot.Status})
+	snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: "Progress", Value: snapshot.Progress})
+	snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: "SourceDiskType", Value: snapshot.SourceDiskType})
+	return snapshotInfo
+}
@@ -0,0 +1,379 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// SetNameTag 등 드라이버가 관리하는 Tag로 사용자 Tag에서 제외 함.
+var cbDriverTagKeys = map[string]bool{
+	"Name":               true,
+	"cbName":             true,
+	CBMetaDefaultTagName: true,
+}
+
+// VM, SecurityGroup, Disk는 ECS Tag API를 VPC, VNetwork(VSwitch), PublicIP(EIP)는 VPC Tag API를 사용 함.
+type AlibabaTagHandler struct {
+	Region    idrv.RegionInfo
+	Client    *ecs.Client
+	VPCClient *vpc.Client
+}
+
+// ECS Tag API의 ResourceType
+var ecsTagResourceTypes = map[irs.RSType]string{
+	irs.RSTypeVM:            "instance",
+	irs.RSTypeSecurityGroup: "securitygroup",
+	irs.RSTypeDisk:          "disk",
+}
+
+// VPC Tag API의 ResourceType
+var vpcTagResourceTypes = map[irs.RSType]string{
+	irs.RSTypeVPC:      "VPC",
+	irs.RSTypeVNetwork: "VSWITCH",
+	irs.RSTypePublicIP: "EIP",
+}
+
+func checkTagResourceType(resType irs.RSType) error {
+	if _, ok := ecsTagResourceTypes[resType]; ok {
+		return nil
+	}
+	if _, ok := vpcTagResourceTypes[resType]; ok {
+		return nil
+	}
+	return errors.New("지원하지 않는 리소스 타입[" + string(resType) + "] 입니다.")
+}
+
+func checkUserTagKey(key string) error {
+	if key == "" {
+		return errors.New("Tag Key가 없습니다.")
+	}
+	if cbDriverTagKeys[key] {
+		return errors.New("[" + key + "] Tag는 드라이버에서 관리하므로 변경할 수 없습니다.")
+	}
+	return nil
+}
+
+// 리소스 생성 요청의 사용자 Tag(TagList)는 생성 전에 확인 함.
+func checkUserTagList(tagList []irs.KeyValue) error {
+	for _, tag := range tagList {
+		if err := checkUserTagKey(tag.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 생성 요청에 Tag를 전달할 수 없는 리소스(VM, EIP)는 생성 직후 사용자 Tag를 설정 함.
+func addUserTagList(tagHandler AlibabaTagHandler, resType irs.RSType, resId string, tagList []irs.KeyValue) error {
+	for _, tag := range tagList {
+		if _, err := tagHandler.AddTag(resType, resId, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyword가 Tag의 Key 또는 Value에 포함되면 true (""과 "*"은 모든 Tag)
+func matchTagKeyword(tag irs.KeyValue, keyword string) bool {
+	if keyword == "" || keyword == "*" {
+		return true
+	}
+	return strings.Contains(tag.Key, keyword) || strings.Contains(tag.Value, keyword)
+}
+
+func (tagHandler *AlibabaTagHandler) AddTag(resType irs.RSType, resId string, tag irs.KeyValue) (irs.KeyValue, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s] / tag : [%v]", resType, resId, tag)
+
+	if err := checkTagResourceType(resType); err != nil {
+		return irs.KeyValue{}, err
+	}
+	if err := checkUserTagKey(tag.Key); err != nil {
+		return irs.KeyValue{}, err
+	}
+
+	// 같은 Key의 Tag는 Value를 덮어 씀
+	if ecsResType, ok := ecsTagResourceTypes[resType]; ok {
+		request := ecs.CreateTagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = ecsResType
+		request.ResourceId = &[]string{resId}
+		request.Tag = &[]ecs.TagResourcesTag{{Key: tag.Key, Value: tag.Value}}
+
+		_, err := tagHandler.Client.TagResources(request)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.KeyValue{}, err
+		}
+	} else {
+		request := vpc.CreateTagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = vpcTagResourceTypes[resType]
+		request.ResourceId = &[]string{resId}
+		request.Tag = &[]vpc.TagResourcesTag{{Key: tag.Key, Value: tag.Value}}
+
+		_, err := tagHandler.VPCClient.TagResources(request)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.KeyValue{}, err
+		}
+	}
+
+	return tag, nil
+}
+
+func (tagHandler *AlibabaTagHandler) ListTag(resType irs.RSType, resId string) ([]irs.KeyValue, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s]", resType, resId)
+
+	if err := checkTagResourceType(resType); err != nil {
+		return nil, err
+	}
+	if resId == "" {
+		return nil, errors.New("리소스 ID가 없습니다.")
+	}
+
+	tagList := []irs.KeyValue{}
+	if ecsResType, ok := ecsTagResourceTypes[resType]; ok {
+		request := ecs.CreateListTagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = ecsResType
+		request.ResourceId = &[]string{resId}
+
+		for {
+			response, err := tagHandler.Client.ListTagResources(request)
+			if err != nil {
+				cblogger.Error(err)
+				return nil, err
+			}
+			for _, tagRes := range response.TagResources.TagResource {
+				if !cbDriverTagKeys[tagRes.TagKey] {
+					tagList = append(tagList, irs.KeyValue{Key: tagRes.TagKey, Value: tagRes.TagValue})
+				}
+			}
+			if response.NextToken == "" {
+				break
+			}
+			request.NextToken = response.NextToken
+		}
+	} else {
+		request := vpc.CreateListTagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = vpcTagResourceTypes[resType]
+		request.ResourceId = &[]string{resId}
+
+		for {
+			response, err := tagHandler.VPCClient.ListTagResources(request)
+			if err != nil {
+				cblogger.Error(err)
+				return nil, err
+			}
+			for _, tagRes := range response.TagResources.TagResource {
+				if !cbDriverTagKeys[tagRes.TagKey] {
+					tagList = append(tagList, irs.KeyValue{Key: tagRes.TagKey, Value: tagRes.TagValue})
+				}
+			}
+			if response.NextToken == "" {
+				break
+			}
+			request.NextToken = response.NextToken
+		}
+	}
+
+	return tagList, nil
+}
+
+func (tagHandler *AlibabaTagHandler) GetTag(resType irs.RSType, resId string, key string) (irs.KeyValue, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s] / key : [%s]", resType, resId, key)
+
+	tagList, err := tagHandler.ListTag(resType, resId)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+	for _, tag := range tagList {
+		if tag.Key == key {
+			return tag, nil
+		}
+	}
+	return irs.KeyValue{}, errors.New("리소스[" + resId + "]에 Tag[" + key + "]가 없습니다.")
+}
+
+func (tagHandler *AlibabaTagHandler) RemoveTag(resType irs.RSType, resId string, key string) (bool, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s] / key : [%s]", resType, resId, key)
+
+	if err := checkUserTagKey(key); err != nil {
+		return false, err
+	}
+	if _, err := tagHandler.GetTag(resType, resId, key); err != nil {
+		return false, err
+	}
+
+	if ecsResType, ok := ecsTagResourceTypes[resType]; ok {
+		request := ecs.CreateUntagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = ecsResType
+		request.ResourceId = &[]string{resId}
+		request.TagKey = &[]string{key}
+
+		_, err := tagHandler.Client.UntagResources(request)
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	} else {
+		request := vpc.CreateUnTagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = vpcTagResourceTypes[resType]
+		request.ResourceId = &[]string{resId}
+		request.TagKey = &[]string{key}
+
+		_, err := tagHandler.VPCClient.UnTagResources(request)
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// keyword가 Key 또는 Value에 포함된 Tag를 가진 리소스 목록을 리턴 함. (리소스의 모든 사용자 Tag를 함께 리턴)
+func (tagHandler *AlibabaTagHandler) FindTaggedResources(resType irs.RSType, keyword string) ([]*irs.TaggedResourceInfo, error) {
+	cblogger.Infof("resType : [%s] / keyword : [%s]", resType, keyword)
+
+	if err := checkTagResourceType(resType); err != nil {
+		return nil, err
+	}
+
+	var resIdList []string
+	var err error
+	if ecsResType, ok := ecsTagResourceTypes[resType]; ok {
+		resIdList, err = tagHandler.findEcsTaggedResourceIds(ecsResType, keyword)
+	} else {
+		resIdList, err = tagHandler.findVpcTaggedResourceIds(vpcTagResourceTypes[resType], keyword)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	taggedResList := []*irs.TaggedResourceInfo{}
+	for _, resId := range resIdList {
+		tagList, err := tagHandler.ListTag(resType, resId)
+		if err != nil {
+			return nil, err
+		}
+		taggedResList = append(taggedResList, &irs.TaggedResourceInfo{ResType: resType, ResId: resId, TagList: tagList})
+	}
+	return taggedResList, nil
+}
+
+// ECS의 DescribeTags는 리소스 ID 없이 Tag 목록만 리턴하므로 keyword에 맞는 Tag로 리소스를 다시 조회 함.
+func (tagHandler *AlibabaTagHandler) findEcsTaggedResourceIds(ecsResType string, keyword string) ([]string, error) {
+	var matchedTags []ecs.ListTagResourcesTag
+
+	request := ecs.CreateDescribeTagsRequest()
+	request.Scheme = "https"
+	request.RegionId = tagHandler.Region.Region
+	request.ResourceType = ecsResType
+	request.PageSize = "100"
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response, err := tagHandler.Client.DescribeTags(request)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for _, tag := range response.Tags.Tag {
+			if cbDriverTagKeys[tag.TagKey] {
+				continue
+			}
+			if matchTagKeyword(irs.KeyValue{Key: tag.TagKey, Value: tag.TagValue}, keyword) {
+				matchedTags = append(matchedTags, ecs.ListTagResourcesTag{Key: tag.TagKey, Value: tag.TagValue})
+			}
+		}
+		if pageNumber*response.PageSize >= response.TotalCount || len(response.Tags.Tag) == 0 {
+			break
+		}
+	}
+
+	var resIdList []string
+	resIdMap := map[string]bool{}
+	for _, tag := range matchedTags {
+		request := ecs.CreateListTagResourcesRequest()
+		request.Scheme = "https"
+		request.RegionId = tagHandler.Region.Region
+		request.ResourceType = ecsResType
+		request.Tag = &[]ecs.ListTagResourcesTag{tag}
+
+		for {
+			response, err := tagHandler.Client.ListTagResources(request)
+			if err != nil {
+				cblogger.Error(err)
+				return nil, err
+			}
+			for _, tagRes := range response.TagResources.TagResource {
+				if !resIdMap[tagRes.ResourceId] {
+					resIdMap[tagRes.ResourceId] = true
+					resIdList = append(resIdList, tagRes.ResourceId)
+				}
+			}
+			if response.NextToken == "" {
+				break
+			}
+			request.NextToken = response.NextToken
+		}
+	}
+	return resIdList, nil
+}
+
+// VPC의 DescribeTags는 리소스 타입의 모든 (리소스 ID, Tag) 목록을 리턴 함.
+func (tagHandler *AlibabaTagHandler) findVpcTaggedResourceIds(vpcResType string, keyword string) ([]string, error) {
+	var resIdList []string
+	resIdMap := map[string]bool{}
+
+	request := vpc.CreateDescribeTagsRequest()
+	request.Scheme = "https"
+	request.RegionId = tagHandler.Region.Region
+	request.ResourceType = vpcResType
+	for {
+		response, err := tagHandler.VPCClient.DescribeTags(request)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for _, tagRes := range response.TagResources.TagResource {
+			if cbDriverTagKeys[tagRes.TagKey] || resIdMap[tagRes.ResourceId] {
+				continue
+			}
+			if matchTagKeyword(irs.KeyValue{Key: tagRes.TagKey, Value: tagRes.TagValue}, keyword) {
+				resIdMap[tagRes.ResourceId] = true
+				resIdList = append(resIdList, tagRes.ResourceId)
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return resIdList, nil
+}
@@ -55,6 +55,10 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	cblogger.Info(vmReqInfo)
 	spew.Dump(vmReqInfo)
 	
+	if err := checkUserTagList(vmReqInfo.TagList); err != nil {
+		return irs.VMInfo{}, err
+	}
+
 	imageID := vmReqInfo.ImageInfo.Id
 	instanceType := vmReqInfo.SpecID // "t2.micro"
 	minCount := requests.NewInteger64(1)
@@ -102,6 +106,13 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 		return irs.VMInfo{}, errtag
 	}
 
+	// RunInstances 요청에 Tag를 전달하지 않으므로 생성 직후 사용자 Tag를 설정 함.
+	tagHandler := AlibabaTagHandler{Region: vmHandler.Region, Client: vmHandler.Client}
+	if errUserTag := addUserTagList(tagHandler, irs.RSTypeVM, newVmId, vmReqInfo.TagList); errUserTag != nil {
+		cblogger.Error("Could not create user tags for instance ", newVmId, errUserTag)
+		return irs.VMInfo{}, errUserTag
+	}
+
 	//EC2에 EIP 할당
 	cblogger.Infof("[%s] EC2에 [%s] IP 할당 시작", newVmId, vmReqInfo.PublicIPId)
 	assocRes, errIp := vmHandler.AssociatePublicIP(vmReqInfo.PublicIPId, newVmId)
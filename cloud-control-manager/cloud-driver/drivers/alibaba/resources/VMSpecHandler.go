@@ -0,0 +1,136 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AlibabaVMSpecHandler struct {
+	Region idrv.RegionInfo
+	Client *ecs.Client
+}
+
+func (vmSpecHandler *AlibabaVMSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	cblogger.Debug("Start")
+
+	instanceTypeList, err := vmSpecHandler.describeInstanceTypes(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var vmSpecInfoList []*irs.VMSpecInfo
+	for _, instanceType := range instanceTypeList {
+		vmSpecInfo := vmSpecHandler.ExtractVMSpecInfo(instanceType)
+		vmSpecInfoList = append(vmSpecInfoList, &vmSpecInfo)
+	}
+	return vmSpecInfoList, nil
+}
+
+func (vmSpecHandler *AlibabaVMSpecHandler) GetVMSpec(name string) (irs.VMSpecInfo, error) {
+	cblogger.Infof("Name : [%s]", name)
+
+	instanceTypeList, err := vmSpecHandler.describeInstanceTypes([]string{name})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMSpecInfo{}, err
+	}
+	if len(instanceTypeList) < 1 {
+		return irs.VMSpecInfo{}, errors.New("VMSpec[" + name + "] 정보를 찾을 수 없습니다.")
+	}
+
+	return vmSpecHandler.ExtractVMSpecInfo(instanceTypeList[0]), nil
+}
+
+// Alibaba의 InstanceType 목록을 JSON으로 리턴 함.
+func (vmSpecHandler *AlibabaVMSpecHandler) ListOrgVMSpec() (string, error) {
+	cblogger.Debug("Start")
+
+	instanceTypeList, err := vmSpecHandler.describeInstanceTypes(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	jsonBytes, err := json.Marshal(instanceTypeList)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// instanceTypes가 nil이면 전체 Instance Type을 조회 함.
+func (vmSpecHandler *AlibabaVMSpecHandler) describeInstanceTypes(instanceTypes []string) ([]ecs.InstanceType, error) {
+	request := ecs.CreateDescribeInstanceTypesRequest()
+	request.Scheme = "https"
+
+	result, err := vmSpecHandler.Client.DescribeInstanceTypes(request)
+	if err != nil {
+		return nil, err
+	}
+	if instanceTypes == nil {
+		return result.InstanceTypes.InstanceType, nil
+	}
+
+	var instanceTypeList []ecs.InstanceType
+	for _, instanceType := range result.InstanceTypes.InstanceType {
+		for _, name := range instanceTypes {
+			if instanceType.InstanceTypeId == name {
+				instanceTypeList = append(instanceTypeList, instanceType)
+			}
+		}
+	}
+	return instanceTypeList, nil
+}
+
+// InstanceType에서 VMSpecInfo 정보를 추출함 (MemorySize: GB, LocalStorageCapacity: GB, InstanceBandwidthRx: Kbps)
+func (vmSpecHandler *AlibabaVMSpecHandler) ExtractVMSpecInfo(instanceType ecs.InstanceType) irs.VMSpecInfo {
+	vmSpecInfo := irs.VMSpecInfo{
+		Region: vmSpecHandler.Region.Region,
+		Name:   instanceType.InstanceTypeId,
+		VCpu:   irs.VCpuInfo{Count: strconv.Itoa(instanceType.CpuCoreCount)},
+		Mem:    strconv.Itoa(int(instanceType.MemorySize * 1024)),
+		Disk:   strconv.FormatInt(instanceType.LocalStorageCapacity, 10),
+	}
+
+	if instanceType.GPUAmount > 0 {
+		vmSpecInfo.Gpu = []irs.GpuInfo{{
+			Count: strconv.Itoa(instanceType.GPUAmount),
+			Mfr:   "NVIDIA",
+			Model: instanceType.GPUSpec,
+		}}
+	}
+	if instanceType.InstanceBandwidthRx > 0 {
+		vmSpecInfo.Network = strconv.FormatFloat(float64(instanceType.InstanceBandwidthRx)/1024/1024, 'f', -1, 64)
+	}
+
+	vmSpecInfo.KeyValueList = []irs.KeyValue{
+		{Key: "InstanceTypeFamily", Value: instanceType.InstanceTypeFamily},
+		{Key: "InstanceFamilyLevel", Value: instanceType.InstanceFamilyLevel},
+		{Key: "LocalStorageCategory", Value: instanceType.LocalStorageCategory},
+		{Key: "LocalStorageAmount", Value: strconv.Itoa(instanceType.LocalStorageAmount)},
+		{Key: "EniQuantity", Value: strconv.Itoa(instanceType.EniQuantity)},
+	}
+	// ex) X86, ARM
+	if instanceType.CpuArchitecture != "" {
+		vmSpecInfo.KeyValueList = append(vmSpecInfo.KeyValueList, irs.KeyValue{Key: "CpuArchitecture", Value: instanceType.CpuArchitecture})
+	}
+
+	return vmSpecInfo
+}
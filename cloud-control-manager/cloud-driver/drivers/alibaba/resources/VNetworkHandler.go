@@ -296,6 +296,10 @@ func (vNetworkHandler *AlibabaVNetworkHandler) CreateVNetwork(vNetworkReqInfo ir
 	// 기본 가상 네트워크가 생성되지 않았을 경우 디폴트 네트워크 생성 (CB-VNet)
 	cblogger.Info(vNetworkReqInfo)
 
+	if err := checkUserTagList(vNetworkReqInfo.TagList); err != nil {
+		return irs.VNetworkInfo{}, err
+	}
+
 	//최대 5개의 VPC 생성 제한이 있기 때문에 기본VPC 조회시 에러 처리를 해줌.
 	VpcId, errVpc := vNetworkHandler.FindOrCreateMcloudBaristaDefaultVPC(vNetworkReqInfo)
 	cblogger.Info("CBDefaultVPC 조회 결과 : ", VpcId)
@@ -330,6 +334,13 @@ func (vNetworkHandler *AlibabaVNetworkHandler) CreateVNetwork(vNetworkReqInfo ir
 		request.VSwitchName = vNetworkReqInfo.Name // "vsw-zep04"
 	}
 	request.Description = request.VSwitchName
+	if len(vNetworkReqInfo.TagList) > 0 {
+		var tags []vpc.CreateVSwitchTag
+		for _, tag := range vNetworkReqInfo.TagList {
+			tags = append(tags, vpc.CreateVSwitchTag{Key: tag.Key, Value: tag.Value})
+		}
+		request.Tag = &tags
+	}
 
 	// input := &ec2.CreateSubnetInput{
 	// 	//CidrBlock: aws.String(vNetworkReqInfo.CidrBlock),
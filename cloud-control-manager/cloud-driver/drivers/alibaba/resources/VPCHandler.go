@@ -0,0 +1,311 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBVPCWaitTime  = 120 // seconds
+	CBVPCCheckTime = 2   // seconds
+)
+
+// VPC의 서브넷은 VSwitch로 매핑 함. (VSwitch는 Zone 단위 자원)
+type AlibabaVPCHandler struct {
+	Region idrv.RegionInfo
+	Client *vpc.Client
+}
+
+func (vpcHandler *AlibabaVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCInfo, error) {
+	cblogger.Info("Start CreateVPC : ", vpcReqInfo)
+
+	if vpcReqInfo.CIDR == "" {
+		return irs.VPCInfo{}, errors.New("VPC의 CIDR 정보가 필요합니다.")
+	}
+	if err := checkUserTagList(vpcReqInfo.TagList); err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	request := vpc.CreateCreateVpcRequest()
+	request.Scheme = "https"
+	request.VpcName = vpcReqInfo.Name
+	request.CidrBlock = vpcReqInfo.CIDR
+	if len(vpcReqInfo.TagList) > 0 {
+		var tags []vpc.CreateVpcTag
+		for _, tag := range vpcReqInfo.TagList {
+			tags = append(tags, vpc.CreateVpcTag{Key: tag.Key, Value: tag.Value})
+		}
+		request.Tag = &tags
+	}
+
+	result, err := vpcHandler.Client.CreateVpc(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create VPC: %s, %v.", vpcReqInfo.Name, err)
+		return irs.VPCInfo{}, err
+	}
+	cblogger.Infof("Created VPC %q %s", result.VpcId, vpcReqInfo.Name)
+
+	// VSwitch는 VPC가 Available 상태일 때만 생성할 수 있음.
+	if _, err := vpcHandler.waitForVPCStatus(result.VpcId, "Available"); err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		if _, err := vpcHandler.createVSwitch(result.VpcId, subnetInfo); err != nil {
+			// VSwitch 생성 실패 시 생성한 VPC 삭제
+			if _, errDel := vpcHandler.DeleteVPC(result.VpcId); errDel != nil {
+				cblogger.Errorf("Unable to delete VPC: %s, %v.", result.VpcId, errDel)
+			}
+			return irs.VPCInfo{}, err
+		}
+	}
+
+	return vpcHandler.GetVPC(result.VpcId)
+}
+
+func (vpcHandler *AlibabaVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
+	cblogger.Debug("Start")
+
+	request := vpc.CreateDescribeVpcsRequest()
+	request.Scheme = "https"
+
+	var vpcInfoList []*irs.VPCInfo
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBDiskPageSize)
+
+		result, err := vpcHandler.Client.DescribeVpcs(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get VPCs, %v", err)
+			return nil, err
+		}
+		for _, cur := range result.Vpcs.Vpc {
+			vpcInfo, err := vpcHandler.extractVPCInfo(cur)
+			if err != nil {
+				return nil, err
+			}
+			vpcInfoList = append(vpcInfoList, &vpcInfo)
+		}
+		if pageNumber*CBDiskPageSize >= result.TotalCount {
+			break
+		}
+	}
+	return vpcInfoList, nil
+}
+
+func (vpcHandler *AlibabaVPCHandler) GetVPC(vpcID string) (irs.VPCInfo, error) {
+	cblogger.Infof("vpcID : [%s]", vpcID)
+
+	vpcInfo, err := vpcHandler.describeVPC(vpcID)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.extractVPCInfo(vpcInfo)
+}
+
+// VPC의 VSwitch들을 먼저 삭제한 후 VPC를 삭제 함.
+func (vpcHandler *AlibabaVPCHandler) DeleteVPC(vpcID string) (bool, error) {
+	cblogger.Infof("vpcID : [%s]", vpcID)
+
+	vSwitchList, err := vpcHandler.describeVSwitches(vpcID)
+	if err != nil {
+		return false, err
+	}
+	for _, vSwitch := range vSwitchList {
+		if _, err := vpcHandler.RemoveSubnet(vpcID, vSwitch.VSwitchId); err != nil {
+			return false, err
+		}
+	}
+
+	// VSwitch 삭제가 완료되어야 VPC를 삭제할 수 있음.
+	for i := 0; ; i++ {
+		vSwitchList, err := vpcHandler.describeVSwitches(vpcID)
+		if err != nil {
+			return false, err
+		}
+		if len(vSwitchList) == 0 {
+			break
+		}
+		if i >= CBVPCWaitTime/CBVPCCheckTime {
+			return false, errors.New("VPC[" + vpcID + "]의 VSwitch들이 삭제되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+
+	request := vpc.CreateDeleteVpcRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcID
+
+	_, err = vpcHandler.Client.DeleteVpc(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete VPC: %s, %v.", vpcID, err)
+		return false, err
+	}
+	cblogger.Infof("Successfully deleted %q VPC", vpcID)
+	return true, nil
+}
+
+func (vpcHandler *AlibabaVPCHandler) AddSubnet(vpcID string, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("vpcID : [%s], subnetInfo : [%v]", vpcID, subnetInfo)
+
+	if _, err := vpcHandler.createVSwitch(vpcID, subnetInfo); err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.GetVPC(vpcID)
+}
+
+func (vpcHandler *AlibabaVPCHandler) RemoveSubnet(vpcID string, subnetID string) (bool, error) {
+	cblogger.Infof("vpcID : [%s], subnetID : [%s]", vpcID, subnetID)
+
+	request := vpc.CreateDeleteVSwitchRequest()
+	request.Scheme = "https"
+	request.VSwitchId = subnetID
+
+	_, err := vpcHandler.Client.DeleteVSwitch(request)
+	if err != nil {
+		cblogger.Errorf("Unable to delete VSwitch: %s, %v.", subnetID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *AlibabaVPCHandler) createVSwitch(vpcID string, subnetInfo irs.SubnetInfo) (string, error) {
+	if subnetInfo.CIDR == "" {
+		return "", errors.New("Subnet[" + subnetInfo.Name + "]의 CIDR 정보가 필요합니다.")
+	}
+
+	request := vpc.CreateCreateVSwitchRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcID
+	request.VSwitchName = subnetInfo.Name
+	request.CidrBlock = subnetInfo.CIDR
+	request.ZoneId = subnetInfo.Zone
+	if request.ZoneId == "" {
+		request.ZoneId = vpcHandler.Region.Zone
+	}
+
+	result, err := vpcHandler.Client.CreateVSwitch(request)
+	if err != nil {
+		cblogger.Errorf("Unable to create VSwitch: %s, %v.", subnetInfo.Name, err)
+		return "", err
+	}
+	cblogger.Infof("Created VSwitch %q %s", result.VSwitchId, subnetInfo.Name)
+
+	// 다음 VSwitch 생성이나 VPC 조회 전에 생성 완료를 기다림.
+	for i := 0; i < CBVPCWaitTime/CBVPCCheckTime; i++ {
+		vSwitchList, err := vpcHandler.describeVSwitches(vpcID)
+		if err != nil {
+			return "", err
+		}
+		for _, vSwitch := range vSwitchList {
+			if vSwitch.VSwitchId == result.VSwitchId && vSwitch.Status == "Available" {
+				return result.VSwitchId, nil
+			}
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+	return "", errors.New("VSwitch[" + result.VSwitchId + "]가 Available 상태가 되지 않았습니다.")
+}
+
+func (vpcHandler *AlibabaVPCHandler) describeVPC(vpcID string) (vpc.Vpc, error) {
+	request := vpc.CreateDescribeVpcsRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcID
+
+	result, err := vpcHandler.Client.DescribeVpcs(request)
+	if err != nil {
+		cblogger.Errorf("Unable to get VPC: %s, %v.", vpcID, err)
+		return vpc.Vpc{}, err
+	}
+	if len(result.Vpcs.Vpc) < 1 {
+		return vpc.Vpc{}, errors.New("VPC[" + vpcID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.Vpcs.Vpc[0], nil
+}
+
+func (vpcHandler *AlibabaVPCHandler) describeVSwitches(vpcID string) ([]vpc.VSwitch, error) {
+	request := vpc.CreateDescribeVSwitchesRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcID
+
+	var vSwitchList []vpc.VSwitch
+	for pageNumber := CBPageNumber; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(CBDiskPageSize)
+
+		result, err := vpcHandler.Client.DescribeVSwitches(request)
+		if err != nil {
+			cblogger.Errorf("Unable to get VSwitches, %v", err)
+			return nil, err
+		}
+		vSwitchList = append(vSwitchList, result.VSwitches.VSwitch...)
+		if pageNumber*CBDiskPageSize >= result.TotalCount {
+			break
+		}
+	}
+	return vSwitchList, nil
+}
+
+// VPC의 상태가 status가 될 때까지 대기 함.
+func (vpcHandler *AlibabaVPCHandler) waitForVPCStatus(vpcID string, status string) (vpc.Vpc, error) {
+	for i := 0; i < CBVPCWaitTime/CBVPCCheckTime; i++ {
+		vpcInfo, err := vpcHandler.describeVPC(vpcID)
+		if err != nil {
+			return vpc.Vpc{}, err
+		}
+		if vpcInfo.Status == status {
+			return vpcInfo, nil
+		}
+		time.Sleep(time.Second * CBVPCCheckTime)
+	}
+	return vpc.Vpc{}, errors.New("VPC[" + vpcID + "]가 " + status + " 상태가 되지 않았습니다.")
+}
+
+// VPC와 VPC에 속한 VSwitch 정보를 추출함
+func (vpcHandler *AlibabaVPCHandler) extractVPCInfo(vpcInfo vpc.Vpc) (irs.VPCInfo, error) {
+	result := irs.VPCInfo{
+		Id:   vpcInfo.VpcId,
+		Name: vpcInfo.VpcName,
+		CIDR: vpcInfo.CidrBlock,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: vpcInfo.Status},
+			{Key: "IsDefault", Value: strconv.FormatBool(vpcInfo.IsDefault)},
+			{Key: "VRouterId", Value: vpcInfo.VRouterId},
+			{Key: "CreationTime", Value: vpcInfo.CreationTime},
+		},
+	}
+
+	vSwitchList, err := vpcHandler.describeVSwitches(vpcInfo.VpcId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	for _, vSwitch := range vSwitchList {
+		result.SubnetInfoList = append(result.SubnetInfoList, irs.SubnetInfo{
+			Id:   vSwitch.VSwitchId,
+			Name: vSwitch.VSwitchName,
+			CIDR: vSwitch.CidrBlock,
+			Zone: vSwitch.ZoneId,
+			KeyValueList: []irs.KeyValue{
+				{Key: "Status", Value: vSwitch.Status},
+				{Key: "AvailableIpAddressCount", Value: strconv.FormatInt(vSwitch.AvailableIpAddressCount, 10)},
+			},
+		})
+	}
+	return result, nil
+}
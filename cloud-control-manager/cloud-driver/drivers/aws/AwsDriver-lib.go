@@ -24,7 +24,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 import (
 	"fmt"
@@ -49,6 +54,18 @@ func (AwsDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.VNicHandler = true
 	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.DiskHandler = true
+	drvCapabilityInfo.SnapshotHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.RouterHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+	drvCapabilityInfo.NLBHandler = true
+	drvCapabilityInfo.ObjectStorageHandler = true
+	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.AutoScalingHandler = true
+	drvCapabilityInfo.TagHandler = true
 
 	return drvCapabilityInfo
 }
@@ -81,6 +98,86 @@ func getVMClient(connectionInfo idrv.ConnectionInfo) (*ec2.EC2, error) {
 	return svc, nil
 }
 
+func getNLBClient(connectionInfo idrv.ConnectionInfo) (*elbv2.ELBV2, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create ELBv2 service client
+	svc := elbv2.New(sess)
+
+	return svc, nil
+}
+
+func getS3Client(connectionInfo idrv.ConnectionInfo) (*s3.S3, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create S3 service client
+	svc := s3.New(sess)
+
+	return svc, nil
+}
+
+func getEKSClient(connectionInfo idrv.ConnectionInfo) (*eks.EKS, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create EKS service client
+	svc := eks.New(sess)
+
+	return svc, nil
+}
+
+func getRoute53Client(connectionInfo idrv.ConnectionInfo) (*route53.Route53, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create Route 53 service client
+	svc := route53.New(sess)
+
+	return svc, nil
+}
+
+func getAutoScalingClient(connectionInfo idrv.ConnectionInfo) (*autoscaling.AutoScaling, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create Auto Scaling service client
+	svc := autoscaling.New(sess)
+
+	return svc, nil
+}
+
 func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
@@ -97,6 +194,26 @@ func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 	if err != nil {
 		return nil, err
 	}
+	nlbClient, err := getNLBClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	s3Client, err := getS3Client(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	eksClient, err := getEKSClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	route53Client, err := getRoute53Client(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+	autoScalingClient, err := getAutoScalingClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	//iConn = acon.AwsCloudConnection{}
 	iConn := acon.AwsCloudConnection{
@@ -109,6 +226,18 @@ func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		ImageClient:    vmClient,
 		PublicIPClient: vmClient,
 		SecurityClient: vmClient,
+		VMSpecClient:   vmClient,
+		DiskClient:     vmClient,
+		SnapshotClient: vmClient,
+		VPCClient:      vmClient,
+		RouterClient:   vmClient,
+		NATClient:      vmClient,
+		NLBClient:      nlbClient,
+		S3Client:       s3Client,
+		EKSClient:      eksClient,
+		DNSClient:      route53Client,
+
+		AutoScalingClient: autoScalingClient,
 	}
 
 	return &iConn, nil // return type: (icon.CloudConnection, error)
@@ -21,7 +21,12 @@ import (
 	ars "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
 
 	//ec2drv "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 
 //type AwsCloudConnection struct{}
@@ -35,6 +40,18 @@ type AwsCloudConnection struct {
 	ImageClient    *ec2.EC2
 	PublicIPClient *ec2.EC2
 	SecurityClient *ec2.EC2
+	VMSpecClient   *ec2.EC2
+	DiskClient     *ec2.EC2
+	SnapshotClient *ec2.EC2
+	VPCClient      *ec2.EC2
+	RouterClient   *ec2.EC2
+	NATClient      *ec2.EC2
+	NLBClient      *elbv2.ELBV2
+	S3Client       *s3.S3
+	EKSClient      *eks.EKS
+	DNSClient      *route53.Route53
+
+	AutoScalingClient *autoscaling.AutoScaling
 }
 
 var cblogger *logrus.Logger
@@ -100,3 +117,87 @@ func (cloudConn *AwsCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandle
 
 	return &handler, nil
 }
+
+func (cloudConn *AwsCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsVMSpecHandler{cloudConn.Region, cloudConn.VMSpecClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsDiskHandler{cloudConn.Region, cloudConn.DiskClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateSnapshotHandler() (irs.SnapshotHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsSnapshotHandler{cloudConn.Region, cloudConn.SnapshotClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsVPCHandler{cloudConn.Region, cloudConn.VPCClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateRouterHandler() (irs.RouterHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsRouterHandler{cloudConn.Region, cloudConn.RouterClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsNATGatewayHandler{cloudConn.Region, cloudConn.NATClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsNLBHandler{cloudConn.Region, cloudConn.VMClient, cloudConn.NLBClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateObjectStorageHandler() (irs.ObjectStorageHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsObjectStorageHandler{cloudConn.Region, cloudConn.S3Client}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsClusterHandler{cloudConn.Region, cloudConn.VMClient, cloudConn.EKSClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsDNSHandler{cloudConn.Region, cloudConn.DNSClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateAutoScalingHandler() (irs.AutoScalingHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsAutoScalingHandler{cloudConn.Region, cloudConn.VMClient, cloudConn.AutoScalingClient}
+
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsTagHandler{cloudConn.Region, cloudConn.VMClient}
+
+	return &handler, nil
+}
@@ -0,0 +1,357 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//AutoScalingHandler는 Launch Template 기반의 Auto Scaling Group을 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBLaunchTemplateLatest = "$Latest"
+	CBTargetTrackingPolicy = "TargetTrackingScaling"
+	CBCPUUtilizationMetric = "ASGAverageCPUUtilization"
+)
+
+// Auto Scaling Group 이름을 ID로 사용하며, Launch Template도 같은 이름으로 생성 함.
+type AwsAutoScalingHandler struct {
+	Region            idrv.RegionInfo
+	Client            *ec2.EC2
+	AutoScalingClient *autoscaling.AutoScaling
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) CreateAutoScalingGroup(groupReqInfo irs.AutoScalingGroupReqInfo) (irs.AutoScalingGroupInfo, error) {
+	cblogger.Info("Start : ", groupReqInfo)
+
+	vmTemplate := groupReqInfo.VMTemplate
+	if vmTemplate.VirtualNetworkId == "" {
+		return irs.AutoScalingGroupInfo{}, errors.New("Auto Scaling Group 생성을 위한 Subnet(VirtualNetworkId) 정보가 없습니다.")
+	}
+
+	templateData := &ec2.RequestLaunchTemplateData{
+		ImageId:          aws.String(vmTemplate.ImageId),
+		InstanceType:     aws.String(vmTemplate.VMSpecId),
+		SecurityGroupIds: aws.StringSlice(vmTemplate.SecurityGroupIds),
+	}
+	if vmTemplate.KeyPairName != "" {
+		templateData.KeyName = aws.String(vmTemplate.KeyPairName)
+	}
+
+	templateResult, err := autoScalingHandler.Client.CreateLaunchTemplate(&ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(groupReqInfo.Name),
+		LaunchTemplateData: templateData,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create Launch Template: %s, %v.", groupReqInfo.Name, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	cblogger.Infof("Launch Template 생성 완료 - ID : [%s]", aws.StringValue(templateResult.LaunchTemplate.LaunchTemplateId))
+
+	input := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(groupReqInfo.Name),
+		LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(groupReqInfo.Name),
+			Version:            aws.String(CBLaunchTemplateLatest),
+		},
+		DesiredCapacity:   aws.Int64(int64(groupReqInfo.DesiredSize)),
+		MinSize:           aws.Int64(int64(groupReqInfo.MinSize)),
+		MaxSize:           aws.Int64(int64(groupReqInfo.MaxSize)),
+		VPCZoneIdentifier: aws.String(vmTemplate.VirtualNetworkId),
+	}
+
+	_, err = autoScalingHandler.AutoScalingClient.CreateAutoScalingGroup(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create Auto Scaling Group: %s, %v.", groupReqInfo.Name, err)
+		//생성하지 못한 Auto Scaling Group의 Launch Template은 삭제 함.
+		autoScalingHandler.deleteLaunchTemplate(groupReqInfo.Name)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	err = autoScalingHandler.AutoScalingClient.WaitUntilGroupExists(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{groupReqInfo.Name}),
+	})
+	if err != nil {
+		cblogger.Errorf("Error occurred while waiting for Auto Scaling Group %s to be created, %v.", groupReqInfo.Name, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	return autoScalingHandler.GetAutoScalingGroup(groupReqInfo.Name)
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) ListAutoScalingGroup() ([]*irs.AutoScalingGroupInfo, error) {
+	cblogger.Info("Start")
+
+	var groupInfoList []*irs.AutoScalingGroupInfo
+	var groupList []*autoscaling.Group
+	err := autoScalingHandler.AutoScalingClient.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{},
+		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			groupList = append(groupList, page.AutoScalingGroups...)
+			return !lastPage
+		})
+	if err != nil {
+		cblogger.Errorf("Unable to list Auto Scaling Groups, %v", err)
+		return nil, err
+	}
+
+	for _, group := range groupList {
+		groupInfo := autoScalingHandler.extractAutoScalingGroupInfo(group)
+		groupInfoList = append(groupInfoList, &groupInfo)
+	}
+	return groupInfoList, nil
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) GetAutoScalingGroup(groupID string) (irs.AutoScalingGroupInfo, error) {
+	cblogger.Info("groupID : ", groupID)
+
+	group, err := autoScalingHandler.describeAutoScalingGroup(groupID)
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	return autoScalingHandler.extractAutoScalingGroupInfo(group), nil
+}
+
+//ForceDelete 옵션으로 Auto Scaling Group의 모든 인스턴스를 함께 종료 함.
+func (autoScalingHandler *AwsAutoScalingHandler) DeleteAutoScalingGroup(groupID string) (bool, error) {
+	cblogger.Info("groupID : ", groupID)
+
+	group, err := autoScalingHandler.describeAutoScalingGroup(groupID)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = autoScalingHandler.AutoScalingClient.DeleteAutoScalingGroup(&autoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(groupID),
+		ForceDelete:          aws.Bool(true),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete Auto Scaling Group: %s, %v.", groupID, err)
+		return false, err
+	}
+
+	err = autoScalingHandler.AutoScalingClient.WaitUntilGroupNotExists(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{groupID}),
+	})
+	if err != nil {
+		cblogger.Errorf("Error occurred while waiting for Auto Scaling Group %s to be deleted, %v.", groupID, err)
+		return false, err
+	}
+
+	if group.LaunchTemplate != nil {
+		if err := autoScalingHandler.deleteLaunchTemplate(aws.StringValue(group.LaunchTemplate.LaunchTemplateName)); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) ChangeAutoScalingGroupSize(groupID string, desiredSize int, minSize int, maxSize int) (irs.AutoScalingGroupInfo, error) {
+	cblogger.Infof("groupID : [%s], desiredSize : [%d], minSize : [%d], maxSize : [%d]", groupID, desiredSize, minSize, maxSize)
+
+	_, err := autoScalingHandler.AutoScalingClient.UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(groupID),
+		DesiredCapacity:      aws.Int64(int64(desiredSize)),
+		MinSize:              aws.Int64(int64(minSize)),
+		MaxSize:              aws.Int64(int64(maxSize)),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to update Auto Scaling Group: %s, %v.", groupID, err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	return autoScalingHandler.GetAutoScalingGroup(groupID)
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) ListAutoScalingGroupMember(groupID string) ([]*irs.AutoScalingMemberInfo, error) {
+	cblogger.Info("groupID : ", groupID)
+
+	group, err := autoScalingHandler.describeAutoScalingGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var memberInfoList []*irs.AutoScalingMemberInfo
+	for _, instance := range group.Instances {
+		lifecycleState := aws.StringValue(instance.LifecycleState)
+		healthStatus := aws.StringValue(instance.HealthStatus)
+
+		memberInfo := irs.AutoScalingMemberInfo{
+			VMId:   aws.StringValue(instance.InstanceId),
+			Status: getAutoScalingMemberStatus(lifecycleState, healthStatus),
+			Zone:   aws.StringValue(instance.AvailabilityZone),
+			KeyValueList: []irs.KeyValue{
+				{Key: "LifecycleState", Value: lifecycleState},
+				{Key: "HealthStatus", Value: healthStatus},
+				{Key: "InstanceType", Value: aws.StringValue(instance.InstanceType)},
+			},
+		}
+		memberInfoList = append(memberInfoList, &memberInfo)
+	}
+	return memberInfoList, nil
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) AddScalingPolicy(groupID string, policyReqInfo irs.ScalingPolicyReqInfo) (irs.ScalingPolicyInfo, error) {
+	cblogger.Info("Start : ", policyReqInfo)
+
+	result, err := autoScalingHandler.AutoScalingClient.PutScalingPolicy(&autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName: aws.String(groupID),
+		PolicyName:           aws.String(policyReqInfo.Name),
+		PolicyType:           aws.String(CBTargetTrackingPolicy),
+		TargetTrackingConfiguration: &autoscaling.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String(CBCPUUtilizationMetric),
+			},
+			TargetValue: aws.Float64(policyReqInfo.TargetCPUUtilization),
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to put Scaling Policy: %s, %v.", policyReqInfo.Name, err)
+		return irs.ScalingPolicyInfo{}, err
+	}
+
+	policyInfo := irs.ScalingPolicyInfo{
+		Id:                   aws.StringValue(result.PolicyARN),
+		Name:                 policyReqInfo.Name,
+		TargetCPUUtilization: policyReqInfo.TargetCPUUtilization,
+	}
+	return policyInfo, nil
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) ListScalingPolicy(groupID string) ([]*irs.ScalingPolicyInfo, error) {
+	cblogger.Info("groupID : ", groupID)
+
+	var policyInfoList []*irs.ScalingPolicyInfo
+	err := autoScalingHandler.AutoScalingClient.DescribePoliciesPages(&autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(groupID),
+		PolicyTypes:          aws.StringSlice([]string{CBTargetTrackingPolicy}),
+	}, func(page *autoscaling.DescribePoliciesOutput, lastPage bool) bool {
+		for _, policy := range page.ScalingPolicies {
+			policyInfo := irs.ScalingPolicyInfo{
+				Id:   aws.StringValue(policy.PolicyARN),
+				Name: aws.StringValue(policy.PolicyName),
+				KeyValueList: []irs.KeyValue{
+					{Key: "PolicyType", Value: aws.StringValue(policy.PolicyType)},
+				},
+			}
+			if policy.TargetTrackingConfiguration != nil {
+				policyInfo.TargetCPUUtilization = aws.Float64Value(policy.TargetTrackingConfiguration.TargetValue)
+			}
+			policyInfoList = append(policyInfoList, &policyInfo)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to list Scaling Policies: %s, %v.", groupID, err)
+		return nil, err
+	}
+	return policyInfoList, nil
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) RemoveScalingPolicy(groupID string, policyName string) (bool, error) {
+	cblogger.Infof("groupID : [%s], policyName : [%s]", groupID, policyName)
+
+	_, err := autoScalingHandler.AutoScalingClient.DeletePolicy(&autoscaling.DeletePolicyInput{
+		AutoScalingGroupName: aws.String(groupID),
+		PolicyName:           aws.String(policyName),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete Scaling Policy: %s, %v.", policyName, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) describeAutoScalingGroup(groupID string) (*autoscaling.Group, error) {
+	result, err := autoScalingHandler.AutoScalingClient.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{groupID}),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get Auto Scaling Group: %s, %v.", groupID, err)
+		return nil, err
+	}
+	if len(result.AutoScalingGroups) < 1 {
+		return nil, errors.New("Auto Scaling Group[" + groupID + "] 정보가 존재하지 않습니다.")
+	}
+	return result.AutoScalingGroups[0], nil
+}
+
+//Launch Template 정보는 VM 템플릿으로 변환하며, 조회에 실패해도 Auto Scaling Group 정보는 반환 함.
+func (autoScalingHandler *AwsAutoScalingHandler) extractAutoScalingGroupInfo(group *autoscaling.Group) irs.AutoScalingGroupInfo {
+	groupInfo := irs.AutoScalingGroupInfo{
+		Id:          aws.StringValue(group.AutoScalingGroupName),
+		Name:        aws.StringValue(group.AutoScalingGroupName),
+		DesiredSize: int(aws.Int64Value(group.DesiredCapacity)),
+		MinSize:     int(aws.Int64Value(group.MinSize)),
+		MaxSize:     int(aws.Int64Value(group.MaxSize)),
+		KeyValueList: []irs.KeyValue{
+			{Key: "AutoScalingGroupARN", Value: aws.StringValue(group.AutoScalingGroupARN)},
+			{Key: "AvailabilityZones", Value: strings.Join(aws.StringValueSlice(group.AvailabilityZones), ",")},
+			{Key: "HealthCheckType", Value: aws.StringValue(group.HealthCheckType)},
+			{Key: "Status", Value: aws.StringValue(group.Status)},
+		},
+	}
+	groupInfo.VMTemplate.VirtualNetworkId = aws.StringValue(group.VPCZoneIdentifier)
+
+	if group.LaunchTemplate == nil {
+		return groupInfo
+	}
+	templateName := aws.StringValue(group.LaunchTemplate.LaunchTemplateName)
+	groupInfo.KeyValueList = append(groupInfo.KeyValueList, irs.KeyValue{Key: "LaunchTemplateName", Value: templateName})
+
+	result, err := autoScalingHandler.Client.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(templateName),
+		Versions:           aws.StringSlice([]string{CBLaunchTemplateLatest}),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get Launch Template: %s, %v.", templateName, err)
+		return groupInfo
+	}
+	if len(result.LaunchTemplateVersions) > 0 && result.LaunchTemplateVersions[0].LaunchTemplateData != nil {
+		templateData := result.LaunchTemplateVersions[0].LaunchTemplateData
+		groupInfo.VMTemplate.ImageId = aws.StringValue(templateData.ImageId)
+		groupInfo.VMTemplate.VMSpecId = aws.StringValue(templateData.InstanceType)
+		groupInfo.VMTemplate.KeyPairName = aws.StringValue(templateData.KeyName)
+		groupInfo.VMTemplate.SecurityGroupIds = aws.StringValueSlice(templateData.SecurityGroupIds)
+	}
+	return groupInfo
+}
+
+func (autoScalingHandler *AwsAutoScalingHandler) deleteLaunchTemplate(templateName string) error {
+	_, err := autoScalingHandler.Client.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(templateName),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete Launch Template: %s, %v.", templateName, err)
+		return err
+	}
+	return nil
+}
+
+//Auto Scaling Group 인스턴스의 LifecycleState(Pending:Wait 등 세부 상태 포함)를 Auto Scaling 멤버 상태로 변환 함.
+func getAutoScalingMemberStatus(lifecycleState string, healthStatus string) irs.AutoScalingMemberStatus {
+	if healthStatus == "Unhealthy" {
+		return irs.AutoScalingMemberFailed
+	}
+	switch {
+	case strings.HasPrefix(lifecycleState, autoscaling.LifecycleStatePending):
+		return irs.AutoScalingMemberPending
+	case strings.HasPrefix(lifecycleState, autoscaling.LifecycleStateTerminating), lifecycleState == autoscaling.LifecycleStateTerminated, lifecycleState == autoscaling.LifecycleStateDetaching:
+		return irs.AutoScalingMemberTerminating
+	default:
+		return irs.AutoScalingMemberInService
+	}
+}
@@ -0,0 +1,506 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//ClusterHandler는 EKS Cluster 및 Managed Node Group을 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Cluster 이름을 ID로 사용하며, Node Group도 이름을 ID로 사용 함.
+// EKS는 IAM Role이 필요하므로 KeyValueList의 ClusterRoleArn/NodeRoleArn으로 전달 받음.
+type AwsClusterHandler struct {
+	Region    idrv.RegionInfo
+	Client    *ec2.EC2
+	EKSClient *eks.EKS
+}
+
+func (clusterHandler *AwsClusterHandler) CreateCluster(clusterReqInfo irs.ClusterReqInfo) (irs.ClusterInfo, error) {
+	cblogger.Info("Start : ", clusterReqInfo)
+
+	roleArn := getKeyValue(clusterReqInfo.KeyValueList, irs.ClusterRoleArnKey)
+	if roleArn == "" {
+		return irs.ClusterInfo{}, errors.New("EKS Cluster 생성을 위한 " + irs.ClusterRoleArnKey + " 정보가 없습니다.")
+	}
+
+	input := &eks.CreateClusterInput{
+		Name:    aws.String(clusterReqInfo.Name),
+		RoleArn: aws.String(roleArn),
+		ResourcesVpcConfig: &eks.VpcConfigRequest{
+			SubnetIds:        aws.StringSlice(clusterReqInfo.SubnetIds),
+			SecurityGroupIds: aws.StringSlice(clusterReqInfo.SecurityGroupIds),
+		},
+	}
+	if clusterReqInfo.Version != "" {
+		input.Version = aws.String(clusterReqInfo.Version)
+	}
+
+	result, err := clusterHandler.EKSClient.CreateCluster(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create EKS cluster: %s, %v.", clusterReqInfo.Name, err)
+		return irs.ClusterInfo{}, err
+	}
+	cblogger.Infof("EKS Cluster 생성 요청 완료 - ARN : [%s]", aws.StringValue(result.Cluster.Arn))
+
+	//Node Group은 Cluster가 ACTIVE 상태가 되어야 생성할 수 있음. (약 10~15분 소요)
+	if len(clusterReqInfo.NodeGroupList) > 0 {
+		err = clusterHandler.EKSClient.WaitUntilClusterActive(&eks.DescribeClusterInput{
+			Name: aws.String(clusterReqInfo.Name),
+		})
+		if err != nil {
+			cblogger.Errorf("Error occurred while waiting for cluster %s to be active, %v.", clusterReqInfo.Name, err)
+			return irs.ClusterInfo{}, err
+		}
+
+		for _, nodeGroupReqInfo := range clusterReqInfo.NodeGroupList {
+			if getKeyValue(nodeGroupReqInfo.KeyValueList, irs.NodeRoleArnKey) == "" {
+				nodeGroupReqInfo.KeyValueList = append(nodeGroupReqInfo.KeyValueList, irs.KeyValue{Key: irs.NodeRoleArnKey, Value: getKeyValue(clusterReqInfo.KeyValueList, irs.NodeRoleArnKey)})
+			}
+			_, err := clusterHandler.AddNodeGroup(clusterReqInfo.Name, nodeGroupReqInfo)
+			if err != nil {
+				return irs.ClusterInfo{}, err
+			}
+		}
+	}
+
+	return clusterHandler.GetCluster(clusterReqInfo.Name)
+}
+
+func (clusterHandler *AwsClusterHandler) ListCluster() ([]*irs.ClusterInfo, error) {
+	cblogger.Info("Start")
+
+	var clusterNames []*string
+	err := clusterHandler.EKSClient.ListClustersPages(&eks.ListClustersInput{}, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		clusterNames = append(clusterNames, page.Clusters...)
+		return true
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to list EKS clusters, %v", err)
+		return nil, err
+	}
+
+	var clusterList []*irs.ClusterInfo
+	for _, clusterName := range clusterNames {
+		clusterInfo, err := clusterHandler.GetCluster(aws.StringValue(clusterName))
+		if err != nil {
+			return nil, err
+		}
+		clusterList = append(clusterList, &clusterInfo)
+	}
+
+	return clusterList, nil
+}
+
+func (clusterHandler *AwsClusterHandler) GetCluster(clusterID string) (irs.ClusterInfo, error) {
+	cblogger.Info("Start : ", clusterID)
+
+	result, err := clusterHandler.EKSClient.DescribeCluster(&eks.DescribeClusterInput{
+		Name: aws.String(clusterID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get EKS cluster %s, %v", clusterID, err)
+		return irs.ClusterInfo{}, err
+	}
+	cluster := result.Cluster
+
+	clusterInfo := irs.ClusterInfo{
+		Id:       aws.StringValue(cluster.Name),
+		Name:     aws.StringValue(cluster.Name),
+		Version:  aws.StringValue(cluster.Version),
+		Endpoint: aws.StringValue(cluster.Endpoint),
+		Status:   getClusterStatus(aws.StringValue(cluster.Status)),
+		KeyValueList: []irs.KeyValue{
+			{Key: "Arn", Value: aws.StringValue(cluster.Arn)},
+			{Key: "Status", Value: aws.StringValue(cluster.Status)},
+			{Key: irs.ClusterRoleArnKey, Value: aws.StringValue(cluster.RoleArn)},
+			{Key: "PlatformVersion", Value: aws.StringValue(cluster.PlatformVersion)},
+		},
+	}
+	if cluster.CreatedAt != nil {
+		clusterInfo.CreatedTime = cluster.CreatedAt.Format(time.RFC3339)
+	}
+	if cluster.ResourcesVpcConfig != nil {
+		clusterInfo.VPCId = aws.StringValue(cluster.ResourcesVpcConfig.VpcId)
+		clusterInfo.SubnetIds = aws.StringValueSlice(cluster.ResourcesVpcConfig.SubnetIds)
+		clusterInfo.SecurityGroupIds = aws.StringValueSlice(cluster.ResourcesVpcConfig.SecurityGroupIds)
+	}
+
+	//Cluster 생성 중에는 Node Group을 조회할 수 없음.
+	if aws.StringValue(cluster.Status) == eks.ClusterStatusActive || aws.StringValue(cluster.Status) == eks.ClusterStatusUpdating {
+		nodeGroupList, err := clusterHandler.ListNodeGroup(clusterID)
+		if err != nil {
+			return irs.ClusterInfo{}, err
+		}
+		for _, nodeGroupInfo := range nodeGroupList {
+			clusterInfo.NodeGroupList = append(clusterInfo.NodeGroupList, *nodeGroupInfo)
+		}
+	}
+
+	return clusterInfo, nil
+}
+
+//Node Group이 남아 있으면 Cluster를 삭제할 수 없으므로 Node Group을 먼저 삭제 함.
+func (clusterHandler *AwsClusterHandler) DeleteCluster(clusterID string) (bool, error) {
+	cblogger.Info("Start : ", clusterID)
+
+	nodeGroupList, err := clusterHandler.ListNodeGroup(clusterID)
+	if err != nil {
+		return false, err
+	}
+	for _, nodeGroupInfo := range nodeGroupList {
+		_, err := clusterHandler.RemoveNodeGroup(clusterID, nodeGroupInfo.Id)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, err = clusterHandler.EKSClient.DeleteCluster(&eks.DeleteClusterInput{
+		Name: aws.String(clusterID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete EKS cluster %s, %v", clusterID, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (clusterHandler *AwsClusterHandler) AddNodeGroup(clusterID string, nodeGroupReqInfo irs.NodeGroupReqInfo) (irs.NodeGroupInfo, error) {
+	cblogger.Infof("Start : [%s] [%v]", clusterID, nodeGroupReqInfo)
+
+	clusterInfo, err := clusterHandler.GetCluster(clusterID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	//NodeRoleArn이 없으면 기존 Node Group의 Role을 사용 함.
+	nodeRoleArn := getKeyValue(nodeGroupReqInfo.KeyValueList, irs.NodeRoleArnKey)
+	if nodeRoleArn == "" && len(clusterInfo.NodeGroupList) > 0 {
+		nodeRoleArn = getKeyValue(clusterInfo.NodeGroupList[0].KeyValueList, irs.NodeRoleArnKey)
+	}
+	if nodeRoleArn == "" {
+		return irs.NodeGroupInfo{}, errors.New("EKS Node Group 생성을 위한 " + irs.NodeRoleArnKey + " 정보가 없습니다.")
+	}
+
+	input := &eks.CreateNodegroupInput{
+		ClusterName:   aws.String(clusterID),
+		NodegroupName: aws.String(nodeGroupReqInfo.Name),
+		NodeRole:      aws.String(nodeRoleArn),
+		Subnets:       aws.StringSlice(clusterInfo.SubnetIds),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			DesiredSize: aws.Int64(int64(nodeGroupReqInfo.DesiredNodeSize)),
+			MinSize:     aws.Int64(int64(nodeGroupReqInfo.MinNodeSize)),
+			MaxSize:     aws.Int64(int64(nodeGroupReqInfo.MaxNodeSize)),
+		},
+	}
+	if nodeGroupReqInfo.VMSpecName != "" {
+		input.InstanceTypes = aws.StringSlice([]string{nodeGroupReqInfo.VMSpecName})
+	}
+	if nodeGroupReqInfo.RootDiskSize != "" {
+		diskSize, err := strconv.ParseInt(nodeGroupReqInfo.RootDiskSize, 10, 64)
+		if err != nil {
+			return irs.NodeGroupInfo{}, errors.New("RootDiskSize 정보가 올바르지 않습니다. : " + nodeGroupReqInfo.RootDiskSize)
+		}
+		input.DiskSize = aws.Int64(diskSize)
+	}
+	if nodeGroupReqInfo.ImageName != "" {
+		input.AmiType = aws.String(nodeGroupReqInfo.ImageName)
+	}
+	if nodeGroupReqInfo.KeyPairName != "" {
+		input.RemoteAccess = &eks.RemoteAccessConfig{
+			Ec2SshKey: aws.String(nodeGroupReqInfo.KeyPairName),
+		}
+	}
+
+	result, err := clusterHandler.EKSClient.CreateNodegroup(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create node group %s of %s, %v", nodeGroupReqInfo.Name, clusterID, err)
+		return irs.NodeGroupInfo{}, err
+	}
+	cblogger.Infof("Node Group 생성 요청 완료 - ARN : [%s]", aws.StringValue(result.Nodegroup.NodegroupArn))
+
+	err = clusterHandler.EKSClient.WaitUntilNodegroupActive(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterID),
+		NodegroupName: aws.String(nodeGroupReqInfo.Name),
+	})
+	if err != nil {
+		cblogger.Errorf("Error occurred while waiting for node group %s to be active, %v", nodeGroupReqInfo.Name, err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupReqInfo.Name)
+}
+
+func (clusterHandler *AwsClusterHandler) ListNodeGroup(clusterID string) ([]*irs.NodeGroupInfo, error) {
+	cblogger.Info("Start : ", clusterID)
+
+	var nodeGroupNames []*string
+	err := clusterHandler.EKSClient.ListNodegroupsPages(&eks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterID),
+	}, func(page *eks.ListNodegroupsOutput, lastPage bool) bool {
+		nodeGroupNames = append(nodeGroupNames, page.Nodegroups...)
+		return true
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to list node groups of %s, %v", clusterID, err)
+		return nil, err
+	}
+
+	var nodeGroupList []*irs.NodeGroupInfo
+	for _, nodeGroupName := range nodeGroupNames {
+		nodeGroupInfo, err := clusterHandler.getNodeGroup(clusterID, aws.StringValue(nodeGroupName))
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupList = append(nodeGroupList, &nodeGroupInfo)
+	}
+
+	return nodeGroupList, nil
+}
+
+func (clusterHandler *AwsClusterHandler) RemoveNodeGroup(clusterID string, nodeGroupID string) (bool, error) {
+	cblogger.Infof("Start : [%s] [%s]", clusterID, nodeGroupID)
+
+	_, err := clusterHandler.EKSClient.DeleteNodegroup(&eks.DeleteNodegroupInput{
+		ClusterName:   aws.String(clusterID),
+		NodegroupName: aws.String(nodeGroupID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete node group %s of %s, %v", nodeGroupID, clusterID, err)
+		return false, err
+	}
+
+	err = clusterHandler.EKSClient.WaitUntilNodegroupDeleted(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterID),
+		NodegroupName: aws.String(nodeGroupID),
+	})
+	if err != nil {
+		cblogger.Errorf("Error occurred while waiting for node group %s to be deleted, %v", nodeGroupID, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (clusterHandler *AwsClusterHandler) ChangeNodeGroupScaling(clusterID string, nodeGroupID string, desiredNodeSize int, minNodeSize int, maxNodeSize int) (irs.NodeGroupInfo, error) {
+	cblogger.Infof("Start : [%s] [%s] desired[%d] min[%d] max[%d]", clusterID, nodeGroupID, desiredNodeSize, minNodeSize, maxNodeSize)
+
+	_, err := clusterHandler.EKSClient.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(clusterID),
+		NodegroupName: aws.String(nodeGroupID),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			DesiredSize: aws.Int64(int64(desiredNodeSize)),
+			MinSize:     aws.Int64(int64(minNodeSize)),
+			MaxSize:     aws.Int64(int64(maxNodeSize)),
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to update node group %s of %s, %v", nodeGroupID, clusterID, err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupID)
+}
+
+//Control Plane의 버전만 변경 함. (한 번에 1개의 Minor 버전만 올릴 수 있음)
+func (clusterHandler *AwsClusterHandler) UpgradeCluster(clusterID string, newVersion string) (irs.ClusterInfo, error) {
+	cblogger.Infof("Start : [%s] [%s]", clusterID, newVersion)
+
+	result, err := clusterHandler.EKSClient.UpdateClusterVersion(&eks.UpdateClusterVersionInput{
+		Name:    aws.String(clusterID),
+		Version: aws.String(newVersion),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to upgrade EKS cluster %s, %v", clusterID, err)
+		return irs.ClusterInfo{}, err
+	}
+	cblogger.Infof("EKS Cluster 업그레이드 요청 완료 - Update ID : [%s]", aws.StringValue(result.Update.Id))
+
+	return clusterHandler.GetCluster(clusterID)
+}
+
+//인증은 aws cli의 "aws eks get-token"을 이용하는 kubeconfig를 생성 함.
+func (clusterHandler *AwsClusterHandler) GetKubeConfig(clusterID string) (string, error) {
+	cblogger.Info("Start : ", clusterID)
+
+	result, err := clusterHandler.EKSClient.DescribeCluster(&eks.DescribeClusterInput{
+		Name: aws.String(clusterID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get EKS cluster %s, %v", clusterID, err)
+		return "", err
+	}
+	cluster := result.Cluster
+	if cluster.Endpoint == nil || cluster.CertificateAuthority == nil {
+		return "", errors.New("EKS Cluster가 아직 준비되지 않았습니다. : " + clusterID)
+	}
+
+	return makeEksKubeConfig(aws.StringValue(cluster.CertificateAuthority.Data), aws.StringValue(cluster.Endpoint), clusterID, clusterHandler.Region.Region), nil
+}
+
+//eksKubeConfigTemplate의 순서 : CA, Endpoint, Cluster 이름(cluster, context, user 이름 및 get-token 인자) 7개, Region
+func makeEksKubeConfig(caData string, endpoint string, clusterID string, region string) string {
+	return fmt.Sprintf(eksKubeConfigTemplate,
+		caData, endpoint,
+		clusterID,
+		clusterID, clusterID, clusterID,
+		clusterID,
+		clusterID,
+		clusterID, region)
+}
+
+const eksKubeConfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: %s
+  name: %s
+contexts:
+- context:
+    cluster: %s
+    user: %s
+  name: %s
+current-context: %s
+users:
+- name: %s
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args:
+      - eks
+      - get-token
+      - --cluster-name
+      - %s
+      - --region
+      - %s
+`
+
+func (clusterHandler *AwsClusterHandler) getNodeGroup(clusterID string, nodeGroupName string) (irs.NodeGroupInfo, error) {
+	result, err := clusterHandler.EKSClient.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterID),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get node group %s of %s, %v", nodeGroupName, clusterID, err)
+		return irs.NodeGroupInfo{}, err
+	}
+	nodeGroup := result.Nodegroup
+
+	nodeGroupInfo := irs.NodeGroupInfo{
+		Id:        aws.StringValue(nodeGroup.NodegroupName),
+		Name:      aws.StringValue(nodeGroup.NodegroupName),
+		ImageName: aws.StringValue(nodeGroup.AmiType),
+		Status:    getNodeGroupStatus(aws.StringValue(nodeGroup.Status)),
+		KeyValueList: []irs.KeyValue{
+			{Key: "Arn", Value: aws.StringValue(nodeGroup.NodegroupArn)},
+			{Key: "Status", Value: aws.StringValue(nodeGroup.Status)},
+			{Key: "Version", Value: aws.StringValue(nodeGroup.Version)},
+			{Key: irs.NodeRoleArnKey, Value: aws.StringValue(nodeGroup.NodeRole)},
+		},
+	}
+	if len(nodeGroup.InstanceTypes) > 0 {
+		nodeGroupInfo.VMSpecName = aws.StringValue(nodeGroup.InstanceTypes[0])
+	}
+	if nodeGroup.DiskSize != nil {
+		nodeGroupInfo.RootDiskSize = strconv.FormatInt(*nodeGroup.DiskSize, 10)
+	}
+	if nodeGroup.RemoteAccess != nil {
+		nodeGroupInfo.KeyPairName = aws.StringValue(nodeGroup.RemoteAccess.Ec2SshKey)
+	}
+	//EKS Managed Node Group은 Min/Max 범위 내에서 Cluster Autoscaler가 조정 함.
+	if nodeGroup.ScalingConfig != nil {
+		nodeGroupInfo.DesiredNodeSize = int(aws.Int64Value(nodeGroup.ScalingConfig.DesiredSize))
+		nodeGroupInfo.MinNodeSize = int(aws.Int64Value(nodeGroup.ScalingConfig.MinSize))
+		nodeGroupInfo.MaxNodeSize = int(aws.Int64Value(nodeGroup.ScalingConfig.MaxSize))
+		nodeGroupInfo.OnAutoScaling = nodeGroupInfo.MinNodeSize != nodeGroupInfo.MaxNodeSize
+	}
+
+	nodes, err := clusterHandler.getNodeGroupInstances(clusterID, nodeGroupName)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	nodeGroupInfo.Nodes = nodes
+
+	return nodeGroupInfo, nil
+}
+
+//Managed Node Group의 Instance에는 eks:cluster-name, eks:nodegroup-name 태그가 추가 됨.
+func (clusterHandler *AwsClusterHandler) getNodeGroupInstances(clusterID string, nodeGroupName string) ([]string, error) {
+	result, err := clusterHandler.Client.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("tag:eks:cluster-name"), Values: aws.StringSlice([]string{clusterID})},
+			{Name: aws.String("tag:eks:nodegroup-name"), Values: aws.StringSlice([]string{nodeGroupName})},
+			{Name: aws.String("instance-state-name"), Values: aws.StringSlice([]string{"pending", "running", "stopping", "stopped"})},
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get instances of node group %s, %v", nodeGroupName, err)
+		return nil, err
+	}
+
+	var instanceIds []string
+	for _, reservation := range result.Reservations {
+		for _, instance := range reservation.Instances {
+			instanceIds = append(instanceIds, aws.StringValue(instance.InstanceId))
+		}
+	}
+	return instanceIds, nil
+}
+
+func getClusterStatus(status string) irs.ClusterStatus {
+	switch status {
+	case eks.ClusterStatusCreating, eks.ClusterStatusPending:
+		return irs.ClusterCreating
+	case eks.ClusterStatusActive:
+		return irs.ClusterActive
+	case eks.ClusterStatusUpdating:
+		return irs.ClusterUpdating
+	case eks.ClusterStatusDeleting:
+		return irs.ClusterDeleting
+	default:
+		return irs.ClusterFailed
+	}
+}
+
+func getNodeGroupStatus(status string) irs.ClusterStatus {
+	switch status {
+	case eks.NodegroupStatusCreating:
+		return irs.ClusterCreating
+	case eks.NodegroupStatusActive:
+		return irs.ClusterActive
+	case eks.NodegroupStatusUpdating:
+		return irs.ClusterUpdating
+	case eks.NodegroupStatusDeleting:
+		return irs.ClusterDeleting
+	default:
+		return irs.ClusterFailed
+	}
+}
+
+func getKeyValue(keyValueList []irs.KeyValue, key string) string {
+	for _, keyValue := range keyValueList {
+		if keyValue.Key == key {
+			return keyValue.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+type testKubeConfig struct {
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			CAData string `yaml:"certificate-authority-data"`
+			Server string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	CurrentContext string `yaml:"current-context"`
+	Users          []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Exec struct {
+				Command string   `yaml:"command"`
+				Args    []string `yaml:"args"`
+			} `yaml:"exec"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+func TestMakeEksKubeConfig(t *testing.T) {
+	kubeConfig := makeEksKubeConfig("Q0EtREFUQQ==", "https://ABCD.gr7.ap-northeast-2.eks.amazonaws.com", "cb-eks01", "ap-northeast-2")
+
+	config := testKubeConfig{}
+	if err := yaml.Unmarshal([]byte(kubeConfig), &config); err != nil {
+		t.Fatalf("invalid kubeconfig: %v\n%s", err, kubeConfig)
+	}
+
+	if len(config.Clusters) != 1 || len(config.Contexts) != 1 || len(config.Users) != 1 {
+		t.Fatalf("want 1 cluster, context and user:\n%s", kubeConfig)
+	}
+	cluster := config.Clusters[0]
+	if cluster.Name != "cb-eks01" {
+		t.Errorf("cluster name: got %q", cluster.Name)
+	}
+	if cluster.Cluster.CAData != "Q0EtREFUQQ==" {
+		t.Errorf("certificate-authority-data: got %q", cluster.Cluster.CAData)
+	}
+	if cluster.Cluster.Server != "https://ABCD.gr7.ap-northeast-2.eks.amazonaws.com" {
+		t.Errorf("server: got %q", cluster.Cluster.Server)
+	}
+
+	context := config.Contexts[0]
+	if context.Name != "cb-eks01" || context.Context.Cluster != "cb-eks01" || context.Context.User != "cb-eks01" {
+		t.Errorf("context: got %+v", context)
+	}
+	if config.CurrentContext != "cb-eks01" {
+		t.Errorf("current-context: got %q", config.CurrentContext)
+	}
+
+	user := config.Users[0]
+	if user.Name != "cb-eks01" {
+		t.Errorf("user name: got %q", user.Name)
+	}
+	if user.User.Exec.Command != "aws" {
+		t.Errorf("exec command: got %q", user.User.Exec.Command)
+	}
+	wantArgs := []string{"eks", "get-token", "--cluster-name", "cb-eks01", "--region", "ap-northeast-2"}
+	if !reflect.DeepEqual(user.User.Exec.Args, wantArgs) {
+		t.Errorf("exec args: got %v, want %v", user.User.Exec.Args, wantArgs)
+	}
+}
@@ -0,0 +1,375 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//DNSHandler는 Route 53 Hosted Zone 및 Record Set을 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBDNSDefaultTTL    = 300 // seconds
+	CBHostedZonePrefix = "/hostedzone/"
+	CBChangePrefix     = "/change/"
+)
+
+type AwsDNSHandler struct {
+	Region idrv.RegionInfo
+	Client *route53.Route53
+}
+
+func (dnsHandler *AwsDNSHandler) setterZone(hostedZone *route53.HostedZone, delegationSet *route53.DelegationSet) irs.DNSZoneInfo {
+	zoneInfo := irs.DNSZoneInfo{
+		Id:   strings.TrimPrefix(aws.StringValue(hostedZone.Id), CBHostedZonePrefix),
+		Name: strings.TrimSuffix(aws.StringValue(hostedZone.Name), "."),
+		KeyValueList: []irs.KeyValue{
+			{Key: "CallerReference", Value: aws.StringValue(hostedZone.CallerReference)},
+			{Key: "ResourceRecordSetCount", Value: strconv.FormatInt(aws.Int64Value(hostedZone.ResourceRecordSetCount), 10)},
+		},
+	}
+	if hostedZone.Config != nil {
+		zoneInfo.Description = aws.StringValue(hostedZone.Config.Comment)
+		zoneInfo.KeyValueList = append(zoneInfo.KeyValueList, irs.KeyValue{Key: "PrivateZone", Value: strconv.FormatBool(aws.BoolValue(hostedZone.Config.PrivateZone))})
+	}
+	if delegationSet != nil {
+		zoneInfo.NameServers = aws.StringValueSlice(delegationSet.NameServers)
+	}
+	return zoneInfo
+}
+
+func setterRecordSet(recordSet *route53.ResourceRecordSet, zoneName string) *irs.DNSRecordInfo {
+	recordInfo := &irs.DNSRecordInfo{
+		Name:         getRelativeDNSName(aws.StringValue(recordSet.Name), zoneName),
+		Type:         irs.DNSRecordType(aws.StringValue(recordSet.Type)),
+		TTL:          aws.Int64Value(recordSet.TTL),
+		ChangeStatus: irs.DNSChangeInSync,
+	}
+	for _, record := range recordSet.ResourceRecords {
+		value := aws.StringValue(record.Value)
+		//TXT 레코드는 큰따옴표로 감싸져 있음
+		if recordInfo.Type == irs.DNSRecordTXT {
+			value = strings.Trim(value, "\"")
+		}
+		recordInfo.Values = append(recordInfo.Values, value)
+	}
+	return recordInfo
+}
+
+func (dnsHandler *AwsDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	cblogger.Info("Start : ", zoneReqInfo.Name)
+
+	input := &route53.CreateHostedZoneInput{
+		Name:            aws.String(zoneReqInfo.Name),
+		CallerReference: aws.String(zoneReqInfo.Name + "-" + strconv.FormatInt(time.Now().UnixNano(), 10)),
+	}
+	if zoneReqInfo.Description != "" {
+		input.HostedZoneConfig = &route53.HostedZoneConfig{
+			Comment: aws.String(zoneReqInfo.Description),
+		}
+	}
+
+	result, err := dnsHandler.Client.CreateHostedZone(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create hosted zone: %s, %v.", zoneReqInfo.Name, err)
+		return irs.DNSZoneInfo{}, err
+	}
+	cblogger.Info(result)
+
+	return dnsHandler.setterZone(result.HostedZone, result.DelegationSet), nil
+}
+
+func (dnsHandler *AwsDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	cblogger.Info("Start")
+
+	var zoneIdList []string
+	err := dnsHandler.Client.ListHostedZonesPages(&route53.ListHostedZonesInput{}, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+		for _, hostedZone := range page.HostedZones {
+			zoneIdList = append(zoneIdList, aws.StringValue(hostedZone.Id))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	//Name Server 목록은 Hosted Zone 상세 조회에만 포함되어 있음
+	var zoneList []*irs.DNSZoneInfo
+	for _, zoneId := range zoneIdList {
+		zoneInfo, err := dnsHandler.GetZone(zoneId)
+		if err != nil {
+			return nil, err
+		}
+		zoneList = append(zoneList, &zoneInfo)
+	}
+	return zoneList, nil
+}
+
+func (dnsHandler *AwsDNSHandler) GetZone(zoneID string) (irs.DNSZoneInfo, error) {
+	cblogger.Info("Start : ", zoneID)
+
+	result, err := dnsHandler.Client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(zoneID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSZoneInfo{}, err
+	}
+
+	return dnsHandler.setterZone(result.HostedZone, result.DelegationSet), nil
+}
+
+//NS, SOA 이외의 Record Set이 남아 있으면 Hosted Zone을 삭제할 수 없으므로 Record Set을 먼저 삭제 함.
+func (dnsHandler *AwsDNSHandler) DeleteZone(zoneID string) (bool, error) {
+	cblogger.Info("Start : ", zoneID)
+
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return false, err
+	}
+
+	var changes []*route53.Change
+	err = dnsHandler.Client.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		for _, recordSet := range page.ResourceRecordSets {
+			recordType := aws.StringValue(recordSet.Type)
+			if (recordType == route53.RRTypeNs || recordType == route53.RRTypeSoa) && getRelativeDNSName(aws.StringValue(recordSet.Name), zoneInfo.Name) == irs.DNSZoneApex {
+				continue
+			}
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: recordSet,
+			})
+		}
+		return !lastPage
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	if len(changes) > 0 {
+		_, err = dnsHandler.Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch:  &route53.ChangeBatch{Changes: changes},
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	_, err = dnsHandler.Client.DeleteHostedZone(&route53.DeleteHostedZoneInput{
+		Id: aws.String(zoneID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *AwsDNSHandler) CreateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	cblogger.Infof("Start : [%s] [%s] [%s]", zoneID, recordReqInfo.Name, recordReqInfo.Type)
+
+	return dnsHandler.changeRecord(zoneID, route53.ChangeActionCreate, recordReqInfo)
+}
+
+func (dnsHandler *AwsDNSHandler) ListRecord(zoneID string) ([]*irs.DNSRecordInfo, error) {
+	cblogger.Info("Start : ", zoneID)
+
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordList []*irs.DNSRecordInfo
+	err = dnsHandler.Client.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		for _, recordSet := range page.ResourceRecordSets {
+			if !isDNSRecordTypeSupported(aws.StringValue(recordSet.Type)) {
+				continue
+			}
+			recordList = append(recordList, setterRecordSet(recordSet, zoneInfo.Name))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return recordList, nil
+}
+
+//UPSERT는 없는 Record Set도 생성하므로 존재 여부를 먼저 확인 함.
+func (dnsHandler *AwsDNSHandler) UpdateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	cblogger.Infof("Start : [%s] [%s] [%s]", zoneID, recordReqInfo.Name, recordReqInfo.Type)
+
+	if _, err := dnsHandler.getRecordSet(zoneID, recordReqInfo.Name, recordReqInfo.Type); err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	return dnsHandler.changeRecord(zoneID, route53.ChangeActionUpsert, recordReqInfo)
+}
+
+//DELETE는 기존 Record Set의 값과 TTL이 정확히 일치해야 하므로 조회 후 삭제 함.
+func (dnsHandler *AwsDNSHandler) DeleteRecord(zoneID string, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	cblogger.Infof("Start : [%s] [%s] [%s]", zoneID, recordName, recordType)
+
+	recordSet, err := dnsHandler.getRecordSet(zoneID, recordName, recordType)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = dnsHandler.Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(route53.ChangeActionDelete),
+					ResourceRecordSet: recordSet,
+				},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+//모든 Route 53 DNS 서버에 반영되면 INSYNC 상태가 됨. (보통 60초 이내)
+func (dnsHandler *AwsDNSHandler) WaitForDNSChange(zoneID string, changeID string) (irs.DNSChangeStatus, error) {
+	cblogger.Infof("Start : [%s] [%s]", zoneID, changeID)
+
+	err := dnsHandler.Client.WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{
+		Id: aws.String(changeID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSChangePending, err
+	}
+	return irs.DNSChangeInSync, nil
+}
+
+func (dnsHandler *AwsDNSHandler) changeRecord(zoneID string, action string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	if !isDNSRecordTypeSupported(string(recordReqInfo.Type)) {
+		return irs.DNSRecordInfo{}, errors.New("Type " + string(recordReqInfo.Type) + " is not supported")
+	}
+	if len(recordReqInfo.Values) == 0 {
+		return irs.DNSRecordInfo{}, errors.New("Values is required")
+	}
+
+	ttl := recordReqInfo.TTL
+	if ttl == 0 {
+		ttl = CBDNSDefaultTTL
+	}
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(getDNSRecordFQDN(recordReqInfo.Name, zoneInfo.Name)),
+		Type: aws.String(string(recordReqInfo.Type)),
+		TTL:  aws.Int64(ttl),
+	}
+	for _, value := range recordReqInfo.Values {
+		if recordReqInfo.Type == irs.DNSRecordTXT {
+			value = "\"" + strings.Trim(value, "\"") + "\""
+		}
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+	}
+
+	result, err := dnsHandler.Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(action),
+					ResourceRecordSet: recordSet,
+				},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSRecordInfo{}, err
+	}
+	cblogger.Info(result)
+
+	recordInfo := setterRecordSet(recordSet, zoneInfo.Name)
+	recordInfo.ChangeId = strings.TrimPrefix(aws.StringValue(result.ChangeInfo.Id), CBChangePrefix)
+	recordInfo.ChangeStatus = irs.DNSChangePending
+	if aws.StringValue(result.ChangeInfo.Status) == route53.ChangeStatusInsync {
+		recordInfo.ChangeStatus = irs.DNSChangeInSync
+	}
+	return *recordInfo, nil
+}
+
+func (dnsHandler *AwsDNSHandler) getRecordSet(zoneID string, recordName string, recordType irs.DNSRecordType) (*route53.ResourceRecordSet, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn := getDNSRecordFQDN(recordName, zoneInfo.Name)
+	result, err := dnsHandler.Client.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(fqdn),
+		StartRecordType: aws.String(string(recordType)),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if len(result.ResourceRecordSets) == 0 ||
+		!strings.EqualFold(strings.TrimSuffix(aws.StringValue(result.ResourceRecordSets[0].Name), "."), fqdn) ||
+		aws.StringValue(result.ResourceRecordSets[0].Type) != string(recordType) {
+		return nil, errors.New("record " + recordName + " " + string(recordType) + " does not exist")
+	}
+	return result.ResourceRecordSets[0], nil
+}
+
+func isDNSRecordTypeSupported(recordType string) bool {
+	switch irs.DNSRecordType(recordType) {
+	case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordCNAME, irs.DNSRecordTXT:
+		return true
+	}
+	return false
+}
+
+//ex) www, example.com => www.example.com, @ => example.com
+func getDNSRecordFQDN(recordName string, zoneName string) string {
+	if recordName == "" || recordName == irs.DNSZoneApex {
+		return zoneName
+	}
+	return recordName + "." + zoneName
+}
+
+//ex) www.example.com., example.com => www
+func getRelativeDNSName(fqdn string, zoneName string) string {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	if strings.EqualFold(fqdn, zoneName) {
+		return irs.DNSZoneApex
+	}
+	return strings.TrimSuffix(fqdn, "."+zoneName)
+}
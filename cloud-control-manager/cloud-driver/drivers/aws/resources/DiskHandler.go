@@ -0,0 +1,309 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AwsDiskHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+// EBS 볼륨을 생성 함. (Zone 정보가 없으면 리전의 첫 번째 가용 영역에 생성)
+func (diskHandler *AwsDiskHandler) CreateDisk(diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	cblogger.Info("Start : ", diskReqInfo)
+
+	size, err := strconv.ParseInt(diskReqInfo.DiskSize, 10, 64)
+	if err != nil {
+		return irs.DiskInfo{}, errors.New("DiskSize[" + diskReqInfo.DiskSize + "] 값이 올바르지 않습니다.")
+	}
+
+	zone, err := diskHandler.getZone(diskReqInfo.Zone)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	//사용자 Tag는 Name Tag와 함께 볼륨 생성 시 설정 함.
+	userTags, err := getUserTags(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	input := &ec2.CreateVolumeInput{
+		AvailabilityZone: aws.String(zone),
+		Size:             aws.Int64(size),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeVolume),
+				Tags: append([]*ec2.Tag{
+					{Key: aws.String("Name"), Value: aws.String(diskReqInfo.Name)},
+				}, userTags...),
+			},
+		},
+	}
+	if diskReqInfo.DiskType != "" {
+		input.VolumeType = aws.String(diskReqInfo.DiskType)
+	}
+
+	result, err := diskHandler.Client.CreateVolume(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create Disk: %s, %v.", diskReqInfo.Name, err)
+		return irs.DiskInfo{}, err
+	}
+	cblogger.Infof("EBS 볼륨 생성 요청 성공 - Volume Id : [%s]", *result.VolumeId)
+
+	err = diskHandler.Client.WaitUntilVolumeAvailable(&ec2.DescribeVolumesInput{
+		VolumeIds: []*string{result.VolumeId},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.GetDisk(*result.VolumeId)
+}
+
+func (diskHandler *AwsDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
+	cblogger.Debug("Start")
+
+	var diskInfoList []*irs.DiskInfo
+	err := diskHandler.Client.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.Volumes {
+			diskInfo := ExtractDiskInfo(volume)
+			diskInfoList = append(diskInfoList, &diskInfo)
+		}
+		return true
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return diskInfoList, nil
+}
+
+func (diskHandler *AwsDiskHandler) GetDisk(diskID string) (irs.DiskInfo, error) {
+	cblogger.Infof("diskID : [%s]", diskID)
+
+	volume, err := diskHandler.describeVolume(diskID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	return ExtractDiskInfo(volume), nil
+}
+
+func (diskHandler *AwsDiskHandler) ChangeDiskSize(diskID string, size string) (bool, error) {
+	cblogger.Infof("diskID : [%s], size : [%s]", diskID, size)
+
+	newSize, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return false, errors.New("size[" + size + "] 값이 올바르지 않습니다.")
+	}
+
+	_, err = diskHandler.Client.ModifyVolume(&ec2.ModifyVolumeInput{
+		VolumeId: aws.String(diskID),
+		Size:     aws.Int64(newSize),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AwsDiskHandler) DeleteDisk(diskID string) (bool, error) {
+	cblogger.Infof("diskID : [%s]", diskID)
+
+	_, err := diskHandler.Client.DeleteVolume(&ec2.DeleteVolumeInput{
+		VolumeId: aws.String(diskID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// VM에서 사용 중이지 않은 디바이스 이름(/dev/sdf ~ /dev/sdp)으로 연결 함.
+func (diskHandler *AwsDiskHandler) AttachDisk(diskID string, vmID string) (irs.DiskInfo, error) {
+	cblogger.Infof("diskID : [%s], vmID : [%s]", diskID, vmID)
+
+	device, err := diskHandler.getFreeDeviceName(vmID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	_, err = diskHandler.Client.AttachVolume(&ec2.AttachVolumeInput{
+		Device:     aws.String(device),
+		InstanceId: aws.String(vmID),
+		VolumeId:   aws.String(diskID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	err = diskHandler.Client.WaitUntilVolumeInUse(&ec2.DescribeVolumesInput{
+		VolumeIds: []*string{aws.String(diskID)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.GetDisk(diskID)
+}
+
+func (diskHandler *AwsDiskHandler) DetachDisk(diskID string, vmID string) (bool, error) {
+	cblogger.Infof("diskID : [%s], vmID : [%s]", diskID, vmID)
+
+	_, err := diskHandler.Client.DetachVolume(&ec2.DetachVolumeInput{
+		InstanceId: aws.String(vmID),
+		VolumeId:   aws.String(diskID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	err = diskHandler.Client.WaitUntilVolumeAvailable(&ec2.DescribeVolumesInput{
+		VolumeIds: []*string{aws.String(diskID)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AwsDiskHandler) describeVolume(diskID string) (*ec2.Volume, error) {
+	result, err := diskHandler.Client.DescribeVolumes(&ec2.DescribeVolumesInput{
+		VolumeIds: []*string{aws.String(diskID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Volumes) < 1 {
+		return nil, errors.New("Disk[" + diskID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.Volumes[0], nil
+}
+
+// 요청 Zone -> 연결 정보의 Zone -> 리전의 첫 번째 가용 영역 순서로 사용
+func (diskHandler *AwsDiskHandler) getZone(zone string) (string, error) {
+	if zone != "" {
+		return zone, nil
+	}
+	if diskHandler.Region.Zone != "" {
+		return diskHandler.Region.Zone, nil
+	}
+
+	result, err := diskHandler.Client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("state"), Values: aws.StringSlice([]string{"available"})},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(result.AvailabilityZones) < 1 {
+		return "", errors.New("Region[" + diskHandler.Region.Region + "]에 사용 가능한 Zone이 없습니다.")
+	}
+	return aws.StringValue(result.AvailabilityZones[0].ZoneName), nil
+}
+
+func (diskHandler *AwsDiskHandler) getFreeDeviceName(vmID string) (string, error) {
+	result, err := diskHandler.Client.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(vmID)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(result.Reservations) < 1 || len(result.Reservations[0].Instances) < 1 {
+		return "", errors.New("VM[" + vmID + "] 정보를 찾을 수 없습니다.")
+	}
+
+	usedDevice := map[string]bool{}
+	for _, mapping := range result.Reservations[0].Instances[0].BlockDeviceMappings {
+		usedDevice[aws.StringValue(mapping.DeviceName)] = true
+	}
+	for c := 'f'; c <= 'p'; c++ {
+		device := "/dev/sd" + string(c)
+		if !usedDevice[device] && !usedDevice["/dev/xvd"+string(c)] {
+			return device, nil
+		}
+	}
+	return "", errors.New("VM[" + vmID + "]에 연결 가능한 디바이스 이름이 없습니다.")
+}
+
+// Volume에서 DiskInfo 정보를 추출함
+func ExtractDiskInfo(volume *ec2.Volume) irs.DiskInfo {
+	diskInfo := irs.DiskInfo{
+		Id:          aws.StringValue(volume.VolumeId),
+		DiskType:    aws.StringValue(volume.VolumeType),
+		DiskSize:    strconv.FormatInt(aws.Int64Value(volume.Size), 10),
+		Zone:        aws.StringValue(volume.AvailabilityZone),
+		Status:      convertDiskStatus(aws.StringValue(volume.State)),
+		CreatedTime: aws.TimeValue(volume.CreateTime),
+	}
+
+	for _, tag := range volume.Tags {
+		if aws.StringValue(tag.Key) == "Name" {
+			diskInfo.Name = aws.StringValue(tag.Value)
+			break
+		}
+	}
+
+	keyValueList := []irs.KeyValue{
+		{Key: "State", Value: aws.StringValue(volume.State)},
+		{Key: "Encrypted", Value: strconv.FormatBool(aws.BoolValue(volume.Encrypted))},
+	}
+	if volume.Iops != nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "Iops", Value: strconv.FormatInt(*volume.Iops, 10)})
+	}
+	if volume.SnapshotId != nil && *volume.SnapshotId != "" {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "SnapshotId", Value: *volume.SnapshotId})
+	}
+	for _, attachment := range volume.Attachments {
+		diskInfo.OwnerVMId = aws.StringValue(attachment.InstanceId)
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "Device", Value: aws.StringValue(attachment.Device)})
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "DeleteOnTermination", Value: strconv.FormatBool(aws.BoolValue(attachment.DeleteOnTermination))})
+	}
+	diskInfo.KeyValueList = keyValueList
+
+	return diskInfo
+}
+
+// EBS 볼륨 상태 : creating | available | in-use | deleting | deleted | error
+func convertDiskStatus(state string) irs.DiskStatus {
+	switch state {
+	case ec2.VolumeStateCreating:
+		return irs.DiskCreating
+	case ec2.VolumeStateAvailable:
+		return irs.DiskAvailable
+	case ec2.VolumeStateInUse:
+		return irs.DiskAttached
+	case ec2.VolumeStateDeleting, ec2.VolumeStateDeleted:
+		return irs.DiskDeleting
+	default:
+		return irs.DiskError
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -28,10 +30,51 @@ type AwsImageHandler struct {
 	Client *ec2.EC2
 }
 
-//@TODO : 작업해야 함.
+// VM으로부터 AMI를 생성 함. (생성 완료를 기다리지 않으므로 GetImage로 상태를 확인해야 함)
 func (imageHandler *AwsImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
+	cblogger.Info("Start : ", imageReqInfo)
 
-	return irs.ImageInfo{}, nil
+	if imageReqInfo.SourceVMId == "" {
+		return irs.ImageInfo{}, errors.New("이미지를 생성할 VM의 ID(SourceVMId)가 필요합니다.")
+	}
+
+	input := &ec2.CreateImageInput{
+		InstanceId: aws.String(imageReqInfo.SourceVMId),
+		Name:       aws.String(imageReqInfo.Name),
+		NoReboot:   aws.Bool(imageReqInfo.NoReboot),
+	}
+	if imageReqInfo.Description != "" {
+		input.Description = aws.String(imageReqInfo.Description)
+	}
+
+	result, err := imageHandler.Client.CreateImage(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create Image: %s, %v.", imageReqInfo.Name, err)
+		return irs.ImageInfo{}, err
+	}
+	cblogger.Infof("AMI 생성 요청 성공 - Image Id : [%s]", *result.ImageId)
+
+	return imageHandler.GetImage(*result.ImageId)
+}
+
+// 계정이 소유한 AMI만 조회 함.
+func (imageHandler *AwsImageHandler) ListMyImage() ([]*irs.ImageInfo, error) {
+	cblogger.Debug("Start")
+
+	result, err := imageHandler.Client.DescribeImages(&ec2.DescribeImagesInput{
+		Owners: aws.StringSlice([]string{"self"}),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var imageInfoList []*irs.ImageInfo
+	for _, cur := range result.Images {
+		imageInfo := ExtractImageDescribeInfo(cur)
+		imageInfoList = append(imageInfoList, &imageInfo)
+	}
+	return imageInfoList, nil
 }
 
 //@TODO : 목록이 너무 많기 때문에 amazon 계정으로 공유된 퍼블릭 이미지중 AMI만 조회 함.
@@ -89,16 +132,106 @@ func (imageHandler *AwsImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 	return imageInfoList, nil
 }
 
+// Owner 미지정 시 조회하는 AMI Owner 목록 (amazon: Amazon Linux, Windows 등 AWS 제공 AMI)
+var awsTrustedImageOwners = []string{
+	"amazon",
+	"099720109477", //Canonical (Ubuntu)
+	"309956199498", //Red Hat (RHEL)
+	"136693071363", //Debian
+	"013907871322", //SUSE
+}
+
+// 필터를 DescribeImages의 서버 측 필터로 변환하여 조회 함. (생성일 조건은 지원하지 않음)
+// 소유자(Owner)가 없으면 awsTrustedImageOwners의 이미지만 조회 함.
+func (imageHandler *AwsImageHandler) ListImageByFilter(filterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	cblogger.Infof("filterInfo : [%v]", filterInfo)
+
+	input := &ec2.DescribeImagesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("image-type"),
+				Values: aws.StringSlice([]string{"machine"}),
+			},
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.ImageStateAvailable}),
+			},
+		},
+	}
+
+	// Owner를 지정하지 않으면 임의의 계정이 공개한 AMI가 조회되지 않도록 신뢰할 수 있는 Owner의 AMI만 조회 함.
+	if filterInfo.Owner == "" {
+		input.Owners = aws.StringSlice(awsTrustedImageOwners)
+	} else {
+		input.Owners = aws.StringSlice([]string{filterInfo.Owner})
+	}
+
+	// Windows AMI는 이름이 대문자로 시작(Windows_Server-2019-...)하므로 platform으로 구분 함.
+	osFamily := strings.ToLower(filterInfo.OSFamily)
+	if osFamily == "windows" {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("platform"),
+			Values: aws.StringSlice([]string{"windows"}),
+		})
+		osFamily = ""
+	}
+	if osFamily != "" || filterInfo.OSVersion != "" {
+		// ex) ubuntu/images/hvm-ssd/ubuntu-jammy-22.04-amd64-server-20221018
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("name"),
+			Values: aws.StringSlice([]string{"*" + osFamily + "*" + filterInfo.OSVersion + "*"}),
+		})
+	}
+	if filterInfo.NamePattern != "" {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("name"),
+			Values: aws.StringSlice([]string{filterInfo.NamePattern}),
+		})
+	}
+	if filterInfo.Architecture != "" {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("architecture"),
+			Values: aws.StringSlice([]string{convertArchitecture(filterInfo.Architecture)}),
+		})
+	}
+
+	result, err := imageHandler.Client.DescribeImages(input)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var imageInfoList []*irs.ImageInfo
+	for _, cur := range result.Images {
+		imageInfo := ExtractImageDescribeInfo(cur)
+		imageInfoList = append(imageInfoList, &imageInfo)
+	}
+	return imageInfoList, nil
+}
+
+// AMI의 아키텍처 : i386 | x86_64 | arm64
+func convertArchitecture(arch string) string {
+	switch strings.ToLower(arch) {
+	case "amd64", "x64":
+		return ec2.ArchitectureValuesX8664
+	case "aarch64", "arm":
+		return ec2.ArchitectureValuesArm64
+	default:
+		return strings.ToLower(arch)
+	}
+}
+
 //Image 정보를 추출함
 func ExtractImageDescribeInfo(image *ec2.Image) irs.ImageInfo {
 	//spew.Dump(image)
 	imageInfo := irs.ImageInfo{
 		Id:     *image.ImageId,
 		Name:   *image.Name,
-		Status: *image.State,
+		Status: convertImageStatus(*image.State),
 	}
 
 	keyValueList := []irs.KeyValue{
+		{Key: "State", Value: *image.State},
 		{Key: "CreationDate", Value: *image.CreationDate},
 		{Key: "Architecture", Value: *image.Architecture},
 		{Key: "OwnerId", Value: *image.OwnerId},
@@ -108,10 +241,18 @@ func ExtractImageDescribeInfo(image *ec2.Image) irs.ImageInfo {
 		{Key: "Public", Value: strconv.FormatBool(*image.Public)},
 	}
 
+	// ex) 2019-08-26T09:22:29.000Z
+	if createdTime, err := time.Parse(time.RFC3339, *image.CreationDate); err == nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: irs.ImageCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
+	}
+
 	// 일부 이미지들은 아래 정보가 없어서 예외 처리 함.
 	if !reflect.ValueOf(image.Description).IsNil() {
 		keyValueList = append(keyValueList, irs.KeyValue{Key: "Description", Value: *image.Description})
 	}
+	if image.StateReason != nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "StateReason", Value: aws.StringValue(image.StateReason.Message)})
+	}
 	if !reflect.ValueOf(image.ImageOwnerAlias).IsNil() {
 		keyValueList = append(keyValueList, irs.KeyValue{Key: "ImageOwnerAlias", Value: *image.ImageOwnerAlias})
 	}
@@ -128,6 +269,18 @@ func ExtractImageDescribeInfo(image *ec2.Image) irs.ImageInfo {
 	return imageInfo
 }
 
+// AMI 상태 : pending | available | invalid | deregistered | transient | failed | error
+func convertImageStatus(state string) string {
+	switch state {
+	case ec2.ImageStatePending:
+		return irs.ImageCreating
+	case ec2.ImageStateAvailable:
+		return irs.ImageAvailable
+	default:
+		return irs.ImageUnavailable
+	}
+}
+
 func (imageHandler *AwsImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
 	cblogger.Infof("imageID : [%s]", imageID)
 
@@ -164,7 +317,16 @@ func (imageHandler *AwsImageHandler) GetImage(imageID string) (irs.ImageInfo, er
 
 }
 
-//@TODO : 삭제 API 찾아야 함.
+// AMI 등록을 해제 함. (AMI의 EBS 스냅샷은 삭제되지 않음)
 func (imageHandler *AwsImageHandler) DeleteImage(imageID string) (bool, error) {
-	return false, nil
+	cblogger.Infof("imageID : [%s]", imageID)
+
+	_, err := imageHandler.Client.DeregisterImage(&ec2.DeregisterImageInput{
+		ImageId: aws.String(imageID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
 }
@@ -1,12 +1,15 @@
 package resources
 
 import (
+	"errors"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/davecgh/go-spew/spew"
+	"golang.org/x/crypto/ssh"
 )
 
 type AwsKeyPairHandler struct {
@@ -91,6 +94,51 @@ func (keyPairHandler *AwsKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPairReq
 	return keyPairInfo, nil
 }
 
+//기존 OpenSSH 공개키(ssh-rsa, ssh-ed25519)를 키페어로 등록 함.
+func (keyPairHandler *AwsKeyPairHandler) ImportKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	cblogger.Info(keyPairReqInfo)
+
+	publicKey, err := parseImportPublicKey(keyPairReqInfo.PublicKey)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.KeyPairInfo{}, err
+	}
+
+	result, err := keyPairHandler.Client.ImportKeyPair(&ec2.ImportKeyPairInput{
+		KeyName:           aws.String(keyPairReqInfo.Name),
+		PublicKeyMaterial: ssh.MarshalAuthorizedKey(publicKey),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "InvalidKeyPair.Duplicate" {
+			cblogger.Errorf("Keypair %q already exists.", keyPairReqInfo.Name)
+			return irs.KeyPairInfo{}, err
+		}
+		cblogger.Errorf("Unable to import key pair: %s, %v.", keyPairReqInfo.Name, err)
+		return irs.KeyPairInfo{}, err
+	}
+
+	cblogger.Infof("Imported key pair %q %s", *result.KeyName, *result.KeyFingerprint)
+	keyPairInfo := irs.KeyPairInfo{
+		Name:        *result.KeyName,
+		Fingerprint: *result.KeyFingerprint,
+		PublicKey:   string(ssh.MarshalAuthorizedKey(publicKey)),
+	}
+
+	return keyPairInfo, nil
+}
+
+//OpenSSH 형식 공개키 검증 (ssh-rsa, ssh-ed25519만 지원)
+func parseImportPublicKey(publicKeyString string) (ssh.PublicKey, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKeyString))
+	if err != nil {
+		return nil, errors.New("invalid OpenSSH public key: " + err.Error())
+	}
+	if publicKey.Type() != ssh.KeyAlgoRSA && publicKey.Type() != ssh.KeyAlgoED25519 {
+		return nil, errors.New("unsupported public key type: " + publicKey.Type())
+	}
+	return publicKey, nil
+}
+
 //혼선을 피하기 위해 keyPairID 대신 keyName으로 변경 함.
 func (keyPairHandler *AwsKeyPairHandler) GetKey(keyName string) (irs.KeyPairInfo, error) {
 	//keyPairID := keyName
@@ -0,0 +1,362 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//NATGatewayHandler는 Private 서브넷의 외부 통신을 위한 NAT Gateway를 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBNATGatewayWaitTime  = 300 // seconds
+	CBNATGatewayCheckTime = 5   // seconds
+)
+
+type AwsNATGatewayHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+// Public 서브넷에 EIP를 할당한 NAT Gateway를 생성 함.
+func (natHandler *AwsNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayReqInfo) (irs.NATGatewayInfo, error) {
+	cblogger.Info("Start : ", natGatewayReqInfo)
+
+	if natGatewayReqInfo.SubnetId == "" {
+		return irs.NATGatewayInfo{}, errors.New("NAT Gateway를 생성할 Public 서브넷 정보가 필요합니다.")
+	}
+
+	allocRes, err := natHandler.Client.AllocateAddress(&ec2.AllocateAddressInput{
+		Domain: aws.String("vpc"),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NATGatewayInfo{}, err
+	}
+	allocationId := *allocRes.AllocationId
+	cblogger.Infof("EIP 할당 완료 - Allocation Id : [%s]", allocationId)
+
+	result, err := natHandler.Client.CreateNatGateway(&ec2.CreateNatGatewayInput{
+		SubnetId:     aws.String(natGatewayReqInfo.SubnetId),
+		AllocationId: aws.String(allocationId),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create NatGateway: %s, %v.", natGatewayReqInfo.Name, err)
+		natHandler.releaseAddress(allocationId)
+		return irs.NATGatewayInfo{}, err
+	}
+	natGatewayId := *result.NatGateway.NatGatewayId
+	cblogger.Infof("NatGateway 생성 요청 완료 - NatGateway Id : [%s]", natGatewayId)
+
+	if !SetNameTag(natHandler.Client, natGatewayId, natGatewayReqInfo.Name) {
+		cblogger.Errorf("NatGateway에 %s Name 설정 실패", natGatewayReqInfo.Name)
+	}
+
+	err = natHandler.Client.WaitUntilNatGatewayAvailable(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{aws.String(natGatewayId)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NATGatewayInfo{}, err
+	}
+
+	return natHandler.GetNATGateway(natGatewayId)
+}
+
+func (natHandler *AwsNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	cblogger.Debug("Start")
+
+	result, err := natHandler.Client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var natGatewayInfoList []*irs.NATGatewayInfo
+	for _, natGateway := range result.NatGateways {
+		if aws.StringValue(natGateway.State) == ec2.NatGatewayStateDeleted {
+			continue
+		}
+		natGatewayInfo, err := natHandler.extractNATGatewayInfo(natGateway)
+		if err != nil {
+			return nil, err
+		}
+		natGatewayInfoList = append(natGatewayInfoList, &natGatewayInfo)
+	}
+	return natGatewayInfoList, nil
+}
+
+func (natHandler *AwsNATGatewayHandler) GetNATGateway(natGatewayID string) (irs.NATGatewayInfo, error) {
+	cblogger.Infof("natGatewayID : [%s]", natGatewayID)
+
+	natGateway, err := natHandler.describeNatGateway(natGatewayID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.extractNATGatewayInfo(natGateway)
+}
+
+// NAT Gateway로의 라우팅 정보를 삭제하고 NAT Gateway 삭제가 완료되면 EIP를 해제 함.
+func (natHandler *AwsNATGatewayHandler) DeleteNATGateway(natGatewayID string) (bool, error) {
+	cblogger.Infof("natGatewayID : [%s]", natGatewayID)
+
+	natGateway, err := natHandler.describeNatGateway(natGatewayID)
+	if err != nil {
+		return false, err
+	}
+
+	routeTableList, err := natHandler.describeNatRouteTables(natGatewayID)
+	if err != nil {
+		return false, err
+	}
+	for _, routeTable := range routeTableList {
+		for _, route := range routeTable.Routes {
+			if aws.StringValue(route.NatGatewayId) != natGatewayID {
+				continue
+			}
+			_, err := natHandler.Client.DeleteRoute(&ec2.DeleteRouteInput{
+				RouteTableId:         routeTable.RouteTableId,
+				DestinationCidrBlock: route.DestinationCidrBlock,
+			})
+			if err != nil {
+				cblogger.Error(err)
+				return false, err
+			}
+		}
+	}
+
+	_, err = natHandler.Client.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
+		NatGatewayId: aws.String(natGatewayID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete NatGateway: %s, %v.", natGatewayID, err)
+		return false, err
+	}
+
+	// NAT Gateway가 삭제되어야 EIP를 해제할 수 있음.
+	for i := 0; ; i++ {
+		deleting, err := natHandler.describeNatGateway(natGatewayID)
+		if err != nil {
+			return false, err
+		}
+		if aws.StringValue(deleting.State) == ec2.NatGatewayStateDeleted {
+			break
+		}
+		if i >= CBNATGatewayWaitTime/CBNATGatewayCheckTime {
+			return false, errors.New("NatGateway[" + natGatewayID + "]가 삭제되지 않았습니다.")
+		}
+		time.Sleep(time.Second * CBNATGatewayCheckTime)
+	}
+
+	for _, address := range natGateway.NatGatewayAddresses {
+		natHandler.releaseAddress(aws.StringValue(address.AllocationId))
+	}
+	return true, nil
+}
+
+// 라우팅 테이블(또는 서브넷의 라우팅 테이블)의 0.0.0.0/0 라우팅 대상을 NAT Gateway로 지정 함.
+func (natHandler *AwsNATGatewayHandler) AssociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (irs.NATGatewayInfo, error) {
+	cblogger.Infof("natGatewayID : [%s], associationInfo : [%v]", natGatewayID, associationInfo)
+
+	routeTable, err := natHandler.getAssociationRouteTable(natGatewayID, associationInfo)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	exist := false
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" {
+			exist = true
+		}
+	}
+	if exist {
+		_, err = natHandler.Client.ReplaceRoute(&ec2.ReplaceRouteInput{
+			RouteTableId:         routeTable.RouteTableId,
+			DestinationCidrBlock: aws.String("0.0.0.0/0"),
+			NatGatewayId:         aws.String(natGatewayID),
+		})
+	} else {
+		_, err = natHandler.Client.CreateRoute(&ec2.CreateRouteInput{
+			RouteTableId:         routeTable.RouteTableId,
+			DestinationCidrBlock: aws.String("0.0.0.0/0"),
+			NatGatewayId:         aws.String(natGatewayID),
+		})
+	}
+	if err != nil {
+		cblogger.Errorf("RouteTable[%s]에 NatGateway[%s] 라우팅 정보 추가 실패", *routeTable.RouteTableId, natGatewayID)
+		cblogger.Error(err)
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.GetNATGateway(natGatewayID)
+}
+
+func (natHandler *AwsNATGatewayHandler) DisassociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (bool, error) {
+	cblogger.Infof("natGatewayID : [%s], associationInfo : [%v]", natGatewayID, associationInfo)
+
+	routeTable, err := natHandler.getAssociationRouteTable(natGatewayID, associationInfo)
+	if err != nil {
+		return false, err
+	}
+
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.NatGatewayId) != natGatewayID {
+			continue
+		}
+		_, err := natHandler.Client.DeleteRoute(&ec2.DeleteRouteInput{
+			RouteTableId:         routeTable.RouteTableId,
+			DestinationCidrBlock: route.DestinationCidrBlock,
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+		return true, nil
+	}
+	return false, errors.New("RouteTable[" + *routeTable.RouteTableId + "]에 NatGateway[" + natGatewayID + "]로의 라우팅 정보가 없습니다.")
+}
+
+func (natHandler *AwsNATGatewayHandler) describeNatGateway(natGatewayID string) (*ec2.NatGateway, error) {
+	result, err := natHandler.Client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{aws.String(natGatewayID)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if len(result.NatGateways) < 1 {
+		return nil, errors.New("NatGateway[" + natGatewayID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.NatGateways[0], nil
+}
+
+// NAT Gateway로 라우팅하는 라우팅 테이블 목록
+func (natHandler *AwsNATGatewayHandler) describeNatRouteTables(natGatewayID string) ([]*ec2.RouteTable, error) {
+	result, err := natHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("route.nat-gateway-id"),
+				Values: aws.StringSlice([]string{natGatewayID}),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return result.RouteTables, nil
+}
+
+// RouterId가 없으면 서브넷이 연결된 라우팅 테이블(연결된 테이블이 없으면 VPC의 Main 라우팅 테이블)을 사용 함.
+func (natHandler *AwsNATGatewayHandler) getAssociationRouteTable(natGatewayID string, associationInfo irs.NATAssociationInfo) (*ec2.RouteTable, error) {
+	var filters []*ec2.Filter
+	switch {
+	case associationInfo.RouterId != "":
+		filters = []*ec2.Filter{
+			{Name: aws.String("route-table-id"), Values: aws.StringSlice([]string{associationInfo.RouterId})},
+		}
+	case associationInfo.SubnetId != "":
+		filters = []*ec2.Filter{
+			{Name: aws.String("association.subnet-id"), Values: aws.StringSlice([]string{associationInfo.SubnetId})},
+		}
+	default:
+		return nil, errors.New("NAT Gateway와 연결할 라우팅 테이블 또는 서브넷 정보가 필요합니다.")
+	}
+
+	result, err := natHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{Filters: filters})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if len(result.RouteTables) > 0 {
+		return result.RouteTables[0], nil
+	}
+	if associationInfo.RouterId != "" {
+		return nil, errors.New("RouteTable[" + associationInfo.RouterId + "] 정보를 찾을 수 없습니다.")
+	}
+
+	// 명시적으로 연결된 라우팅 테이블이 없는 서브넷은 VPC의 Main 라우팅 테이블을 사용 함.
+	natGateway, err := natHandler.describeNatGateway(natGatewayID)
+	if err != nil {
+		return nil, err
+	}
+	result, err = natHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []*string{natGateway.VpcId}},
+			{Name: aws.String("association.main"), Values: aws.StringSlice([]string{"true"})},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if len(result.RouteTables) < 1 {
+		return nil, errors.New("VPC[" + aws.StringValue(natGateway.VpcId) + "]의 Main 라우팅 테이블을 찾을 수 없습니다.")
+	}
+	return result.RouteTables[0], nil
+}
+
+func (natHandler *AwsNATGatewayHandler) releaseAddress(allocationId string) {
+	_, err := natHandler.Client.ReleaseAddress(&ec2.ReleaseAddressInput{
+		AllocationId: aws.String(allocationId),
+	})
+	if err != nil {
+		cblogger.Errorf("EIP[%s] 해제 실패", allocationId)
+		cblogger.Error(err)
+	}
+}
+
+//NatGateway와 NatGateway로 라우팅하는 라우팅 테이블 정보를 추출함
+func (natHandler *AwsNATGatewayHandler) extractNATGatewayInfo(natGateway *ec2.NatGateway) (irs.NATGatewayInfo, error) {
+	natGatewayInfo := irs.NATGatewayInfo{
+		Id:       aws.StringValue(natGateway.NatGatewayId),
+		VPCId:    aws.StringValue(natGateway.VpcId),
+		SubnetId: aws.StringValue(natGateway.SubnetId),
+		Status:   aws.StringValue(natGateway.State),
+	}
+
+	//Name은 Tag의 "Name" 속성에만 저장됨
+	for _, t := range natGateway.Tags {
+		if aws.StringValue(t.Key) == "Name" {
+			natGatewayInfo.Name = aws.StringValue(t.Value)
+			break
+		}
+	}
+
+	for _, address := range natGateway.NatGatewayAddresses {
+		natGatewayInfo.PublicIP = aws.StringValue(address.PublicIp)
+		natGatewayInfo.KeyValueList = append(natGatewayInfo.KeyValueList,
+			irs.KeyValue{Key: "AllocationId", Value: aws.StringValue(address.AllocationId)},
+			irs.KeyValue{Key: "PrivateIp", Value: aws.StringValue(address.PrivateIp)},
+		)
+	}
+
+	if natGatewayInfo.Status == ec2.NatGatewayStateDeleted {
+		return natGatewayInfo, nil
+	}
+	routeTableList, err := natHandler.describeNatRouteTables(natGatewayInfo.Id)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	for _, routeTable := range routeTableList {
+		natGatewayInfo.RouterIdList = append(natGatewayInfo.RouterIdList, aws.StringValue(routeTable.RouteTableId))
+		for _, association := range routeTable.Associations {
+			if association.SubnetId != nil {
+				natGatewayInfo.SubnetIdList = append(natGatewayInfo.SubnetIdList, *association.SubnetId)
+			}
+		}
+	}
+	return natGatewayInfo, nil
+}
@@ -0,0 +1,459 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//NLBHandler는 Network Load Balancer(Listener, Target Group, Health Check)를 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBNLBDefaultHealthCheckInterval  = 30 // seconds, 10 or 30
+	CBNLBDefaultHealthCheckThreshold = 3
+)
+
+// Load Balancer 이름을 ID로 사용하며 Target Group도 같은 이름으로 생성 함.
+type AwsNLBHandler struct {
+	Region    idrv.RegionInfo
+	Client    *ec2.EC2
+	NLBClient *elbv2.ELBV2
+}
+
+func (nlbHandler *AwsNLBHandler) CreateNLB(nlbReqInfo irs.NLBReqInfo) (irs.NLBInfo, error) {
+	cblogger.Info("Start : ", nlbReqInfo)
+
+	listenerPort, err := strconv.ParseInt(nlbReqInfo.Listener.Port, 10, 64)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("Listener Port 정보가 올바르지 않습니다. : " + nlbReqInfo.Listener.Port)
+	}
+	vmGroupPort, err := strconv.ParseInt(nlbReqInfo.VMGroup.Port, 10, 64)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("VMGroup Port 정보가 올바르지 않습니다. : " + nlbReqInfo.VMGroup.Port)
+	}
+
+	// NLB는 AZ별로 1개의 서브넷만 사용할 수 있음.
+	subnetIdList, err := nlbHandler.getSubnetPerZone(nlbReqInfo.VPCId)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	scheme := elbv2.LoadBalancerSchemeEnumInternetFacing
+	if nlbReqInfo.Type == irs.NLBInternal {
+		scheme = elbv2.LoadBalancerSchemeEnumInternal
+	}
+	lbResult, err := nlbHandler.NLBClient.CreateLoadBalancer(&elbv2.CreateLoadBalancerInput{
+		Name:    aws.String(nlbReqInfo.Name),
+		Type:    aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+		Scheme:  aws.String(scheme),
+		Subnets: aws.StringSlice(subnetIdList),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create LoadBalancer: %s, %v.", nlbReqInfo.Name, err)
+		return irs.NLBInfo{}, err
+	}
+	loadBalancer := lbResult.LoadBalancers[0]
+	cblogger.Infof("LoadBalancer 생성 요청 완료 - ARN : [%s]", *loadBalancer.LoadBalancerArn)
+
+	// Target Group 및 Health Check 설정
+	healthChecker := nlbReqInfo.HealthChecker
+	tgInput := &elbv2.CreateTargetGroupInput{
+		Name:                       aws.String(nlbReqInfo.Name),
+		Protocol:                   aws.String(getNLBProtocol(nlbReqInfo.VMGroup.Protocol)),
+		Port:                       aws.Int64(vmGroupPort),
+		VpcId:                      aws.String(nlbReqInfo.VPCId),
+		TargetType:                 aws.String(elbv2.TargetTypeEnumInstance),
+		HealthCheckProtocol:        aws.String(getNLBProtocol(healthChecker.Protocol)),
+		HealthCheckIntervalSeconds: aws.Int64(CBNLBDefaultHealthCheckInterval),
+		HealthyThresholdCount:      aws.Int64(CBNLBDefaultHealthCheckThreshold),
+		UnhealthyThresholdCount:    aws.Int64(CBNLBDefaultHealthCheckThreshold),
+	}
+	if healthChecker.Port != "" {
+		tgInput.HealthCheckPort = aws.String(healthChecker.Port)
+	}
+	if healthChecker.Interval > 0 {
+		tgInput.HealthCheckIntervalSeconds = aws.Int64(int64(healthChecker.Interval))
+	}
+	if healthChecker.Timeout > 0 {
+		tgInput.HealthCheckTimeoutSeconds = aws.Int64(int64(healthChecker.Timeout))
+	}
+	// NLB는 Healthy/Unhealthy Threshold가 같아야 함.
+	if healthChecker.Threshold > 0 {
+		tgInput.HealthyThresholdCount = aws.Int64(int64(healthChecker.Threshold))
+		tgInput.UnhealthyThresholdCount = aws.Int64(int64(healthChecker.Threshold))
+	}
+	tgResult, err := nlbHandler.NLBClient.CreateTargetGroup(tgInput)
+	if err != nil {
+		cblogger.Errorf("Unable to create TargetGroup: %s, %v.", nlbReqInfo.Name, err)
+		return irs.NLBInfo{}, err
+	}
+	targetGroup := tgResult.TargetGroups[0]
+
+	if len(nlbReqInfo.VMGroup.VMs) > 0 {
+		if err := nlbHandler.registerTargets(targetGroup.TargetGroupArn, nlbReqInfo.VMGroup.VMs); err != nil {
+			return irs.NLBInfo{}, err
+		}
+	}
+
+	_, err = nlbHandler.NLBClient.CreateListener(&elbv2.CreateListenerInput{
+		LoadBalancerArn: loadBalancer.LoadBalancerArn,
+		Protocol:        aws.String(getNLBProtocol(nlbReqInfo.Listener.Protocol)),
+		Port:            aws.Int64(listenerPort),
+		DefaultActions: []*elbv2.Action{
+			{
+				Type:           aws.String(elbv2.ActionTypeEnumForward),
+				TargetGroupArn: targetGroup.TargetGroupArn,
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create Listener: %s, %v.", nlbReqInfo.Name, err)
+		return irs.NLBInfo{}, err
+	}
+
+	err = nlbHandler.NLBClient.WaitUntilLoadBalancerAvailable(&elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: []*string{loadBalancer.LoadBalancerArn},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NLBInfo{}, err
+	}
+
+	return nlbHandler.GetNLB(nlbReqInfo.Name)
+}
+
+func (nlbHandler *AwsNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
+	cblogger.Debug("Start")
+
+	var nlbInfoList []*irs.NLBInfo
+	var loadBalancerList []*elbv2.LoadBalancer
+	err := nlbHandler.NLBClient.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		loadBalancerList = append(loadBalancerList, page.LoadBalancers...)
+		return true
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	for _, loadBalancer := range loadBalancerList {
+		if aws.StringValue(loadBalancer.Type) != elbv2.LoadBalancerTypeEnumNetwork {
+			continue
+		}
+		nlbInfo, err := nlbHandler.extractNLBInfo(loadBalancer)
+		if err != nil {
+			return nil, err
+		}
+		nlbInfoList = append(nlbInfoList, &nlbInfo)
+	}
+	return nlbInfoList, nil
+}
+
+func (nlbHandler *AwsNLBHandler) GetNLB(nlbID string) (irs.NLBInfo, error) {
+	cblogger.Infof("nlbID : [%s]", nlbID)
+
+	loadBalancer, err := nlbHandler.describeLoadBalancer(nlbID)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	return nlbHandler.extractNLBInfo(loadBalancer)
+}
+
+// Load Balancer를 삭제하면 Listener도 함께 삭제되며, Target Group은 Load Balancer 삭제 후에 삭제 가능 함.
+func (nlbHandler *AwsNLBHandler) DeleteNLB(nlbID string) (bool, error) {
+	cblogger.Infof("nlbID : [%s]", nlbID)
+
+	loadBalancer, err := nlbHandler.describeLoadBalancer(nlbID)
+	if err != nil {
+		return false, err
+	}
+	targetGroupList, err := nlbHandler.describeTargetGroups(loadBalancer.LoadBalancerArn)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = nlbHandler.NLBClient.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: loadBalancer.LoadBalancerArn,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete LoadBalancer: %s, %v.", nlbID, err)
+		return false, err
+	}
+	err = nlbHandler.NLBClient.WaitUntilLoadBalancersDeleted(&elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: []*string{loadBalancer.LoadBalancerArn},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	for _, targetGroup := range targetGroupList {
+		_, err := nlbHandler.NLBClient.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{
+			TargetGroupArn: targetGroup.TargetGroupArn,
+		})
+		if err != nil {
+			cblogger.Errorf("Unable to delete TargetGroup: %s, %v.", aws.StringValue(targetGroup.TargetGroupName), err)
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (nlbHandler *AwsNLBHandler) AddVMs(nlbID string, vmIDs []string) (irs.VMGroupInfo, error) {
+	cblogger.Infof("nlbID : [%s], vmIDs : [%v]", nlbID, vmIDs)
+
+	targetGroup, err := nlbHandler.getTargetGroup(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	if err := nlbHandler.registerTargets(targetGroup.TargetGroupArn, vmIDs); err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	return nlbHandler.extractVMGroupInfo(targetGroup)
+}
+
+func (nlbHandler *AwsNLBHandler) RemoveVMs(nlbID string, vmIDs []string) (bool, error) {
+	cblogger.Infof("nlbID : [%s], vmIDs : [%v]", nlbID, vmIDs)
+
+	targetGroup, err := nlbHandler.getTargetGroup(nlbID)
+	if err != nil {
+		return false, err
+	}
+
+	var targets []*elbv2.TargetDescription
+	for _, vmID := range vmIDs {
+		targets = append(targets, &elbv2.TargetDescription{Id: aws.String(vmID)})
+	}
+	_, err = nlbHandler.NLBClient.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+		TargetGroupArn: targetGroup.TargetGroupArn,
+		Targets:        targets,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to deregister Targets: %s, %v.", nlbID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (nlbHandler *AwsNLBHandler) GetVMGroupHealthInfo(nlbID string) (irs.HealthInfo, error) {
+	cblogger.Infof("nlbID : [%s]", nlbID)
+
+	targetGroup, err := nlbHandler.getTargetGroup(nlbID)
+	if err != nil {
+		return irs.HealthInfo{}, err
+	}
+	result, err := nlbHandler.NLBClient.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: targetGroup.TargetGroupArn,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.HealthInfo{}, err
+	}
+
+	healthInfo := irs.HealthInfo{}
+	for _, description := range result.TargetHealthDescriptions {
+		vmID := aws.StringValue(description.Target.Id)
+		healthInfo.AllVMs = append(healthInfo.AllVMs, vmID)
+		if aws.StringValue(description.TargetHealth.State) == elbv2.TargetHealthStateEnumHealthy {
+			healthInfo.HealthyVMs = append(healthInfo.HealthyVMs, vmID)
+		} else {
+			healthInfo.UnHealthyVMs = append(healthInfo.UnHealthyVMs, vmID)
+		}
+	}
+	return healthInfo, nil
+}
+
+func (nlbHandler *AwsNLBHandler) describeLoadBalancer(nlbID string) (*elbv2.LoadBalancer, error) {
+	result, err := nlbHandler.NLBClient.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+		Names: aws.StringSlice([]string{nlbID}),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if len(result.LoadBalancers) < 1 {
+		return nil, errors.New("LoadBalancer[" + nlbID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.LoadBalancers[0], nil
+}
+
+func (nlbHandler *AwsNLBHandler) describeTargetGroups(loadBalancerArn *string) ([]*elbv2.TargetGroup, error) {
+	result, err := nlbHandler.NLBClient.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: loadBalancerArn,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return result.TargetGroups, nil
+}
+
+func (nlbHandler *AwsNLBHandler) getTargetGroup(nlbID string) (*elbv2.TargetGroup, error) {
+	loadBalancer, err := nlbHandler.describeLoadBalancer(nlbID)
+	if err != nil {
+		return nil, err
+	}
+	targetGroupList, err := nlbHandler.describeTargetGroups(loadBalancer.LoadBalancerArn)
+	if err != nil {
+		return nil, err
+	}
+	if len(targetGroupList) < 1 {
+		return nil, errors.New("LoadBalancer[" + nlbID + "]의 TargetGroup 정보를 찾을 수 없습니다.")
+	}
+	return targetGroupList[0], nil
+}
+
+func (nlbHandler *AwsNLBHandler) registerTargets(targetGroupArn *string, vmIDs []string) error {
+	var targets []*elbv2.TargetDescription
+	for _, vmID := range vmIDs {
+		targets = append(targets, &elbv2.TargetDescription{Id: aws.String(vmID)})
+	}
+	_, err := nlbHandler.NLBClient.RegisterTargets(&elbv2.RegisterTargetsInput{
+		TargetGroupArn: targetGroupArn,
+		Targets:        targets,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to register Targets: %v, %v.", vmIDs, err)
+		return err
+	}
+	return nil
+}
+
+// VPC의 서브넷 중 AZ별로 첫번째 서브넷을 선택 함.
+func (nlbHandler *AwsNLBHandler) getSubnetPerZone(vpcID string) ([]string, error) {
+	result, err := nlbHandler.Client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcID})},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	zoneMap := map[string]bool{}
+	var subnetIdList []string
+	for _, subnet := range result.Subnets {
+		zone := aws.StringValue(subnet.AvailabilityZone)
+		if zoneMap[zone] {
+			continue
+		}
+		zoneMap[zone] = true
+		subnetIdList = append(subnetIdList, aws.StringValue(subnet.SubnetId))
+	}
+	if len(subnetIdList) < 1 {
+		return nil, errors.New("VPC[" + vpcID + "]에 서브넷이 없습니다.")
+	}
+	return subnetIdList, nil
+}
+
+func getNLBProtocol(protocol string) string {
+	if protocol == "" {
+		return elbv2.ProtocolEnumTcp
+	}
+	return protocol
+}
+
+func (nlbHandler *AwsNLBHandler) extractVMGroupInfo(targetGroup *elbv2.TargetGroup) (irs.VMGroupInfo, error) {
+	vmGroupInfo := irs.VMGroupInfo{
+		Protocol: aws.StringValue(targetGroup.Protocol),
+		Port:     strconv.FormatInt(aws.Int64Value(targetGroup.Port), 10),
+		KeyValueList: []irs.KeyValue{
+			{Key: "TargetGroupArn", Value: aws.StringValue(targetGroup.TargetGroupArn)},
+		},
+	}
+
+	result, err := nlbHandler.NLBClient.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: targetGroup.TargetGroupArn,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMGroupInfo{}, err
+	}
+	for _, description := range result.TargetHealthDescriptions {
+		vmGroupInfo.VMs = append(vmGroupInfo.VMs, aws.StringValue(description.Target.Id))
+	}
+	return vmGroupInfo, nil
+}
+
+//Load Balancer와 Listener, Target Group(Health Check) 정보를 추출함
+func (nlbHandler *AwsNLBHandler) extractNLBInfo(loadBalancer *elbv2.LoadBalancer) (irs.NLBInfo, error) {
+	nlbInfo := irs.NLBInfo{
+		Id:    aws.StringValue(loadBalancer.LoadBalancerName),
+		Name:  aws.StringValue(loadBalancer.LoadBalancerName),
+		VPCId: aws.StringValue(loadBalancer.VpcId),
+		Type:  irs.NLBPublic,
+		KeyValueList: []irs.KeyValue{
+			{Key: "LoadBalancerArn", Value: aws.StringValue(loadBalancer.LoadBalancerArn)},
+		},
+	}
+	if aws.StringValue(loadBalancer.Scheme) == elbv2.LoadBalancerSchemeEnumInternal {
+		nlbInfo.Type = irs.NLBInternal
+	}
+	if loadBalancer.State != nil {
+		nlbInfo.KeyValueList = append(nlbInfo.KeyValueList, irs.KeyValue{Key: "State", Value: aws.StringValue(loadBalancer.State.Code)})
+	}
+	if loadBalancer.CreatedTime != nil {
+		nlbInfo.CreatedTime = loadBalancer.CreatedTime.String()
+	}
+
+	listenerResult, err := nlbHandler.NLBClient.DescribeListeners(&elbv2.DescribeListenersInput{
+		LoadBalancerArn: loadBalancer.LoadBalancerArn,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NLBInfo{}, err
+	}
+	if len(listenerResult.Listeners) > 0 {
+		listener := listenerResult.Listeners[0]
+		nlbInfo.Listener = irs.ListenerInfo{
+			Protocol: aws.StringValue(listener.Protocol),
+			Port:     strconv.FormatInt(aws.Int64Value(listener.Port), 10),
+			DNSName:  aws.StringValue(loadBalancer.DNSName),
+			KeyValueList: []irs.KeyValue{
+				{Key: "ListenerArn", Value: aws.StringValue(listener.ListenerArn)},
+			},
+		}
+	}
+	for _, availabilityZone := range loadBalancer.AvailabilityZones {
+		for _, address := range availabilityZone.LoadBalancerAddresses {
+			if address.IpAddress != nil {
+				nlbInfo.Listener.IP = aws.StringValue(address.IpAddress)
+			}
+		}
+	}
+
+	targetGroupList, err := nlbHandler.describeTargetGroups(loadBalancer.LoadBalancerArn)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if len(targetGroupList) > 0 {
+		targetGroup := targetGroupList[0]
+		nlbInfo.VMGroup, err = nlbHandler.extractVMGroupInfo(targetGroup)
+		if err != nil {
+			return irs.NLBInfo{}, err
+		}
+		nlbInfo.HealthChecker = irs.HealthCheckerInfo{
+			Protocol:  aws.StringValue(targetGroup.HealthCheckProtocol),
+			Port:      aws.StringValue(targetGroup.HealthCheckPort),
+			Interval:  int(aws.Int64Value(targetGroup.HealthCheckIntervalSeconds)),
+			Timeout:   int(aws.Int64Value(targetGroup.HealthCheckTimeoutSeconds)),
+			Threshold: int(aws.Int64Value(targetGroup.HealthyThresholdCount)),
+		}
+	}
+	return nlbInfo, nil
+}
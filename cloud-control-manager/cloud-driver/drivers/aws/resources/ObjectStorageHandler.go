@@ -0,0 +1,271 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//ObjectStorageHandler는 S3 Bucket 및 Object를 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AwsObjectStorageHandler struct {
+	Region idrv.RegionInfo
+	Client *s3.S3
+}
+
+func (storageHandler *AwsObjectStorageHandler) CreateBucket(bucketName string) (irs.BucketInfo, error) {
+	cblogger.Info("Start : ", bucketName)
+
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	}
+	//us-east-1 리전은 LocationConstraint를 지정하면 오류가 발생 함.
+	if storageHandler.Region.Region != "us-east-1" {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(storageHandler.Region.Region),
+		}
+	}
+
+	result, err := storageHandler.Client.CreateBucket(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create bucket: %s, %v.", bucketName, err)
+		return irs.BucketInfo{}, err
+	}
+	cblogger.Info(result)
+
+	err = storageHandler.Client.WaitUntilBucketExists(&s3.HeadBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		cblogger.Errorf("Error occurred while waiting for bucket %s to be created, %v.", bucketName, err)
+		return irs.BucketInfo{}, err
+	}
+
+	bucketList, err := storageHandler.ListBucket()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	for _, bucketInfo := range bucketList {
+		if bucketInfo.Name == bucketName {
+			return *bucketInfo, nil
+		}
+	}
+	return irs.BucketInfo{Name: bucketName, Region: storageHandler.Region.Region}, nil
+}
+
+//S3의 Bucket 목록은 리전 구분 없이 계정 전체를 대상으로 조회 됨.
+func (storageHandler *AwsObjectStorageHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	cblogger.Info("Start")
+
+	result, err := storageHandler.Client.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		cblogger.Errorf("Unable to list buckets, %v", err)
+		return nil, err
+	}
+
+	var bucketList []*irs.BucketInfo
+	for _, bucket := range result.Buckets {
+		bucketInfo := irs.BucketInfo{
+			Name:        aws.StringValue(bucket.Name),
+			CreatedTime: aws.TimeValue(bucket.CreationDate).Format(time.RFC3339),
+		}
+
+		location, err := storageHandler.Client.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: bucket.Name})
+		if err != nil {
+			cblogger.Errorf("Unable to get location of bucket %s, %v", bucketInfo.Name, err)
+		} else {
+			//LocationConstraint가 없으면 us-east-1 리전임.
+			bucketInfo.Region = aws.StringValue(location.LocationConstraint)
+			if bucketInfo.Region == "" {
+				bucketInfo.Region = "us-east-1"
+			}
+		}
+		bucketList = append(bucketList, &bucketInfo)
+	}
+
+	return bucketList, nil
+}
+
+//Bucket은 비어 있어야 삭제 가능 함.
+func (storageHandler *AwsObjectStorageHandler) DeleteBucket(bucketName string) (bool, error) {
+	cblogger.Info("Start : ", bucketName)
+
+	_, err := storageHandler.Client.DeleteBucket(&s3.DeleteBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete bucket %s, %v", bucketName, err)
+		return false, err
+	}
+
+	err = storageHandler.Client.WaitUntilBucketNotExists(&s3.HeadBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		cblogger.Errorf("Error occurred while waiting for bucket %s to be deleted, %v", bucketName, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+//크기를 알 수 없는 Stream도 업로드할 수 있도록 s3manager의 Uploader를 이용 함.
+func (storageHandler *AwsObjectStorageHandler) PutObject(bucketName string, objectKey string, body io.Reader, contentType string) (irs.ObjectInfo, error) {
+	cblogger.Infof("Start : [%s] [%s]", bucketName, objectKey)
+
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Body:   body,
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(storageHandler.Client)
+	result, err := uploader.Upload(input)
+	if err != nil {
+		cblogger.Errorf("Unable to upload %s to %s, %v", objectKey, bucketName, err)
+		return irs.ObjectInfo{}, err
+	}
+	cblogger.Info("업로드 완료 : ", result.Location)
+
+	return storageHandler.headObject(bucketName, objectKey)
+}
+
+func (storageHandler *AwsObjectStorageHandler) GetObject(bucketName string, objectKey string) (io.ReadCloser, irs.ObjectInfo, error) {
+	cblogger.Infof("Start : [%s] [%s]", bucketName, objectKey)
+
+	result, err := storageHandler.Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to download %s from %s, %v", objectKey, bucketName, err)
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	objectInfo := irs.ObjectInfo{
+		Key:          objectKey,
+		Size:         aws.Int64Value(result.ContentLength),
+		ETag:         strings.Trim(aws.StringValue(result.ETag), "\""),
+		LastModified: aws.TimeValue(result.LastModified).Format(time.RFC3339),
+		StorageClass: aws.StringValue(result.StorageClass),
+		ContentType:  aws.StringValue(result.ContentType),
+	}
+
+	return result.Body, objectInfo, nil
+}
+
+func (storageHandler *AwsObjectStorageHandler) ListObject(bucketName string, prefix string) ([]*irs.ObjectInfo, error) {
+	cblogger.Infof("Start : [%s] prefix[%s]", bucketName, prefix)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	var objectList []*irs.ObjectInfo
+	err := storageHandler.Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objectList = append(objectList, &irs.ObjectInfo{
+				Key:          aws.StringValue(object.Key),
+				Size:         aws.Int64Value(object.Size),
+				ETag:         strings.Trim(aws.StringValue(object.ETag), "\""),
+				LastModified: aws.TimeValue(object.LastModified).Format(time.RFC3339),
+				StorageClass: aws.StringValue(object.StorageClass),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to list objects of %s, %v", bucketName, err)
+		return nil, err
+	}
+
+	return objectList, nil
+}
+
+func (storageHandler *AwsObjectStorageHandler) DeleteObject(bucketName string, objectKey string) (bool, error) {
+	cblogger.Infof("Start : [%s] [%s]", bucketName, objectKey)
+
+	_, err := storageHandler.Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete object %s from %s, %v", objectKey, bucketName, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (storageHandler *AwsObjectStorageHandler) GetPresignedURL(bucketName string, objectKey string, method string, expiresSeconds int) (string, error) {
+	cblogger.Infof("Start : [%s] [%s] [%s] [%d]", bucketName, objectKey, method, expiresSeconds)
+
+	var urlStr string
+	var err error
+	expires := time.Duration(expiresSeconds) * time.Second
+
+	switch strings.ToUpper(method) {
+	case irs.PresignedURLGet:
+		req, _ := storageHandler.Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		urlStr, err = req.Presign(expires)
+	case irs.PresignedURLPut:
+		req, _ := storageHandler.Client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		urlStr, err = req.Presign(expires)
+	default:
+		return "", errors.New("지원하지 않는 Method 입니다. (GET 또는 PUT) : " + method)
+	}
+	if err != nil {
+		cblogger.Errorf("Failed to sign request, %v", err)
+		return "", err
+	}
+
+	return urlStr, nil
+}
+
+func (storageHandler *AwsObjectStorageHandler) headObject(bucketName string, objectKey string) (irs.ObjectInfo, error) {
+	result, err := storageHandler.Client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to get object %s from %s, %v", objectKey, bucketName, err)
+		return irs.ObjectInfo{}, err
+	}
+
+	return irs.ObjectInfo{
+		Key:          objectKey,
+		Size:         aws.Int64Value(result.ContentLength),
+		ETag:         strings.Trim(aws.StringValue(result.ETag), "\""),
+		LastModified: aws.TimeValue(result.LastModified).Format(time.RFC3339),
+		StorageClass: aws.StringValue(result.StorageClass),
+		ContentType:  aws.StringValue(result.ContentType),
+	}, nil
+}
@@ -33,9 +33,16 @@ func (publicIpHandler *AwsPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.Pu
 
 	//var publicIPInfo irs.PublicIPInfo
 
+	//사용자 Tag는 EIP 할당 시 함께 설정 함.
+	tagSpecifications, err := getTagSpecifications(irs.RSTypePublicIP, publicIPReqInfo.TagList)
+	if err != nil {
+		return irs.PublicIPInfo{}, err
+	}
+
 	// Attempt to allocate the Elastic IP address.
 	allocRes, err := publicIpHandler.Client.AllocateAddress(&ec2.AllocateAddressInput{
-		Domain: aws.String("vpc"), // 적용 범위 : VPC
+		Domain:            aws.String("vpc"), // 적용 범위 : VPC
+		TagSpecifications: tagSpecifications,
 	})
 
 	if err != nil {
@@ -0,0 +1,381 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//RouterHandler는 VPC의 라우팅 테이블(Route Table)을 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AwsRouterHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+func (routerHandler *AwsRouterHandler) CreateRouter(routerReqInfo irs.RouterReqInfo) (irs.RouterInfo, error) {
+	cblogger.Info("Start : ", routerReqInfo)
+
+	if routerReqInfo.VPCId == "" {
+		return irs.RouterInfo{}, errors.New("라우팅 테이블을 생성할 VPC 정보가 필요합니다.")
+	}
+
+	result, err := routerHandler.Client.CreateRouteTable(&ec2.CreateRouteTableInput{
+		VpcId: aws.String(routerReqInfo.VPCId),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create RouteTable: %s, %v.", routerReqInfo.Name, err)
+		return irs.RouterInfo{}, err
+	}
+	routeTableId := *result.RouteTable.RouteTableId
+	cblogger.Infof("RouteTable 생성 완료 - RouteTable Id : [%s]", routeTableId)
+
+	if !SetNameTag(routerHandler.Client, routeTableId, routerReqInfo.Name) {
+		cblogger.Errorf("RouteTable에 %s Name 설정 실패", routerReqInfo.Name)
+	}
+
+	if routerReqInfo.InternetGateway {
+		return routerHandler.AttachInternetGateway(routeTableId)
+	}
+	return routerHandler.GetRouter(routeTableId)
+}
+
+func (routerHandler *AwsRouterHandler) ListRouter() ([]*irs.RouterInfo, error) {
+	cblogger.Debug("Start")
+
+	result, err := routerHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var routerInfoList []*irs.RouterInfo
+	for _, routeTable := range result.RouteTables {
+		routerInfo := ExtractRouterInfo(routeTable)
+		routerInfoList = append(routerInfoList, &routerInfo)
+	}
+	return routerInfoList, nil
+}
+
+func (routerHandler *AwsRouterHandler) GetRouter(routerID string) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s]", routerID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return ExtractRouterInfo(routeTable), nil
+}
+
+// 연결된 서브넷들의 연결을 해제한 후 라우팅 테이블을 삭제 함. (VPC의 Main 라우팅 테이블은 삭제할 수 없음)
+func (routerHandler *AwsRouterHandler) DeleteRouter(routerID string) (bool, error) {
+	cblogger.Infof("routerID : [%s]", routerID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return false, err
+	}
+	for _, association := range routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			return false, errors.New("VPC의 Main 라우팅 테이블[" + routerID + "]은 삭제할 수 없습니다.")
+		}
+	}
+	for _, association := range routeTable.Associations {
+		_, err := routerHandler.Client.DisassociateRouteTable(&ec2.DisassociateRouteTableInput{
+			AssociationId: association.RouteTableAssociationId,
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	_, err = routerHandler.Client.DeleteRouteTable(&ec2.DeleteRouteTableInput{
+		RouteTableId: aws.String(routerID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete RouteTable: %s, %v.", routerID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// IP 타입의 Next Hop은 지원하지 않음. (VM 또는 NAT Gateway를 사용)
+func (routerHandler *AwsRouterHandler) AddRoute(routerID string, routeInfo irs.RouteInfo) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s], routeInfo : [%v]", routerID, routeInfo)
+
+	input := &ec2.CreateRouteInput{
+		RouteTableId:         aws.String(routerID),
+		DestinationCidrBlock: aws.String(routeInfo.DestinationCIDR),
+	}
+	switch routeInfo.TargetType {
+	case irs.RouteTargetInternetGateway:
+		igwId := routeInfo.TargetId
+		if igwId == "" {
+			routeTable, err := routerHandler.describeRouteTable(routerID)
+			if err != nil {
+				return irs.RouterInfo{}, err
+			}
+			igwId, err = routerHandler.getInternetGatewayId(*routeTable.VpcId)
+			if err != nil {
+				return irs.RouterInfo{}, err
+			}
+		}
+		input.GatewayId = aws.String(igwId)
+	case irs.RouteTargetNATGateway:
+		input.NatGatewayId = aws.String(routeInfo.TargetId)
+	case irs.RouteTargetVM:
+		input.InstanceId = aws.String(routeInfo.TargetId)
+	default:
+		return irs.RouterInfo{}, errors.New("AWS는 " + string(routeInfo.TargetType) + " 타입의 라우팅 대상을 지원하지 않습니다.")
+	}
+
+	_, err := routerHandler.Client.CreateRoute(input)
+	if err != nil {
+		cblogger.Errorf("RouteTable[%s]에 라우팅(%s) 정보 추가 실패", routerID, routeInfo.DestinationCIDR)
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.GetRouter(routerID)
+}
+
+func (routerHandler *AwsRouterHandler) RemoveRoute(routerID string, destinationCIDR string) (bool, error) {
+	cblogger.Infof("routerID : [%s], destinationCIDR : [%s]", routerID, destinationCIDR)
+
+	_, err := routerHandler.Client.DeleteRoute(&ec2.DeleteRouteInput{
+		RouteTableId:         aws.String(routerID),
+		DestinationCidrBlock: aws.String(destinationCIDR),
+	})
+	if err != nil {
+		cblogger.Errorf("RouteTable[%s]에 대한 라우팅(%s) 정보 삭제 실패", routerID, destinationCIDR)
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// 서브넷은 하나의 라우팅 테이블에만 연결되므로 다른 라우팅 테이블에 연결되어 있으면 교체 함.
+func (routerHandler *AwsRouterHandler) AddInterface(routerID string, subnetID string) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s], subnetID : [%s]", routerID, subnetID)
+
+	associationId, err := routerHandler.getSubnetAssociationId(subnetID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+
+	if associationId != "" {
+		_, err = routerHandler.Client.ReplaceRouteTableAssociation(&ec2.ReplaceRouteTableAssociationInput{
+			AssociationId: aws.String(associationId),
+			RouteTableId:  aws.String(routerID),
+		})
+	} else {
+		_, err = routerHandler.Client.AssociateRouteTable(&ec2.AssociateRouteTableInput{
+			RouteTableId: aws.String(routerID),
+			SubnetId:     aws.String(subnetID),
+		})
+	}
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.GetRouter(routerID)
+}
+
+// 연결이 해제된 서브넷은 VPC의 Main 라우팅 테이블을 사용 함.
+func (routerHandler *AwsRouterHandler) DeleteInterface(routerID string, subnetID string) (bool, error) {
+	cblogger.Infof("routerID : [%s], subnetID : [%s]", routerID, subnetID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return false, err
+	}
+	for _, association := range routeTable.Associations {
+		if aws.StringValue(association.SubnetId) != subnetID {
+			continue
+		}
+		_, err := routerHandler.Client.DisassociateRouteTable(&ec2.DisassociateRouteTableInput{
+			AssociationId: association.RouteTableAssociationId,
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+		return true, nil
+	}
+	return false, errors.New("Subnet[" + subnetID + "]은 RouteTable[" + routerID + "]에 연결되어 있지 않습니다.")
+}
+
+// VPC에 IGW가 없으면 생성하여 연결한 후 0.0.0.0/0 라우팅 정보를 추가 함.
+func (routerHandler *AwsRouterHandler) AttachInternetGateway(routerID string) (irs.RouterInfo, error) {
+	cblogger.Infof("routerID : [%s]", routerID)
+
+	routeTable, err := routerHandler.describeRouteTable(routerID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	vpcId := *routeTable.VpcId
+
+	igwId, err := routerHandler.getInternetGatewayId(vpcId)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	if igwId == "" {
+		result, err := routerHandler.Client.CreateInternetGateway(&ec2.CreateInternetGatewayInput{})
+		if err != nil {
+			cblogger.Error(err)
+			return irs.RouterInfo{}, err
+		}
+		igwId = *result.InternetGateway.InternetGatewayId
+
+		_, err = routerHandler.Client.AttachInternetGateway(&ec2.AttachInternetGatewayInput{
+			InternetGatewayId: aws.String(igwId),
+			VpcId:             aws.String(vpcId),
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return irs.RouterInfo{}, err
+		}
+		cblogger.Infof("VPC[%s]에 IGW[%s] 연결 완료", vpcId, igwId)
+	}
+
+	return routerHandler.AddRoute(routerID, irs.RouteInfo{
+		DestinationCIDR: "0.0.0.0/0",
+		TargetType:      irs.RouteTargetInternetGateway,
+		TargetId:        igwId,
+	})
+}
+
+// 0.0.0.0/0 라우팅 정보만 제거 함. (IGW는 VPC 삭제 시 함께 삭제 됨)
+func (routerHandler *AwsRouterHandler) DetachInternetGateway(routerID string) (bool, error) {
+	return routerHandler.RemoveRoute(routerID, "0.0.0.0/0")
+}
+
+func (routerHandler *AwsRouterHandler) describeRouteTable(routerID string) (*ec2.RouteTable, error) {
+	result, err := routerHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		RouteTableIds: []*string{aws.String(routerID)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if len(result.RouteTables) < 1 {
+		return nil, errors.New("RouteTable[" + routerID + "] 정보를 찾을 수 없습니다.")
+	}
+	return result.RouteTables[0], nil
+}
+
+// VPC에 연결된 IGW의 ID를 찾음 (없으면 빈 문자열)
+func (routerHandler *AwsRouterHandler) getInternetGatewayId(vpcId string) (string, error) {
+	result, err := routerHandler.Client.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.vpc-id"),
+				Values: aws.StringSlice([]string{vpcId}),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if len(result.InternetGateways) < 1 {
+		return "", nil
+	}
+	return *result.InternetGateways[0].InternetGatewayId, nil
+}
+
+// 서브넷이 명시적으로 연결된 라우팅 테이블의 Association ID를 찾음 (없으면 빈 문자열)
+func (routerHandler *AwsRouterHandler) getSubnetAssociationId(subnetID string) (string, error) {
+	result, err := routerHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("association.subnet-id"),
+				Values: aws.StringSlice([]string{subnetID}),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	for _, routeTable := range result.RouteTables {
+		for _, association := range routeTable.Associations {
+			if aws.StringValue(association.SubnetId) == subnetID {
+				return aws.StringValue(association.RouteTableAssociationId), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+//RouteTable 정보를 추출함
+func ExtractRouterInfo(routeTable *ec2.RouteTable) irs.RouterInfo {
+	routerInfo := irs.RouterInfo{
+		Id:    aws.StringValue(routeTable.RouteTableId),
+		VPCId: aws.StringValue(routeTable.VpcId),
+	}
+
+	//Name은 Tag의 "Name" 속성에만 저장됨
+	for _, t := range routeTable.Tags {
+		if aws.StringValue(t.Key) == "Name" {
+			routerInfo.Name = aws.StringValue(t.Value)
+			break
+		}
+	}
+
+	for _, route := range routeTable.Routes {
+		routeInfo := irs.RouteInfo{
+			DestinationCIDR: aws.StringValue(route.DestinationCidrBlock),
+		}
+		gatewayId := aws.StringValue(route.GatewayId)
+		switch {
+		case gatewayId == "local":
+			routeInfo.TargetType = irs.RouteTargetLocal
+		case strings.HasPrefix(gatewayId, "igw-"):
+			routeInfo.TargetType = irs.RouteTargetInternetGateway
+			routeInfo.TargetId = gatewayId
+			if routeInfo.DestinationCIDR == "0.0.0.0/0" {
+				routerInfo.InternetGateway = true
+			}
+		case route.NatGatewayId != nil:
+			routeInfo.TargetType = irs.RouteTargetNATGateway
+			routeInfo.TargetId = *route.NatGatewayId
+		case route.InstanceId != nil:
+			routeInfo.TargetType = irs.RouteTargetVM
+			routeInfo.TargetId = *route.InstanceId
+		default:
+			// ex) Peering, Transit Gateway, VPC Endpoint
+			routeInfo.TargetType = irs.RouteTargetOther
+			routeInfo.TargetId = gatewayId + aws.StringValue(route.VpcPeeringConnectionId) + aws.StringValue(route.TransitGatewayId)
+		}
+		routerInfo.RouteList = append(routerInfo.RouteList, routeInfo)
+	}
+
+	isMain := false
+	for _, association := range routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			isMain = true
+		}
+		if association.SubnetId != nil {
+			routerInfo.SubnetIdList = append(routerInfo.SubnetIdList, *association.SubnetId)
+		}
+	}
+	routerInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Main", Value: strconv.FormatBool(isMain)},
+	}
+	return routerInfo
+}
@@ -14,6 +14,7 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -28,7 +29,6 @@ type AwsSecurityHandler struct {
 	Client *ec2.EC2
 }
 
-//@TODO : 존재하는 보안 그룹에 정책 추가하는 기능 필요
 //VPC 생략 시 활성화된 세션의 기본 VPC를 이용 함.
 func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
 	cblogger.Infof("securityReqInfo : ", securityReqInfo)
@@ -45,6 +45,12 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 	cblogger.Infof("==> [%s] CB Default VPC 정보 찾음", awsCBNetworkInfo.VpcId)
 	vpcId := awsCBNetworkInfo.VpcId
 
+	//사용자 Tag는 보안 그룹 생성 시 함께 설정 함.
+	tagSpecifications, err := getTagSpecifications(irs.RSTypeSecurityGroup, securityReqInfo.TagList)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	// Create the security group with the VPC, name and description.
 	//createRes, err := securityHandler.Client.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
 	input := ec2.CreateSecurityGroupInput{
@@ -52,6 +58,8 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 		Description: aws.String(securityReqInfo.Name),
 		//		VpcId:       aws.String(securityReqInfo.VpcId),awsCBNetworkInfo
 		VpcId: aws.String(vpcId),
+
+		TagSpecifications: tagSpecifications,
 	}
 	cblogger.Debugf("보안 그룹 생성 요청 정보", input)
 	createRes, err := securityHandler.Client.CreateSecurityGroup(&input)
@@ -74,124 +82,11 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 
 	//newGroupId = *createRes.GroupId
 
-	cblogger.Infof("인바운드 보안 정책 처리")
-	//Ingress 처리
-	var ipPermissions []*ec2.IpPermission
-	for _, ip := range *securityReqInfo.SecurityRules {
-		//for _, ip := range securityReqInfo.IPPermissions {
-		if ip.Direction != "inbound" {
-			cblogger.Debug("==> inbound가 아닌 보안 그룹 Skip : ", ip.Direction)
-			continue
-		}
-
-		ipPermission := new(ec2.IpPermission)
-		ipPermission.SetIpProtocol(ip.IPProtocol)
-
-		if ip.FromPort != "" {
-			if n, err := strconv.ParseInt(ip.FromPort, 10, 64); err == nil {
-				ipPermission.SetFromPort(n)
-			} else {
-				cblogger.Error(ip.FromPort, "은 숫자가 아님!!")
-				return irs.SecurityInfo{}, err
-			}
-		} else {
-			//ipPermission.SetFromPort(0)
-		}
-
-		if ip.ToPort != "" {
-			if n, err := strconv.ParseInt(ip.ToPort, 10, 64); err == nil {
-				ipPermission.SetToPort(n)
-			} else {
-				cblogger.Error(ip.ToPort, "은 숫자가 아님!!")
-				return irs.SecurityInfo{}, err
-			}
-		} else {
-			//ipPermission.SetToPort(0)
-		}
-
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				//SetCidrIp(ip.Cidr),
-				SetCidrIp("0.0.0.0/0"),
-		})
-		ipPermissions = append(ipPermissions, ipPermission)
-	}
-
-	//인바운드 정책이 있는 경우에만 처리
-	if len(ipPermissions) > 0 {
-		// Add permissions to the security group
-		_, err = securityHandler.Client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
-			//GroupName:     aws.String(securityReqInfo.Name),
-			GroupId:       createRes.GroupId,
-			IpPermissions: ipPermissions,
-		})
-		if err != nil {
-			cblogger.Errorf("Unable to set security group %q ingress, %v", securityReqInfo.Name, err)
-			return irs.SecurityInfo{}, err
-		}
-
-		cblogger.Info("Successfully set security group ingress")
-	}
-
-	cblogger.Infof("아웃바운드 보안 정책 처리")
-	//Egress 처리
-	var ipPermissionsEgress []*ec2.IpPermission
-	//for _, ip := range securityReqInfo.IPPermissionsEgress {
-	for _, ip := range *securityReqInfo.SecurityRules {
-		if ip.Direction != "outbound" {
-			cblogger.Debug("==> outbound가 아닌 보안 그룹 Skip : ", ip.Direction)
-			continue
-		}
-
-		ipPermission := new(ec2.IpPermission)
-		ipPermission.SetIpProtocol(ip.IPProtocol)
-		//ipPermission.SetFromPort(ip.FromPort)
-		//ipPermission.SetToPort(ip.ToPort)
-		if ip.FromPort != "" {
-			if n, err := strconv.ParseInt(ip.FromPort, 10, 64); err == nil {
-				ipPermission.SetFromPort(n)
-			} else {
-				cblogger.Error(ip.FromPort, "은 숫자가 아님!!")
-				return irs.SecurityInfo{}, err
-			}
-		} else {
-			//ipPermission.SetFromPort(0)
-		}
-
-		if ip.ToPort != "" {
-			if n, err := strconv.ParseInt(ip.ToPort, 10, 64); err == nil {
-				ipPermission.SetToPort(n)
-			} else {
-				cblogger.Error(ip.ToPort, "은 숫자가 아님!!")
-				return irs.SecurityInfo{}, err
-			}
-		} else {
-			//ipPermission.SetToPort(0)
-		}
-
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				//SetCidrIp(ip.Cidr),
-				SetCidrIp("0.0.0.0/0"),
-		})
-		//ipPermissions = append(ipPermissions, ipPermission)
-		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
-	}
-
-	//아웃바운드 정책이 있는 경우에만 처리
-	if len(ipPermissionsEgress) > 0 {
-
-		// Add permissions to the security group
-		_, err = securityHandler.Client.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
-			GroupId:       createRes.GroupId,
-			IpPermissions: ipPermissionsEgress,
-		})
-		if err != nil {
-			cblogger.Errorf("Unable to set security group %q egress, %v", securityReqInfo.Name, err)
-			return irs.SecurityInfo{}, err
-		}
-
-		cblogger.Info("Successfully set security group egress")
+	//인바운드/아웃바운드 보안 정책 처리
+	err = securityHandler.authorizeSecurityRules(*createRes.GroupId, securityReqInfo.SecurityRules)
+	if err != nil {
+		cblogger.Errorf("Unable to set security group %q rules, %v", securityReqInfo.Name, err)
+		return irs.SecurityInfo{}, err
 	}
 
 	cblogger.Info("Name Tag 처리")
@@ -349,8 +244,7 @@ func ExtractIpPermissionCommon(ip *ec2.IpPermission, securityRuleInfo *irs.Secur
 	}
 
 	if !reflect.ValueOf(ip.ToPort).IsNil() {
-		//securityRuleInfo.ToPort = *ip.ToPort
-		securityRuleInfo.FromPort = strconv.FormatInt(*ip.ToPort, 10)
+		securityRuleInfo.ToPort = strconv.FormatInt(*ip.ToPort, 10)
 	}
 
 	securityRuleInfo.IPProtocol = *ip.IpProtocol
@@ -365,8 +259,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		for _, ipv4 := range ip.IpRanges {
 			cblogger.Debug("Inbound/Outbound 정보 조회 : ", *ip.IpProtocol)
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				//Cidr: *ipv4.CidrIp,
+				Direction:   direction, // "inbound | outbound"
+				CIDR:        *ipv4.CidrIp,
+				Description: aws.StringValue(ipv4.Description),
 			}
 			cblogger.Debug(*ipv4.CidrIp)
 
@@ -377,8 +272,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		//ipv6 처리
 		for _, ipv6 := range ip.Ipv6Ranges {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				//Cidr: *ipv6.CidrIpv6,
+				Direction:   direction, // "inbound | outbound"
+				CIDR:        *ipv6.CidrIpv6,
+				Description: aws.StringValue(ipv6.Description),
 			}
 			cblogger.Debug(*ipv6.CidrIpv6)
 
@@ -389,8 +285,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		//ELB나 보안그룹 참조 방식 처리
 		for _, userIdGroup := range ip.UserIdGroupPairs {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				//Cidr: *userIdGroup.GroupId,
+				Direction:             direction, // "inbound | outbound"
+				SourceSecurityGroupId: *userIdGroup.GroupId,
+				Description:           aws.StringValue(userIdGroup.Description),
 			}
 			cblogger.Debug(*userIdGroup.UserId)
 
@@ -441,3 +338,158 @@ func (securityHandler *AwsSecurityHandler) DeleteSecurity(securityID string) (bo
 
 	return true, nil
 }
+
+//기존 보안 그룹에 인바운드/아웃바운드 보안 정책 추가
+func (securityHandler *AwsSecurityHandler) AddRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	cblogger.Infof("securityID : [%s]", securityID)
+	spew.Dump(securityRules)
+
+	err := securityHandler.authorizeSecurityRules(securityID, securityRules)
+	if err != nil {
+		cblogger.Errorf("Unable to add security group %q rules, %v", securityID, err)
+		return irs.SecurityInfo{}, err
+	}
+
+	return securityHandler.GetSecurity(securityID)
+}
+
+//기존 보안 그룹에서 인바운드/아웃바운드 보안 정책 삭제
+func (securityHandler *AwsSecurityHandler) RemoveRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	cblogger.Infof("securityID : [%s]", securityID)
+	spew.Dump(securityRules)
+
+	ipPermissions, err := convertIpPermissions(securityRules, "inbound")
+	if err != nil {
+		return false, err
+	}
+	if len(ipPermissions) > 0 {
+		_, err = securityHandler.Client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityID),
+			IpPermissions: ipPermissions,
+		})
+		if err != nil {
+			cblogger.Errorf("Unable to revoke security group %q ingress, %v", securityID, err)
+			return false, err
+		}
+	}
+
+	ipPermissionsEgress, err := convertIpPermissions(securityRules, "outbound")
+	if err != nil {
+		return false, err
+	}
+	if len(ipPermissionsEgress) > 0 {
+		_, err = securityHandler.Client.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityID),
+			IpPermissions: ipPermissionsEgress,
+		})
+		if err != nil {
+			cblogger.Errorf("Unable to revoke security group %q egress, %v", securityID, err)
+			return false, err
+		}
+	}
+
+	cblogger.Infof("Successfully removed security group %q rules.", securityID)
+	return true, nil
+}
+
+//인바운드/아웃바운드 정책이 있는 경우에만 처리
+func (securityHandler *AwsSecurityHandler) authorizeSecurityRules(securityID string, securityRules *[]irs.SecurityRuleInfo) error {
+	cblogger.Infof("인바운드 보안 정책 처리")
+	ipPermissions, err := convertIpPermissions(securityRules, "inbound")
+	if err != nil {
+		return err
+	}
+	if len(ipPermissions) > 0 {
+		_, err = securityHandler.Client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityID),
+			IpPermissions: ipPermissions,
+		})
+		if err != nil {
+			return err
+		}
+		cblogger.Info("Successfully set security group ingress")
+	}
+
+	cblogger.Infof("아웃바운드 보안 정책 처리")
+	ipPermissionsEgress, err := convertIpPermissions(securityRules, "outbound")
+	if err != nil {
+		return err
+	}
+	if len(ipPermissionsEgress) > 0 {
+		_, err = securityHandler.Client.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityID),
+			IpPermissions: ipPermissionsEgress,
+		})
+		if err != nil {
+			return err
+		}
+		cblogger.Info("Successfully set security group egress")
+	}
+	return nil
+}
+
+//보안 정책 중 direction(inbound | outbound)에 해당하는 정책만 IpPermission으로 변환
+//SourceSecurityGroupId가 있으면 CIDR 대신 보안 그룹 참조 방식으로 처리하며, CIDR이 없으면 0.0.0.0/0으로 처리
+func convertIpPermissions(securityRules *[]irs.SecurityRuleInfo, direction string) ([]*ec2.IpPermission, error) {
+	var ipPermissions []*ec2.IpPermission
+	if securityRules == nil {
+		return ipPermissions, nil
+	}
+
+	for _, ip := range *securityRules {
+		if ip.Direction != direction {
+			cblogger.Debug("==> "+direction+"가 아닌 보안 정책 Skip : ", ip.Direction)
+			continue
+		}
+
+		ipPermission := new(ec2.IpPermission)
+		ipPermission.SetIpProtocol(ip.IPProtocol)
+
+		if ip.FromPort != "" {
+			n, err := strconv.ParseInt(ip.FromPort, 10, 64)
+			if err != nil {
+				cblogger.Error(ip.FromPort, "은 숫자가 아님!!")
+				return nil, err
+			}
+			ipPermission.SetFromPort(n)
+		}
+
+		if ip.ToPort != "" {
+			n, err := strconv.ParseInt(ip.ToPort, 10, 64)
+			if err != nil {
+				cblogger.Error(ip.ToPort, "은 숫자가 아님!!")
+				return nil, err
+			}
+			ipPermission.SetToPort(n)
+		}
+
+		if ip.SourceSecurityGroupId != "" {
+			userIdGroupPair := (&ec2.UserIdGroupPair{}).SetGroupId(ip.SourceSecurityGroupId)
+			if ip.Description != "" {
+				userIdGroupPair.SetDescription(ip.Description)
+			}
+			ipPermission.SetUserIdGroupPairs([]*ec2.UserIdGroupPair{userIdGroupPair})
+		} else {
+			cidr := ip.CIDR
+			if cidr == "" {
+				cidr = "0.0.0.0/0"
+			}
+
+			if strings.Contains(cidr, ":") {
+				ipv6Range := (&ec2.Ipv6Range{}).SetCidrIpv6(cidr)
+				if ip.Description != "" {
+					ipv6Range.SetDescription(ip.Description)
+				}
+				ipPermission.SetIpv6Ranges([]*ec2.Ipv6Range{ipv6Range})
+			} else {
+				ipRange := (&ec2.IpRange{}).SetCidrIp(cidr)
+				if ip.Description != "" {
+					ipRange.SetDescription(ip.Description)
+				}
+				ipPermission.SetIpRanges([]*ec2.IpRange{ipRange})
+			}
+		}
+		ipPermissions = append(ipPermissions, ipPermission)
+	}
+	return ipPermissions, nil
+}
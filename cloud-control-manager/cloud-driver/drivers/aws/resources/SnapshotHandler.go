@@ -0,0 +1,241 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AwsSnapshotHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+// VM 스냅샷은 VM의 루트 볼륨 스냅샷으로 생성하며, 원본 VM ID는 태그로 보관 함.
+func (snapshotHandler *AwsSnapshotHandler) CreateSnapshot(snapshotReqInfo irs.SnapshotReqInfo) (irs.SnapshotInfo, error) {
+	cblogger.Info("Start : ", snapshotReqInfo)
+
+	volumeID, err := snapshotHandler.getSourceVolumeID(snapshotReqInfo)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+
+	tags := []*ec2.Tag{
+		{Key: aws.String("Name"), Value: aws.String(snapshotReqInfo.Name)},
+	}
+	if snapshotReqInfo.SourceType == irs.SnapshotSourceVM {
+		tags = append(tags, &ec2.Tag{Key: aws.String(irs.SnapshotSourceVMKey), Value: aws.String(snapshotReqInfo.SourceId)})
+	}
+
+	snapshot, err := snapshotHandler.Client.CreateSnapshot(&ec2.CreateSnapshotInput{
+		VolumeId: aws.String(volumeID),
+		TagSpecifications: []*ec2.TagSpecification{
+			{ResourceType: aws.String(ec2.ResourceTypeSnapshot), Tags: tags},
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create Snapshot: %s, %v.", snapshotReqInfo.Name, err)
+		return irs.SnapshotInfo{}, err
+	}
+	cblogger.Infof("스냅샷 생성 요청 성공 - Snapshot Id : [%s]", *snapshot.SnapshotId)
+
+	err = snapshotHandler.Client.WaitUntilSnapshotCompleted(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{snapshot.SnapshotId},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+
+	return snapshotHandler.GetSnapshot(*snapshot.SnapshotId)
+}
+
+// 계정이 소유한 스냅샷만 조회 함.
+func (snapshotHandler *AwsSnapshotHandler) ListSnapshot() ([]*irs.SnapshotInfo, error) {
+	cblogger.Debug("Start")
+
+	var snapshotInfoList []*irs.SnapshotInfo
+	input := &ec2.DescribeSnapshotsInput{
+		OwnerIds: aws.StringSlice([]string{"self"}),
+	}
+	err := snapshotHandler.Client.DescribeSnapshotsPages(input, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.Snapshots {
+			snapshotInfo := ExtractSnapshotInfo(snapshot)
+			snapshotInfoList = append(snapshotInfoList, &snapshotInfo)
+		}
+		return true
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return snapshotInfoList, nil
+}
+
+func (snapshotHandler *AwsSnapshotHandler) GetSnapshot(snapshotID string) (irs.SnapshotInfo, error) {
+	cblogger.Infof("snapshotID : [%s]", snapshotID)
+
+	result, err := snapshotHandler.Client.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{aws.String(snapshotID)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+	if len(result.Snapshots) < 1 {
+		return irs.SnapshotInfo{}, errors.New("Snapshot[" + snapshotID + "] 정보를 찾을 수 없습니다.")
+	}
+	return ExtractSnapshotInfo(result.Snapshots[0]), nil
+}
+
+func (snapshotHandler *AwsSnapshotHandler) DeleteSnapshot(snapshotID string) (bool, error) {
+	cblogger.Infof("snapshotID : [%s]", snapshotID)
+
+	_, err := snapshotHandler.Client.DeleteSnapshot(&ec2.DeleteSnapshotInput{
+		SnapshotId: aws.String(snapshotID),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (snapshotHandler *AwsSnapshotHandler) CreateDiskFromSnapshot(snapshotID string, diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	cblogger.Infof("snapshotID : [%s], diskReqInfo : [%v]", snapshotID, diskReqInfo)
+
+	diskHandler := AwsDiskHandler{snapshotHandler.Region, snapshotHandler.Client}
+	zone, err := diskHandler.getZone(diskReqInfo.Zone)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	//사용자 Tag는 Name Tag와 함께 볼륨 생성 시 설정 함.
+	userTags, err := getUserTags(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	input := &ec2.CreateVolumeInput{
+		AvailabilityZone: aws.String(zone),
+		SnapshotId:       aws.String(snapshotID),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeVolume),
+				Tags: append([]*ec2.Tag{
+					{Key: aws.String("Name"), Value: aws.String(diskReqInfo.Name)},
+				}, userTags...),
+			},
+		},
+	}
+	if diskReqInfo.DiskType != "" {
+		input.VolumeType = aws.String(diskReqInfo.DiskType)
+	}
+	if diskReqInfo.DiskSize != "" {
+		size, err := strconv.ParseInt(diskReqInfo.DiskSize, 10, 64)
+		if err != nil {
+			return irs.DiskInfo{}, errors.New("DiskSize[" + diskReqInfo.DiskSize + "] 값이 올바르지 않습니다.")
+		}
+		input.Size = aws.Int64(size)
+	}
+
+	result, err := snapshotHandler.Client.CreateVolume(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create Disk: %s, %v.", diskReqInfo.Name, err)
+		return irs.DiskInfo{}, err
+	}
+
+	err = snapshotHandler.Client.WaitUntilVolumeAvailable(&ec2.DescribeVolumesInput{
+		VolumeIds: []*string{result.VolumeId},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.GetDisk(*result.VolumeId)
+}
+
+// 스냅샷 대상 볼륨 ID (VM인 경우 루트 디바이스의 EBS 볼륨)
+func (snapshotHandler *AwsSnapshotHandler) getSourceVolumeID(snapshotReqInfo irs.SnapshotReqInfo) (string, error) {
+	switch snapshotReqInfo.SourceType {
+	case irs.SnapshotSourceDisk, "":
+		return snapshotReqInfo.SourceId, nil
+	case irs.SnapshotSourceVM:
+	default:
+		return "", errors.New("SourceType[" + string(snapshotReqInfo.SourceType) + "] 값이 올바르지 않습니다.")
+	}
+
+	vmID := snapshotReqInfo.SourceId
+	result, err := snapshotHandler.Client.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(vmID)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(result.Reservations) < 1 || len(result.Reservations[0].Instances) < 1 {
+		return "", errors.New("VM[" + vmID + "] 정보를 찾을 수 없습니다.")
+	}
+
+	instance := result.Reservations[0].Instances[0]
+	for _, mapping := range instance.BlockDeviceMappings {
+		if aws.StringValue(mapping.DeviceName) == aws.StringValue(instance.RootDeviceName) && mapping.Ebs != nil {
+			return aws.StringValue(mapping.Ebs.VolumeId), nil
+		}
+	}
+	return "", errors.New("VM[" + vmID + "]의 루트 볼륨을 찾을 수 없습니다.")
+}
+
+// Snapshot에서 SnapshotInfo 정보를 추출함
+func ExtractSnapshotInfo(snapshot *ec2.Snapshot) irs.SnapshotInfo {
+	snapshotInfo := irs.SnapshotInfo{
+		Id:       aws.StringValue(snapshot.SnapshotId),
+		DiskSize: strconv.FormatInt(aws.Int64Value(snapshot.VolumeSize), 10),
+	}
+
+	// State : pending | completed | error
+	switch aws.StringValue(snapshot.State) {
+	case ec2.SnapshotStatePending:
+		snapshotInfo.Status = irs.SnapshotCreating
+	case ec2.SnapshotStateCompleted:
+		snapshotInfo.Status = irs.SnapshotAvailable
+	default:
+		snapshotInfo.Status = irs.SnapshotError
+	}
+
+	keyValueList := []irs.KeyValue{
+		{Key: irs.SnapshotSourceDiskKey, Value: aws.StringValue(snapshot.VolumeId)},
+	}
+	for _, tag := range snapshot.Tags {
+		switch aws.StringValue(tag.Key) {
+		case "Name":
+			snapshotInfo.Name = aws.StringValue(tag.Value)
+		case irs.SnapshotSourceVMKey:
+			keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotSourceVMKey, Value: aws.StringValue(tag.Value)})
+		}
+	}
+	keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotCreatedTimeKey, Value: aws.TimeValue(snapshot.StartTime).Format(time.RFC3339)})
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "State", Value: aws.StringValue(snapshot.State)})
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "Progress", Value: aws.StringValue(snapshot.Progress)})
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "Encrypted", Value: strconv.FormatBool(aws.BoolValue(snapshot.Encrypted))})
+	snapshotInfo.KeyValueList = keyValueList
+
+	return snapshotInfo
+}
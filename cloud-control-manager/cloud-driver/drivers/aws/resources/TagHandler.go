@@ -0,0 +1,272 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//TagHandler는 EC2 리소스(Instance, VPC, Subnet, Security Group, Volume, Elastic IP)의 사용자 Tag를 처리하는 핸들러임.
+package resources
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+//드라이버가 리소스 이름으로 사용하는 Tag(SetNameTag)로 사용자 Tag에서 제외 함.
+const CBNameTagKey string = "Name"
+
+type AwsTagHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+//DescribeTags의 resource-type 필터 값
+var awsTagResourceTypes = map[irs.RSType]string{
+	irs.RSTypeVM:            "instance",
+	irs.RSTypeVPC:           "vpc",
+	irs.RSTypeVNetwork:      "subnet",
+	irs.RSTypeSecurityGroup: "security-group",
+	irs.RSTypeDisk:          "volume",
+	irs.RSTypePublicIP:      "elastic-ip",
+}
+
+func getAwsTagResourceType(resType irs.RSType) (string, error) {
+	awsResType, ok := awsTagResourceTypes[resType]
+	if !ok {
+		return "", errors.New("지원하지 않는 리소스 타입[" + string(resType) + "] 입니다.")
+	}
+	return awsResType, nil
+}
+
+//Name Tag는 드라이버가 관리하므로 사용자 Tag로 변경할 수 없음.
+func checkUserTagKey(key string) error {
+	if key == "" {
+		return errors.New("Tag Key가 없습니다.")
+	}
+	if key == CBNameTagKey {
+		return errors.New("Name Tag는 드라이버에서 관리하므로 변경할 수 없습니다.")
+	}
+	return nil
+}
+
+//리소스 생성 요청의 사용자 Tag(TagList)를 EC2 Tag로 변환 함.
+func getUserTags(tagList []irs.KeyValue) ([]*ec2.Tag, error) {
+	var tags []*ec2.Tag
+	for _, tag := range tagList {
+		if err := checkUserTagKey(tag.Key); err != nil {
+			return nil, err
+		}
+		tags = append(tags, &ec2.Tag{
+			Key:   aws.String(tag.Key),
+			Value: aws.String(tag.Value),
+		})
+	}
+	return tags, nil
+}
+
+//리소스 생성 요청(RunInstances, CreateSubnet 등)에 사용자 Tag를 함께 전달하기 위한 TagSpecification (사용자 Tag가 없으면 nil)
+func getTagSpecifications(resType irs.RSType, tagList []irs.KeyValue) ([]*ec2.TagSpecification, error) {
+	tags, err := getUserTags(tagList)
+	if err != nil || len(tags) == 0 {
+		return nil, err
+	}
+	awsResType, err := getAwsTagResourceType(resType)
+	if err != nil {
+		return nil, err
+	}
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(awsResType),
+			Tags:         tags,
+		},
+	}, nil
+}
+
+//keyword가 Tag의 Key 또는 Value에 포함되면 true (""과 "*"은 모든 Tag)
+func matchTagKeyword(tag irs.KeyValue, keyword string) bool {
+	if keyword == "" || keyword == "*" {
+		return true
+	}
+	return strings.Contains(tag.Key, keyword) || strings.Contains(tag.Value, keyword)
+}
+
+func (tagHandler *AwsTagHandler) describeTags(filters []*ec2.Filter) ([]*ec2.TagDescription, error) {
+	var tagList []*ec2.TagDescription
+	err := tagHandler.Client.DescribeTagsPages(&ec2.DescribeTagsInput{Filters: filters},
+		func(page *ec2.DescribeTagsOutput, lastPage bool) bool {
+			tagList = append(tagList, page.Tags...)
+			return !lastPage
+		})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return tagList, nil
+}
+
+func (tagHandler *AwsTagHandler) listResourceTags(resType irs.RSType, resId string, key string) ([]irs.KeyValue, error) {
+	awsResType, err := getAwsTagResourceType(resType)
+	if err != nil {
+		return nil, err
+	}
+	if resId == "" {
+		return nil, errors.New("리소스 ID가 없습니다.")
+	}
+
+	filters := []*ec2.Filter{
+		{Name: aws.String("resource-type"), Values: aws.StringSlice([]string{awsResType})},
+		{Name: aws.String("resource-id"), Values: aws.StringSlice([]string{resId})},
+	}
+	if key != "" {
+		filters = append(filters, &ec2.Filter{Name: aws.String("key"), Values: aws.StringSlice([]string{key})})
+	}
+
+	tagDescList, err := tagHandler.describeTags(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	tagList := []irs.KeyValue{}
+	for _, tagDesc := range tagDescList {
+		if aws.StringValue(tagDesc.Key) == CBNameTagKey {
+			continue
+		}
+		tagList = append(tagList, irs.KeyValue{Key: aws.StringValue(tagDesc.Key), Value: aws.StringValue(tagDesc.Value)})
+	}
+	return tagList, nil
+}
+
+//같은 Key의 Tag가 있으면 Value를 덮어 씀.
+func (tagHandler *AwsTagHandler) AddTag(resType irs.RSType, resId string, tag irs.KeyValue) (irs.KeyValue, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s] / tag : [%v]", resType, resId, tag)
+
+	if _, err := getAwsTagResourceType(resType); err != nil {
+		return irs.KeyValue{}, err
+	}
+	if err := checkUserTagKey(tag.Key); err != nil {
+		return irs.KeyValue{}, err
+	}
+
+	_, err := tagHandler.Client.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String(resId)},
+		Tags: []*ec2.Tag{
+			{
+				Key:   aws.String(tag.Key),
+				Value: aws.String(tag.Value),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.KeyValue{}, err
+	}
+
+	return tag, nil
+}
+
+func (tagHandler *AwsTagHandler) ListTag(resType irs.RSType, resId string) ([]irs.KeyValue, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s]", resType, resId)
+
+	return tagHandler.listResourceTags(resType, resId, "")
+}
+
+func (tagHandler *AwsTagHandler) GetTag(resType irs.RSType, resId string, key string) (irs.KeyValue, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s] / key : [%s]", resType, resId, key)
+
+	if key == "" {
+		return irs.KeyValue{}, errors.New("Tag Key가 없습니다.")
+	}
+
+	tagList, err := tagHandler.listResourceTags(resType, resId, key)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+	if len(tagList) == 0 {
+		return irs.KeyValue{}, errors.New("리소스[" + resId + "]에 Tag[" + key + "]가 없습니다.")
+	}
+
+	return tagList[0], nil
+}
+
+func (tagHandler *AwsTagHandler) RemoveTag(resType irs.RSType, resId string, key string) (bool, error) {
+	cblogger.Infof("resType : [%s] / resId : [%s] / key : [%s]", resType, resId, key)
+
+	if err := checkUserTagKey(key); err != nil {
+		return false, err
+	}
+
+	_, err := tagHandler.GetTag(resType, resId, key)
+	if err != nil {
+		return false, err
+	}
+
+	//Value를 지정하지 않으면 Key에 해당하는 Tag를 삭제 함.
+	_, err = tagHandler.Client.DeleteTags(&ec2.DeleteTagsInput{
+		Resources: []*string{aws.String(resId)},
+		Tags:      []*ec2.Tag{{Key: aws.String(key)}},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+//keyword가 Key 또는 Value에 포함된 Tag를 가진 리소스 목록을 리턴 함. (리소스의 모든 사용자 Tag를 함께 리턴)
+func (tagHandler *AwsTagHandler) FindTaggedResources(resType irs.RSType, keyword string) ([]*irs.TaggedResourceInfo, error) {
+	cblogger.Infof("resType : [%s] / keyword : [%s]", resType, keyword)
+
+	awsResType, err := getAwsTagResourceType(resType)
+	if err != nil {
+		return nil, err
+	}
+
+	tagDescList, err := tagHandler.describeTags([]*ec2.Filter{
+		{Name: aws.String("resource-type"), Values: aws.StringSlice([]string{awsResType})},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resInfoList []*irs.TaggedResourceInfo
+	resInfoMap := map[string]*irs.TaggedResourceInfo{}
+	matchedMap := map[string]bool{}
+	for _, tagDesc := range tagDescList {
+		if aws.StringValue(tagDesc.Key) == CBNameTagKey {
+			continue
+		}
+
+		resId := aws.StringValue(tagDesc.ResourceId)
+		resInfo, exist := resInfoMap[resId]
+		if !exist {
+			resInfo = &irs.TaggedResourceInfo{ResType: resType, ResId: resId}
+			resInfoMap[resId] = resInfo
+			resInfoList = append(resInfoList, resInfo)
+		}
+
+		tag := irs.KeyValue{Key: aws.StringValue(tagDesc.Key), Value: aws.StringValue(tagDesc.Value)}
+		resInfo.TagList = append(resInfo.TagList, tag)
+		if matchTagKeyword(tag, keyword) {
+			matchedMap[resId] = true
+		}
+	}
+
+	taggedResList := []*irs.TaggedResourceInfo{}
+	for _, resInfo := range resInfoList {
+		if matchedMap[resInfo.ResId] {
+			taggedResList = append(taggedResList, resInfo)
+		}
+	}
+
+	return taggedResList, nil
+}
@@ -76,6 +76,12 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	subnetID := vmReqInfo.VirtualNetworkId // "subnet-cf9ccf83" - 미지정시 기본 VPC의 기본 서브넷이 임의로 이용되며 PublicIP가 할당 됨.
 	baseName := vmReqInfo.VMName           //"mcloud-barista-VMHandlerTest"
 
+	//사용자 Tag는 인스턴스 생성 시 함께 설정 함.
+	tagSpecifications, err := getTagSpecifications(irs.RSTypeVM, vmReqInfo.TagList)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
 	cblogger.Info("Create EC2 Instance")
 
 	input := &ec2.RunInstancesInput{
@@ -93,6 +99,7 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 
 		SubnetId: aws.String(subnetID), // set a subnet.
 
+		TagSpecifications: tagSpecifications,
 	}
 	cblogger.Info(input)
 
@@ -0,0 +1,152 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AwsVMSpecHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+func (vmSpecHandler *AwsVMSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	cblogger.Debug("Start")
+
+	instanceTypeList, err := vmSpecHandler.describeInstanceTypes(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var vmSpecInfoList []*irs.VMSpecInfo
+	for _, instanceType := range instanceTypeList {
+		vmSpecInfo := vmSpecHandler.ExtractVMSpecInfo(instanceType)
+		vmSpecInfoList = append(vmSpecInfoList, &vmSpecInfo)
+	}
+	return vmSpecInfoList, nil
+}
+
+func (vmSpecHandler *AwsVMSpecHandler) GetVMSpec(name string) (irs.VMSpecInfo, error) {
+	cblogger.Infof("Name : [%s]", name)
+
+	instanceTypeList, err := vmSpecHandler.describeInstanceTypes([]*string{aws.String(name)})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMSpecInfo{}, err
+	}
+	if len(instanceTypeList) < 1 {
+		return irs.VMSpecInfo{}, errors.New("VMSpec[" + name + "] 정보를 찾을 수 없습니다.")
+	}
+
+	return vmSpecHandler.ExtractVMSpecInfo(instanceTypeList[0]), nil
+}
+
+// AWS의 InstanceTypeInfo 목록을 JSON으로 리턴 함.
+func (vmSpecHandler *AwsVMSpecHandler) ListOrgVMSpec() (string, error) {
+	cblogger.Debug("Start")
+
+	instanceTypeList, err := vmSpecHandler.describeInstanceTypes(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	jsonBytes, err := json.Marshal(instanceTypeList)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// 리전에서 제공하는 Instance Type을 조회 함. (instanceTypes가 nil이면 전체 조회)
+func (vmSpecHandler *AwsVMSpecHandler) describeInstanceTypes(instanceTypes []*string) ([]*ec2.InstanceTypeInfo, error) {
+	input := &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: instanceTypes,
+	}
+
+	var instanceTypeList []*ec2.InstanceTypeInfo
+	err := vmSpecHandler.Client.DescribeInstanceTypesPages(input, func(page *ec2.DescribeInstanceTypesOutput, lastPage bool) bool {
+		instanceTypeList = append(instanceTypeList, page.InstanceTypes...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instanceTypeList, nil
+}
+
+// InstanceTypeInfo에서 VMSpecInfo 정보를 추출함
+func (vmSpecHandler *AwsVMSpecHandler) ExtractVMSpecInfo(instanceType *ec2.InstanceTypeInfo) irs.VMSpecInfo {
+	vmSpecInfo := irs.VMSpecInfo{
+		Region: vmSpecHandler.Region.Region,
+		Name:   aws.StringValue(instanceType.InstanceType),
+		Disk:   "0",
+	}
+
+	if instanceType.VCpuInfo != nil {
+		vmSpecInfo.VCpu.Count = strconv.FormatInt(aws.Int64Value(instanceType.VCpuInfo.DefaultVCpus), 10)
+	}
+	if instanceType.ProcessorInfo != nil && instanceType.ProcessorInfo.SustainedClockSpeedInGhz != nil {
+		vmSpecInfo.VCpu.Clock = strconv.FormatFloat(*instanceType.ProcessorInfo.SustainedClockSpeedInGhz, 'f', -1, 64)
+	}
+	if instanceType.MemoryInfo != nil {
+		vmSpecInfo.Mem = strconv.FormatInt(aws.Int64Value(instanceType.MemoryInfo.SizeInMiB), 10)
+	}
+	if instanceType.GpuInfo != nil {
+		for _, gpu := range instanceType.GpuInfo.Gpus {
+			gpuInfo := irs.GpuInfo{
+				Count: strconv.FormatInt(aws.Int64Value(gpu.Count), 10),
+				Mfr:   aws.StringValue(gpu.Manufacturer),
+				Model: aws.StringValue(gpu.Name),
+			}
+			if gpu.MemoryInfo != nil {
+				gpuInfo.Mem = strconv.FormatInt(aws.Int64Value(gpu.MemoryInfo.SizeInMiB), 10)
+			}
+			vmSpecInfo.Gpu = append(vmSpecInfo.Gpu, gpuInfo)
+		}
+	}
+	if instanceType.InstanceStorageInfo != nil {
+		vmSpecInfo.Disk = strconv.FormatInt(aws.Int64Value(instanceType.InstanceStorageInfo.TotalSizeInGB), 10)
+	}
+	if instanceType.NetworkInfo != nil {
+		vmSpecInfo.Network = aws.StringValue(instanceType.NetworkInfo.NetworkPerformance)
+	}
+
+	keyValueList := []irs.KeyValue{
+		{Key: "CurrentGeneration", Value: strconv.FormatBool(aws.BoolValue(instanceType.CurrentGeneration))},
+		{Key: "FreeTierEligible", Value: strconv.FormatBool(aws.BoolValue(instanceType.FreeTierEligible))},
+		{Key: "BurstablePerformanceSupported", Value: strconv.FormatBool(aws.BoolValue(instanceType.BurstablePerformanceSupported))},
+		{Key: "SupportedRootDeviceTypes", Value: strings.Join(aws.StringValueSlice(instanceType.SupportedRootDeviceTypes), ",")},
+	}
+	if instanceType.ProcessorInfo != nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "SupportedArchitectures", Value: strings.Join(aws.StringValueSlice(instanceType.ProcessorInfo.SupportedArchitectures), ",")})
+	}
+	if instanceType.Hypervisor != nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "Hypervisor", Value: *instanceType.Hypervisor})
+	}
+	if instanceType.NetworkInfo != nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "MaximumNetworkInterfaces", Value: strconv.FormatInt(aws.Int64Value(instanceType.NetworkInfo.MaximumNetworkInterfaces), 10)})
+	}
+	vmSpecInfo.KeyValueList = keyValueList
+
+	return vmSpecInfo
+}
@@ -41,6 +41,7 @@ type AwsVNetworkHandler struct {
 type AwsVpcReqInfo struct {
 	Name      string
 	CidrBlock string // AWS
+	TagList   []irs.KeyValue
 }
 
 type AwsVpcInfo struct {
@@ -207,8 +208,15 @@ func (vNetworkHandler *AwsVNetworkHandler) GetVpc(vpcName string) (AwsVpcInfo, e
 func (vNetworkHandler *AwsVNetworkHandler) CreateVpc(awsVpcReqInfo AwsVpcReqInfo) (AwsVpcInfo, error) {
 	cblogger.Info(awsVpcReqInfo)
 
+	//사용자 Tag는 VPC 생성 시 함께 설정 함.
+	tagSpecifications, err := getTagSpecifications(irs.RSTypeVPC, awsVpcReqInfo.TagList)
+	if err != nil {
+		return AwsVpcInfo{}, err
+	}
+
 	input := &ec2.CreateVpcInput{
-		CidrBlock: aws.String(awsVpcReqInfo.CidrBlock),
+		CidrBlock:         aws.String(awsVpcReqInfo.CidrBlock),
+		TagSpecifications: tagSpecifications,
 	}
 
 	spew.Dump(input)
@@ -327,10 +335,27 @@ func (vNetworkHandler *AwsVNetworkHandler) CreateVpc(awsVpcReqInfo AwsVpcReqInfo
 func (vNetworkHandler *AwsVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VNetworkReqInfo) (irs.VNetworkInfo, error) {
 	cblogger.Info(vNetworkReqInfo)
 
+	//사용자 Tag는 서브넷 생성 시 함께 설정 함.
+	tagSpecifications, err := getTagSpecifications(irs.RSTypeVNetwork, vNetworkReqInfo.TagList)
+	if err != nil {
+		return irs.VNetworkInfo{}, err
+	}
+
 	vpcList, _ := vNetworkHandler.ListVNetwork()
 	if len(vpcList) > 0 {
 		cblogger.Error("이미 Default Subnet이 존재하기 때문에 생성하지 않고 기존 정보를 리턴함.")
 		cblogger.Info(vpcList)
+		//기존 서브넷을 리턴하므로 사용자 Tag는 기존 서브넷에 설정 함.
+		if len(tagSpecifications) > 0 {
+			_, errTag := vNetworkHandler.Client.CreateTags(&ec2.CreateTagsInput{
+				Resources: []*string{aws.String(vpcList[0].Id)},
+				Tags:      tagSpecifications[0].Tags,
+			})
+			if errTag != nil {
+				cblogger.Error(errTag)
+				return irs.VNetworkInfo{}, errTag
+			}
+		}
 		return *vpcList[0], nil
 	}
 
@@ -347,6 +372,8 @@ func (vNetworkHandler *AwsVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VN
 		//CidrBlock: aws.String(vNetworkReqInfo.CidrBlock),
 		CidrBlock: aws.String(GetCBDefaultCidrBlock()), // VPC와 동일한 대역의 CB-Default Subnet을 생성 함.
 		VpcId:     aws.String(vpcId),
+
+		TagSpecifications: tagSpecifications,
 	}
 
 	cblogger.Info(input)
@@ -0,0 +1,239 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+//VPCHandler는 사용자가 지정한 CIDR로 VPC와 서브넷을 처리하는 핸들러임.
+//CB-Default VPC를 숨겨서 처리하는 VNetworkHandler와 달리 VPC를 직접 노출 함.
+package resources
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AwsVPCHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+// VPC를 생성하고 요청한 서브넷들을 생성 함.
+// PublicIP를 사용할 수 있도록 VNetworkHandler와 동일하게 IGW를 생성하여 연결 함.
+func (vpcHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCInfo, error) {
+	cblogger.Info("Start : ", vpcReqInfo)
+
+	if vpcReqInfo.CIDR == "" {
+		return irs.VPCInfo{}, errors.New("VPC의 CIDR 정보가 필요합니다.")
+	}
+
+	vNetworkHandler := AwsVNetworkHandler{vpcHandler.Region, vpcHandler.Client}
+	awsVpcInfo, err := vNetworkHandler.CreateVpc(AwsVpcReqInfo{
+		Name:      vpcReqInfo.Name,
+		CidrBlock: vpcReqInfo.CIDR,
+		TagList:   vpcReqInfo.TagList,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to create VPC: %s, %v.", vpcReqInfo.Name, err)
+		return irs.VPCInfo{}, err
+	}
+	cblogger.Infof("VPC 생성 완료 - VPC Id : [%s]", awsVpcInfo.Id)
+
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		if _, err := vpcHandler.createSubnet(awsVpcInfo.Id, subnetInfo); err != nil {
+			//서브넷 생성 실패 시 생성한 VPC 삭제
+			if _, errDel := vpcHandler.DeleteVPC(awsVpcInfo.Id); errDel != nil {
+				cblogger.Errorf("Unable to delete VPC: %s, %v.", awsVpcInfo.Id, errDel)
+			}
+			return irs.VPCInfo{}, err
+		}
+	}
+
+	return vpcHandler.GetVPC(awsVpcInfo.Id)
+}
+
+func (vpcHandler *AwsVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
+	cblogger.Debug("Start")
+
+	result, err := vpcHandler.Client.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var vpcInfoList []*irs.VPCInfo
+	for _, curVpc := range result.Vpcs {
+		vpcInfo, err := vpcHandler.extractVPCInfo(curVpc)
+		if err != nil {
+			return nil, err
+		}
+		vpcInfoList = append(vpcInfoList, &vpcInfo)
+	}
+	return vpcInfoList, nil
+}
+
+func (vpcHandler *AwsVPCHandler) GetVPC(vpcID string) (irs.VPCInfo, error) {
+	cblogger.Infof("vpcID : [%s]", vpcID)
+
+	result, err := vpcHandler.Client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: []*string{aws.String(vpcID)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	if len(result.Vpcs) < 1 {
+		return irs.VPCInfo{}, errors.New("VPC[" + vpcID + "] 정보를 찾을 수 없습니다.")
+	}
+
+	return vpcHandler.extractVPCInfo(result.Vpcs[0])
+}
+
+// VPC의 서브넷들을 먼저 삭제한 후 IGW와 VPC를 삭제 함.
+func (vpcHandler *AwsVPCHandler) DeleteVPC(vpcID string) (bool, error) {
+	cblogger.Infof("vpcID : [%s]", vpcID)
+
+	subnetList, err := vpcHandler.describeSubnets(vpcID)
+	if err != nil {
+		return false, err
+	}
+	for _, subnet := range subnetList {
+		if _, err := vpcHandler.RemoveSubnet(vpcID, *subnet.SubnetId); err != nil {
+			return false, err
+		}
+	}
+
+	vNetworkHandler := AwsVNetworkHandler{vpcHandler.Region, vpcHandler.Client}
+	return vNetworkHandler.DeleteVpc(vpcID)
+}
+
+func (vpcHandler *AwsVPCHandler) AddSubnet(vpcID string, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("vpcID : [%s], subnetInfo : [%v]", vpcID, subnetInfo)
+
+	if _, err := vpcHandler.createSubnet(vpcID, subnetInfo); err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.GetVPC(vpcID)
+}
+
+func (vpcHandler *AwsVPCHandler) RemoveSubnet(vpcID string, subnetID string) (bool, error) {
+	cblogger.Infof("vpcID : [%s], subnetID : [%s]", vpcID, subnetID)
+
+	_, err := vpcHandler.Client.DeleteSubnet(&ec2.DeleteSubnetInput{
+		SubnetId: aws.String(subnetID),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to delete Subnet: %s, %v.", subnetID, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *AwsVPCHandler) createSubnet(vpcID string, subnetInfo irs.SubnetInfo) (string, error) {
+	if subnetInfo.CIDR == "" {
+		return "", errors.New("Subnet[" + subnetInfo.Name + "]의 CIDR 정보가 필요합니다.")
+	}
+
+	input := &ec2.CreateSubnetInput{
+		VpcId:     aws.String(vpcID),
+		CidrBlock: aws.String(subnetInfo.CIDR),
+	}
+	zone := subnetInfo.Zone
+	if zone == "" {
+		zone = vpcHandler.Region.Zone
+	}
+	if zone != "" {
+		input.AvailabilityZone = aws.String(zone)
+	}
+
+	result, err := vpcHandler.Client.CreateSubnet(input)
+	if err != nil {
+		cblogger.Errorf("Unable to create Subnet: %s, %v.", subnetInfo.Name, err)
+		return "", err
+	}
+	subnetID := *result.Subnet.SubnetId
+	cblogger.Infof("Subnet 생성 완료 - Subnet Id : [%s]", subnetID)
+
+	if !SetNameTag(vpcHandler.Client, subnetID, subnetInfo.Name) {
+		cblogger.Errorf("Subnet에 %s Name 설정 실패", subnetInfo.Name)
+	}
+
+	// IGW 라우팅이 설정된 VPC의 라우팅 테이블에 서브넷을 연결 함.
+	vNetworkHandler := AwsVNetworkHandler{vpcHandler.Region, vpcHandler.Client}
+	if err := vNetworkHandler.AssociateRouteTable(vpcID, subnetID); err != nil {
+		return subnetID, err
+	}
+	return subnetID, nil
+}
+
+func (vpcHandler *AwsVPCHandler) describeSubnets(vpcID string) ([]*ec2.Subnet, error) {
+	result, err := vpcHandler.Client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return result.Subnets, nil
+}
+
+//VPC와 VPC에 속한 서브넷 정보를 추출함
+func (vpcHandler *AwsVPCHandler) extractVPCInfo(vpc *ec2.Vpc) (irs.VPCInfo, error) {
+	awsVpcInfo := ExtractVpcDescribeInfo(vpc)
+	vpcInfo := irs.VPCInfo{
+		Id:   awsVpcInfo.Id,
+		Name: awsVpcInfo.Name,
+		CIDR: awsVpcInfo.CidrBlock,
+		KeyValueList: []irs.KeyValue{
+			{Key: "State", Value: awsVpcInfo.State},
+			{Key: "IsDefault", Value: strconv.FormatBool(awsVpcInfo.IsDefault)},
+		},
+	}
+
+	subnetList, err := vpcHandler.describeSubnets(awsVpcInfo.Id)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	for _, subnet := range subnetList {
+		vpcInfo.SubnetInfoList = append(vpcInfo.SubnetInfoList, ExtractSubnetInfo(subnet))
+	}
+	return vpcInfo, nil
+}
+
+//Subnet 정보를 추출함
+func ExtractSubnetInfo(subnet *ec2.Subnet) irs.SubnetInfo {
+	subnetInfo := irs.SubnetInfo{
+		Id:   aws.StringValue(subnet.SubnetId),
+		CIDR: aws.StringValue(subnet.CidrBlock),
+		Zone: aws.StringValue(subnet.AvailabilityZone),
+	}
+
+	//Name은 Tag의 "Name" 속성에만 저장됨
+	for _, t := range subnet.Tags {
+		if aws.StringValue(t.Key) == "Name" {
+			subnetInfo.Name = aws.StringValue(t.Value)
+			break
+		}
+	}
+
+	subnetInfo.KeyValueList = []irs.KeyValue{
+		{Key: "State", Value: aws.StringValue(subnet.State)},
+		{Key: "AvailableIpAddressCount", Value: strconv.FormatInt(aws.Int64Value(subnet.AvailableIpAddressCount), 10)},
+		{Key: "MapPublicIpOnLaunch", Value: strconv.FormatBool(aws.BoolValue(subnet.MapPublicIpOnLaunch))},
+	}
+	return subnetInfo
+}
@@ -13,7 +13,13 @@ package azure
 import (
 	"context"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-11-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	natnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	resourcemgmt "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-10-01/resources"
+	storagemgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	azcon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/azure/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -37,6 +43,18 @@ func (AzureDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.VNicHandler = true
 	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.DiskHandler = true
+	drvCapabilityInfo.SnapshotHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.RouterHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+	drvCapabilityInfo.NLBHandler = true
+	drvCapabilityInfo.ObjectStorageHandler = true
+	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.AutoScalingHandler = true
+	drvCapabilityInfo.TagHandler = true
 
 	return drvCapabilityInfo
 }
@@ -80,18 +98,119 @@ func (driver *AzureDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (ico
 		return nil, err
 	}
 
+	Ctx, vmSpecClient, err := getVMSpecClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, resourceSkuClient, err := getResourceSkuClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, diskClient, err := getDiskClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, snapshotClient, err := getSnapshotClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, routeTableClient, err := getRouteTableClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, routeClient, err := getRouteClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, natGatewayClient, err := getNATGatewayClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, natPublicIPClient, err := getNATPublicIPClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, natSubnetClient, err := getNATSubnetClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, loadBalancerClient, err := getLoadBalancerClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, storageAccountClient, err := getStorageAccountClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, managedClusterClient, err := getManagedClusterClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, agentPoolClient, err := getAgentPoolClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, vmssClient, err := getVMSSClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, vmssVMClient, err := getVMSSVMClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, dnsZoneClient, err := getDNSZoneClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, recordSetClient, err := getRecordSetClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, autoscaleClient, err := getAutoscaleClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, tagsClient, err := getTagsClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, resourceClient, err := getResourceClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	iConn := azcon.AzureCloudConnection{
-		CredentialInfo:      connectionInfo.CredentialInfo,
-		Region:              connectionInfo.RegionInfo,
-		Ctx:                 Ctx,
-		VMClient:            VMClient,
-		ImageClient:         imageClient,
-		PublicIPClient:      publicIPClient,
-		SecurityGroupClient: sgClient,
-		VNetClient:          VNetClient,
-		VNicClient:          vNicClient,
-		IPConfigClient:      IPConfigClient,
-		SubnetClient:        SubnetClient,
+		CredentialInfo:       connectionInfo.CredentialInfo,
+		Region:               connectionInfo.RegionInfo,
+		Ctx:                  Ctx,
+		VMClient:             VMClient,
+		ImageClient:          imageClient,
+		PublicIPClient:       publicIPClient,
+		SecurityGroupClient:  sgClient,
+		VNetClient:           VNetClient,
+		VNicClient:           vNicClient,
+		IPConfigClient:       IPConfigClient,
+		SubnetClient:         SubnetClient,
+		VMSpecClient:         vmSpecClient,
+		ResourceSkuClient:    resourceSkuClient,
+		DiskClient:           diskClient,
+		SnapshotClient:       snapshotClient,
+		RouteTableClient:     routeTableClient,
+		RouteClient:          routeClient,
+		NATGatewayClient:     natGatewayClient,
+		NATPublicIPClient:    natPublicIPClient,
+		NATSubnetClient:      natSubnetClient,
+		NLBClient:            loadBalancerClient,
+		StorageAccountClient: storageAccountClient,
+		ManagedClusterClient: managedClusterClient,
+		AgentPoolClient:      agentPoolClient,
+		VMSSClient:           vmssClient,
+		VMSSVMClient:         vmssVMClient,
+		DNSZoneClient:        dnsZoneClient,
+		RecordSetClient:      recordSetClient,
+		AutoscaleClient:      autoscaleClient,
+		TagsClient:           tagsClient,
+		ResourceClient:       resourceClient,
 	}
 	return &iConn, nil
 }
@@ -213,4 +332,284 @@ func getSubnetClient(credential idrv.CredentialInfo) (context.Context, *network.
 	return ctx, &subnetClient, nil
 }
 
+func getVMSpecClient(credential idrv.CredentialInfo) (context.Context, *compute.VirtualMachineSizesClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmSpecClient := compute.NewVirtualMachineSizesClient(credential.SubscriptionId)
+	vmSpecClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &vmSpecClient, nil
+}
+
+func getResourceSkuClient(credential idrv.CredentialInfo) (context.Context, *compute.ResourceSkusClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resourceSkuClient := compute.NewResourceSkusClient(credential.SubscriptionId)
+	resourceSkuClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &resourceSkuClient, nil
+}
+
+func getDiskClient(credential idrv.CredentialInfo) (context.Context, *compute.DisksClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diskClient := compute.NewDisksClient(credential.SubscriptionId)
+	diskClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &diskClient, nil
+}
+
+func getSnapshotClient(credential idrv.CredentialInfo) (context.Context, *compute.SnapshotsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotClient := compute.NewSnapshotsClient(credential.SubscriptionId)
+	snapshotClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &snapshotClient, nil
+}
+
+func getRouteTableClient(credential idrv.CredentialInfo) (context.Context, *network.RouteTablesClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routeTableClient := network.NewRouteTablesClient(credential.SubscriptionId)
+	routeTableClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &routeTableClient, nil
+}
+
+func getRouteClient(credential idrv.CredentialInfo) (context.Context, *network.RoutesClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routeClient := network.NewRoutesClient(credential.SubscriptionId)
+	routeClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &routeClient, nil
+}
+
 var TestDriver AzureDriver
+
+func getNATGatewayClient(credential idrv.CredentialInfo) (context.Context, *natnetwork.NatGatewaysClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	natGatewayClient := natnetwork.NewNatGatewaysClient(credential.SubscriptionId)
+	natGatewayClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &natGatewayClient, nil
+}
+
+func getNATPublicIPClient(credential idrv.CredentialInfo) (context.Context, *natnetwork.PublicIPAddressesClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicIPClient := natnetwork.NewPublicIPAddressesClient(credential.SubscriptionId)
+	publicIPClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &publicIPClient, nil
+}
+
+func getNATSubnetClient(credential idrv.CredentialInfo) (context.Context, *natnetwork.SubnetsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetClient := natnetwork.NewSubnetsClient(credential.SubscriptionId)
+	subnetClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &subnetClient, nil
+}
+
+func getLoadBalancerClient(credential idrv.CredentialInfo) (context.Context, *network.LoadBalancersClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loadBalancerClient := network.NewLoadBalancersClient(credential.SubscriptionId)
+	loadBalancerClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &loadBalancerClient, nil
+}
+
+func getStorageAccountClient(credential idrv.CredentialInfo) (context.Context, *storagemgmt.AccountsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageAccountClient := storagemgmt.NewAccountsClient(credential.SubscriptionId)
+	storageAccountClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &storageAccountClient, nil
+}
+
+func getManagedClusterClient(credential idrv.CredentialInfo) (context.Context, *containerservice.ManagedClustersClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	managedClusterClient := containerservice.NewManagedClustersClient(credential.SubscriptionId)
+	managedClusterClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &managedClusterClient, nil
+}
+
+func getAgentPoolClient(credential idrv.CredentialInfo) (context.Context, *containerservice.AgentPoolsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	agentPoolClient := containerservice.NewAgentPoolsClient(credential.SubscriptionId)
+	agentPoolClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &agentPoolClient, nil
+}
+
+func getVMSSClient(credential idrv.CredentialInfo) (context.Context, *compute.VirtualMachineScaleSetsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmssClient := compute.NewVirtualMachineScaleSetsClient(credential.SubscriptionId)
+	vmssClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &vmssClient, nil
+}
+
+func getVMSSVMClient(credential idrv.CredentialInfo) (context.Context, *compute.VirtualMachineScaleSetVMsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmssVMClient := compute.NewVirtualMachineScaleSetVMsClient(credential.SubscriptionId)
+	vmssVMClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &vmssVMClient, nil
+}
+
+func getDNSZoneClient(credential idrv.CredentialInfo) (context.Context, *dns.ZonesClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dnsZoneClient := dns.NewZonesClient(credential.SubscriptionId)
+	dnsZoneClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &dnsZoneClient, nil
+}
+
+func getRecordSetClient(credential idrv.CredentialInfo) (context.Context, *dns.RecordSetsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recordSetClient := dns.NewRecordSetsClient(credential.SubscriptionId)
+	recordSetClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &recordSetClient, nil
+}
+
+func getAutoscaleClient(credential idrv.CredentialInfo) (context.Context, *insights.AutoscaleSettingsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	autoscaleClient := insights.NewAutoscaleSettingsClient(credential.SubscriptionId)
+	autoscaleClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &autoscaleClient, nil
+}
+
+func getTagsClient(credential idrv.CredentialInfo) (context.Context, *resourcemgmt.TagsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagsClient := resourcemgmt.NewTagsClient(credential.SubscriptionId)
+	tagsClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &tagsClient, nil
+}
+
+func getResourceClient(credential idrv.CredentialInfo) (context.Context, *resourcemgmt.Client, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resourceClient := resourcemgmt.NewClient(credential.SubscriptionId)
+	resourceClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &resourceClient, nil
+}
@@ -13,7 +13,13 @@ package connect
 import (
 	"context"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-11-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	natnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	resourcemgmt "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-10-01/resources"
+	storagemgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	cblog "github.com/cloud-barista/cb-log"
 	azrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/azure/resources"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -29,17 +35,37 @@ func init() {
 }
 
 type AzureCloudConnection struct {
-	CredentialInfo      idrv.CredentialInfo
-	Region              idrv.RegionInfo
-	Ctx                 context.Context
-	VMClient            *compute.VirtualMachinesClient
-	ImageClient         *compute.ImagesClient
-	PublicIPClient      *network.PublicIPAddressesClient
-	SecurityGroupClient *network.SecurityGroupsClient
-	VNetClient          *network.VirtualNetworksClient
-	VNicClient          *network.InterfacesClient
-	IPConfigClient      *network.InterfaceIPConfigurationsClient
-	SubnetClient        *network.SubnetsClient
+	CredentialInfo       idrv.CredentialInfo
+	Region               idrv.RegionInfo
+	Ctx                  context.Context
+	VMClient             *compute.VirtualMachinesClient
+	ImageClient          *compute.ImagesClient
+	PublicIPClient       *network.PublicIPAddressesClient
+	SecurityGroupClient  *network.SecurityGroupsClient
+	VNetClient           *network.VirtualNetworksClient
+	VNicClient           *network.InterfacesClient
+	IPConfigClient       *network.InterfaceIPConfigurationsClient
+	SubnetClient         *network.SubnetsClient
+	VMSpecClient         *compute.VirtualMachineSizesClient
+	ResourceSkuClient    *compute.ResourceSkusClient
+	DiskClient           *compute.DisksClient
+	SnapshotClient       *compute.SnapshotsClient
+	RouteTableClient     *network.RouteTablesClient
+	RouteClient          *network.RoutesClient
+	NATGatewayClient     *natnetwork.NatGatewaysClient
+	NATPublicIPClient    *natnetwork.PublicIPAddressesClient
+	NATSubnetClient      *natnetwork.SubnetsClient
+	NLBClient            *network.LoadBalancersClient
+	StorageAccountClient *storagemgmt.AccountsClient
+	ManagedClusterClient *containerservice.ManagedClustersClient
+	AgentPoolClient      *containerservice.AgentPoolsClient
+	VMSSClient           *compute.VirtualMachineScaleSetsClient
+	VMSSVMClient         *compute.VirtualMachineScaleSetVMsClient
+	DNSZoneClient        *dns.ZonesClient
+	RecordSetClient      *dns.RecordSetsClient
+	AutoscaleClient      *insights.AutoscaleSettingsClient
+	TagsClient           *resourcemgmt.TagsClient
+	ResourceClient       *resourcemgmt.Client
 }
 
 func (cloudConn *AzureCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
@@ -84,6 +110,78 @@ func (cloudConn *AzureCloudConnection) CreateVMHandler() (irs.VMHandler, error)
 	return &vmHandler, nil
 }
 
+func (cloudConn *AzureCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateVMSpecHandler()!")
+	vmSpecHandler := azrs.AzureVMSpecHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VMSpecClient, cloudConn.ResourceSkuClient}
+	return &vmSpecHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateDiskHandler()!")
+	diskHandler := azrs.AzureDiskHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.DiskClient, cloudConn.VMClient}
+	return &diskHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateSnapshotHandler() (irs.SnapshotHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateSnapshotHandler()!")
+	snapshotHandler := azrs.AzureSnapshotHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.SnapshotClient, cloudConn.DiskClient, cloudConn.VMClient}
+	return &snapshotHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateVPCHandler()!")
+	vpcHandler := azrs.AzureVPCHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VNetClient, cloudConn.SubnetClient}
+	return &vpcHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateRouterHandler() (irs.RouterHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateRouterHandler()!")
+	routerHandler := azrs.AzureRouterHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.RouteTableClient, cloudConn.RouteClient, cloudConn.SubnetClient}
+	return &routerHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateNATGatewayHandler()!")
+	natGatewayHandler := azrs.AzureNATGatewayHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.NATGatewayClient, cloudConn.NATPublicIPClient, cloudConn.NATSubnetClient}
+	return &natGatewayHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateNLBHandler()!")
+	nlbHandler := azrs.AzureNLBHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.NLBClient, cloudConn.PublicIPClient, cloudConn.SubnetClient, cloudConn.VNicClient, cloudConn.VMClient}
+	return &nlbHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateObjectStorageHandler() (irs.ObjectStorageHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateObjectStorageHandler()!")
+	storageHandler := azrs.AzureObjectStorageHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.StorageAccountClient}
+	return &storageHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateClusterHandler()!")
+	clusterHandler := azrs.AzureClusterHandler{cloudConn.CredentialInfo, cloudConn.Region, cloudConn.Ctx, cloudConn.ManagedClusterClient, cloudConn.AgentPoolClient, cloudConn.SubnetClient, cloudConn.SecurityGroupClient, cloudConn.VMSSClient, cloudConn.VMSSVMClient}
+	return &clusterHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateDNSHandler()!")
+	dnsHandler := azrs.AzureDNSHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.DNSZoneClient, cloudConn.RecordSetClient}
+	return &dnsHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateAutoScalingHandler() (irs.AutoScalingHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateAutoScalingHandler()!")
+	asgHandler := azrs.AzureAutoScalingHandler{cloudConn.CredentialInfo, cloudConn.Region, cloudConn.Ctx, cloudConn.VMSSClient, cloudConn.VMSSVMClient, cloudConn.AutoscaleClient, cloudConn.SubnetClient, cloudConn.SecurityGroupClient}
+	return &asgHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateTagHandler()!")
+	tagHandler := azrs.AzureTagHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.TagsClient, cloudConn.ResourceClient}
+	return &tagHandler, nil
+}
+
 func (AzureCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
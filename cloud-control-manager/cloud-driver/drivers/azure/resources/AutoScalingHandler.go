@@ -0,0 +1,509 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBScaleSetMinSizeTag    = "MinSize"
+	CBScaleSetMaxSizeTag    = "MaxSize"
+	CBScaleSetSubnetTag     = "SubnetId"
+	CBScaleSetSGTag         = "SecurityGroupId"
+	CBScaleSetKeyPairTag    = "KeyPairName"
+	CBScaleSetNicSuffix     = "-nic"
+	CBScaleSetIPSuffix      = "-ipconfig"
+	CBAutoscaleProfileName  = "CB-Profile"
+	CBAutoscaleMetricName   = "Percentage CPU"
+	CBAutoscaleTimeGrain    = "PT1M"
+	CBAutoscaleTimeWindow   = "PT5M"
+	CBAutoscaleCooldown     = "PT5M"
+	CBAutoscaleChangeCount  = "1"
+	CBAutoscaleScaleInRatio = 0.5
+)
+
+// VM Scale Set 이름을 ID로 사용, VirtualNetworkId는 CB-VNet의 서브넷 이름, SecurityGroupIds는 Security Group 이름
+// VM Scale Set에는 최소/최대 크기가 없으므로 Tag에 저장하고, Scaling Policy(Autoscale Setting)의 Capacity로 사용
+// Azure는 Target Tracking을 지원하지 않으므로 평균 CPU 사용률이 목표값 초과 시 1대 증가, 목표값의 절반 미만 시 1대 감소하는 규칙으로 구성
+type AzureAutoScalingHandler struct {
+	CredentialInfo      idrv.CredentialInfo
+	Region              idrv.RegionInfo
+	Ctx                 context.Context
+	Client              *compute.VirtualMachineScaleSetsClient
+	VMClient            *compute.VirtualMachineScaleSetVMsClient
+	AutoscaleClient     *insights.AutoscaleSettingsClient
+	SubnetClient        *network.SubnetsClient
+	SecurityGroupClient *network.SecurityGroupsClient
+}
+
+func setterScaleSet(scaleSet compute.VirtualMachineScaleSet) *irs.AutoScalingGroupInfo {
+	groupInfo := &irs.AutoScalingGroupInfo{
+		Id:   toString(scaleSet.Name),
+		Name: toString(scaleSet.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(scaleSet.ID)},
+		},
+	}
+	if scaleSet.Sku != nil {
+		groupInfo.VMTemplate.VMSpecId = toString(scaleSet.Sku.Name)
+		if scaleSet.Sku.Capacity != nil {
+			groupInfo.DesiredSize = int(*scaleSet.Sku.Capacity)
+		}
+	}
+	if minSize, ok := scaleSet.Tags[CBScaleSetMinSizeTag]; ok {
+		groupInfo.MinSize, _ = strconv.Atoi(toString(minSize))
+	}
+	if maxSize, ok := scaleSet.Tags[CBScaleSetMaxSizeTag]; ok {
+		groupInfo.MaxSize, _ = strconv.Atoi(toString(maxSize))
+	}
+	if subnetName, ok := scaleSet.Tags[CBScaleSetSubnetTag]; ok {
+		groupInfo.VMTemplate.VirtualNetworkId = toString(subnetName)
+	}
+	if sgName, ok := scaleSet.Tags[CBScaleSetSGTag]; ok {
+		groupInfo.VMTemplate.SecurityGroupIds = []string{toString(sgName)}
+	}
+	if keyPairName, ok := scaleSet.Tags[CBScaleSetKeyPairTag]; ok {
+		groupInfo.VMTemplate.KeyPairName = toString(keyPairName)
+	}
+	if scaleSet.VirtualMachineScaleSetProperties != nil {
+		groupInfo.KeyValueList = append(groupInfo.KeyValueList, irs.KeyValue{Key: "ProvisioningState", Value: toString(scaleSet.ProvisioningState)})
+		profile := scaleSet.VirtualMachineProfile
+		if profile != nil && profile.StorageProfile != nil && profile.StorageProfile.ImageReference != nil {
+			groupInfo.VMTemplate.ImageId = toString(profile.StorageProfile.ImageReference.ID)
+		}
+		if profile != nil && profile.OsProfile != nil {
+			groupInfo.VMTemplate.VMUserId = toString(profile.OsProfile.AdminUsername)
+		}
+	}
+	return groupInfo
+}
+
+func (asgHandler *AzureAutoScalingHandler) CreateAutoScalingGroup(groupReqInfo irs.AutoScalingGroupReqInfo) (irs.AutoScalingGroupInfo, error) {
+	// Check VM Scale Set Exists
+	scaleSet, _ := asgHandler.Client.Get(asgHandler.Ctx, CBResourceGroupName, groupReqInfo.Name)
+	if scaleSet.ID != nil {
+		errMsg := fmt.Sprintf("VirtualMachineScaleSet with name %s already exist", groupReqInfo.Name)
+		return irs.AutoScalingGroupInfo{}, errors.New(errMsg)
+	}
+
+	vmTemplate := groupReqInfo.VMTemplate
+	subnet, err := asgHandler.SubnetClient.Get(asgHandler.Ctx, CBResourceGroupName, CBVirutalNetworkName, vmTemplate.VirtualNetworkId, "")
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	nicConfig := compute.VirtualMachineScaleSetNetworkConfiguration{
+		Name: to.StringPtr(groupReqInfo.Name + CBScaleSetNicSuffix),
+		VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
+			Primary: to.BoolPtr(true),
+			IPConfigurations: &[]compute.VirtualMachineScaleSetIPConfiguration{
+				{
+					Name: to.StringPtr(groupReqInfo.Name + CBScaleSetIPSuffix),
+					VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+						Subnet: &compute.APIEntityReference{ID: subnet.ID},
+					},
+				},
+			},
+		},
+	}
+	tags := map[string]*string{
+		CBScaleSetMinSizeTag: to.StringPtr(strconv.Itoa(groupReqInfo.MinSize)),
+		CBScaleSetMaxSizeTag: to.StringPtr(strconv.Itoa(groupReqInfo.MaxSize)),
+		CBScaleSetSubnetTag:  to.StringPtr(vmTemplate.VirtualNetworkId),
+	}
+	// NIC에는 하나의 Security Group만 연결 가능
+	if len(vmTemplate.SecurityGroupIds) != 0 {
+		securityGroup, err := asgHandler.SecurityGroupClient.Get(asgHandler.Ctx, CBResourceGroupName, vmTemplate.SecurityGroupIds[0], "")
+		if err != nil {
+			cblogger.Error(err)
+			return irs.AutoScalingGroupInfo{}, err
+		}
+		nicConfig.NetworkSecurityGroup = &compute.SubResource{ID: securityGroup.ID}
+		tags[CBScaleSetSGTag] = to.StringPtr(vmTemplate.SecurityGroupIds[0])
+	}
+
+	osProfile := &compute.VirtualMachineScaleSetOSProfile{
+		ComputerNamePrefix: to.StringPtr(groupReqInfo.Name),
+		AdminUsername:      to.StringPtr(CBVMUser),
+	}
+	if vmTemplate.KeyPairName == "" {
+		osProfile.AdminPassword = to.StringPtr(vmTemplate.VMUserPasswd)
+	} else {
+		publicKey, err := GetPublicKey(asgHandler.CredentialInfo, vmTemplate.KeyPairName)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.AutoScalingGroupInfo{}, err
+		}
+		osProfile.LinuxConfiguration = &compute.LinuxConfiguration{
+			SSH: &compute.SSHConfiguration{
+				PublicKeys: &[]compute.SSHPublicKey{
+					{
+						Path:    to.StringPtr(fmt.Sprintf("/home/%s/.ssh/authorized_keys", CBVMUser)),
+						KeyData: to.StringPtr(publicKey),
+					},
+				},
+			},
+		}
+		tags[CBScaleSetKeyPairTag] = to.StringPtr(vmTemplate.KeyPairName)
+	}
+
+	createOpts := compute.VirtualMachineScaleSet{
+		Location: &asgHandler.Region.Region,
+		Sku: &compute.Sku{
+			Name:     to.StringPtr(vmTemplate.VMSpecId),
+			Capacity: to.Int64Ptr(int64(groupReqInfo.DesiredSize)),
+		},
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			UpgradePolicy: &compute.UpgradePolicy{
+				Mode: compute.Manual,
+			},
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				OsProfile: osProfile,
+				StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+					ImageReference: &compute.ImageReference{
+						ID: to.StringPtr(vmTemplate.ImageId),
+					},
+				},
+				NetworkProfile: &compute.VirtualMachineScaleSetNetworkProfile{
+					NetworkInterfaceConfigurations: &[]compute.VirtualMachineScaleSetNetworkConfiguration{nicConfig},
+				},
+			},
+		},
+		Tags: tags,
+	}
+
+	future, err := asgHandler.Client.CreateOrUpdate(asgHandler.Ctx, CBResourceGroupName, groupReqInfo.Name, createOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	err = future.WaitForCompletionRef(asgHandler.Ctx, asgHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	return asgHandler.GetAutoScalingGroup(groupReqInfo.Name)
+}
+
+func (asgHandler *AzureAutoScalingHandler) ListAutoScalingGroup() ([]*irs.AutoScalingGroupInfo, error) {
+	iter, err := asgHandler.Client.ListComplete(asgHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var groupList []*irs.AutoScalingGroupInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(asgHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		groupList = append(groupList, setterScaleSet(iter.Value()))
+	}
+	return groupList, nil
+}
+
+func (asgHandler *AzureAutoScalingHandler) GetAutoScalingGroup(groupID string) (irs.AutoScalingGroupInfo, error) {
+	scaleSet, err := asgHandler.Client.Get(asgHandler.Ctx, CBResourceGroupName, groupID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	groupInfo := setterScaleSet(scaleSet)
+	return *groupInfo, nil
+}
+
+// VM Scale Set에 연결된 Autoscale Setting도 함께 삭제
+func (asgHandler *AzureAutoScalingHandler) DeleteAutoScalingGroup(groupID string) (bool, error) {
+	policyList, err := asgHandler.ListScalingPolicy(groupID)
+	if err != nil {
+		return false, err
+	}
+	for _, policy := range policyList {
+		if _, err := asgHandler.RemoveScalingPolicy(groupID, policy.Name); err != nil {
+			return false, err
+		}
+	}
+
+	future, err := asgHandler.Client.Delete(asgHandler.Ctx, CBResourceGroupName, groupID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(asgHandler.Ctx, asgHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Autoscale Setting이 있으면 Capacity도 함께 변경 (Autoscale Setting의 Capacity가 VM Scale Set의 크기를 제한함)
+func (asgHandler *AzureAutoScalingHandler) ChangeAutoScalingGroupSize(groupID string, desiredSize int, minSize int, maxSize int) (irs.AutoScalingGroupInfo, error) {
+	scaleSet, err := asgHandler.Client.Get(asgHandler.Ctx, CBResourceGroupName, groupID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	tags := scaleSet.Tags
+	if tags == nil {
+		tags = map[string]*string{}
+	}
+	tags[CBScaleSetMinSizeTag] = to.StringPtr(strconv.Itoa(minSize))
+	tags[CBScaleSetMaxSizeTag] = to.StringPtr(strconv.Itoa(maxSize))
+
+	future, err := asgHandler.Client.Update(asgHandler.Ctx, CBResourceGroupName, groupID, compute.VirtualMachineScaleSetUpdate{
+		Sku: &compute.Sku{
+			Name:     scaleSet.Sku.Name,
+			Capacity: to.Int64Ptr(int64(desiredSize)),
+		},
+		Tags: tags,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	err = future.WaitForCompletionRef(asgHandler.Ctx, asgHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	settingList, err := asgHandler.listAutoscaleSetting(toString(scaleSet.ID))
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	for _, setting := range settingList {
+		if setting.Profiles != nil {
+			for i := range *setting.Profiles {
+				(*setting.Profiles)[i].Capacity = getScaleCapacity(desiredSize, minSize, maxSize)
+			}
+		}
+		_, err := asgHandler.AutoscaleClient.CreateOrUpdate(asgHandler.Ctx, CBResourceGroupName, toString(setting.Name), setting)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.AutoScalingGroupInfo{}, err
+		}
+	}
+
+	return asgHandler.GetAutoScalingGroup(groupID)
+}
+
+// VMId는 VM Scale Set의 인스턴스 ID
+func (asgHandler *AzureAutoScalingHandler) ListAutoScalingGroupMember(groupID string) ([]*irs.AutoScalingMemberInfo, error) {
+	iter, err := asgHandler.VMClient.ListComplete(asgHandler.Ctx, CBResourceGroupName, groupID, "", "", "instanceView")
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var memberList []*irs.AutoScalingMemberInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(asgHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		vm := iter.Value()
+		memberInfo := &irs.AutoScalingMemberInfo{
+			VMId: toString(vm.InstanceID),
+			KeyValueList: []irs.KeyValue{
+				{Key: "Name", Value: toString(vm.Name)},
+				{Key: "ResourceId", Value: toString(vm.ID)},
+			},
+		}
+		if vm.Zones != nil && len(*vm.Zones) > 0 {
+			memberInfo.Zone = (*vm.Zones)[0]
+		}
+		var provisioningState string
+		if vm.VirtualMachineScaleSetVMProperties != nil {
+			provisioningState = toString(vm.ProvisioningState)
+			memberInfo.KeyValueList = append(memberInfo.KeyValueList, irs.KeyValue{Key: "ProvisioningState", Value: provisioningState})
+			if vm.InstanceView != nil && vm.InstanceView.Statuses != nil {
+				for _, status := range *vm.InstanceView.Statuses {
+					if strings.HasPrefix(toString(status.Code), "PowerState/") {
+						memberInfo.KeyValueList = append(memberInfo.KeyValueList, irs.KeyValue{Key: "PowerState", Value: toString(status.Code)})
+					}
+				}
+			}
+		}
+		memberInfo.Status = getScaleSetVMStatus(provisioningState)
+		memberList = append(memberList, memberInfo)
+	}
+	return memberList, nil
+}
+
+// VM Scale Set당 하나의 Autoscale Setting만 생성 가능 (Autoscale Setting 이름 = Policy 이름)
+func (asgHandler *AzureAutoScalingHandler) AddScalingPolicy(groupID string, policyReqInfo irs.ScalingPolicyReqInfo) (irs.ScalingPolicyInfo, error) {
+	scaleSet, err := asgHandler.Client.Get(asgHandler.Ctx, CBResourceGroupName, groupID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ScalingPolicyInfo{}, err
+	}
+
+	settingList, err := asgHandler.listAutoscaleSetting(toString(scaleSet.ID))
+	if err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+	if len(settingList) != 0 {
+		errMsg := fmt.Sprintf("VirtualMachineScaleSet %s already has the scaling policy %s", groupID, toString(settingList[0].Name))
+		return irs.ScalingPolicyInfo{}, errors.New(errMsg)
+	}
+
+	groupInfo := setterScaleSet(scaleSet)
+	scaleOutRule := getCPUScaleRule(toString(scaleSet.ID), insights.GreaterThan, policyReqInfo.TargetCPUUtilization, insights.ScaleDirectionIncrease)
+	scaleInRule := getCPUScaleRule(toString(scaleSet.ID), insights.LessThan, policyReqInfo.TargetCPUUtilization*CBAutoscaleScaleInRatio, insights.ScaleDirectionDecrease)
+
+	setting, err := asgHandler.AutoscaleClient.CreateOrUpdate(asgHandler.Ctx, CBResourceGroupName, policyReqInfo.Name, insights.AutoscaleSettingResource{
+		Location: &asgHandler.Region.Region,
+		AutoscaleSetting: &insights.AutoscaleSetting{
+			Name:              to.StringPtr(policyReqInfo.Name),
+			Enabled:           to.BoolPtr(true),
+			TargetResourceURI: scaleSet.ID,
+			Profiles: &[]insights.AutoscaleProfile{
+				{
+					Name:     to.StringPtr(CBAutoscaleProfileName),
+					Capacity: getScaleCapacity(groupInfo.DesiredSize, groupInfo.MinSize, groupInfo.MaxSize),
+					Rules:    &[]insights.ScaleRule{scaleOutRule, scaleInRule},
+				},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ScalingPolicyInfo{}, err
+	}
+
+	return *setterAutoscaleSetting(setting), nil
+}
+
+func (asgHandler *AzureAutoScalingHandler) ListScalingPolicy(groupID string) ([]*irs.ScalingPolicyInfo, error) {
+	scaleSet, err := asgHandler.Client.Get(asgHandler.Ctx, CBResourceGroupName, groupID)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	settingList, err := asgHandler.listAutoscaleSetting(toString(scaleSet.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var policyList []*irs.ScalingPolicyInfo
+	for _, setting := range settingList {
+		policyList = append(policyList, setterAutoscaleSetting(setting))
+	}
+	return policyList, nil
+}
+
+func (asgHandler *AzureAutoScalingHandler) RemoveScalingPolicy(groupID string, policyName string) (bool, error) {
+	_, err := asgHandler.AutoscaleClient.Delete(asgHandler.Ctx, CBResourceGroupName, policyName)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Resource Group의 Autoscale Setting 중 대상이 VM Scale Set인 것만 조회
+func (asgHandler *AzureAutoScalingHandler) listAutoscaleSetting(scaleSetID string) ([]insights.AutoscaleSettingResource, error) {
+	iter, err := asgHandler.AutoscaleClient.ListByResourceGroupComplete(asgHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var settingList []insights.AutoscaleSettingResource
+	for ; iter.NotDone(); err = iter.NextWithContext(asgHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		setting := iter.Value()
+		if setting.AutoscaleSetting != nil && strings.EqualFold(toString(setting.TargetResourceURI), scaleSetID) {
+			settingList = append(settingList, setting)
+		}
+	}
+	return settingList, nil
+}
+
+func setterAutoscaleSetting(setting insights.AutoscaleSettingResource) *irs.ScalingPolicyInfo {
+	policyInfo := &irs.ScalingPolicyInfo{
+		Id:   toString(setting.ID),
+		Name: toString(setting.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+		},
+	}
+	if setting.AutoscaleSetting == nil || setting.Profiles == nil {
+		return policyInfo
+	}
+	// 목표값은 Scale-out 규칙의 임계값
+	for _, profile := range *setting.Profiles {
+		if profile.Rules == nil {
+			continue
+		}
+		for _, rule := range *profile.Rules {
+			if rule.ScaleAction != nil && rule.ScaleAction.Direction == insights.ScaleDirectionIncrease && rule.MetricTrigger != nil && rule.MetricTrigger.Threshold != nil {
+				policyInfo.TargetCPUUtilization = *rule.MetricTrigger.Threshold
+			}
+		}
+	}
+	return policyInfo
+}
+
+func getCPUScaleRule(scaleSetID string, operator insights.ComparisonOperationType, threshold float64, direction insights.ScaleDirection) insights.ScaleRule {
+	return insights.ScaleRule{
+		MetricTrigger: &insights.MetricTrigger{
+			MetricName:        to.StringPtr(CBAutoscaleMetricName),
+			MetricResourceURI: to.StringPtr(scaleSetID),
+			TimeGrain:         to.StringPtr(CBAutoscaleTimeGrain),
+			Statistic:         insights.MetricStatisticTypeAverage,
+			TimeWindow:        to.StringPtr(CBAutoscaleTimeWindow),
+			TimeAggregation:   insights.TimeAggregationTypeAverage,
+			Operator:          operator,
+			Threshold:         to.Float64Ptr(threshold),
+		},
+		ScaleAction: &insights.ScaleAction{
+			Direction: direction,
+			Type:      insights.ChangeCount,
+			Value:     to.StringPtr(CBAutoscaleChangeCount),
+			Cooldown:  to.StringPtr(CBAutoscaleCooldown),
+		},
+	}
+}
+
+func getScaleCapacity(desiredSize int, minSize int, maxSize int) *insights.ScaleCapacity {
+	return &insights.ScaleCapacity{
+		Minimum: to.StringPtr(strconv.Itoa(minSize)),
+		Maximum: to.StringPtr(strconv.Itoa(maxSize)),
+		Default: to.StringPtr(strconv.Itoa(desiredSize)),
+	}
+}
+
+func getScaleSetVMStatus(provisioningState string) irs.AutoScalingMemberStatus {
+	switch provisioningState {
+	case "Succeeded":
+		return irs.AutoScalingMemberInService
+	case "Deleting":
+		return irs.AutoScalingMemberTerminating
+	case "Failed":
+		return irs.AutoScalingMemberFailed
+	default:
+		return irs.AutoScalingMemberPending
+	}
+}
@@ -0,0 +1,565 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-11-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBClusterVPCTag      = "VPCId"
+	CBClusterSubnetTag   = "SubnetId"
+	CBClusterSGTag       = "SecurityGroupId"
+	CBClusterDNSSuffix   = "-dns"
+	CBAgentPoolNameLimit = 12
+)
+
+// AKS Agent Pool 이름은 소문자로 시작하는 소문자/숫자 조합이며 최대 12자
+var agentPoolNameRegexp = regexp.MustCompile("^[a-z][a-z0-9]{0,11}$")
+
+// Managed Cluster 이름을 ID로 사용하며, Node Group은 Agent Pool(VMSS) 이름을 ID로 사용
+// Cluster 생성 시 첫 번째 Node Group은 System Pool, 나머지는 User Pool로 생성
+// Node는 Node Resource Group(MC_...)에 생성되는 VMSS의 Instance 이름으로 표시
+type AzureClusterHandler struct {
+	CredentialInfo      idrv.CredentialInfo
+	Region              idrv.RegionInfo
+	Ctx                 context.Context
+	Client              *containerservice.ManagedClustersClient
+	AgentPoolClient     *containerservice.AgentPoolsClient
+	SubnetClient        *network.SubnetsClient
+	SecurityGroupClient *network.SecurityGroupsClient
+	VMSSClient          *compute.VirtualMachineScaleSetsClient
+	VMSSVMClient        *compute.VirtualMachineScaleSetVMsClient
+}
+
+func (clusterHandler *AzureClusterHandler) setterCluster(cluster containerservice.ManagedCluster) (*irs.ClusterInfo, error) {
+	clusterInfo := &irs.ClusterInfo{
+		Id:   toString(cluster.Name),
+		Name: toString(cluster.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(cluster.ID)},
+		},
+	}
+	if vpcID, ok := cluster.Tags[CBClusterVPCTag]; ok {
+		clusterInfo.VPCId = toString(vpcID)
+	}
+	if subnetID, ok := cluster.Tags[CBClusterSubnetTag]; ok {
+		clusterInfo.SubnetIds = []string{toString(subnetID)}
+	}
+	if sgID, ok := cluster.Tags[CBClusterSGTag]; ok {
+		clusterInfo.SecurityGroupIds = []string{toString(sgID)}
+	}
+	if cluster.ManagedClusterProperties == nil {
+		return clusterInfo, nil
+	}
+
+	clusterInfo.Version = toString(cluster.KubernetesVersion)
+	clusterInfo.Endpoint = toString(cluster.Fqdn)
+	clusterInfo.Status = getClusterStatus(toString(cluster.ProvisioningState))
+	clusterInfo.KeyValueList = append(clusterInfo.KeyValueList,
+		irs.KeyValue{Key: "ProvisioningState", Value: toString(cluster.ProvisioningState)},
+		irs.KeyValue{Key: "NodeResourceGroup", Value: toString(cluster.NodeResourceGroup)},
+	)
+
+	if cluster.AgentPoolProfiles != nil {
+		for _, profile := range *cluster.AgentPoolProfiles {
+			nodeGroupInfo, err := clusterHandler.setterNodeGroup(toString(profile.Name), toString(cluster.NodeResourceGroup), containerservice.ManagedClusterAgentPoolProfileProperties{
+				Count:               profile.Count,
+				VMSize:              profile.VMSize,
+				OsDiskSizeGB:        profile.OsDiskSizeGB,
+				MaxCount:            profile.MaxCount,
+				MinCount:            profile.MinCount,
+				EnableAutoScaling:   profile.EnableAutoScaling,
+				Mode:                profile.Mode,
+				OrchestratorVersion: profile.OrchestratorVersion,
+				NodeImageVersion:    profile.NodeImageVersion,
+				ProvisioningState:   profile.ProvisioningState,
+			})
+			if err != nil {
+				return nil, err
+			}
+			clusterInfo.NodeGroupList = append(clusterInfo.NodeGroupList, *nodeGroupInfo)
+		}
+	}
+	return clusterInfo, nil
+}
+
+func (clusterHandler *AzureClusterHandler) setterNodeGroup(name string, nodeResourceGroup string, profile containerservice.ManagedClusterAgentPoolProfileProperties) (*irs.NodeGroupInfo, error) {
+	nodeGroupInfo := &irs.NodeGroupInfo{
+		Id:              name,
+		Name:            name,
+		ImageName:       toString(profile.NodeImageVersion),
+		VMSpecName:      string(profile.VMSize),
+		Status:          getClusterStatus(toString(profile.ProvisioningState)),
+		OnAutoScaling:   profile.EnableAutoScaling != nil && *profile.EnableAutoScaling,
+		DesiredNodeSize: int(to.Int32(profile.Count)),
+		MinNodeSize:     int(to.Int32(profile.MinCount)),
+		MaxNodeSize:     int(to.Int32(profile.MaxCount)),
+		KeyValueList: []irs.KeyValue{
+			{Key: "Mode", Value: string(profile.Mode)},
+			{Key: "OrchestratorVersion", Value: toString(profile.OrchestratorVersion)},
+			{Key: "ProvisioningState", Value: toString(profile.ProvisioningState)},
+		},
+	}
+	if profile.OsDiskSizeGB != nil {
+		nodeGroupInfo.RootDiskSize = strconv.Itoa(int(*profile.OsDiskSizeGB))
+	}
+	// Auto Scaling을 사용하지 않으면 Min/Max는 현재 Node 수와 동일
+	if !nodeGroupInfo.OnAutoScaling {
+		nodeGroupInfo.MinNodeSize = nodeGroupInfo.DesiredNodeSize
+		nodeGroupInfo.MaxNodeSize = nodeGroupInfo.DesiredNodeSize
+	}
+
+	if nodeResourceGroup != "" {
+		nodes, err := clusterHandler.getNodes(nodeResourceGroup, name)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupInfo.Nodes = nodes
+	}
+	return nodeGroupInfo, nil
+}
+
+func (clusterHandler *AzureClusterHandler) CreateCluster(clusterReqInfo irs.ClusterReqInfo) (irs.ClusterInfo, error) {
+	// Check Cluster Exists
+	cluster, _ := clusterHandler.Client.Get(clusterHandler.Ctx, CBResourceGroupName, clusterReqInfo.Name)
+	if cluster.ID != nil {
+		return irs.ClusterInfo{}, errors.New("ManagedCluster with name " + clusterReqInfo.Name + " already exist")
+	}
+	if len(clusterReqInfo.NodeGroupList) == 0 {
+		return irs.ClusterInfo{}, errors.New("AKS cluster requires at least one node group")
+	}
+	if len(clusterReqInfo.SubnetIds) == 0 {
+		return irs.ClusterInfo{}, errors.New("AKS cluster requires a subnet")
+	}
+
+	// Node는 모두 같은 Subnet에 생성 (AKS는 Pool별 Subnet을 지정할 수 있으나 첫 번째 Subnet만 사용)
+	subnet, err := clusterHandler.SubnetClient.Get(clusterHandler.Ctx, CBResourceGroupName, clusterReqInfo.VPCId, clusterReqInfo.SubnetIds[0], "")
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ClusterInfo{}, err
+	}
+	// AKS Node에는 NSG를 직접 지정할 수 없으므로 Subnet에 Security Group을 연결
+	if len(clusterReqInfo.SecurityGroupIds) != 0 {
+		err = clusterHandler.attachSecurityGroup(clusterReqInfo.VPCId, subnet, clusterReqInfo.SecurityGroupIds[0])
+		if err != nil {
+			cblogger.Error(err)
+			return irs.ClusterInfo{}, err
+		}
+	}
+
+	var agentPoolProfiles []containerservice.ManagedClusterAgentPoolProfile
+	for i, nodeGroupReqInfo := range clusterReqInfo.NodeGroupList {
+		profile, err := getAgentPoolProfile(nodeGroupReqInfo, toString(subnet.ID))
+		if err != nil {
+			return irs.ClusterInfo{}, err
+		}
+		agentPoolProfile := containerservice.ManagedClusterAgentPoolProfile{
+			Name:              to.StringPtr(nodeGroupReqInfo.Name),
+			Count:             profile.Count,
+			VMSize:            profile.VMSize,
+			OsDiskSizeGB:      profile.OsDiskSizeGB,
+			VnetSubnetID:      profile.VnetSubnetID,
+			OsType:            profile.OsType,
+			MaxCount:          profile.MaxCount,
+			MinCount:          profile.MinCount,
+			EnableAutoScaling: profile.EnableAutoScaling,
+			Type:              profile.Type,
+			Mode:              containerservice.User,
+		}
+		if i == 0 {
+			agentPoolProfile.Mode = containerservice.System
+		}
+		agentPoolProfiles = append(agentPoolProfiles, agentPoolProfile)
+	}
+
+	createOpts := containerservice.ManagedCluster{
+		Location: &clusterHandler.Region.Region,
+		Identity: &containerservice.ManagedClusterIdentity{
+			Type: containerservice.ResourceIdentityTypeSystemAssigned,
+		},
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			DNSPrefix:         to.StringPtr(clusterReqInfo.Name + CBClusterDNSSuffix),
+			AgentPoolProfiles: &agentPoolProfiles,
+			EnableRBAC:        to.BoolPtr(true),
+			NetworkProfile: &containerservice.NetworkProfile{
+				NetworkPlugin: containerservice.Azure,
+			},
+		},
+		Tags: map[string]*string{
+			CBClusterVPCTag:    to.StringPtr(clusterReqInfo.VPCId),
+			CBClusterSubnetTag: to.StringPtr(clusterReqInfo.SubnetIds[0]),
+		},
+	}
+	if clusterReqInfo.Version != "" {
+		createOpts.KubernetesVersion = to.StringPtr(clusterReqInfo.Version)
+	}
+	if len(clusterReqInfo.SecurityGroupIds) != 0 {
+		createOpts.Tags[CBClusterSGTag] = to.StringPtr(clusterReqInfo.SecurityGroupIds[0])
+	}
+
+	// Node 접속용 KeyPair는 첫 번째 Node Group의 KeyPair를 Cluster 전체에 사용
+	if keyPairName := clusterReqInfo.NodeGroupList[0].KeyPairName; keyPairName != "" {
+		publicKey, err := GetPublicKey(clusterHandler.CredentialInfo, keyPairName)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.ClusterInfo{}, err
+		}
+		createOpts.LinuxProfile = &containerservice.LinuxProfile{
+			AdminUsername: to.StringPtr(CBVMUser),
+			SSH: &containerservice.SSHConfiguration{
+				PublicKeys: &[]containerservice.SSHPublicKey{
+					{KeyData: to.StringPtr(publicKey)},
+				},
+			},
+		}
+	}
+
+	future, err := clusterHandler.Client.CreateOrUpdate(clusterHandler.Ctx, CBResourceGroupName, clusterReqInfo.Name, createOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ClusterInfo{}, err
+	}
+	err = future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ClusterInfo{}, err
+	}
+
+	return clusterHandler.GetCluster(clusterReqInfo.Name)
+}
+
+func (clusterHandler *AzureClusterHandler) ListCluster() ([]*irs.ClusterInfo, error) {
+	iter, err := clusterHandler.Client.ListByResourceGroupComplete(clusterHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var clusterList []*irs.ClusterInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(clusterHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		clusterInfo, err := clusterHandler.setterCluster(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		clusterList = append(clusterList, clusterInfo)
+	}
+	return clusterList, nil
+}
+
+func (clusterHandler *AzureClusterHandler) GetCluster(clusterID string) (irs.ClusterInfo, error) {
+	cluster, err := clusterHandler.Client.Get(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	clusterInfo, err := clusterHandler.setterCluster(cluster)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	return *clusterInfo, nil
+}
+
+// Cluster를 삭제하면 Agent Pool과 Node Resource Group도 함께 삭제됨
+func (clusterHandler *AzureClusterHandler) DeleteCluster(clusterID string) (bool, error) {
+	future, err := clusterHandler.Client.Delete(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// 추가되는 Agent Pool은 User Pool로 생성하며, Cluster의 Subnet을 그대로 사용
+func (clusterHandler *AzureClusterHandler) AddNodeGroup(clusterID string, nodeGroupReqInfo irs.NodeGroupReqInfo) (irs.NodeGroupInfo, error) {
+	cluster, err := clusterHandler.Client.Get(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	var subnetID string
+	if cluster.ManagedClusterProperties != nil && cluster.AgentPoolProfiles != nil && len(*cluster.AgentPoolProfiles) > 0 {
+		subnetID = toString((*cluster.AgentPoolProfiles)[0].VnetSubnetID)
+	}
+
+	profile, err := getAgentPoolProfile(nodeGroupReqInfo, subnetID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	profile.Mode = containerservice.User
+
+	future, err := clusterHandler.AgentPoolClient.CreateOrUpdate(clusterHandler.Ctx, CBResourceGroupName, clusterID, nodeGroupReqInfo.Name, containerservice.AgentPool{
+		ManagedClusterAgentPoolProfileProperties: profile,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NodeGroupInfo{}, err
+	}
+	err = future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.AgentPoolClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupReqInfo.Name)
+}
+
+func (clusterHandler *AzureClusterHandler) ListNodeGroup(clusterID string) ([]*irs.NodeGroupInfo, error) {
+	cluster, err := clusterHandler.Client.Get(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	nodeResourceGroup := ""
+	if cluster.ManagedClusterProperties != nil {
+		nodeResourceGroup = toString(cluster.NodeResourceGroup)
+	}
+
+	iter, err := clusterHandler.AgentPoolClient.ListComplete(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var nodeGroupList []*irs.NodeGroupInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(clusterHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		agentPool := iter.Value()
+		if agentPool.ManagedClusterAgentPoolProfileProperties == nil {
+			continue
+		}
+		nodeGroupInfo, err := clusterHandler.setterNodeGroup(toString(agentPool.Name), nodeResourceGroup, *agentPool.ManagedClusterAgentPoolProfileProperties)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupList = append(nodeGroupList, nodeGroupInfo)
+	}
+	return nodeGroupList, nil
+}
+
+// System Pool이 하나만 남은 경우 AKS에서 삭제를 거부함
+func (clusterHandler *AzureClusterHandler) RemoveNodeGroup(clusterID string, nodeGroupID string) (bool, error) {
+	future, err := clusterHandler.AgentPoolClient.Delete(clusterHandler.Ctx, CBResourceGroupName, clusterID, nodeGroupID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.AgentPoolClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Min/Max가 다르면 Cluster Autoscaler를 사용하고, 같으면 Desired 수로 고정
+func (clusterHandler *AzureClusterHandler) ChangeNodeGroupScaling(clusterID string, nodeGroupID string, desiredNodeSize int, minNodeSize int, maxNodeSize int) (irs.NodeGroupInfo, error) {
+	agentPool, err := clusterHandler.AgentPoolClient.Get(clusterHandler.Ctx, CBResourceGroupName, clusterID, nodeGroupID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	if agentPool.ManagedClusterAgentPoolProfileProperties == nil {
+		return irs.NodeGroupInfo{}, errors.New("failed to get agent pool " + nodeGroupID)
+	}
+
+	agentPool.Count = to.Int32Ptr(int32(desiredNodeSize))
+	if minNodeSize != maxNodeSize {
+		agentPool.EnableAutoScaling = to.BoolPtr(true)
+		agentPool.MinCount = to.Int32Ptr(int32(minNodeSize))
+		agentPool.MaxCount = to.Int32Ptr(int32(maxNodeSize))
+	} else {
+		agentPool.EnableAutoScaling = to.BoolPtr(false)
+		agentPool.MinCount = nil
+		agentPool.MaxCount = nil
+	}
+
+	future, err := clusterHandler.AgentPoolClient.CreateOrUpdate(clusterHandler.Ctx, CBResourceGroupName, clusterID, nodeGroupID, agentPool)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NodeGroupInfo{}, err
+	}
+	err = future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.AgentPoolClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupID)
+}
+
+// Control Plane과 모든 Agent Pool의 Kubernetes 버전을 함께 변경
+func (clusterHandler *AzureClusterHandler) UpgradeCluster(clusterID string, newVersion string) (irs.ClusterInfo, error) {
+	cluster, err := clusterHandler.Client.Get(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	if cluster.ManagedClusterProperties == nil {
+		return irs.ClusterInfo{}, errors.New("failed to get managed cluster " + clusterID)
+	}
+
+	cluster.KubernetesVersion = to.StringPtr(newVersion)
+	if cluster.AgentPoolProfiles != nil {
+		for i := range *cluster.AgentPoolProfiles {
+			(*cluster.AgentPoolProfiles)[i].OrchestratorVersion = to.StringPtr(newVersion)
+		}
+	}
+
+	future, err := clusterHandler.Client.CreateOrUpdate(clusterHandler.Ctx, CBResourceGroupName, clusterID, cluster)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ClusterInfo{}, err
+	}
+	err = future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ClusterInfo{}, err
+	}
+
+	return clusterHandler.GetCluster(clusterID)
+}
+
+func (clusterHandler *AzureClusterHandler) GetKubeConfig(clusterID string) (string, error) {
+	result, err := clusterHandler.Client.ListClusterUserCredentials(clusterHandler.Ctx, CBResourceGroupName, clusterID)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if result.Kubeconfigs == nil || len(*result.Kubeconfigs) == 0 || (*result.Kubeconfigs)[0].Value == nil {
+		return "", errors.New("failed to get kubeconfig of " + clusterID)
+	}
+	return string(*(*result.Kubeconfigs)[0].Value), nil
+}
+
+func (clusterHandler *AzureClusterHandler) getNodeGroup(clusterID string, nodeGroupID string) (irs.NodeGroupInfo, error) {
+	nodeGroupList, err := clusterHandler.ListNodeGroup(clusterID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	for _, nodeGroupInfo := range nodeGroupList {
+		if nodeGroupInfo.Id == nodeGroupID {
+			return *nodeGroupInfo, nil
+		}
+	}
+	return irs.NodeGroupInfo{}, errors.New("failed to get agent pool " + nodeGroupID)
+}
+
+// Agent Pool의 VMSS는 Node Resource Group에 poolName 태그와 함께 생성됨
+func (clusterHandler *AzureClusterHandler) getNodes(nodeResourceGroup string, poolName string) ([]string, error) {
+	vmssIter, err := clusterHandler.VMSSClient.ListComplete(clusterHandler.Ctx, nodeResourceGroup)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var nodes []string
+	for ; vmssIter.NotDone(); err = vmssIter.NextWithContext(clusterHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		vmss := vmssIter.Value()
+		if tag, ok := vmss.Tags["poolName"]; !ok || toString(tag) != poolName {
+			continue
+		}
+
+		vmIter, err := clusterHandler.VMSSVMClient.ListComplete(clusterHandler.Ctx, nodeResourceGroup, toString(vmss.Name), "", "", "")
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for ; vmIter.NotDone(); err = vmIter.NextWithContext(clusterHandler.Ctx) {
+			if err != nil {
+				cblogger.Error(err)
+				return nil, err
+			}
+			vm := vmIter.Value()
+			if vm.VirtualMachineScaleSetVMProperties != nil && vm.OsProfile != nil {
+				nodes = append(nodes, toString(vm.OsProfile.ComputerName))
+			} else {
+				nodes = append(nodes, toString(vm.Name))
+			}
+		}
+	}
+	return nodes, nil
+}
+
+func (clusterHandler *AzureClusterHandler) attachSecurityGroup(vpcID string, subnet network.Subnet, securityGroupID string) error {
+	securityGroup, err := clusterHandler.SecurityGroupClient.Get(clusterHandler.Ctx, CBResourceGroupName, securityGroupID, "")
+	if err != nil {
+		return err
+	}
+	if subnet.SubnetPropertiesFormat == nil {
+		subnet.SubnetPropertiesFormat = &network.SubnetPropertiesFormat{}
+	}
+	subnet.NetworkSecurityGroup = &network.SecurityGroup{ID: securityGroup.ID}
+
+	future, err := clusterHandler.SubnetClient.CreateOrUpdate(clusterHandler.Ctx, CBResourceGroupName, vpcID, toString(subnet.Name), subnet)
+	if err != nil {
+		return err
+	}
+	return future.WaitForCompletionRef(clusterHandler.Ctx, clusterHandler.SubnetClient.Client)
+}
+
+func getAgentPoolProfile(nodeGroupReqInfo irs.NodeGroupReqInfo, subnetID string) (*containerservice.ManagedClusterAgentPoolProfileProperties, error) {
+	if !agentPoolNameRegexp.MatchString(nodeGroupReqInfo.Name) {
+		return nil, errors.New("invalid agent pool name " + nodeGroupReqInfo.Name + ", must be lowercase alphanumeric and up to " + strconv.Itoa(CBAgentPoolNameLimit) + " characters")
+	}
+
+	profile := &containerservice.ManagedClusterAgentPoolProfileProperties{
+		Count:  to.Int32Ptr(int32(nodeGroupReqInfo.DesiredNodeSize)),
+		VMSize: containerservice.VMSizeTypes(nodeGroupReqInfo.VMSpecName),
+		OsType: containerservice.Linux,
+		Type:   containerservice.VirtualMachineScaleSets,
+	}
+	if subnetID != "" {
+		profile.VnetSubnetID = to.StringPtr(subnetID)
+	}
+	if nodeGroupReqInfo.RootDiskSize != "" {
+		diskSize, err := strconv.Atoi(nodeGroupReqInfo.RootDiskSize)
+		if err != nil {
+			return nil, errors.New("invalid root disk size " + nodeGroupReqInfo.RootDiskSize)
+		}
+		profile.OsDiskSizeGB = to.Int32Ptr(int32(diskSize))
+	}
+	if nodeGroupReqInfo.OnAutoScaling {
+		profile.EnableAutoScaling = to.BoolPtr(true)
+		profile.MinCount = to.Int32Ptr(int32(nodeGroupReqInfo.MinNodeSize))
+		profile.MaxCount = to.Int32Ptr(int32(nodeGroupReqInfo.MaxNodeSize))
+	}
+	return profile, nil
+}
+
+func getClusterStatus(provisioningState string) irs.ClusterStatus {
+	switch provisioningState {
+	case "Creating":
+		return irs.ClusterCreating
+	case "Succeeded":
+		return irs.ClusterActive
+	case "Updating", "Upgrading", "Scaling":
+		return irs.ClusterUpdating
+	case "Deleting":
+		return irs.ClusterDeleting
+	default:
+		return irs.ClusterFailed
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	keyvault "github.com/cloud-barista/cb-spider/cloud-control-manager/key-vault"
 	"io"
-	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -17,7 +17,8 @@ const (
 	CBVirutalNetworkName = "CB-VNet"
 	CBVnetDefaultCidr    = "130.0.0.0/16"
 	CBVMUser             = "cb-user"
-	CBKeyPairPath        = "/cloud-control-manager/cloud-driver/driver-libs/.ssh-azure/"
+	CBKeyPairPath        = "/cloud-control-manager/cloud-driver/driver-libs/.ssh-azure/" // 기존 KeyPair 파일 경로, Key Vault로 이동됨
+	CBKeyVaultDomain     = "azure"
 )
 
 // 서브넷 CIDR 생성 (CIDR C class 기준 생성)
@@ -60,18 +61,30 @@ func CreateHashString(credentialInfo idrv.CredentialInfo) (string, error) {
 	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
 }
 
+// Key Vault의 KeyPair Scope(Credential 해시) 조회, 기존 KeyPair 파일이 있으면 Key Vault로 이동
+func GetKeyVaultScope(credentialInfo idrv.CredentialInfo) (string, error) {
+	hashString, err := CreateHashString(credentialInfo)
+	if err != nil {
+		return "", err
+	}
+
+	err = keyvault.MigrateKeyFiles(CBKeyVaultDomain, hashString, os.Getenv("CBSPIDER_ROOT")+CBKeyPairPath)
+	if err != nil {
+		return "", err
+	}
+	return hashString, nil
+}
+
 // Public KeyPair 정보 가져오기
 func GetPublicKey(credentialInfo idrv.CredentialInfo, keyPairName string) (string, error) {
-	keyPairPath := os.Getenv("CBSPIDER_ROOT") + CBKeyPairPath
-	hashString, err := CreateHashString(credentialInfo)
+	scope, err := GetKeyVaultScope(credentialInfo)
 	if err != nil {
 		return "", err
 	}
 
-	publicKeyPath := keyPairPath + hashString + "--" + keyPairName + ".pub"
-	publicKeyBytes, err := ioutil.ReadFile(publicKeyPath)
+	keyInfo, err := keyvault.GetKey(CBKeyVaultDomain, scope, keyPairName)
 	if err != nil {
 		return "", err
 	}
-	return string(publicKeyBytes), nil
+	return keyInfo.PublicKey, nil
 }
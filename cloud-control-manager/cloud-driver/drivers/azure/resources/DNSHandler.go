@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBDNSDefaultTTL     = 300 // seconds
+	CBDNSZoneLocation   = "global"
+	CBDNSDescriptionTag = "Description"
+)
+
+// DNS Zone 이름(도메인 이름)을 ID로 사용, Record Set 이름은 Zone 기준의 상대 이름(@: Zone 자체)
+// Azure DNS는 변경 사항이 요청 완료 시점에 모든 Name Server에 반영되므로 별도의 Change ID가 없음
+type AzureDNSHandler struct {
+	Region          idrv.RegionInfo
+	Ctx             context.Context
+	Client          *dns.ZonesClient
+	RecordSetClient *dns.RecordSetsClient
+}
+
+func setterZone(zone dns.Zone) *irs.DNSZoneInfo {
+	zoneInfo := &irs.DNSZoneInfo{
+		Id:   toString(zone.Name),
+		Name: toString(zone.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(zone.ID)},
+		},
+	}
+	if description, ok := zone.Tags[CBDNSDescriptionTag]; ok {
+		zoneInfo.Description = toString(description)
+	}
+	if zone.ZoneProperties != nil {
+		if zone.NameServers != nil {
+			zoneInfo.NameServers = *zone.NameServers
+		}
+		zoneInfo.KeyValueList = append(zoneInfo.KeyValueList, irs.KeyValue{Key: "ZoneType", Value: string(zone.ZoneType)})
+	}
+	return zoneInfo
+}
+
+func setterRecordSet(recordSet dns.RecordSet) *irs.DNSRecordInfo {
+	recordInfo := &irs.DNSRecordInfo{
+		Name:         toString(recordSet.Name),
+		Type:         irs.DNSRecordType(getNameFromID(toString(recordSet.Type))),
+		ChangeStatus: irs.DNSChangeInSync,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceId", Value: toString(recordSet.ID)},
+			{Key: "Etag", Value: toString(recordSet.Etag)},
+		},
+	}
+	if recordSet.RecordSetProperties == nil {
+		return recordInfo
+	}
+	if recordSet.TTL != nil {
+		recordInfo.TTL = *recordSet.TTL
+	}
+	if recordSet.ARecords != nil {
+		for _, record := range *recordSet.ARecords {
+			recordInfo.Values = append(recordInfo.Values, toString(record.Ipv4Address))
+		}
+	}
+	if recordSet.AaaaRecords != nil {
+		for _, record := range *recordSet.AaaaRecords {
+			recordInfo.Values = append(recordInfo.Values, toString(record.Ipv6Address))
+		}
+	}
+	if recordSet.CnameRecord != nil {
+		recordInfo.Values = append(recordInfo.Values, toString(recordSet.CnameRecord.Cname))
+	}
+	if recordSet.TxtRecords != nil {
+		for _, record := range *recordSet.TxtRecords {
+			if record.Value != nil {
+				recordInfo.Values = append(recordInfo.Values, strings.Join(*record.Value, ""))
+			}
+		}
+	}
+	return recordInfo
+}
+
+func (dnsHandler *AzureDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	zone := dns.Zone{
+		Location: to.StringPtr(CBDNSZoneLocation),
+		ZoneProperties: &dns.ZoneProperties{
+			ZoneType: dns.Public,
+		},
+	}
+	if zoneReqInfo.Description != "" {
+		zone.Tags = map[string]*string{
+			CBDNSDescriptionTag: to.StringPtr(zoneReqInfo.Description),
+		}
+	}
+
+	// If-None-Match: * 로 이미 존재하는 Zone은 덮어쓰지 않음
+	result, err := dnsHandler.Client.CreateOrUpdate(dnsHandler.Ctx, CBResourceGroupName, zoneReqInfo.Name, zone, "", "*")
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSZoneInfo{}, err
+	}
+
+	zoneInfo := setterZone(result)
+	return *zoneInfo, nil
+}
+
+func (dnsHandler *AzureDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	iter, err := dnsHandler.Client.ListByResourceGroupComplete(dnsHandler.Ctx, CBResourceGroupName, nil)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var zoneList []*irs.DNSZoneInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(dnsHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		zoneList = append(zoneList, setterZone(iter.Value()))
+	}
+	return zoneList, nil
+}
+
+func (dnsHandler *AzureDNSHandler) GetZone(zoneID string) (irs.DNSZoneInfo, error) {
+	zone, err := dnsHandler.Client.Get(dnsHandler.Ctx, CBResourceGroupName, zoneID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSZoneInfo{}, err
+	}
+
+	zoneInfo := setterZone(zone)
+	return *zoneInfo, nil
+}
+
+// Zone을 삭제하면 Zone의 모든 Record Set도 함께 삭제됨
+func (dnsHandler *AzureDNSHandler) DeleteZone(zoneID string) (bool, error) {
+	future, err := dnsHandler.Client.Delete(dnsHandler.Ctx, CBResourceGroupName, zoneID, "")
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(dnsHandler.Ctx, dnsHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *AzureDNSHandler) CreateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	// If-None-Match: * 로 이미 존재하는 Record Set은 덮어쓰지 않음
+	return dnsHandler.putRecordSet(zoneID, recordReqInfo, "", "*")
+}
+
+func (dnsHandler *AzureDNSHandler) ListRecord(zoneID string) ([]*irs.DNSRecordInfo, error) {
+	iter, err := dnsHandler.RecordSetClient.ListByDNSZoneComplete(dnsHandler.Ctx, CBResourceGroupName, zoneID, nil, "")
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var recordList []*irs.DNSRecordInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(dnsHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		recordInfo := setterRecordSet(iter.Value())
+		switch recordInfo.Type {
+		case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordCNAME, irs.DNSRecordTXT:
+			recordList = append(recordList, recordInfo)
+		}
+	}
+	return recordList, nil
+}
+
+func (dnsHandler *AzureDNSHandler) UpdateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	// If-Match: * 로 존재하는 Record Set만 변경
+	return dnsHandler.putRecordSet(zoneID, recordReqInfo, "*", "")
+}
+
+func (dnsHandler *AzureDNSHandler) DeleteRecord(zoneID string, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	_, err := dnsHandler.RecordSetClient.Delete(dnsHandler.Ctx, CBResourceGroupName, zoneID, getRecordSetName(recordName), dns.RecordType(recordType), "")
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *AzureDNSHandler) WaitForDNSChange(zoneID string, changeID string) (irs.DNSChangeStatus, error) {
+	return irs.DNSChangeInSync, nil
+}
+
+func (dnsHandler *AzureDNSHandler) putRecordSet(zoneID string, recordReqInfo irs.DNSRecordReqInfo, ifMatch string, ifNoneMatch string) (irs.DNSRecordInfo, error) {
+	if len(recordReqInfo.Values) == 0 {
+		return irs.DNSRecordInfo{}, errors.New("Values is required")
+	}
+
+	ttl := recordReqInfo.TTL
+	if ttl == 0 {
+		ttl = CBDNSDefaultTTL
+	}
+	properties := &dns.RecordSetProperties{
+		TTL: to.Int64Ptr(ttl),
+	}
+	switch recordReqInfo.Type {
+	case irs.DNSRecordA:
+		var records []dns.ARecord
+		for _, value := range recordReqInfo.Values {
+			records = append(records, dns.ARecord{Ipv4Address: to.StringPtr(value)})
+		}
+		properties.ARecords = &records
+	case irs.DNSRecordAAAA:
+		var records []dns.AaaaRecord
+		for _, value := range recordReqInfo.Values {
+			records = append(records, dns.AaaaRecord{Ipv6Address: to.StringPtr(value)})
+		}
+		properties.AaaaRecords = &records
+	case irs.DNSRecordCNAME:
+		if len(recordReqInfo.Values) != 1 {
+			return irs.DNSRecordInfo{}, errors.New("CNAME record requires only one value")
+		}
+		properties.CnameRecord = &dns.CnameRecord{Cname: to.StringPtr(recordReqInfo.Values[0])}
+	case irs.DNSRecordTXT:
+		var records []dns.TxtRecord
+		for _, value := range recordReqInfo.Values {
+			records = append(records, dns.TxtRecord{Value: &[]string{value}})
+		}
+		properties.TxtRecords = &records
+	default:
+		errMsg := fmt.Sprintf("Type %s is not supported", recordReqInfo.Type)
+		return irs.DNSRecordInfo{}, errors.New(errMsg)
+	}
+
+	recordSet, err := dnsHandler.RecordSetClient.CreateOrUpdate(dnsHandler.Ctx, CBResourceGroupName, zoneID, getRecordSetName(recordReqInfo.Name), dns.RecordType(recordReqInfo.Type), dns.RecordSet{
+		RecordSetProperties: properties,
+	}, ifMatch, ifNoneMatch)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSRecordInfo{}, err
+	}
+
+	recordInfo := setterRecordSet(recordSet)
+	return *recordInfo, nil
+}
+
+func getRecordSetName(recordName string) string {
+	if recordName == "" {
+		return irs.DNSZoneApex
+	}
+	return recordName
+}
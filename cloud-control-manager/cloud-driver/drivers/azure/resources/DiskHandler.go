@@ -0,0 +1,286 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AzureDiskHandler struct {
+	Region   idrv.RegionInfo
+	Ctx      context.Context
+	Client   *compute.DisksClient
+	VMClient *compute.VirtualMachinesClient
+}
+
+// Managed Disk는 VM과 같이 이름을 ID로 사용
+func setterDisk(disk compute.Disk) *irs.DiskInfo {
+	diskInfo := &irs.DiskInfo{
+		Id:   toString(disk.Name),
+		Name: toString(disk.Name),
+	}
+	if disk.Sku != nil {
+		diskInfo.DiskType = string(disk.Sku.Name)
+	}
+	if disk.Zones != nil && len(*disk.Zones) > 0 {
+		diskInfo.Zone = (*disk.Zones)[0]
+	}
+	if disk.ManagedBy != nil {
+		// ex) /subscriptions/.../resourceGroups/CB-GROUP/providers/Microsoft.Compute/virtualMachines/CB-VM
+		vmIdArr := strings.Split(*disk.ManagedBy, "/")
+		diskInfo.OwnerVMId = vmIdArr[len(vmIdArr)-1]
+	}
+
+	var provisioningState string
+	if disk.DiskProperties != nil {
+		diskInfo.DiskSize = toInt32String(disk.DiskSizeGB)
+		if disk.TimeCreated != nil {
+			diskInfo.CreatedTime = disk.TimeCreated.Time
+		}
+		provisioningState = toString(disk.ProvisioningState)
+	}
+	diskInfo.Status = getDiskStatus(provisioningState, diskInfo.OwnerVMId)
+
+	diskInfo.KeyValueList = []irs.KeyValue{
+		{Key: "ResourceId", Value: toString(disk.ID)},
+		{Key: "Location", Value: toString(disk.Location)},
+		{Key: "ProvisioningState", Value: provisioningState},
+	}
+	return diskInfo
+}
+
+// ProvisioningState : Creating | Updating | Succeeded | Failed | Deleting
+func getDiskStatus(provisioningState string, ownerVMId string) irs.DiskStatus {
+	switch provisioningState {
+	case "Creating":
+		return irs.DiskCreating
+	case "Deleting":
+		return irs.DiskDeleting
+	case "Failed":
+		return irs.DiskError
+	}
+	if ownerVMId != "" {
+		return irs.DiskAttached
+	}
+	return irs.DiskAvailable
+}
+
+func (diskHandler *AzureDiskHandler) CreateDisk(diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	size, err := strconv.Atoi(diskReqInfo.DiskSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("DiskSize %s is not a valid number", diskReqInfo.DiskSize)
+		return irs.DiskInfo{}, errors.New(errMsg)
+	}
+
+	diskType := compute.StandardLRS
+	if diskReqInfo.DiskType != "" {
+		diskType = compute.DiskStorageAccountTypes(diskReqInfo.DiskType)
+	}
+
+	tags, err := getterTags(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	diskOpts := compute.Disk{
+		Location: &diskHandler.Region.Region,
+		Sku:      &compute.DiskSku{Name: diskType},
+		Tags:     tags,
+		DiskProperties: &compute.DiskProperties{
+			CreationData: &compute.CreationData{CreateOption: compute.Empty},
+			DiskSizeGB:   to.Int32Ptr(int32(size)),
+		},
+	}
+	if diskReqInfo.Zone != "" {
+		diskOpts.Zones = &[]string{diskReqInfo.Zone}
+	}
+
+	future, err := diskHandler.Client.CreateOrUpdate(diskHandler.Ctx, CBResourceGroupName, diskReqInfo.Name, diskOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	err = future.WaitForCompletionRef(diskHandler.Ctx, diskHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.GetDisk(diskReqInfo.Name)
+}
+
+func (diskHandler *AzureDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
+	iter, err := diskHandler.Client.ListByResourceGroupComplete(diskHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var diskList []*irs.DiskInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(diskHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		diskInfo := setterDisk(iter.Value())
+		diskList = append(diskList, diskInfo)
+	}
+	return diskList, nil
+}
+
+func (diskHandler *AzureDiskHandler) GetDisk(diskID string) (irs.DiskInfo, error) {
+	disk, err := diskHandler.Client.Get(diskHandler.Ctx, CBResourceGroupName, diskID)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	diskInfo := setterDisk(disk)
+	return *diskInfo, nil
+}
+
+// Resize는 디스크가 연결된 VM이 중지(Deallocated)된 상태에서만 가능
+func (diskHandler *AzureDiskHandler) ChangeDiskSize(diskID string, size string) (bool, error) {
+	newSize, err := strconv.Atoi(size)
+	if err != nil {
+		errMsg := fmt.Sprintf("size %s is not a valid number", size)
+		return false, errors.New(errMsg)
+	}
+
+	updateOpts := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{
+			DiskSizeGB: to.Int32Ptr(int32(newSize)),
+		},
+	}
+	future, err := diskHandler.Client.Update(diskHandler.Ctx, CBResourceGroupName, diskID, updateOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(diskHandler.Ctx, diskHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AzureDiskHandler) DeleteDisk(diskID string) (bool, error) {
+	future, err := diskHandler.Client.Delete(diskHandler.Ctx, CBResourceGroupName, diskID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(diskHandler.Ctx, diskHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// VM의 DataDisks에 사용하지 않는 LUN으로 추가 후 VM 정보를 갱신
+func (diskHandler *AzureDiskHandler) AttachDisk(diskID string, vmID string) (irs.DiskInfo, error) {
+	disk, err := diskHandler.Client.Get(diskHandler.Ctx, CBResourceGroupName, diskID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	vm, err := diskHandler.VMClient.Get(diskHandler.Ctx, CBResourceGroupName, vmID, "")
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil {
+		errMsg := fmt.Sprintf("VM %s has no storage profile", vmID)
+		return irs.DiskInfo{}, errors.New(errMsg)
+	}
+
+	var dataDisks []compute.DataDisk
+	if vm.StorageProfile.DataDisks != nil {
+		dataDisks = *vm.StorageProfile.DataDisks
+	}
+	usedLun := map[int32]bool{}
+	for _, dataDisk := range dataDisks {
+		if dataDisk.Lun != nil {
+			usedLun[*dataDisk.Lun] = true
+		}
+	}
+	var lun int32
+	for usedLun[lun] {
+		lun++
+	}
+
+	dataDisks = append(dataDisks, compute.DataDisk{
+		Lun:          to.Int32Ptr(lun),
+		Name:         disk.Name,
+		CreateOption: compute.DiskCreateOptionTypesAttach,
+		ManagedDisk:  &compute.ManagedDiskParameters{ID: disk.ID},
+	})
+	vm.StorageProfile.DataDisks = &dataDisks
+
+	if err := diskHandler.updateVM(vmID, vm); err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return diskHandler.GetDisk(diskID)
+}
+
+func (diskHandler *AzureDiskHandler) DetachDisk(diskID string, vmID string) (bool, error) {
+	vm, err := diskHandler.VMClient.Get(diskHandler.Ctx, CBResourceGroupName, vmID, "")
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil || vm.StorageProfile.DataDisks == nil {
+		errMsg := fmt.Sprintf("Disk %s is not attached to VM %s", diskID, vmID)
+		return false, errors.New(errMsg)
+	}
+
+	var dataDisks []compute.DataDisk
+	for _, dataDisk := range *vm.StorageProfile.DataDisks {
+		if strings.EqualFold(toString(dataDisk.Name), diskID) {
+			continue
+		}
+		dataDisks = append(dataDisks, dataDisk)
+	}
+	if len(dataDisks) == len(*vm.StorageProfile.DataDisks) {
+		errMsg := fmt.Sprintf("Disk %s is not attached to VM %s", diskID, vmID)
+		return false, errors.New(errMsg)
+	}
+	vm.StorageProfile.DataDisks = &dataDisks
+
+	if err := diskHandler.updateVM(vmID, vm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *AzureDiskHandler) updateVM(vmID string, vm compute.VirtualMachine) error {
+	future, err := diskHandler.VMClient.CreateOrUpdate(diskHandler.Ctx, CBResourceGroupName, vmID, vm)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	err = future.WaitForCompletionRef(diskHandler.Ctx, diskHandler.VMClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	natnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBNATGatewayVPCTag         = "VPCId"
+	CBNATGatewayPublicIPSuffix = "-PublicIP"
+)
+
+// NAT Gateway는 2019-06-01 API부터 지원되므로 해당 버전의 Client를 사용
+// (NAT Gateway와 함께 사용하는 Public IP는 Standard SKU만 허용)
+type AzureNATGatewayHandler struct {
+	Region         idrv.RegionInfo
+	Ctx            context.Context
+	Client         *natnetwork.NatGatewaysClient
+	PublicIPClient *natnetwork.PublicIPAddressesClient
+	SubnetClient   *natnetwork.SubnetsClient
+}
+
+// NAT Gateway 이름을 ID로 사용, VPC(Virtual Network) 이름은 Tag에 저장
+func (natHandler *AzureNATGatewayHandler) setterNATGateway(natGateway natnetwork.NatGateway) *irs.NATGatewayInfo {
+	natGatewayInfo := &irs.NATGatewayInfo{
+		Id:   toString(natGateway.Name),
+		Name: toString(natGateway.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(natGateway.ID)},
+		},
+	}
+	if vpcID, ok := natGateway.Tags[CBNATGatewayVPCTag]; ok {
+		natGatewayInfo.VPCId = toString(vpcID)
+	}
+
+	if natGateway.NatGatewayPropertiesFormat == nil {
+		return natGatewayInfo
+	}
+	natGatewayInfo.Status = toString(natGateway.ProvisioningState)
+	if natGateway.PublicIPAddresses != nil {
+		for _, address := range *natGateway.PublicIPAddresses {
+			publicIP, err := natHandler.PublicIPClient.Get(natHandler.Ctx, CBResourceGroupName, getNameFromID(toString(address.ID)), "")
+			if err != nil {
+				cblogger.Error(err)
+				continue
+			}
+			if publicIP.PublicIPAddressPropertiesFormat != nil {
+				natGatewayInfo.PublicIP = toString(publicIP.IPAddress)
+			}
+		}
+	}
+	if natGateway.Subnets != nil {
+		for _, subnet := range *natGateway.Subnets {
+			natGatewayInfo.SubnetIdList = append(natGatewayInfo.SubnetIdList, getNameFromID(toString(subnet.ID)))
+		}
+	}
+	return natGatewayInfo
+}
+
+func (natHandler *AzureNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayReqInfo) (irs.NATGatewayInfo, error) {
+	if natGatewayReqInfo.VPCId == "" {
+		return irs.NATGatewayInfo{}, errors.New("VPCId is required to create the NAT gateway")
+	}
+
+	// Check NAT Gateway Exists
+	natGateway, _ := natHandler.Client.Get(natHandler.Ctx, CBResourceGroupName, natGatewayReqInfo.Name, "")
+	if natGateway.ID != nil {
+		errMsg := fmt.Sprintf("NAT Gateway with name %s already exist", natGatewayReqInfo.Name)
+		createErr := errors.New(errMsg)
+		return irs.NATGatewayInfo{}, createErr
+	}
+
+	// NAT Gateway에서 사용할 Public IP 생성
+	publicIPName := natGatewayReqInfo.Name + CBNATGatewayPublicIPSuffix
+	publicIPOpts := natnetwork.PublicIPAddress{
+		Name: to.StringPtr(publicIPName),
+		Sku: &natnetwork.PublicIPAddressSku{
+			Name: natnetwork.PublicIPAddressSkuNameStandard,
+		},
+		PublicIPAddressPropertiesFormat: &natnetwork.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   natnetwork.IPv4,
+			PublicIPAllocationMethod: natnetwork.Static,
+		},
+		Location: &natHandler.Region.Region,
+	}
+	ipFuture, err := natHandler.PublicIPClient.CreateOrUpdate(natHandler.Ctx, CBResourceGroupName, publicIPName, publicIPOpts)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	err = ipFuture.WaitForCompletionRef(natHandler.Ctx, natHandler.PublicIPClient.Client)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	publicIP, err := natHandler.PublicIPClient.Get(natHandler.Ctx, CBResourceGroupName, publicIPName, "")
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// NAT Gateway 생성
+	createOpts := natnetwork.NatGateway{
+		Sku: &natnetwork.NatGatewaySku{
+			Name: natnetwork.Standard,
+		},
+		NatGatewayPropertiesFormat: &natnetwork.NatGatewayPropertiesFormat{
+			PublicIPAddresses: &[]natnetwork.SubResource{{ID: publicIP.ID}},
+		},
+		Location: &natHandler.Region.Region,
+		Tags: map[string]*string{
+			CBNATGatewayVPCTag: to.StringPtr(natGatewayReqInfo.VPCId),
+		},
+	}
+	future, err := natHandler.Client.CreateOrUpdate(natHandler.Ctx, CBResourceGroupName, natGatewayReqInfo.Name, createOpts)
+	if err != nil {
+		natHandler.deletePublicIP(publicIPName)
+		return irs.NATGatewayInfo{}, err
+	}
+	err = future.WaitForCompletionRef(natHandler.Ctx, natHandler.Client.Client)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// 서브넷 정보가 있으면 생성 시 연결
+	if natGatewayReqInfo.SubnetId != "" {
+		return natHandler.AssociateNATGateway(natGatewayReqInfo.Name, irs.NATAssociationInfo{SubnetId: natGatewayReqInfo.SubnetId})
+	}
+	return natHandler.GetNATGateway(natGatewayReqInfo.Name)
+}
+
+func (natHandler *AzureNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	result, err := natHandler.Client.List(natHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	var natGatewayList []*irs.NATGatewayInfo
+	for _, natGateway := range result.Values() {
+		natGatewayInfo := natHandler.setterNATGateway(natGateway)
+		natGatewayList = append(natGatewayList, natGatewayInfo)
+	}
+	return natGatewayList, nil
+}
+
+func (natHandler *AzureNATGatewayHandler) GetNATGateway(natGatewayID string) (irs.NATGatewayInfo, error) {
+	natGateway, err := natHandler.Client.Get(natHandler.Ctx, CBResourceGroupName, natGatewayID, "")
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	natGatewayInfo := natHandler.setterNATGateway(natGateway)
+	return *natGatewayInfo, nil
+}
+
+// 서브넷에 연결된 NAT Gateway는 삭제할 수 없으므로 먼저 연결을 해제하고, 삭제 후 Public IP도 함께 삭제
+func (natHandler *AzureNATGatewayHandler) DeleteNATGateway(natGatewayID string) (bool, error) {
+	natGateway, err := natHandler.Client.Get(natHandler.Ctx, CBResourceGroupName, natGatewayID, "")
+	if err != nil {
+		return false, err
+	}
+
+	var publicIPNameList []string
+	if natGateway.NatGatewayPropertiesFormat != nil {
+		if natGateway.Subnets != nil {
+			for _, subnet := range *natGateway.Subnets {
+				vpcID, subnetID := getVNetSubnetName(toString(subnet.ID))
+				if err := natHandler.updateSubnetNATGateway(vpcID, subnetID, nil); err != nil {
+					return false, err
+				}
+			}
+		}
+		if natGateway.PublicIPAddresses != nil {
+			for _, address := range *natGateway.PublicIPAddresses {
+				publicIPNameList = append(publicIPNameList, getNameFromID(toString(address.ID)))
+			}
+		}
+	}
+
+	future, err := natHandler.Client.Delete(natHandler.Ctx, CBResourceGroupName, natGatewayID)
+	if err != nil {
+		return false, err
+	}
+	err = future.WaitForCompletionRef(natHandler.Ctx, natHandler.Client.Client)
+	if err != nil {
+		return false, err
+	}
+
+	for _, publicIPName := range publicIPNameList {
+		natHandler.deletePublicIP(publicIPName)
+	}
+	return true, nil
+}
+
+// Azure NAT Gateway는 서브넷에 직접 연결되며 Route Table을 사용하지 않음
+func (natHandler *AzureNATGatewayHandler) AssociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (irs.NATGatewayInfo, error) {
+	if associationInfo.SubnetId == "" {
+		return irs.NATGatewayInfo{}, errors.New("Azure NAT gateway is associated with the subnet, SubnetId is required")
+	}
+
+	natGateway, err := natHandler.Client.Get(natHandler.Ctx, CBResourceGroupName, natGatewayID, "")
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	vpcID := toString(natGateway.Tags[CBNATGatewayVPCTag])
+
+	err = natHandler.updateSubnetNATGateway(vpcID, associationInfo.SubnetId, &natnetwork.SubResource{ID: natGateway.ID})
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.GetNATGateway(natGatewayID)
+}
+
+func (natHandler *AzureNATGatewayHandler) DisassociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (bool, error) {
+	if associationInfo.SubnetId == "" {
+		return false, errors.New("Azure NAT gateway is associated with the subnet, SubnetId is required")
+	}
+
+	natGateway, err := natHandler.Client.Get(natHandler.Ctx, CBResourceGroupName, natGatewayID, "")
+	if err != nil {
+		return false, err
+	}
+	vpcID := toString(natGateway.Tags[CBNATGatewayVPCTag])
+
+	subnet, err := natHandler.SubnetClient.Get(natHandler.Ctx, CBResourceGroupName, vpcID, associationInfo.SubnetId, "")
+	if err != nil {
+		return false, err
+	}
+	if subnet.SubnetPropertiesFormat == nil || subnet.NatGateway == nil || !strings.EqualFold(toString(subnet.NatGateway.ID), toString(natGateway.ID)) {
+		return false, errors.New(fmt.Sprintf("subnet %s is not associated with the NAT gateway %s", associationInfo.SubnetId, natGatewayID))
+	}
+
+	err = natHandler.updateSubnetNATGateway(vpcID, associationInfo.SubnetId, nil)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (natHandler *AzureNATGatewayHandler) updateSubnetNATGateway(vpcID string, subnetID string, natGateway *natnetwork.SubResource) error {
+	if vpcID == "" {
+		return errors.New("VPCId of the NAT gateway is empty")
+	}
+
+	subnet, err := natHandler.SubnetClient.Get(natHandler.Ctx, CBResourceGroupName, vpcID, subnetID, "")
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	if subnet.SubnetPropertiesFormat == nil {
+		subnet.SubnetPropertiesFormat = &natnetwork.SubnetPropertiesFormat{}
+	}
+	subnet.NatGateway = natGateway
+
+	future, err := natHandler.SubnetClient.CreateOrUpdate(natHandler.Ctx, CBResourceGroupName, vpcID, subnetID, subnet)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	err = future.WaitForCompletionRef(natHandler.Ctx, natHandler.SubnetClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (natHandler *AzureNATGatewayHandler) deletePublicIP(publicIPName string) {
+	future, err := natHandler.PublicIPClient.Delete(natHandler.Ctx, CBResourceGroupName, publicIPName)
+	if err != nil {
+		cblogger.Error(err)
+		return
+	}
+	err = future.WaitForCompletionRef(natHandler.Ctx, natHandler.PublicIPClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+	}
+}
+
+// Resource ID 형식: /subscriptions/.../{resourceType}/{name}
+func getNameFromID(resourceID string) string {
+	return resourceID[strings.LastIndex(resourceID, "/")+1:]
+}
+
+// Subnet ID 형식: /subscriptions/.../virtualNetworks/{vNet}/subnets/{subnet}
+func getVNetSubnetName(subnetID string) (string, string) {
+	idArr := strings.Split(subnetID, "/")
+	if len(idArr) < 3 {
+		return "", getNameFromID(subnetID)
+	}
+	return idArr[len(idArr)-3], idArr[len(idArr)-1]
+}
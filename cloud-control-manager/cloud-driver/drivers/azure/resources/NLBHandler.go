@@ -0,0 +1,476 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBNLBVPCTag                      = "VPCId"
+	CBNLBPublicIPSuffix              = "-PublicIP"
+	CBNLBFrontendName                = "CB-Frontend"
+	CBNLBBackendPoolName             = "CB-BackendPool"
+	CBNLBProbeName                   = "CB-Probe"
+	CBNLBRuleName                    = "CB-Rule"
+	CBNLBDefaultHealthCheckInterval  = 15 // seconds
+	CBNLBDefaultHealthCheckThreshold = 2
+)
+
+// Load Balancer 이름을 ID로 사용, Frontend/Backend Pool/Probe/Rule은 각각 1개씩 생성
+// VM은 NIC의 IP Configuration을 Backend Pool에 추가하는 방식으로 연결
+type AzureNLBHandler struct {
+	Region         idrv.RegionInfo
+	Ctx            context.Context
+	Client         *network.LoadBalancersClient
+	PublicIPClient *network.PublicIPAddressesClient
+	SubnetClient   *network.SubnetsClient
+	NicClient      *network.InterfacesClient
+	VMClient       *compute.VirtualMachinesClient
+}
+
+func (nlbHandler *AzureNLBHandler) setterNLB(loadBalancer network.LoadBalancer) *irs.NLBInfo {
+	nlbInfo := &irs.NLBInfo{
+		Id:   toString(loadBalancer.Name),
+		Name: toString(loadBalancer.Name),
+		Type: irs.NLBPublic,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(loadBalancer.ID)},
+		},
+	}
+	if vpcID, ok := loadBalancer.Tags[CBNLBVPCTag]; ok {
+		nlbInfo.VPCId = toString(vpcID)
+	}
+	if loadBalancer.LoadBalancerPropertiesFormat == nil {
+		return nlbInfo
+	}
+	nlbInfo.KeyValueList = append(nlbInfo.KeyValueList, irs.KeyValue{Key: "ProvisioningState", Value: toString(loadBalancer.ProvisioningState)})
+
+	if loadBalancer.FrontendIPConfigurations != nil {
+		for _, frontend := range *loadBalancer.FrontendIPConfigurations {
+			if frontend.FrontendIPConfigurationPropertiesFormat == nil {
+				continue
+			}
+			if frontend.PublicIPAddress != nil {
+				publicIP, err := nlbHandler.PublicIPClient.Get(nlbHandler.Ctx, CBResourceGroupName, getNameFromID(toString(frontend.PublicIPAddress.ID)), "")
+				if err != nil {
+					cblogger.Error(err)
+				} else if publicIP.PublicIPAddressPropertiesFormat != nil {
+					nlbInfo.Listener.IP = toString(publicIP.IPAddress)
+				}
+			} else {
+				nlbInfo.Type = irs.NLBInternal
+				nlbInfo.Listener.IP = toString(frontend.PrivateIPAddress)
+			}
+		}
+	}
+	if loadBalancer.LoadBalancingRules != nil {
+		for _, rule := range *loadBalancer.LoadBalancingRules {
+			if rule.LoadBalancingRulePropertiesFormat == nil {
+				continue
+			}
+			nlbInfo.Listener.Protocol = strings.ToUpper(string(rule.Protocol))
+			nlbInfo.Listener.Port = toInt32String(rule.FrontendPort)
+			nlbInfo.VMGroup.Protocol = strings.ToUpper(string(rule.Protocol))
+			nlbInfo.VMGroup.Port = toInt32String(rule.BackendPort)
+		}
+	}
+	if loadBalancer.Probes != nil {
+		for _, probe := range *loadBalancer.Probes {
+			if probe.ProbePropertiesFormat == nil {
+				continue
+			}
+			nlbInfo.HealthChecker = irs.HealthCheckerInfo{
+				Protocol: strings.ToUpper(string(probe.Protocol)),
+				Port:     toInt32String(probe.Port),
+			}
+			if probe.IntervalInSeconds != nil {
+				nlbInfo.HealthChecker.Interval = int(*probe.IntervalInSeconds)
+			}
+			if probe.NumberOfProbes != nil {
+				nlbInfo.HealthChecker.Threshold = int(*probe.NumberOfProbes)
+			}
+		}
+	}
+	nlbInfo.VMGroup.VMs = nlbHandler.getBackendVMs(loadBalancer)
+	return nlbInfo
+}
+
+func (nlbHandler *AzureNLBHandler) CreateNLB(nlbReqInfo irs.NLBReqInfo) (irs.NLBInfo, error) {
+	if nlbReqInfo.VPCId == "" {
+		return irs.NLBInfo{}, errors.New("VPCId is required to create the load balancer")
+	}
+	listenerPort, err := strconv.Atoi(nlbReqInfo.Listener.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("invalid listener port " + nlbReqInfo.Listener.Port)
+	}
+	vmGroupPort, err := strconv.Atoi(nlbReqInfo.VMGroup.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("invalid VM group port " + nlbReqInfo.VMGroup.Port)
+	}
+
+	// Check Load Balancer Exists
+	loadBalancer, _ := nlbHandler.Client.Get(nlbHandler.Ctx, CBResourceGroupName, nlbReqInfo.Name, "")
+	if loadBalancer.ID != nil {
+		errMsg := fmt.Sprintf("Load Balancer with name %s already exist", nlbReqInfo.Name)
+		createErr := errors.New(errMsg)
+		return irs.NLBInfo{}, createErr
+	}
+
+	// Frontend IP 설정 (PUBLIC: Standard Public IP 생성, INTERNAL: VPC의 서브넷 사용)
+	frontendProperties := &network.FrontendIPConfigurationPropertiesFormat{}
+	if nlbReqInfo.Type == irs.NLBInternal {
+		subnetList, err := nlbHandler.SubnetClient.List(nlbHandler.Ctx, CBResourceGroupName, nlbReqInfo.VPCId)
+		if err != nil {
+			return irs.NLBInfo{}, err
+		}
+		if len(subnetList.Values()) < 1 {
+			return irs.NLBInfo{}, errors.New("subnet is not found in the VPC " + nlbReqInfo.VPCId)
+		}
+		subnet := subnetList.Values()[0]
+		frontendProperties.PrivateIPAllocationMethod = network.Dynamic
+		frontendProperties.Subnet = &network.Subnet{ID: subnet.ID}
+	} else {
+		publicIP, err := nlbHandler.createPublicIP(nlbReqInfo.Name + CBNLBPublicIPSuffix)
+		if err != nil {
+			return irs.NLBInfo{}, err
+		}
+		frontendProperties.PublicIPAddress = &network.PublicIPAddress{ID: publicIP.ID}
+	}
+
+	probeProtocol := network.ProbeProtocolTCP
+	if strings.ToUpper(nlbReqInfo.HealthChecker.Protocol) == "HTTP" {
+		probeProtocol = network.ProbeProtocolHTTP
+	}
+	probePort := vmGroupPort
+	if nlbReqInfo.HealthChecker.Port != "" {
+		if probePort, err = strconv.Atoi(nlbReqInfo.HealthChecker.Port); err != nil {
+			return irs.NLBInfo{}, errors.New("invalid health checker port " + nlbReqInfo.HealthChecker.Port)
+		}
+	}
+	probeProperties := &network.ProbePropertiesFormat{
+		Protocol:          probeProtocol,
+		Port:              to.Int32Ptr(int32(probePort)),
+		IntervalInSeconds: to.Int32Ptr(CBNLBDefaultHealthCheckInterval),
+		NumberOfProbes:    to.Int32Ptr(CBNLBDefaultHealthCheckThreshold),
+	}
+	if probeProtocol == network.ProbeProtocolHTTP {
+		probeProperties.RequestPath = to.StringPtr("/")
+	}
+	if nlbReqInfo.HealthChecker.Interval > 0 {
+		probeProperties.IntervalInSeconds = to.Int32Ptr(int32(nlbReqInfo.HealthChecker.Interval))
+	}
+	if nlbReqInfo.HealthChecker.Threshold > 0 {
+		probeProperties.NumberOfProbes = to.Int32Ptr(int32(nlbReqInfo.HealthChecker.Threshold))
+	}
+
+	// Rule은 Frontend/Backend Pool/Probe의 ID를 참조하므로 Load Balancer 생성 후 추가
+	createOpts := network.LoadBalancer{
+		Sku: &network.LoadBalancerSku{
+			Name: network.LoadBalancerSkuNameStandard,
+		},
+		LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: &[]network.FrontendIPConfiguration{
+				{Name: to.StringPtr(CBNLBFrontendName), FrontendIPConfigurationPropertiesFormat: frontendProperties},
+			},
+			BackendAddressPools: &[]network.BackendAddressPool{
+				{Name: to.StringPtr(CBNLBBackendPoolName)},
+			},
+			Probes: &[]network.Probe{
+				{Name: to.StringPtr(CBNLBProbeName), ProbePropertiesFormat: probeProperties},
+			},
+		},
+		Location: &nlbHandler.Region.Region,
+		Tags: map[string]*string{
+			CBNLBVPCTag: to.StringPtr(nlbReqInfo.VPCId),
+		},
+	}
+	loadBalancer, err = nlbHandler.createOrUpdate(nlbReqInfo.Name, createOpts)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	transportProtocol := network.TransportProtocolTCP
+	if strings.ToUpper(nlbReqInfo.Listener.Protocol) == "UDP" {
+		transportProtocol = network.TransportProtocolUDP
+	}
+	loadBalancer.LoadBalancingRules = &[]network.LoadBalancingRule{
+		{
+			Name: to.StringPtr(CBNLBRuleName),
+			LoadBalancingRulePropertiesFormat: &network.LoadBalancingRulePropertiesFormat{
+				FrontendIPConfiguration: &network.SubResource{ID: (*loadBalancer.FrontendIPConfigurations)[0].ID},
+				BackendAddressPool:      &network.SubResource{ID: (*loadBalancer.BackendAddressPools)[0].ID},
+				Probe:                   &network.SubResource{ID: (*loadBalancer.Probes)[0].ID},
+				Protocol:                transportProtocol,
+				FrontendPort:            to.Int32Ptr(int32(listenerPort)),
+				BackendPort:             to.Int32Ptr(int32(vmGroupPort)),
+			},
+		},
+	}
+	loadBalancer, err = nlbHandler.createOrUpdate(nlbReqInfo.Name, loadBalancer)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	if len(nlbReqInfo.VMGroup.VMs) > 0 {
+		if _, err := nlbHandler.AddVMs(nlbReqInfo.Name, nlbReqInfo.VMGroup.VMs); err != nil {
+			return irs.NLBInfo{}, err
+		}
+	}
+	return nlbHandler.GetNLB(nlbReqInfo.Name)
+}
+
+func (nlbHandler *AzureNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
+	result, err := nlbHandler.Client.List(nlbHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	var nlbList []*irs.NLBInfo
+	for _, loadBalancer := range result.Values() {
+		nlbInfo := nlbHandler.setterNLB(loadBalancer)
+		nlbList = append(nlbList, nlbInfo)
+	}
+	return nlbList, nil
+}
+
+func (nlbHandler *AzureNLBHandler) GetNLB(nlbID string) (irs.NLBInfo, error) {
+	loadBalancer, err := nlbHandler.Client.Get(nlbHandler.Ctx, CBResourceGroupName, nlbID, "")
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	nlbInfo := nlbHandler.setterNLB(loadBalancer)
+	return *nlbInfo, nil
+}
+
+// Backend Pool에 연결된 VM이 있으면 삭제할 수 없으므로 먼저 VM을 제거하고, 삭제 후 Public IP도 함께 삭제
+func (nlbHandler *AzureNLBHandler) DeleteNLB(nlbID string) (bool, error) {
+	loadBalancer, err := nlbHandler.Client.Get(nlbHandler.Ctx, CBResourceGroupName, nlbID, "")
+	if err != nil {
+		return false, err
+	}
+
+	vmList := nlbHandler.getBackendVMs(loadBalancer)
+	if len(vmList) > 0 {
+		if _, err := nlbHandler.RemoveVMs(nlbID, vmList); err != nil {
+			return false, err
+		}
+	}
+
+	var publicIPNameList []string
+	if loadBalancer.LoadBalancerPropertiesFormat != nil && loadBalancer.FrontendIPConfigurations != nil {
+		for _, frontend := range *loadBalancer.FrontendIPConfigurations {
+			if frontend.FrontendIPConfigurationPropertiesFormat != nil && frontend.PublicIPAddress != nil {
+				publicIPNameList = append(publicIPNameList, getNameFromID(toString(frontend.PublicIPAddress.ID)))
+			}
+		}
+	}
+
+	future, err := nlbHandler.Client.Delete(nlbHandler.Ctx, CBResourceGroupName, nlbID)
+	if err != nil {
+		return false, err
+	}
+	err = future.WaitForCompletionRef(nlbHandler.Ctx, nlbHandler.Client.Client)
+	if err != nil {
+		return false, err
+	}
+
+	for _, publicIPName := range publicIPNameList {
+		future, err := nlbHandler.PublicIPClient.Delete(nlbHandler.Ctx, CBResourceGroupName, publicIPName)
+		if err != nil {
+			cblogger.Error(err)
+			continue
+		}
+		if err := future.WaitForCompletionRef(nlbHandler.Ctx, nlbHandler.PublicIPClient.Client); err != nil {
+			cblogger.Error(err)
+		}
+	}
+	return true, nil
+}
+
+func (nlbHandler *AzureNLBHandler) AddVMs(nlbID string, vmIDs []string) (irs.VMGroupInfo, error) {
+	backendPool, err := nlbHandler.getBackendPool(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+
+	for _, vmID := range vmIDs {
+		err := nlbHandler.updateVMBackendPool(vmID, func(poolList []network.BackendAddressPool) []network.BackendAddressPool {
+			for _, pool := range poolList {
+				if strings.EqualFold(toString(pool.ID), toString(backendPool.ID)) {
+					return poolList
+				}
+			}
+			return append(poolList, network.BackendAddressPool{ID: backendPool.ID})
+		})
+		if err != nil {
+			return irs.VMGroupInfo{}, err
+		}
+	}
+
+	nlbInfo, err := nlbHandler.GetNLB(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	return nlbInfo.VMGroup, nil
+}
+
+func (nlbHandler *AzureNLBHandler) RemoveVMs(nlbID string, vmIDs []string) (bool, error) {
+	backendPool, err := nlbHandler.getBackendPool(nlbID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, vmID := range vmIDs {
+		err := nlbHandler.updateVMBackendPool(vmID, func(poolList []network.BackendAddressPool) []network.BackendAddressPool {
+			newPoolList := []network.BackendAddressPool{}
+			for _, pool := range poolList {
+				if !strings.EqualFold(toString(pool.ID), toString(backendPool.ID)) {
+					newPoolList = append(newPoolList, pool)
+				}
+			}
+			return newPoolList
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Azure는 VM별 Probe 상태를 Metric(DipAvailability)으로만 제공하므로 Backend Pool의 VM 목록만 제공
+func (nlbHandler *AzureNLBHandler) GetVMGroupHealthInfo(nlbID string) (irs.HealthInfo, error) {
+	loadBalancer, err := nlbHandler.Client.Get(nlbHandler.Ctx, CBResourceGroupName, nlbID, "")
+	if err != nil {
+		return irs.HealthInfo{}, err
+	}
+	return irs.HealthInfo{AllVMs: nlbHandler.getBackendVMs(loadBalancer)}, nil
+}
+
+func (nlbHandler *AzureNLBHandler) createOrUpdate(nlbName string, loadBalancer network.LoadBalancer) (network.LoadBalancer, error) {
+	future, err := nlbHandler.Client.CreateOrUpdate(nlbHandler.Ctx, CBResourceGroupName, nlbName, loadBalancer)
+	if err != nil {
+		cblogger.Error(err)
+		return network.LoadBalancer{}, err
+	}
+	err = future.WaitForCompletionRef(nlbHandler.Ctx, nlbHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return network.LoadBalancer{}, err
+	}
+	return nlbHandler.Client.Get(nlbHandler.Ctx, CBResourceGroupName, nlbName, "")
+}
+
+// Standard SKU Load Balancer는 Standard SKU Public IP만 사용 가능
+func (nlbHandler *AzureNLBHandler) createPublicIP(publicIPName string) (network.PublicIPAddress, error) {
+	createOpts := network.PublicIPAddress{
+		Name: to.StringPtr(publicIPName),
+		Sku: &network.PublicIPAddressSku{
+			Name: network.PublicIPAddressSkuNameStandard,
+		},
+		PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   network.IPv4,
+			PublicIPAllocationMethod: network.Static,
+		},
+		Location: &nlbHandler.Region.Region,
+	}
+	future, err := nlbHandler.PublicIPClient.CreateOrUpdate(nlbHandler.Ctx, CBResourceGroupName, publicIPName, createOpts)
+	if err != nil {
+		return network.PublicIPAddress{}, err
+	}
+	err = future.WaitForCompletionRef(nlbHandler.Ctx, nlbHandler.PublicIPClient.Client)
+	if err != nil {
+		return network.PublicIPAddress{}, err
+	}
+	return nlbHandler.PublicIPClient.Get(nlbHandler.Ctx, CBResourceGroupName, publicIPName, "")
+}
+
+func (nlbHandler *AzureNLBHandler) getBackendPool(nlbID string) (network.BackendAddressPool, error) {
+	loadBalancer, err := nlbHandler.Client.Get(nlbHandler.Ctx, CBResourceGroupName, nlbID, "")
+	if err != nil {
+		return network.BackendAddressPool{}, err
+	}
+	if loadBalancer.LoadBalancerPropertiesFormat == nil || loadBalancer.BackendAddressPools == nil || len(*loadBalancer.BackendAddressPools) < 1 {
+		return network.BackendAddressPool{}, errors.New("backend pool is not found in the load balancer " + nlbID)
+	}
+	return (*loadBalancer.BackendAddressPools)[0], nil
+}
+
+// VM의 Primary NIC IP Configuration의 Backend Pool 목록을 변경
+func (nlbHandler *AzureNLBHandler) updateVMBackendPool(vmID string, update func(poolList []network.BackendAddressPool) []network.BackendAddressPool) error {
+	vm, err := nlbHandler.VMClient.Get(nlbHandler.Ctx, CBResourceGroupName, vmID, compute.InstanceView)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	if vm.VirtualMachineProperties == nil || vm.NetworkProfile == nil || vm.NetworkProfile.NetworkInterfaces == nil || len(*vm.NetworkProfile.NetworkInterfaces) < 1 {
+		return errors.New("network interface is not found in the VM " + vmID)
+	}
+	nicName := getNameFromID(toString((*vm.NetworkProfile.NetworkInterfaces)[0].ID))
+
+	nic, err := nlbHandler.NicClient.Get(nlbHandler.Ctx, CBResourceGroupName, nicName, "")
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	if nic.InterfacePropertiesFormat == nil || nic.IPConfigurations == nil || len(*nic.IPConfigurations) < 1 {
+		return errors.New("IP configuration is not found in the network interface " + nicName)
+	}
+	ipConfig := &(*nic.IPConfigurations)[0]
+	poolList := []network.BackendAddressPool{}
+	if ipConfig.LoadBalancerBackendAddressPools != nil {
+		poolList = *ipConfig.LoadBalancerBackendAddressPools
+	}
+	poolList = update(poolList)
+	ipConfig.LoadBalancerBackendAddressPools = &poolList
+
+	future, err := nlbHandler.NicClient.CreateOrUpdate(nlbHandler.Ctx, CBResourceGroupName, nicName, nic)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	err = future.WaitForCompletionRef(nlbHandler.Ctx, nlbHandler.NicClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Backend IP Configuration ID 형식: /subscriptions/.../networkInterfaces/{nic}/ipConfigurations/{ipConfig}
+func (nlbHandler *AzureNLBHandler) getBackendVMs(loadBalancer network.LoadBalancer) []string {
+	var vmList []string
+	if loadBalancer.LoadBalancerPropertiesFormat == nil || loadBalancer.BackendAddressPools == nil {
+		return vmList
+	}
+	for _, pool := range *loadBalancer.BackendAddressPools {
+		if pool.BackendAddressPoolPropertiesFormat == nil || pool.BackendIPConfigurations == nil {
+			continue
+		}
+		for _, ipConfig := range *pool.BackendIPConfigurations {
+			idArr := strings.Split(toString(ipConfig.ID), "/")
+			if len(idArr) < 3 {
+				continue
+			}
+			nic, err := nlbHandler.NicClient.Get(nlbHandler.Ctx, CBResourceGroupName, idArr[len(idArr)-3], "")
+			if err != nil {
+				cblogger.Error(err)
+				continue
+			}
+			if nic.InterfacePropertiesFormat != nil && nic.VirtualMachine != nil {
+				vmList = append(vmList, getNameFromID(toString(nic.VirtualMachine.ID)))
+			}
+		}
+	}
+	return vmList
+}
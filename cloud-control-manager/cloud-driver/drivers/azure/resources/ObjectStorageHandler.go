@@ -0,0 +1,314 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	storagemgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBStorageAccountPrefix = "cbspider"
+	CBStorageAccountLength = 24 // 3 ~ 24, 소문자와 숫자만 사용 가능
+)
+
+// Bucket은 Blob Container로 매핑 됨
+// Container는 Storage Account 하위에 생성되므로 구독(Subscription)별로 고정된 이름의 Storage Account를 CB-GROUP에 생성하여 사용
+type AzureObjectStorageHandler struct {
+	Region idrv.RegionInfo
+	Ctx    context.Context
+	Client *storagemgmt.AccountsClient
+}
+
+// Storage Account 이름은 전역적으로 유일해야 하므로 구독 ID를 이용하여 생성
+func (storageHandler *AzureObjectStorageHandler) getStorageAccountName() string {
+	subscriptionId := strings.ToLower(strings.Replace(storageHandler.Client.SubscriptionID, "-", "", -1))
+	accountName := CBStorageAccountPrefix + subscriptionId
+	if len(accountName) > CBStorageAccountLength {
+		accountName = accountName[:CBStorageAccountLength]
+	}
+	return accountName
+}
+
+// Storage Account의 Access Key를 이용하여 Blob Service Client 생성
+// create가 true이면 Storage Account가 없을 경우 생성
+func (storageHandler *AzureObjectStorageHandler) getBlobService(create bool) (*storage.BlobStorageClient, error) {
+	accountName := storageHandler.getStorageAccountName()
+
+	_, err := storageHandler.Client.GetProperties(storageHandler.Ctx, CBResourceGroupName, accountName, "")
+	if err != nil {
+		if !create {
+			cblogger.Error(err)
+			return nil, errors.New("Storage Account가 존재하지 않습니다. : " + accountName)
+		}
+
+		createOpts := storagemgmt.AccountCreateParameters{
+			Sku: &storagemgmt.Sku{
+				Name: storagemgmt.StandardLRS,
+			},
+			Kind:     storagemgmt.StorageV2,
+			Location: &storageHandler.Region.Region,
+			AccountPropertiesCreateParameters: &storagemgmt.AccountPropertiesCreateParameters{
+				EnableHTTPSTrafficOnly: to.BoolPtr(true),
+			},
+		}
+		future, err := storageHandler.Client.Create(storageHandler.Ctx, CBResourceGroupName, accountName, createOpts)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		err = future.WaitForCompletionRef(storageHandler.Ctx, storageHandler.Client.Client)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+	}
+
+	keys, err := storageHandler.Client.ListKeys(storageHandler.Ctx, CBResourceGroupName, accountName, "")
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if keys.Keys == nil || len(*keys.Keys) == 0 {
+		return nil, errors.New("Storage Account의 Access Key 정보가 없습니다. : " + accountName)
+	}
+
+	client, err := storage.NewBasicClient(accountName, toString((*keys.Keys)[0].Value))
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	blobService := client.GetBlobService()
+
+	return &blobService, nil
+}
+
+func (storageHandler *AzureObjectStorageHandler) setterBucket(container storage.Container) *irs.BucketInfo {
+	bucketInfo := &irs.BucketInfo{
+		Name:        container.Name,
+		Region:      storageHandler.Region.Region,
+		CreatedTime: container.Properties.LastModified,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "StorageAccount", Value: storageHandler.getStorageAccountName()},
+		},
+	}
+	return bucketInfo
+}
+
+func setterObject(blob storage.Blob) *irs.ObjectInfo {
+	objectInfo := &irs.ObjectInfo{
+		Key:          blob.Name,
+		Size:         blob.Properties.ContentLength,
+		ETag:         strings.Trim(blob.Properties.Etag, "\""),
+		LastModified: time.Time(blob.Properties.LastModified).Format(time.RFC3339),
+		ContentType:  blob.Properties.ContentType,
+		KeyValueList: []irs.KeyValue{
+			{Key: "BlobType", Value: string(blob.Properties.BlobType)},
+		},
+	}
+	return objectInfo
+}
+
+func (storageHandler *AzureObjectStorageHandler) CreateBucket(bucketName string) (irs.BucketInfo, error) {
+	blobService, err := storageHandler.getBlobService(true)
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+
+	container := blobService.GetContainerReference(bucketName)
+	err = container.Create(&storage.CreateContainerOptions{Access: storage.ContainerAccessTypePrivate})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+
+	bucketList, err := storageHandler.ListBucket()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	for _, bucketInfo := range bucketList {
+		if bucketInfo.Name == bucketName {
+			return *bucketInfo, nil
+		}
+	}
+	return irs.BucketInfo{}, errors.New("생성된 Container 정보를 찾을 수 없습니다. : " + bucketName)
+}
+
+func (storageHandler *AzureObjectStorageHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return nil, err
+	}
+
+	var bucketList []*irs.BucketInfo
+	params := storage.ListContainersParameters{}
+	for {
+		result, err := blobService.ListContainers(params)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for _, container := range result.Containers {
+			bucketList = append(bucketList, storageHandler.setterBucket(container))
+		}
+		if result.NextMarker == "" {
+			break
+		}
+		params.Marker = result.NextMarker
+	}
+
+	return bucketList, nil
+}
+
+func (storageHandler *AzureObjectStorageHandler) DeleteBucket(bucketName string) (bool, error) {
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return false, err
+	}
+
+	// 다른 CSP와 동일하게 비어 있는 Container만 삭제
+	container := blobService.GetContainerReference(bucketName)
+	result, err := container.ListBlobs(storage.ListBlobsParameters{MaxResults: 1})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if len(result.Blobs) > 0 {
+		return false, errors.New("Container가 비어 있지 않습니다. : " + bucketName)
+	}
+
+	err = container.Delete(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (storageHandler *AzureObjectStorageHandler) PutObject(bucketName string, objectKey string, body io.Reader, contentType string) (irs.ObjectInfo, error) {
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+
+	blob := blobService.GetContainerReference(bucketName).GetBlobReference(objectKey)
+	blob.Properties.ContentType = contentType
+	err = blob.CreateBlockBlobFromReader(body, nil)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+
+	err = blob.GetProperties(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+
+	return *setterObject(*blob), nil
+}
+
+func (storageHandler *AzureObjectStorageHandler) GetObject(bucketName string, objectKey string) (io.ReadCloser, irs.ObjectInfo, error) {
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	blob := blobService.GetContainerReference(bucketName).GetBlobReference(objectKey)
+	reader, err := blob.Get(nil)
+	if err != nil {
+		cblogger.Error(err)
+		if reader != nil {
+			reader.Close()
+		}
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	return reader, *setterObject(*blob), nil
+}
+
+func (storageHandler *AzureObjectStorageHandler) ListObject(bucketName string, prefix string) ([]*irs.ObjectInfo, error) {
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return nil, err
+	}
+
+	container := blobService.GetContainerReference(bucketName)
+	var objectList []*irs.ObjectInfo
+	params := storage.ListBlobsParameters{Prefix: prefix}
+	for {
+		result, err := container.ListBlobs(params)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for _, blob := range result.Blobs {
+			objectList = append(objectList, setterObject(blob))
+		}
+		if result.NextMarker == "" {
+			break
+		}
+		params.Marker = result.NextMarker
+	}
+
+	return objectList, nil
+}
+
+func (storageHandler *AzureObjectStorageHandler) DeleteObject(bucketName string, objectKey string) (bool, error) {
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return false, err
+	}
+
+	blob := blobService.GetContainerReference(bucketName).GetBlobReference(objectKey)
+	err = blob.Delete(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Presigned URL은 Blob 단위의 SAS(Shared Access Signature) URI로 생성
+func (storageHandler *AzureObjectStorageHandler) GetPresignedURL(bucketName string, objectKey string, method string, expiresSeconds int) (string, error) {
+	var permissions storage.BlobServiceSASPermissions
+	switch strings.ToUpper(method) {
+	case irs.PresignedURLGet:
+		permissions.Read = true
+	case irs.PresignedURLPut:
+		permissions.Create = true
+		permissions.Write = true
+	default:
+		return "", errors.New("지원하지 않는 Method 입니다. (GET 또는 PUT) : " + method)
+	}
+
+	blobService, err := storageHandler.getBlobService(false)
+	if err != nil {
+		return "", err
+	}
+
+	blob := blobService.GetContainerReference(bucketName).GetBlobReference(objectKey)
+	sasURI, err := blob.GetSASURI(storage.BlobSASOptions{
+		BlobServiceSASPermissions: permissions,
+		SASOptions: storage.SASOptions{
+			Expiry:   time.Now().UTC().Add(time.Duration(expiresSeconds) * time.Second),
+			UseHTTPS: true,
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	return sasURI, nil
+}
@@ -37,6 +37,11 @@ func (publicIpHandler *AzurePublicIPHandler) CreatePublicIP(publicIPReqInfo irs.
 		return irs.PublicIPInfo{}, createErr
 	}
 
+	tags, err := getterTags(publicIPReqInfo.TagList)
+	if err != nil {
+		return irs.PublicIPInfo{}, err
+	}
+
 	createOpts := network.PublicIPAddress{
 		Name: to.StringPtr(publicIPReqInfo.Name),
 		Sku: &network.PublicIPAddressSku{
@@ -48,6 +53,7 @@ func (publicIpHandler *AzurePublicIPHandler) CreatePublicIP(publicIPReqInfo irs.
 			IdleTimeoutInMinutes:     to.Int32Ptr(4),
 		},
 		Location: &publicIpHandler.Region.Region,
+		Tags:     tags,
 	}
 
 	future, err := publicIpHandler.Client.CreateOrUpdate(publicIpHandler.Ctx, CBResourceGroupName, publicIPReqInfo.Name, createOpts)
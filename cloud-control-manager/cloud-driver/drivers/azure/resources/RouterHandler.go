@@ -0,0 +1,321 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const (
+	CBRouterVPCTag         = "VPCId"
+	CBInternetGatewayCIDR  = "0.0.0.0/0"
+	CBInternetGatewayRoute = "CB-Internet"
+)
+
+type AzureRouterHandler struct {
+	Region       idrv.RegionInfo
+	Ctx          context.Context
+	Client       *network.RouteTablesClient
+	RouteClient  *network.RoutesClient
+	SubnetClient *network.SubnetsClient
+}
+
+// Route Table을 Router로 사용 (이름을 ID로 사용, VPC(Virtual Network) 이름은 Tag에 저장)
+func setterRouter(routeTable network.RouteTable) *irs.RouterInfo {
+	routerInfo := &irs.RouterInfo{
+		Id:   toString(routeTable.Name),
+		Name: toString(routeTable.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(routeTable.ID)},
+		},
+	}
+	if vpcID, ok := routeTable.Tags[CBRouterVPCTag]; ok {
+		routerInfo.VPCId = toString(vpcID)
+	}
+
+	if routeTable.RouteTablePropertiesFormat == nil {
+		return routerInfo
+	}
+	if routeTable.Routes != nil {
+		for _, route := range *routeTable.Routes {
+			routeInfo := setterRoute(route)
+			if routeInfo.DestinationCIDR == CBInternetGatewayCIDR && routeInfo.TargetType == irs.RouteTargetInternetGateway {
+				routerInfo.InternetGateway = true
+			}
+			routerInfo.RouteList = append(routerInfo.RouteList, routeInfo)
+		}
+	}
+	if routeTable.Subnets != nil {
+		for _, subnet := range *routeTable.Subnets {
+			// Subnet ID 형식: /subscriptions/.../virtualNetworks/{vNet}/subnets/{subnet}
+			subnetID := toString(subnet.ID)
+			routerInfo.SubnetIdList = append(routerInfo.SubnetIdList, subnetID[strings.LastIndex(subnetID, "/")+1:])
+		}
+	}
+	routerInfo.KeyValueList = append(routerInfo.KeyValueList, irs.KeyValue{Key: "ProvisioningState", Value: toString(routeTable.ProvisioningState)})
+	return routerInfo
+}
+
+func setterRoute(route network.Route) irs.RouteInfo {
+	routeInfo := irs.RouteInfo{TargetType: irs.RouteTargetOther}
+	if route.RoutePropertiesFormat == nil {
+		return routeInfo
+	}
+	routeInfo.DestinationCIDR = toString(route.AddressPrefix)
+	switch route.NextHopType {
+	case network.RouteNextHopTypeInternet:
+		routeInfo.TargetType = irs.RouteTargetInternetGateway
+	case network.RouteNextHopTypeVnetLocal:
+		routeInfo.TargetType = irs.RouteTargetLocal
+	case network.RouteNextHopTypeVirtualAppliance:
+		routeInfo.TargetType = irs.RouteTargetIP
+		routeInfo.TargetId = toString(route.NextHopIPAddress)
+	default:
+		routeInfo.TargetId = string(route.NextHopType)
+	}
+	return routeInfo
+}
+
+// Route 이름은 목적지 CIDR로 생성, ex) 192.168.0.0/16 => 192-168-0-0-16
+func getRouteName(destinationCIDR string) string {
+	if destinationCIDR == CBInternetGatewayCIDR {
+		return CBInternetGatewayRoute
+	}
+	return strings.NewReplacer(".", "-", "/", "-").Replace(destinationCIDR)
+}
+
+func (routerHandler *AzureRouterHandler) CreateRouter(routerReqInfo irs.RouterReqInfo) (irs.RouterInfo, error) {
+	// Check Router Exists
+	routeTable, _ := routerHandler.Client.Get(routerHandler.Ctx, CBResourceGroupName, routerReqInfo.Name, "")
+	if routeTable.ID != nil {
+		errMsg := fmt.Sprintf("Router with name %s already exist", routerReqInfo.Name)
+		return irs.RouterInfo{}, errors.New(errMsg)
+	}
+
+	createOpts := network.RouteTable{
+		Name:                       to.StringPtr(routerReqInfo.Name),
+		RouteTablePropertiesFormat: &network.RouteTablePropertiesFormat{},
+		Location:                   &routerHandler.Region.Region,
+		Tags: map[string]*string{
+			CBRouterVPCTag: to.StringPtr(routerReqInfo.VPCId),
+		},
+	}
+	if routerReqInfo.InternetGateway {
+		createOpts.Routes = &[]network.Route{
+			{
+				Name: to.StringPtr(CBInternetGatewayRoute),
+				RoutePropertiesFormat: &network.RoutePropertiesFormat{
+					AddressPrefix: to.StringPtr(CBInternetGatewayCIDR),
+					NextHopType:   network.RouteNextHopTypeInternet,
+				},
+			},
+		}
+	}
+
+	future, err := routerHandler.Client.CreateOrUpdate(routerHandler.Ctx, CBResourceGroupName, routerReqInfo.Name, createOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+	err = future.WaitForCompletionRef(routerHandler.Ctx, routerHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+
+	return routerHandler.GetRouter(routerReqInfo.Name)
+}
+
+func (routerHandler *AzureRouterHandler) ListRouter() ([]*irs.RouterInfo, error) {
+	iter, err := routerHandler.Client.ListComplete(routerHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var routerList []*irs.RouterInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(routerHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		routerInfo := setterRouter(iter.Value())
+		routerList = append(routerList, routerInfo)
+	}
+	return routerList, nil
+}
+
+func (routerHandler *AzureRouterHandler) GetRouter(routerID string) (irs.RouterInfo, error) {
+	routeTable, err := routerHandler.Client.Get(routerHandler.Ctx, CBResourceGroupName, routerID, "")
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+
+	routerInfo := setterRouter(routeTable)
+	return *routerInfo, nil
+}
+
+// 연결된 Subnet이 있으면 삭제되지 않으므로 먼저 연결을 해제
+func (routerHandler *AzureRouterHandler) DeleteRouter(routerID string) (bool, error) {
+	routerInfo, err := routerHandler.GetRouter(routerID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	for _, subnetID := range routerInfo.SubnetIdList {
+		if _, err := routerHandler.DeleteInterface(routerID, subnetID); err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	future, err := routerHandler.Client.Delete(routerHandler.Ctx, CBResourceGroupName, routerID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(routerHandler.Ctx, routerHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Azure는 Internet Gateway와 IP(Virtual Appliance)만 지원, VM, NAT Gateway는 VM의 Private IP(IP Type)로 지정
+func (routerHandler *AzureRouterHandler) AddRoute(routerID string, routeInfo irs.RouteInfo) (irs.RouterInfo, error) {
+	routeProperties := network.RoutePropertiesFormat{
+		AddressPrefix: to.StringPtr(routeInfo.DestinationCIDR),
+	}
+	switch routeInfo.TargetType {
+	case irs.RouteTargetInternetGateway:
+		routeProperties.NextHopType = network.RouteNextHopTypeInternet
+	case irs.RouteTargetIP:
+		if routeInfo.TargetId == "" {
+			return irs.RouterInfo{}, errors.New("TargetId(next hop IP) is required for the IP target")
+		}
+		routeProperties.NextHopType = network.RouteNextHopTypeVirtualAppliance
+		routeProperties.NextHopIPAddress = to.StringPtr(routeInfo.TargetId)
+	default:
+		errMsg := fmt.Sprintf("Azure does not support the route target type %s, use %s with the private IP", routeInfo.TargetType, irs.RouteTargetIP)
+		return irs.RouterInfo{}, errors.New(errMsg)
+	}
+
+	routeName := getRouteName(routeInfo.DestinationCIDR)
+	route := network.Route{
+		Name:                  to.StringPtr(routeName),
+		RoutePropertiesFormat: &routeProperties,
+	}
+	future, err := routerHandler.RouteClient.CreateOrUpdate(routerHandler.Ctx, CBResourceGroupName, routerID, routeName, route)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+	err = future.WaitForCompletionRef(routerHandler.Ctx, routerHandler.RouteClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+
+	return routerHandler.GetRouter(routerID)
+}
+
+func (routerHandler *AzureRouterHandler) RemoveRoute(routerID string, destinationCIDR string) (bool, error) {
+	future, err := routerHandler.RouteClient.Delete(routerHandler.Ctx, CBResourceGroupName, routerID, getRouteName(destinationCIDR))
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(routerHandler.Ctx, routerHandler.RouteClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Subnet의 Route Table 속성을 변경하여 연결
+func (routerHandler *AzureRouterHandler) AddInterface(routerID string, subnetID string) (irs.RouterInfo, error) {
+	routeTable, err := routerHandler.Client.Get(routerHandler.Ctx, CBResourceGroupName, routerID, "")
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouterInfo{}, err
+	}
+
+	err = routerHandler.updateSubnetRouteTable(setterRouter(routeTable).VPCId, subnetID, &routeTable)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.GetRouter(routerID)
+}
+
+func (routerHandler *AzureRouterHandler) DeleteInterface(routerID string, subnetID string) (bool, error) {
+	routerInfo, err := routerHandler.GetRouter(routerID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	err = routerHandler.updateSubnetRouteTable(routerInfo.VPCId, subnetID, nil)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (routerHandler *AzureRouterHandler) updateSubnetRouteTable(vpcID string, subnetID string, routeTable *network.RouteTable) error {
+	if vpcID == "" {
+		return errors.New("VPCId of the router is empty")
+	}
+
+	subnet, err := routerHandler.SubnetClient.Get(routerHandler.Ctx, CBResourceGroupName, vpcID, subnetID, "")
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	if subnet.SubnetPropertiesFormat == nil {
+		subnet.SubnetPropertiesFormat = &network.SubnetPropertiesFormat{}
+	}
+	subnet.RouteTable = routeTable
+
+	future, err := routerHandler.SubnetClient.CreateOrUpdate(routerHandler.Ctx, CBResourceGroupName, vpcID, subnetID, subnet)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	err = future.WaitForCompletionRef(routerHandler.Ctx, routerHandler.SubnetClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Azure는 별도의 Internet Gateway가 없으므로 0.0.0.0/0 Route의 Next Hop을 Internet으로 지정
+func (routerHandler *AzureRouterHandler) AttachInternetGateway(routerID string) (irs.RouterInfo, error) {
+	return routerHandler.AddRoute(routerID, irs.RouteInfo{
+		DestinationCIDR: CBInternetGatewayCIDR,
+		TargetType:      irs.RouteTargetInternetGateway,
+	})
+}
+
+func (routerHandler *AzureRouterHandler) DetachInternetGateway(routerID string) (bool, error) {
+	return routerHandler.RemoveRoute(routerID, CBInternetGatewayCIDR)
+}
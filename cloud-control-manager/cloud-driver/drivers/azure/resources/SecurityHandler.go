@@ -4,12 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
+const (
+	CBSecurityRuleAnyAddress    = "*"
+	CBSecurityRuleAnyCIDR       = "0.0.0.0/0"
+	CBSecurityRulePriorityStart = 300
+	CBSecurityRulePriorityStep  = 100
+	CBSecurityRulePriorityMin   = 100
+	CBSecurityRulePriorityMax   = 4096
+)
+
 type AzureSecurityHandler struct {
 	Region idrv.RegionInfo
 	Ctx    context.Context
@@ -25,20 +36,39 @@ func setterSec(securityGroup network.SecurityGroup) *irs.SecurityInfo {
 
 	var securityRuleArr []irs.SecurityRuleInfo
 	for _, sgRule := range *securityGroup.SecurityRules {
-		ruleInfo := irs.SecurityRuleInfo{
-			FromPort:   *sgRule.SourcePortRange,
-			ToPort:     *sgRule.DestinationPortRange,
-			IPProtocol: fmt.Sprint(sgRule.Protocol),
-			Direction:  fmt.Sprint(sgRule.Direction),
-		}
-
-		securityRuleArr = append(securityRuleArr, ruleInfo)
+		securityRuleArr = append(securityRuleArr, setterSecRule(sgRule))
 	}
 	security.SecurityRules = &securityRuleArr
 
 	return security
 }
 
+// Inbound는 Source, Outbound는 Destination 주소를 CIDR로 사용 ("*"는 0.0.0.0/0으로 변환)
+func setterSecRule(sgRule network.SecurityRule) irs.SecurityRuleInfo {
+	ruleInfo := irs.SecurityRuleInfo{
+		IPProtocol: fmt.Sprint(sgRule.Protocol),
+		Direction:  fmt.Sprint(sgRule.Direction),
+		CIDR:       toString(sgRule.SourceAddressPrefix),
+	}
+	if sgRule.Direction == network.SecurityRuleDirectionOutbound {
+		ruleInfo.CIDR = toString(sgRule.DestinationAddressPrefix)
+	}
+	if ruleInfo.CIDR == CBSecurityRuleAnyAddress {
+		ruleInfo.CIDR = CBSecurityRuleAnyCIDR
+	}
+
+	// DestinationPortRange 형식: "22", "1000-2000", "*"
+	portArr := strings.Split(toString(sgRule.DestinationPortRange), "-")
+	ruleInfo.FromPort = portArr[0]
+	ruleInfo.ToPort = portArr[len(portArr)-1]
+
+	ruleInfo.Description = toString(sgRule.Description)
+	if sgRule.Priority != nil {
+		ruleInfo.Priority = int(*sgRule.Priority)
+	}
+	return ruleInfo
+}
+
 func (securityHandler *AzureSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
 	// Check SecurityGroup Exists
 	security, _ := securityHandler.Client.Get(securityHandler.Ctx, CBResourceGroupName, securityReqInfo.Name, "")
@@ -48,24 +78,14 @@ func (securityHandler *AzureSecurityHandler) CreateSecurity(securityReqInfo irs.
 		return irs.SecurityInfo{}, createErr
 	}
 
-	var sgRuleList []network.SecurityRule
-	var priorityNum int32
-	for idx, rule := range *securityReqInfo.SecurityRules {
-		priorityNum = int32(300 + idx*100)
-		sgRuleInfo := network.SecurityRule{
-			Name: to.StringPtr(fmt.Sprintf("%s-rules-%d", securityReqInfo.Name, idx+1)),
-			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
-				SourceAddressPrefix:      to.StringPtr("*"),
-				SourcePortRange:          to.StringPtr(rule.FromPort),
-				DestinationAddressPrefix: to.StringPtr("*"),
-				DestinationPortRange:     to.StringPtr(rule.ToPort),
-				Protocol:                 network.SecurityRuleProtocol(rule.IPProtocol),
-				Access:                   network.SecurityRuleAccess("Allow"),
-				Priority:                 to.Int32Ptr(priorityNum),
-				Direction:                network.SecurityRuleDirection(rule.Direction),
-			},
-		}
-		sgRuleList = append(sgRuleList, sgRuleInfo)
+	sgRuleList, err := appendSecurityRules(securityReqInfo.Name, nil, securityReqInfo.SecurityRules)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
+	tags, err := getterTags(securityReqInfo.TagList)
+	if err != nil {
+		return irs.SecurityInfo{}, err
 	}
 
 	createOpts := network.SecurityGroup{
@@ -73,6 +93,7 @@ func (securityHandler *AzureSecurityHandler) CreateSecurity(securityReqInfo irs.
 			SecurityRules: &sgRuleList,
 		},
 		Location: &securityHandler.Region.Region,
+		Tags:     tags,
 	}
 
 	future, err := securityHandler.Client.CreateOrUpdate(securityHandler.Ctx, CBResourceGroupName, securityReqInfo.Name, createOpts)
@@ -127,3 +148,196 @@ func (securityHandler *AzureSecurityHandler) DeleteSecurity(securityID string) (
 	}
 	return true, nil
 }
+
+// 기존 Security Group의 Rule 목록에 Rule을 추가하여 업데이트
+func (securityHandler *AzureSecurityHandler) AddRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	security, err := securityHandler.Client.Get(securityHandler.Ctx, CBResourceGroupName, securityID, "")
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
+	var sgRuleList []network.SecurityRule
+	if security.SecurityRules != nil {
+		sgRuleList = *security.SecurityRules
+	}
+	sgRuleList, err = appendSecurityRules(securityID, sgRuleList, securityRules)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	security.SecurityRules = &sgRuleList
+
+	if err := securityHandler.updateSecurity(securityID, security); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	return securityHandler.GetSecurity(securityID)
+}
+
+// 기존 Security Group의 Rule 목록에서 일치하는 Rule을 제외하여 업데이트
+func (securityHandler *AzureSecurityHandler) RemoveRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	security, err := securityHandler.Client.Get(securityHandler.Ctx, CBResourceGroupName, securityID, "")
+	if err != nil {
+		return false, err
+	}
+	if security.SecurityRules == nil || securityRules == nil {
+		return true, nil
+	}
+
+	var sgRuleList []network.SecurityRule
+	for _, sgRule := range *security.SecurityRules {
+		matched := false
+		for _, rule := range *securityRules {
+			if equalSecurityRule(sgRule, rule) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			sgRuleList = append(sgRuleList, sgRule)
+		}
+	}
+	if len(sgRuleList) == len(*security.SecurityRules) {
+		errMsg := fmt.Sprintf("Security Group %s has no matched rules", securityID)
+		return false, errors.New(errMsg)
+	}
+	security.SecurityRules = &sgRuleList
+
+	if err := securityHandler.updateSecurity(securityID, security); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (securityHandler *AzureSecurityHandler) updateSecurity(securityID string, security network.SecurityGroup) error {
+	future, err := securityHandler.Client.CreateOrUpdate(securityHandler.Ctx, CBResourceGroupName, securityID, security)
+	if err != nil {
+		return err
+	}
+	return future.WaitForCompletionRef(securityHandler.Ctx, securityHandler.Client.Client)
+}
+
+// Rule 이름은 "{Security Group 이름}-rules-{번호}"
+// Priority가 없으면 기존 Rule과 요청 Rule 중 최대 Priority 다음 값을 사용
+// 지정한 Priority는 100~4096 범위이고, 같은 방향(Inbound/Outbound)의 Rule과 중복될 수 없음
+// Azure NSG는 다른 Security Group을 Source로 지정할 수 없음
+func appendSecurityRules(securityName string, sgRuleList []network.SecurityRule, securityRules *[]irs.SecurityRuleInfo) ([]network.SecurityRule, error) {
+	if securityRules == nil {
+		return sgRuleList, nil
+	}
+
+	ruleNum := len(sgRuleList)
+	priorityNum := int32(CBSecurityRulePriorityStart - CBSecurityRulePriorityStep)
+	ruleNames := map[string]bool{}
+	// 방향별 사용 중인 Priority
+	usedPriorities := map[string]bool{}
+	for _, sgRule := range sgRuleList {
+		ruleNames[toString(sgRule.Name)] = true
+		if sgRule.Priority != nil {
+			if *sgRule.Priority > priorityNum {
+				priorityNum = *sgRule.Priority
+			}
+			usedPriorities[getSecurityRulePriorityKey(string(sgRule.Direction), *sgRule.Priority)] = true
+		}
+	}
+
+	// 지정한 Priority를 먼저 검사하여 자동 할당 값과 겹치지 않게 함
+	for _, rule := range *securityRules {
+		if rule.Priority == 0 {
+			continue
+		}
+		if rule.Priority < CBSecurityRulePriorityMin || rule.Priority > CBSecurityRulePriorityMax {
+			return nil, fmt.Errorf("security rule priority %d is out of range(%d~%d)", rule.Priority, CBSecurityRulePriorityMin, CBSecurityRulePriorityMax)
+		}
+		priorityKey := getSecurityRulePriorityKey(rule.Direction, int32(rule.Priority))
+		if usedPriorities[priorityKey] {
+			return nil, fmt.Errorf("security rule priority %d of %s is already used", rule.Priority, rule.Direction)
+		}
+		usedPriorities[priorityKey] = true
+		if int32(rule.Priority) > priorityNum {
+			priorityNum = int32(rule.Priority)
+		}
+	}
+
+	for _, rule := range *securityRules {
+		if rule.SourceSecurityGroupId != "" {
+			return nil, errors.New("Azure network security group does not support the source security group, use CIDR instead")
+		}
+
+		ruleName := ""
+		for ruleName == "" || ruleNames[ruleName] {
+			ruleNum++
+			ruleName = fmt.Sprintf("%s-rules-%d", securityName, ruleNum)
+		}
+		ruleNames[ruleName] = true
+
+		rulePriority := int32(rule.Priority)
+		if rulePriority == 0 {
+			priorityNum += CBSecurityRulePriorityStep
+			if priorityNum > CBSecurityRulePriorityMax {
+				return nil, fmt.Errorf("security rule priority %d is out of range(%d~%d), specify the priority", priorityNum, CBSecurityRulePriorityMin, CBSecurityRulePriorityMax)
+			}
+			rulePriority = priorityNum
+		}
+
+		cidr := getSecurityRuleAddress(rule.CIDR)
+		sourceAddress, destinationAddress := cidr, CBSecurityRuleAnyAddress
+		if strings.EqualFold(rule.Direction, string(network.SecurityRuleDirectionOutbound)) {
+			sourceAddress, destinationAddress = CBSecurityRuleAnyAddress, cidr
+		}
+
+		sgRuleInfo := network.SecurityRule{
+			Name: to.StringPtr(ruleName),
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Description:              to.StringPtr(rule.Description),
+				SourceAddressPrefix:      to.StringPtr(sourceAddress),
+				SourcePortRange:          to.StringPtr(CBSecurityRuleAnyAddress),
+				DestinationAddressPrefix: to.StringPtr(destinationAddress),
+				DestinationPortRange:     to.StringPtr(getSecurityRulePortRange(rule)),
+				Protocol:                 network.SecurityRuleProtocol(rule.IPProtocol),
+				Access:                   network.SecurityRuleAccess("Allow"),
+				Priority:                 to.Int32Ptr(rulePriority),
+				Direction:                network.SecurityRuleDirection(rule.Direction),
+			},
+		}
+		sgRuleList = append(sgRuleList, sgRuleInfo)
+	}
+	return sgRuleList, nil
+}
+
+// Priority는 방향(Inbound/Outbound)별로 유일함
+func getSecurityRulePriorityKey(direction string, priority int32) string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(direction), priority)
+}
+
+// Rule 주소 형식: "*", "10.0.0.0/16"
+func getSecurityRuleAddress(cidr string) string {
+	if cidr == "" || cidr == CBSecurityRuleAnyCIDR {
+		return CBSecurityRuleAnyAddress
+	}
+	return cidr
+}
+
+// Rule 포트 형식: "*", "22", "1000-2000"
+func getSecurityRulePortRange(rule irs.SecurityRuleInfo) string {
+	fromPort, toPort := rule.FromPort, rule.ToPort
+	if fromPort == "" {
+		fromPort = toPort
+	}
+	if toPort == "" || toPort == fromPort {
+		if fromPort == "" {
+			return CBSecurityRuleAnyAddress
+		}
+		return fromPort
+	}
+	return fromPort + "-" + toPort
+}
+
+func equalSecurityRule(sgRule network.SecurityRule, rule irs.SecurityRuleInfo) bool {
+	ruleAddress := toString(sgRule.SourceAddressPrefix)
+	if sgRule.Direction == network.SecurityRuleDirectionOutbound {
+		ruleAddress = toString(sgRule.DestinationAddressPrefix)
+	}
+	return strings.EqualFold(fmt.Sprint(sgRule.Direction), rule.Direction) &&
+		strings.EqualFold(fmt.Sprint(sgRule.Protocol), rule.IPProtocol) &&
+		toString(sgRule.DestinationPortRange) == getSecurityRulePortRange(rule) &&
+		ruleAddress == getSecurityRuleAddress(rule.CIDR)
+}
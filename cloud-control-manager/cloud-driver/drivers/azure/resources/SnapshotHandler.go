@@ -0,0 +1,238 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AzureSnapshotHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.SnapshotsClient
+	DiskClient *compute.DisksClient
+	VMClient   *compute.VirtualMachinesClient
+}
+
+// Snapshot도 이름을 ID로 사용
+func setterSnapshot(snapshot compute.Snapshot) *irs.SnapshotInfo {
+	snapshotInfo := &irs.SnapshotInfo{
+		Id:   toString(snapshot.Name),
+		Name: toString(snapshot.Name),
+	}
+
+	var keyValueList []irs.KeyValue
+	var provisioningState string
+	if snapshot.SnapshotProperties != nil {
+		snapshotInfo.DiskSize = toInt32String(snapshot.DiskSizeGB)
+		provisioningState = toString(snapshot.ProvisioningState)
+		if snapshot.CreationData != nil && snapshot.CreationData.SourceResourceID != nil {
+			// ex) /subscriptions/.../resourceGroups/CB-GROUP/providers/Microsoft.Compute/disks/CB-DISK
+			diskIdArr := strings.Split(*snapshot.CreationData.SourceResourceID, "/")
+			keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotSourceDiskKey, Value: diskIdArr[len(diskIdArr)-1]})
+		}
+	}
+	if vmID, ok := snapshot.Tags[irs.SnapshotSourceVMKey]; ok {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotSourceVMKey, Value: toString(vmID)})
+	}
+	if snapshot.SnapshotProperties != nil && snapshot.TimeCreated != nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotCreatedTimeKey, Value: snapshot.TimeCreated.Time.Format(time.RFC3339)})
+	}
+
+	// ProvisioningState : Creating | Updating | Succeeded | Failed | Deleting
+	switch provisioningState {
+	case "Creating":
+		snapshotInfo.Status = irs.SnapshotCreating
+	case "Deleting":
+		snapshotInfo.Status = irs.SnapshotDeleting
+	case "Failed":
+		snapshotInfo.Status = irs.SnapshotError
+	default:
+		snapshotInfo.Status = irs.SnapshotAvailable
+	}
+
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "ResourceId", Value: toString(snapshot.ID)})
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "ProvisioningState", Value: provisioningState})
+	snapshotInfo.KeyValueList = keyValueList
+	return snapshotInfo
+}
+
+// VM 스냅샷은 VM의 OS 디스크 스냅샷으로 생성하며, 원본 VM 이름은 태그로 보관
+func (snapshotHandler *AzureSnapshotHandler) CreateSnapshot(snapshotReqInfo irs.SnapshotReqInfo) (irs.SnapshotInfo, error) {
+	sourceDiskID, err := snapshotHandler.getSourceDiskID(snapshotReqInfo)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+
+	snapshotOpts := compute.Snapshot{
+		Location: &snapshotHandler.Region.Region,
+		SnapshotProperties: &compute.SnapshotProperties{
+			CreationData: &compute.CreationData{
+				CreateOption:     compute.Copy,
+				SourceResourceID: &sourceDiskID,
+			},
+		},
+		Tags: map[string]*string{},
+	}
+	if snapshotReqInfo.SourceType == irs.SnapshotSourceVM {
+		snapshotOpts.Tags[irs.SnapshotSourceVMKey] = to.StringPtr(snapshotReqInfo.SourceId)
+	}
+
+	future, err := snapshotHandler.Client.CreateOrUpdate(snapshotHandler.Ctx, CBResourceGroupName, snapshotReqInfo.Name, snapshotOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+	err = future.WaitForCompletionRef(snapshotHandler.Ctx, snapshotHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.SnapshotInfo{}, err
+	}
+
+	return snapshotHandler.GetSnapshot(snapshotReqInfo.Name)
+}
+
+func (snapshotHandler *AzureSnapshotHandler) ListSnapshot() ([]*irs.SnapshotInfo, error) {
+	iter, err := snapshotHandler.Client.ListByResourceGroupComplete(snapshotHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var snapshotList []*irs.SnapshotInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(snapshotHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		snapshotInfo := setterSnapshot(iter.Value())
+		snapshotList = append(snapshotList, snapshotInfo)
+	}
+	return snapshotList, nil
+}
+
+func (snapshotHandler *AzureSnapshotHandler) GetSnapshot(snapshotID string) (irs.SnapshotInfo, error) {
+	snapshot, err := snapshotHandler.Client.Get(snapshotHandler.Ctx, CBResourceGroupName, snapshotID)
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+
+	snapshotInfo := setterSnapshot(snapshot)
+	return *snapshotInfo, nil
+}
+
+func (snapshotHandler *AzureSnapshotHandler) DeleteSnapshot(snapshotID string) (bool, error) {
+	future, err := snapshotHandler.Client.Delete(snapshotHandler.Ctx, CBResourceGroupName, snapshotID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(snapshotHandler.Ctx, snapshotHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (snapshotHandler *AzureSnapshotHandler) CreateDiskFromSnapshot(snapshotID string, diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	snapshot, err := snapshotHandler.Client.Get(snapshotHandler.Ctx, CBResourceGroupName, snapshotID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	diskType := compute.StandardLRS
+	if diskReqInfo.DiskType != "" {
+		diskType = compute.DiskStorageAccountTypes(diskReqInfo.DiskType)
+	}
+
+	tags, err := getterTags(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	diskOpts := compute.Disk{
+		Location: &snapshotHandler.Region.Region,
+		Sku:      &compute.DiskSku{Name: diskType},
+		Tags:     tags,
+		DiskProperties: &compute.DiskProperties{
+			CreationData: &compute.CreationData{
+				CreateOption:     compute.Copy,
+				SourceResourceID: snapshot.ID,
+			},
+		},
+	}
+	if diskReqInfo.DiskSize != "" {
+		size, err := strconv.Atoi(diskReqInfo.DiskSize)
+		if err != nil {
+			errMsg := fmt.Sprintf("DiskSize %s is not a valid number", diskReqInfo.DiskSize)
+			return irs.DiskInfo{}, errors.New(errMsg)
+		}
+		diskOpts.DiskSizeGB = to.Int32Ptr(int32(size))
+	}
+	if diskReqInfo.Zone != "" {
+		diskOpts.Zones = &[]string{diskReqInfo.Zone}
+	}
+
+	future, err := snapshotHandler.DiskClient.CreateOrUpdate(snapshotHandler.Ctx, CBResourceGroupName, diskReqInfo.Name, diskOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	err = future.WaitForCompletionRef(snapshotHandler.Ctx, snapshotHandler.DiskClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	disk, err := snapshotHandler.DiskClient.Get(snapshotHandler.Ctx, CBResourceGroupName, diskReqInfo.Name)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	diskInfo := setterDisk(disk)
+	return *diskInfo, nil
+}
+
+// 스냅샷 대상 Managed Disk의 Resource ID (VM인 경우 OS 디스크)
+func (snapshotHandler *AzureSnapshotHandler) getSourceDiskID(snapshotReqInfo irs.SnapshotReqInfo) (string, error) {
+	switch snapshotReqInfo.SourceType {
+	case irs.SnapshotSourceDisk, "":
+		disk, err := snapshotHandler.DiskClient.Get(snapshotHandler.Ctx, CBResourceGroupName, snapshotReqInfo.SourceId)
+		if err != nil {
+			return "", err
+		}
+		return toString(disk.ID), nil
+	case irs.SnapshotSourceVM:
+		vm, err := snapshotHandler.VMClient.Get(snapshotHandler.Ctx, CBResourceGroupName, snapshotReqInfo.SourceId, "")
+		if err != nil {
+			return "", err
+		}
+		if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil || vm.StorageProfile.OsDisk == nil || vm.StorageProfile.OsDisk.ManagedDisk == nil {
+			errMsg := fmt.Sprintf("VM %s has no managed OS disk", snapshotReqInfo.SourceId)
+			return "", errors.New(errMsg)
+		}
+		return toString(vm.StorageProfile.OsDisk.ManagedDisk.ID), nil
+	}
+	errMsg := fmt.Sprintf("SourceType %s is not valid", snapshotReqInfo.SourceType)
+	return "", errors.New(errMsg)
+}
@@ -0,0 +1,205 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	resourcemgmt "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-10-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// 리소스 이름을 ID로 사용 (CBResourceGroupName 리소스 그룹 기준)
+// VNetwork(Subnet)는 Azure에서 Tag를 지원하지 않음
+type AzureTagHandler struct {
+	Region         idrv.RegionInfo
+	Ctx            context.Context
+	Client         *resourcemgmt.TagsClient
+	ResourceClient *resourcemgmt.Client
+}
+
+var azureTagResourceTypes = map[irs.RSType]string{
+	irs.RSTypeVM:            "Microsoft.Compute/virtualMachines",
+	irs.RSTypeVPC:           "Microsoft.Network/virtualNetworks",
+	irs.RSTypeSecurityGroup: "Microsoft.Network/networkSecurityGroups",
+	irs.RSTypeDisk:          "Microsoft.Compute/disks",
+	irs.RSTypePublicIP:      "Microsoft.Network/publicIPAddresses",
+}
+
+func getAzureTagResourceType(resType irs.RSType) (string, error) {
+	azureResType, ok := azureTagResourceTypes[resType]
+	if !ok {
+		return "", errors.New(fmt.Sprintf("resource type %s is not supported by Azure tags", resType))
+	}
+	return azureResType, nil
+}
+
+// keyword가 Tag의 Key 또는 Value에 포함되면 true (""과 "*"은 모든 Tag)
+func matchTagKeyword(tag irs.KeyValue, keyword string) bool {
+	if keyword == "" || keyword == "*" {
+		return true
+	}
+	return strings.Contains(tag.Key, keyword) || strings.Contains(tag.Value, keyword)
+}
+
+func setterTagList(tags map[string]*string) []irs.KeyValue {
+	tagList := []irs.KeyValue{}
+	for key, value := range tags {
+		tagList = append(tagList, irs.KeyValue{Key: key, Value: toString(value)})
+	}
+	return tagList
+}
+
+// 리소스 생성 요청의 사용자 Tag(TagList)를 Azure Tags로 변환 (사용자 Tag가 없으면 nil)
+func getterTags(tagList []irs.KeyValue) (map[string]*string, error) {
+	if len(tagList) == 0 {
+		return nil, nil
+	}
+	tags := map[string]*string{}
+	for _, tag := range tagList {
+		if tag.Key == "" {
+			return nil, errors.New("tag key is required")
+		}
+		tags[tag.Key] = to.StringPtr(tag.Value)
+	}
+	return tags, nil
+}
+
+// Tags API의 scope: 리소스 ID
+func (tagHandler *AzureTagHandler) getScope(resType irs.RSType, resId string) (string, error) {
+	azureResType, err := getAzureTagResourceType(resType)
+	if err != nil {
+		return "", err
+	}
+	if resId == "" {
+		return "", errors.New("resource id is required")
+	}
+	// VMInfo, SecurityInfo의 Id는 전체 리소스 ID
+	if strings.HasPrefix(resId, "/subscriptions/") {
+		return resId, nil
+	}
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s/%s", tagHandler.Client.SubscriptionID, CBResourceGroupName, azureResType, resId), nil
+}
+
+func (tagHandler *AzureTagHandler) getTags(resType irs.RSType, resId string) (map[string]*string, error) {
+	scope, err := tagHandler.getScope(resType, resId)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsResource, err := tagHandler.Client.GetAtScope(tagHandler.Ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	if tagsResource.Properties == nil {
+		return map[string]*string{}, nil
+	}
+	return tagsResource.Properties.Tags, nil
+}
+
+// 같은 Key의 Tag가 있으면 Value를 덮어 씀
+func (tagHandler *AzureTagHandler) AddTag(resType irs.RSType, resId string, tag irs.KeyValue) (irs.KeyValue, error) {
+	scope, err := tagHandler.getScope(resType, resId)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+	if tag.Key == "" {
+		return irs.KeyValue{}, errors.New("tag key is required")
+	}
+
+	patch := resourcemgmt.TagsPatchResource{
+		Operation:  resourcemgmt.TagsPatchOperationMerge,
+		Properties: &resourcemgmt.Tags{Tags: map[string]*string{tag.Key: to.StringPtr(tag.Value)}},
+	}
+	_, err = tagHandler.Client.UpdateAtScope(tagHandler.Ctx, scope, patch)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+
+	return tag, nil
+}
+
+func (tagHandler *AzureTagHandler) ListTag(resType irs.RSType, resId string) ([]irs.KeyValue, error) {
+	tags, err := tagHandler.getTags(resType, resId)
+	if err != nil {
+		return nil, err
+	}
+	return setterTagList(tags), nil
+}
+
+func (tagHandler *AzureTagHandler) GetTag(resType irs.RSType, resId string, key string) (irs.KeyValue, error) {
+	tags, err := tagHandler.getTags(resType, resId)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+
+	value, ok := tags[key]
+	if !ok {
+		return irs.KeyValue{}, errors.New(fmt.Sprintf("tag %s of %s is not exist", key, resId))
+	}
+	return irs.KeyValue{Key: key, Value: toString(value)}, nil
+}
+
+func (tagHandler *AzureTagHandler) RemoveTag(resType irs.RSType, resId string, key string) (bool, error) {
+	tag, err := tagHandler.GetTag(resType, resId, key)
+	if err != nil {
+		return false, err
+	}
+
+	scope, err := tagHandler.getScope(resType, resId)
+	if err != nil {
+		return false, err
+	}
+
+	// Delete 연산은 Key와 Value가 모두 같은 Tag를 삭제함
+	patch := resourcemgmt.TagsPatchResource{
+		Operation:  resourcemgmt.TagsPatchOperationDelete,
+		Properties: &resourcemgmt.Tags{Tags: map[string]*string{tag.Key: to.StringPtr(tag.Value)}},
+	}
+	_, err = tagHandler.Client.UpdateAtScope(tagHandler.Ctx, scope, patch)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// keyword가 Key 또는 Value에 포함된 Tag를 가진 리소스 목록 (리소스의 모든 Tag를 함께 리턴)
+func (tagHandler *AzureTagHandler) FindTaggedResources(resType irs.RSType, keyword string) ([]*irs.TaggedResourceInfo, error) {
+	azureResType, err := getAzureTagResourceType(resType)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := fmt.Sprintf("resourceType eq '%s'", azureResType)
+	resourceList, err := tagHandler.ResourceClient.ListByResourceGroupComplete(tagHandler.Ctx, CBResourceGroupName, filter, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	taggedResList := []*irs.TaggedResourceInfo{}
+	for resourceList.NotDone() {
+		resource := resourceList.Value()
+
+		tagList := setterTagList(resource.Tags)
+		for _, tag := range tagList {
+			if matchTagKeyword(tag, keyword) {
+				taggedResList = append(taggedResList, &irs.TaggedResourceInfo{
+					ResType: resType,
+					ResId:   toString(resource.Name),
+					TagList: tagList,
+				})
+				break
+			}
+		}
+
+		if err := resourceList.NextWithContext(tagHandler.Ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return taggedResList, nil
+}
@@ -47,8 +47,14 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 		return irs.VMInfo{}, createErr
 	}
 
+	tags, err := getterTags(vmReqInfo.TagList)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
 	vmOpts := compute.VirtualMachine{
 		Location: &vmHandler.Region.Region,
+		Tags:     tags,
 		VirtualMachineProperties: &compute.VirtualMachineProperties{
 			HardwareProfile: &compute.HardwareProfile{
 				VMSize: compute.VirtualMachineSizeTypes(vmReqInfo.VMSpecId),
@@ -0,0 +1,183 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AzureVMSpecHandler struct {
+	Region         idrv.RegionInfo
+	Ctx            context.Context
+	Client         *compute.VirtualMachineSizesClient
+	ResourceClient *compute.ResourceSkusClient
+}
+
+func setterVMSpec(region string, vmSize compute.VirtualMachineSize, sku *compute.ResourceSku) *irs.VMSpecInfo {
+	vmSpecInfo := &irs.VMSpecInfo{
+		Region: region,
+		Name:   toString(vmSize.Name),
+		VCpu:   irs.VCpuInfo{Count: toInt32String(vmSize.NumberOfCores)},
+		Mem:    toInt32String(vmSize.MemoryInMB),
+		Disk:   "0",
+	}
+	if vmSize.ResourceDiskSizeInMB != nil {
+		vmSpecInfo.Disk = strconv.Itoa(int(*vmSize.ResourceDiskSizeInMB) / 1024)
+	}
+
+	keyValueList := []irs.KeyValue{
+		{Key: "OsDiskSizeInMB", Value: toInt32String(vmSize.OsDiskSizeInMB)},
+		{Key: "ResourceDiskSizeInMB", Value: toInt32String(vmSize.ResourceDiskSizeInMB)},
+		{Key: "MaxDataDiskCount", Value: toInt32String(vmSize.MaxDataDiskCount)},
+	}
+
+	// GPU, Network 정보는 Resource SKU의 Capabilities에서 추출
+	if sku != nil {
+		if sku.Family != nil {
+			keyValueList = append(keyValueList, irs.KeyValue{Key: "Family", Value: *sku.Family})
+		}
+		if sku.Capabilities != nil {
+			for _, capability := range *sku.Capabilities {
+				switch toString(capability.Name) {
+				case "GPUs":
+					vmSpecInfo.Gpu = []irs.GpuInfo{{Count: toString(capability.Value)}}
+				case "MaxNetworkInterfaces", "AcceleratedNetworkingEnabled", "vCPUsAvailable", "CpuArchitectureType":
+					keyValueList = append(keyValueList, irs.KeyValue{Key: *capability.Name, Value: toString(capability.Value)})
+				}
+			}
+		}
+	}
+	vmSpecInfo.KeyValueList = keyValueList
+
+	return vmSpecInfo
+}
+
+func (vmSpecHandler *AzureVMSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	vmSizeList, err := vmSpecHandler.listVMSize()
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	skuMap := vmSpecHandler.getResourceSkuMap()
+
+	var vmSpecInfoList []*irs.VMSpecInfo
+	for _, vmSize := range vmSizeList {
+		vmSpecInfo := setterVMSpec(vmSpecHandler.Region.Region, vmSize, skuMap[toString(vmSize.Name)])
+		vmSpecInfoList = append(vmSpecInfoList, vmSpecInfo)
+	}
+	return vmSpecInfoList, nil
+}
+
+func (vmSpecHandler *AzureVMSpecHandler) GetVMSpec(name string) (irs.VMSpecInfo, error) {
+	vmSizeList, err := vmSpecHandler.listVMSize()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMSpecInfo{}, err
+	}
+
+	for _, vmSize := range vmSizeList {
+		if strings.EqualFold(toString(vmSize.Name), name) {
+			skuMap := vmSpecHandler.getResourceSkuMap()
+			vmSpecInfo := setterVMSpec(vmSpecHandler.Region.Region, vmSize, skuMap[toString(vmSize.Name)])
+			return *vmSpecInfo, nil
+		}
+	}
+
+	errMsg := fmt.Sprintf("VMSpec with name %s not exist in %s", name, vmSpecHandler.Region.Region)
+	return irs.VMSpecInfo{}, errors.New(errMsg)
+}
+
+func (vmSpecHandler *AzureVMSpecHandler) ListOrgVMSpec() (string, error) {
+	vmSizeList, err := vmSpecHandler.listVMSize()
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	jsonBytes, err := json.Marshal(vmSizeList)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (vmSpecHandler *AzureVMSpecHandler) listVMSize() ([]compute.VirtualMachineSize, error) {
+	result, err := vmSpecHandler.Client.List(vmSpecHandler.Ctx, vmSpecHandler.Region.Region)
+	if err != nil {
+		return nil, err
+	}
+	if result.Value == nil {
+		return nil, nil
+	}
+	return *result.Value, nil
+}
+
+// Resource SKU 목록은 부가 정보(GPU 등)이므로, 조회 실패 시 빈 목록으로 처리
+func (vmSpecHandler *AzureVMSpecHandler) getResourceSkuMap() map[string]*compute.ResourceSku {
+	skuMap := map[string]*compute.ResourceSku{}
+	if vmSpecHandler.ResourceClient == nil {
+		return skuMap
+	}
+
+	iter, err := vmSpecHandler.ResourceClient.ListComplete(vmSpecHandler.Ctx)
+	if err != nil {
+		cblogger.Error(err)
+		return skuMap
+	}
+	for ; iter.NotDone(); err = iter.NextWithContext(vmSpecHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return skuMap
+		}
+		sku := iter.Value()
+		if toString(sku.ResourceType) != "virtualMachines" || !hasLocation(sku.Locations, vmSpecHandler.Region.Region) {
+			continue
+		}
+		skuMap[toString(sku.Name)] = &sku
+	}
+	return skuMap
+}
+
+func hasLocation(locations *[]string, location string) bool {
+	if locations == nil {
+		return false
+	}
+	for _, cur := range *locations {
+		if strings.EqualFold(cur, location) {
+			return true
+		}
+	}
+	return false
+}
+
+func toString(str *string) string {
+	if str == nil {
+		return ""
+	}
+	return *str
+}
+
+func toInt32String(num *int32) string {
+	if num == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*num))
+}
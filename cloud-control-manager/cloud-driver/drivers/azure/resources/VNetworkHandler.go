@@ -30,6 +30,11 @@ func setterVNet(network network.Subnet) *irs.VNetworkInfo {
 }
 
 func (vNetworkHandler *AzureVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VNetworkReqInfo) (irs.VNetworkInfo, error) {
+	// Subnet은 Azure에서 Tag를 지원하지 않음
+	if len(vNetworkReqInfo.TagList) > 0 {
+		return irs.VNetworkInfo{}, errors.New("tags are not supported by Azure subnets")
+	}
+
 	// Check VNet Exists
 	// 기본 가상 네트워크가 생성되지 않았을 경우 디폴트 네트워크 생성 (CB-VNet)
 	vNetwork, _ := vNetworkHandler.Client.Get(vNetworkHandler.Ctx, CBResourceGroupName, CBVirutalNetworkName, "")
@@ -0,0 +1,210 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AzureVPCHandler struct {
+	Region       idrv.RegionInfo
+	Ctx          context.Context
+	Client       *network.VirtualNetworksClient
+	SubnetClient *network.SubnetsClient
+}
+
+// Virtual Network(VPC)와 Subnet은 이름을 ID로 사용 (Azure의 Subnet은 Zone 구분 없음)
+func setterVPC(vNet network.VirtualNetwork) *irs.VPCInfo {
+	vpcInfo := &irs.VPCInfo{
+		Id:   toString(vNet.Name),
+		Name: toString(vNet.Name),
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceGroup", Value: CBResourceGroupName},
+			{Key: "ResourceId", Value: toString(vNet.ID)},
+		},
+	}
+
+	if vNet.VirtualNetworkPropertiesFormat == nil {
+		return vpcInfo
+	}
+	if vNet.AddressSpace != nil && vNet.AddressSpace.AddressPrefixes != nil && len(*vNet.AddressSpace.AddressPrefixes) > 0 {
+		vpcInfo.CIDR = (*vNet.AddressSpace.AddressPrefixes)[0]
+	}
+	if vNet.Subnets != nil {
+		for _, subnet := range *vNet.Subnets {
+			vpcInfo.SubnetInfoList = append(vpcInfo.SubnetInfoList, setterSubnet(subnet))
+		}
+	}
+	vpcInfo.KeyValueList = append(vpcInfo.KeyValueList, irs.KeyValue{Key: "ProvisioningState", Value: toString(vNet.ProvisioningState)})
+	return vpcInfo
+}
+
+func setterSubnet(subnet network.Subnet) irs.SubnetInfo {
+	subnetInfo := irs.SubnetInfo{
+		Id:   toString(subnet.Name),
+		Name: toString(subnet.Name),
+	}
+	if subnet.SubnetPropertiesFormat != nil {
+		subnetInfo.CIDR = toString(subnet.AddressPrefix)
+		subnetInfo.KeyValueList = []irs.KeyValue{
+			{Key: "ProvisioningState", Value: toString(subnet.ProvisioningState)},
+		}
+	}
+	return subnetInfo
+}
+
+func (vpcHandler *AzureVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCInfo, error) {
+	if vpcReqInfo.CIDR == "" {
+		return irs.VPCInfo{}, errors.New("CIDR is required to create a VPC")
+	}
+
+	// Check VPC Exists
+	vNet, _ := vpcHandler.Client.Get(vpcHandler.Ctx, CBResourceGroupName, vpcReqInfo.Name, "")
+	if vNet.ID != nil {
+		errMsg := fmt.Sprintf("VPC with name %s already exist", vpcReqInfo.Name)
+		return irs.VPCInfo{}, errors.New(errMsg)
+	}
+
+	tags, err := getterTags(vpcReqInfo.TagList)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	var subnetList []network.Subnet
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		subnetList = append(subnetList, network.Subnet{
+			Name: to.StringPtr(subnetInfo.Name),
+			SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+				AddressPrefix: to.StringPtr(subnetInfo.CIDR),
+			},
+		})
+	}
+
+	createOpts := network.VirtualNetwork{
+		Name: to.StringPtr(vpcReqInfo.Name),
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			AddressSpace: &network.AddressSpace{
+				AddressPrefixes: &[]string{vpcReqInfo.CIDR},
+			},
+			Subnets: &subnetList,
+		},
+		Location: &vpcHandler.Region.Region,
+		Tags:     tags,
+	}
+
+	future, err := vpcHandler.Client.CreateOrUpdate(vpcHandler.Ctx, CBResourceGroupName, vpcReqInfo.Name, createOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	err = future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		// Subnet 생성 실패 등으로 VNet 생성이 완료되지 않으면 생성된 VNet 삭제
+		if _, errDel := vpcHandler.DeleteVPC(vpcReqInfo.Name); errDel != nil {
+			cblogger.Error(errDel)
+		}
+		return irs.VPCInfo{}, err
+	}
+
+	return vpcHandler.GetVPC(vpcReqInfo.Name)
+}
+
+func (vpcHandler *AzureVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
+	iter, err := vpcHandler.Client.ListComplete(vpcHandler.Ctx, CBResourceGroupName)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var vpcList []*irs.VPCInfo
+	for ; iter.NotDone(); err = iter.NextWithContext(vpcHandler.Ctx) {
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		vpcInfo := setterVPC(iter.Value())
+		vpcList = append(vpcList, vpcInfo)
+	}
+	return vpcList, nil
+}
+
+func (vpcHandler *AzureVPCHandler) GetVPC(vpcID string) (irs.VPCInfo, error) {
+	vNet, err := vpcHandler.Client.Get(vpcHandler.Ctx, CBResourceGroupName, vpcID, "")
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	vpcInfo := setterVPC(vNet)
+	return *vpcInfo, nil
+}
+
+// Virtual Network를 삭제하면 Subnet도 함께 삭제됨
+func (vpcHandler *AzureVPCHandler) DeleteVPC(vpcID string) (bool, error) {
+	future, err := vpcHandler.Client.Delete(vpcHandler.Ctx, CBResourceGroupName, vpcID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.Client.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *AzureVPCHandler) AddSubnet(vpcID string, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
+	if subnetInfo.CIDR == "" {
+		return irs.VPCInfo{}, errors.New("CIDR is required to add a subnet")
+	}
+
+	createOpts := network.Subnet{
+		Name: to.StringPtr(subnetInfo.Name),
+		SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+			AddressPrefix: to.StringPtr(subnetInfo.CIDR),
+		},
+	}
+
+	future, err := vpcHandler.SubnetClient.CreateOrUpdate(vpcHandler.Ctx, CBResourceGroupName, vpcID, subnetInfo.Name, createOpts)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	err = future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.SubnetClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+
+	return vpcHandler.GetVPC(vpcID)
+}
+
+func (vpcHandler *AzureVPCHandler) RemoveSubnet(vpcID string, subnetID string) (bool, error) {
+	future, err := vpcHandler.SubnetClient.Delete(vpcHandler.Ctx, CBResourceGroupName, vpcID, subnetID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	err = future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.SubnetClient.Client)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
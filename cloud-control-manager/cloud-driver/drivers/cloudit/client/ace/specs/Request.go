@@ -0,0 +1,58 @@
+package specs
+
+import (
+	cblog "github.com/cloud-barista/cb-log"
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client"
+	"github.com/sirupsen/logrus"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	// cblog is a global variable.
+	cblogger = cblog.GetLogger("CB-SPIDER")
+}
+
+type VMSpecInfo struct {
+	Id          string
+	Name        string
+	Cpu         int
+	Mem         int // MB
+	Disk        int // GB
+	GpuCount    int
+	Description string
+	Ownership   string
+	CreatedAt   string
+}
+
+func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]VMSpecInfo, error) {
+	requestURL := restClient.CreateRequestBaseURL(client.ACE, "specs")
+	cblogger.Info(requestURL)
+
+	var result client.Result
+	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
+		return nil, result.Err
+	}
+
+	var specList []VMSpecInfo
+	if err := result.ExtractInto(&specList); err != nil {
+		return nil, err
+	}
+	return &specList, nil
+}
+
+func Get(restClient *client.RestClient, specId string, requestOpts *client.RequestOpts) (*VMSpecInfo, error) {
+	requestURL := restClient.CreateRequestBaseURL(client.ACE, "specs", specId)
+	cblogger.Info(requestURL)
+
+	var result client.Result
+	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
+		return nil, result.Err
+	}
+
+	var spec VMSpecInfo
+	if err := result.ExtractInto(&spec); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
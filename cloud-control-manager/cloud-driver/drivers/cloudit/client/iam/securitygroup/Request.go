@@ -119,3 +119,30 @@ func Delete(restClient *client.RestClient, securitygroupId string, requestOpts *
 	}
 	return nil
 }
+
+func CreateRule(restClient *client.RestClient, securitygroupId string, requestOpts *client.RequestOpts) (*SecurityGroupRules, error) {
+	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups", securitygroupId, "rules")
+	cblogger.Info(requestURL)
+
+	var result client.Result
+	if _, result.Err = restClient.Post(requestURL, nil, &result.Body, requestOpts); result.Err != nil {
+		return nil, result.Err
+	}
+
+	var sgRule SecurityGroupRules
+	if err := result.ExtractInto(&sgRule); err != nil {
+		return nil, err
+	}
+	return &sgRule, nil
+}
+
+func DeleteRule(restClient *client.RestClient, securitygroupId string, ruleId string, requestOpts *client.RequestOpts) error {
+	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups", securitygroupId, "rules", ruleId)
+	cblogger.Info(requestURL)
+
+	var result client.Result
+	if _, result.Err = restClient.Delete(requestURL, requestOpts); result.Err != nil {
+		return result.Err
+	}
+	return nil
+}
@@ -11,6 +11,8 @@
 package connect
 
 import (
+	"errors"
+
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client"
 	cirs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/resources"
@@ -71,6 +73,68 @@ func (cloudConn *ClouditCloudConnection) CreateVMHandler() (irs.VMHandler, error
 	return &vmHandler, nil
 }
 
+func (cloudConn *ClouditCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateVMSpecHandler()!")
+	vmSpecHandler := cirs.ClouditVMSpecHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+	return &vmSpecHandler, nil
+}
+
+func (cloudConn *ClouditCloudConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateDiskHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateSnapshotHandler() (irs.SnapshotHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateSnapshotHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateVPCHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateRouterHandler() (irs.RouterHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateRouterHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateNATGatewayHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateNLBHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateObjectStorageHandler() (irs.ObjectStorageHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateObjectStorageHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateClusterHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateDNSHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateAutoScalingHandler() (irs.AutoScalingHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateAutoScalingHandler()!")
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
+	cblogger.Info("Cloudit Cloud Driver: called CreateTagHandler()!")
+	// Cloudit API는 리소스 Tag를 제공하지 않음
+	return nil, errors.New("Cloudit Driver: not supported, Cloudit has no resource tags")
+}
+
 func (ClouditCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"errors"
+
 	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client/ace/image"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -70,6 +72,14 @@ func (imageHandler *ClouditImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 	}
 }
 
+func (imageHandler *ClouditImageHandler) ListMyImage() ([]*irs.ImageInfo, error) {
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
+func (imageHandler *ClouditImageHandler) ListImageByFilter(filterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	return nil, errors.New("Cloudit Driver: not implemented")
+}
+
 func (imageHandler *ClouditImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
 	imageHandler.Client.TokenID = imageHandler.CredentialInfo.AuthToken
 	authHeader := imageHandler.Client.AuthenticatedHeaders()
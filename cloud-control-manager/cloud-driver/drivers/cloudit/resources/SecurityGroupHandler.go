@@ -25,11 +25,14 @@ func setterSecGroup(secGroup securitygroup.SecurityGroupInfo) *irs.SecurityInfo
 
 	var secRuleArr []irs.SecurityRuleInfo
 	for _, sgRule := range secGroup.Rules {
+		// Port 형식: "22", "1000-2000"
+		portArr := strings.Split(sgRule.Port, "-")
 		secRuleInfo := irs.SecurityRuleInfo{
-			FromPort:   sgRule.Port,
-			ToPort:     sgRule.Port,
+			FromPort:   portArr[0],
+			ToPort:     portArr[len(portArr)-1],
 			IPProtocol: sgRule.Protocol,
 			Direction:  sgRule.Type,
+			CIDR:       sgRule.Target,
 		}
 		secRuleArr = append(secRuleArr, secRuleInfo)
 	}
@@ -60,12 +63,9 @@ func (securityHandler *ClouditSecurityHandler) CreateSecurity(securityReqInfo ir
 	// SecurityGroup Rule 설정
 	ruleList := []securitygroup.SecurityGroupRules{}
 	for idx, rule := range *securityReqInfo.SecurityRules {
-		secRuleInfo := securitygroup.SecurityGroupRules{
-			Name:     fmt.Sprintf("%s-rules-%d", securityReqInfo.Name, idx+1),
-			Type:     rule.Direction,
-			Port:     rule.ToPort,
-			Target:   "0.0.0.0/0",
-			Protocol: strings.ToLower(rule.IPProtocol),
+		secRuleInfo, err := getSecGroupRule(fmt.Sprintf("%s-rules-%d", securityReqInfo.Name, idx+1), rule)
+		if err != nil {
+			return irs.SecurityInfo{}, err
 		}
 		ruleList = append(ruleList, secRuleInfo)
 	}
@@ -168,3 +168,114 @@ func (securityHandler *ClouditSecurityHandler) CheckSecurityExist(securityName s
 	}
 	return &securityId, nil
 }
+
+func (securityHandler *ClouditSecurityHandler) AddRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	securityHandler.Client.TokenID = securityHandler.CredentialInfo.AuthToken
+	authHeader := securityHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	securityInfo, err := securitygroup.Get(securityHandler.Client, securityID, &requestOpts)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	sgRules, err := securitygroup.ListRule(securityHandler.Client, securityID, &requestOpts)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
+	if securityRules != nil {
+		// 기존 Rule 이름과 중복되지 않도록 이름 설정
+		ruleIdx := len(*sgRules)
+		for _, rule := range *securityRules {
+			ruleIdx++
+			secRuleInfo, err := getSecGroupRule(fmt.Sprintf("%s-rules-%d", securityInfo.Name, ruleIdx), rule)
+			if err != nil {
+				return irs.SecurityInfo{}, err
+			}
+
+			createOpts := client.RequestOpts{
+				JSONBody:    secRuleInfo,
+				MoreHeaders: authHeader,
+			}
+			if _, err := securitygroup.CreateRule(securityHandler.Client, securityID, &createOpts); err != nil {
+				return irs.SecurityInfo{}, err
+			}
+		}
+	}
+
+	return securityHandler.GetSecurity(securityID)
+}
+
+func (securityHandler *ClouditSecurityHandler) RemoveRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	securityHandler.Client.TokenID = securityHandler.CredentialInfo.AuthToken
+	authHeader := securityHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	sgRules, err := securitygroup.ListRule(securityHandler.Client, securityID, &requestOpts)
+	if err != nil {
+		return false, err
+	}
+	if securityRules == nil {
+		return true, nil
+	}
+
+	// 삭제할 Rule ID 조회
+	var ruleIds []string
+	for _, rule := range *securityRules {
+		secRuleInfo, err := getSecGroupRule("", rule)
+		if err != nil {
+			return false, err
+		}
+
+		ruleId := ""
+		for _, sgRule := range *sgRules {
+			if strings.EqualFold(sgRule.Type, secRuleInfo.Type) && strings.EqualFold(sgRule.Protocol, secRuleInfo.Protocol) &&
+				sgRule.Port == secRuleInfo.Port && sgRule.Target == secRuleInfo.Target {
+				ruleId = sgRule.ID
+				break
+			}
+		}
+		if ruleId == "" {
+			errMsg := fmt.Sprintf("Security Group %s has no rule for %s %s", securityID, secRuleInfo.Protocol, secRuleInfo.Port)
+			return false, errors.New(errMsg)
+		}
+		ruleIds = append(ruleIds, ruleId)
+	}
+
+	for _, ruleId := range ruleIds {
+		if err := securitygroup.DeleteRule(securityHandler.Client, securityID, ruleId, &requestOpts); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func getSecGroupRule(name string, rule irs.SecurityRuleInfo) (securitygroup.SecurityGroupRules, error) {
+	if rule.SourceSecurityGroupId != "" {
+		return securitygroup.SecurityGroupRules{}, errors.New("Cloudit security group does not support the source security group rule")
+	}
+
+	target := rule.CIDR
+	if target == "" {
+		target = "0.0.0.0/0"
+	}
+	port := rule.ToPort
+	if rule.FromPort != "" && rule.FromPort != rule.ToPort {
+		port = fmt.Sprintf("%s-%s", rule.FromPort, rule.ToPort)
+	}
+
+	secRuleInfo := securitygroup.SecurityGroupRules{
+		Name:     name,
+		Type:     rule.Direction,
+		Port:     port,
+		Target:   target,
+		Protocol: strings.ToLower(rule.IPProtocol),
+	}
+	return secRuleInfo, nil
+}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client"
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client/ace/specs"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type ClouditVMSpecHandler struct {
+	CredentialInfo idrv.CredentialInfo
+	Client         *client.RestClient
+}
+
+// VMReqInfo.VMSpecId로 Spec ID를 사용하므로, VMSpecInfo.Name에 Spec ID를 설정
+func setterVMSpec(spec specs.VMSpecInfo) *irs.VMSpecInfo {
+	vmSpecInfo := &irs.VMSpecInfo{
+		Name: spec.Id,
+		VCpu: irs.VCpuInfo{Count: strconv.Itoa(spec.Cpu)},
+		Mem:  strconv.Itoa(spec.Mem),
+		Disk: strconv.Itoa(spec.Disk),
+	}
+	if spec.GpuCount > 0 {
+		vmSpecInfo.Gpu = []irs.GpuInfo{{Count: strconv.Itoa(spec.GpuCount)}}
+	}
+
+	vmSpecInfo.KeyValueList = []irs.KeyValue{
+		{Key: "SpecName", Value: spec.Name},
+		{Key: "Description", Value: spec.Description},
+		{Key: "Ownership", Value: spec.Ownership},
+		// Cloudit은 x86_64 서버만 제공
+		{Key: "Architecture", Value: "x86_64"},
+	}
+	return vmSpecInfo
+}
+
+func (vmSpecHandler *ClouditVMSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	vmSpecHandler.Client.TokenID = vmSpecHandler.CredentialInfo.AuthToken
+	authHeader := vmSpecHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	if specList, err := specs.List(vmSpecHandler.Client, &requestOpts); err != nil {
+		return nil, err
+	} else {
+		var resultList []*irs.VMSpecInfo
+
+		for _, spec := range *specList {
+			vmSpecInfo := setterVMSpec(spec)
+			resultList = append(resultList, vmSpecInfo)
+		}
+		return resultList, nil
+	}
+}
+
+func (vmSpecHandler *ClouditVMSpecHandler) GetVMSpec(specID string) (irs.VMSpecInfo, error) {
+	vmSpecHandler.Client.TokenID = vmSpecHandler.CredentialInfo.AuthToken
+	authHeader := vmSpecHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	if spec, err := specs.Get(vmSpecHandler.Client, specID, &requestOpts); err != nil {
+		return irs.VMSpecInfo{}, err
+	} else {
+		vmSpecInfo := setterVMSpec(*spec)
+		return *vmSpecInfo, nil
+	}
+}
+
+func (vmSpecHandler *ClouditVMSpecHandler) ListOrgVMSpec() (string, error) {
+	vmSpecHandler.Client.TokenID = vmSpecHandler.CredentialInfo.AuthToken
+	authHeader := vmSpecHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	specList, err := specs.List(vmSpecHandler.Client, &requestOpts)
+	if err != nil {
+		return "", err
+	}
+
+	jsonBytes, err := json.Marshal(specList)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
@@ -44,6 +44,18 @@ func (GCPDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.VNicHandler = true
 	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.DiskHandler = true
+	drvCapabilityInfo.SnapshotHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.RouterHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+	drvCapabilityInfo.NLBHandler = true
+	drvCapabilityInfo.ObjectStorageHandler = true
+	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.AutoScalingHandler = true
+	drvCapabilityInfo.TagHandler = true
 
 	return drvCapabilityInfo
 }
@@ -67,9 +79,19 @@ func (driver *GCPDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		ImageClient:         VMClient,
 		PublicIPClient:      VMClient,
 		SecurityGroupClient: VMClient,
+		KeyPairClient:       VMClient,
 		VNetClient:          VMClient,
 		VNicClient:          VMClient,
 		SubnetClient:        VMClient,
+		VMSpecClient:        VMClient,
+		DiskClient:          VMClient,
+		SnapshotClient:      VMClient,
+		VPCClient:           VMClient,
+		RouterClient:        VMClient,
+		NATGatewayClient:    VMClient,
+		NLBClient:           VMClient,
+		AutoScalingClient:   VMClient,
+		TagClient:           VMClient,
 	}
 	return &iConn, nil
 }
@@ -13,11 +13,19 @@ package connect
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 
 	gcprs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/gcp/resources"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	compute "google.golang.org/api/compute/v1"
+	container "google.golang.org/api/container/v1"
+	dns "google.golang.org/api/dns/v1"
+	storage "google.golang.org/api/storage/v1"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
 )
 
 type GCPCloudConnection struct {
@@ -28,9 +36,22 @@ type GCPCloudConnection struct {
 	ImageClient         *compute.Service
 	PublicIPClient      *compute.Service
 	SecurityGroupClient *compute.Service
+	KeyPairClient       *compute.Service
 	VNetClient          *compute.Service
 	VNicClient          *compute.Service
 	SubnetClient        *compute.Service
+	VMSpecClient        *compute.Service
+	DiskClient          *compute.Service
+	SnapshotClient      *compute.Service
+	VPCClient           *compute.Service
+	RouterClient        *compute.Service
+	NATGatewayClient    *compute.Service
+	NLBClient           *compute.Service
+	AutoScalingClient   *compute.Service
+	TagClient           *compute.Service
+	StorageClient       *storage.Service   // CreateObjectStorageHandler()에서 생성
+	ContainerClient     *container.Service // CreateClusterHandler()에서 생성
+	DNSClient           *dns.Service       // CreateDNSHandler()에서 생성
 }
 
 func (cloudConn *GCPCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
@@ -51,8 +72,10 @@ func (cloudConn *GCPCloudConnection) CreateSecurityHandler() (irs.SecurityHandle
 	return &sgHandler, nil
 }
 
-func (GCPCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
-	return nil, nil
+func (cloudConn *GCPCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateKeyPairHandler()!")
+	keypairHandler := gcprs.GCPKeyPairHandler{cloudConn.Credential, cloudConn.Region, cloudConn.Ctx, cloudConn.KeyPairClient}
+	return &keypairHandler, nil
 }
 
 func (cloudConn *GCPCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
@@ -72,6 +95,137 @@ func (cloudConn *GCPCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
 	return &vmHandler, nil
 }
 
+func (cloudConn *GCPCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateVMSpecHandler()!")
+	vmSpecHandler := gcprs.GCPVMSpecHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VMSpecClient, cloudConn.Credential}
+	return &vmSpecHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateDiskHandler()!")
+	diskHandler := gcprs.GCPDiskHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.DiskClient, cloudConn.Credential}
+	return &diskHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateSnapshotHandler() (irs.SnapshotHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateSnapshotHandler()!")
+	snapshotHandler := gcprs.GCPSnapshotHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.SnapshotClient, cloudConn.Credential}
+	return &snapshotHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateVPCHandler()!")
+	vpcHandler := gcprs.GCPVPCHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VPCClient, cloudConn.Credential}
+	return &vpcHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateRouterHandler() (irs.RouterHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateRouterHandler()!")
+	routerHandler := gcprs.GCPRouterHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.RouterClient, cloudConn.Credential}
+	return &routerHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateNATGatewayHandler()!")
+	natGatewayHandler := gcprs.GCPNATGatewayHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.NATGatewayClient, cloudConn.Credential}
+	return &natGatewayHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateNLBHandler()!")
+	nlbHandler := gcprs.GCPNLBHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.NLBClient, cloudConn.Credential}
+	return &nlbHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateObjectStorageHandler() (irs.ObjectStorageHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateObjectStorageHandler()!")
+	if cloudConn.StorageClient == nil {
+		storageClient, err := getStorageClient(cloudConn.Credential)
+		if err != nil {
+			return nil, err
+		}
+		cloudConn.StorageClient = storageClient
+	}
+	storageHandler := gcprs.GCPObjectStorageHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.StorageClient, cloudConn.Credential}
+	return &storageHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateClusterHandler()!")
+	if cloudConn.ContainerClient == nil {
+		containerClient, err := getContainerClient(cloudConn.Credential)
+		if err != nil {
+			return nil, err
+		}
+		cloudConn.ContainerClient = containerClient
+	}
+	clusterHandler := gcprs.GCPClusterHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.ContainerClient, cloudConn.VMClient, cloudConn.Credential}
+	return &clusterHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateDNSHandler()!")
+	if cloudConn.DNSClient == nil {
+		dnsClient, err := getDNSClient(cloudConn.Credential)
+		if err != nil {
+			return nil, err
+		}
+		cloudConn.DNSClient = dnsClient
+	}
+	dnsHandler := gcprs.GCPDNSHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.DNSClient, cloudConn.Credential}
+	return &dnsHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateAutoScalingHandler() (irs.AutoScalingHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateAutoScalingHandler()!")
+	autoScalingHandler := gcprs.GCPAutoScalingHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.AutoScalingClient, cloudConn.Credential}
+	return &autoScalingHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
+	fmt.Println("GCP Cloud Driver: called CreateTagHandler()!")
+	tagHandler := gcprs.GCPTagHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.TagClient, cloudConn.Credential}
+	return &tagHandler, nil
+}
+
+// compute 외의 API 클라이언트는 API마다 scope가 다르므로 Handler를 사용할 때 생성 (ConnectCloud()에서 생성하지 않음)
+func getJWTClient(credential idrv.CredentialInfo, scope string) (*http.Client, error) {
+	data, err := ioutil.ReadFile(credential.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := google.JWTConfigFromJSON(data, scope)
+	if err != nil {
+		return nil, err
+	}
+	return conf.Client(oauth2.NoContext), nil
+}
+
+func getStorageClient(credential idrv.CredentialInfo) (*storage.Service, error) {
+	client, err := getJWTClient(credential, storage.DevstorageFullControlScope)
+	if err != nil {
+		return nil, err
+	}
+	return storage.New(client)
+}
+
+// GKE(Kubernetes Engine)는 cloud-platform scope를 사용함
+func getContainerClient(credential idrv.CredentialInfo) (*container.Service, error) {
+	client, err := getJWTClient(credential, container.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	return container.New(client)
+}
+
+func getDNSClient(credential idrv.CredentialInfo) (*dns.Service, error) {
+	client, err := getJWTClient(credential, dns.NdevClouddnsReadwriteScope)
+	if err != nil {
+		return nil, err
+	}
+	return dns.New(client)
+}
+
 func (GCPCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
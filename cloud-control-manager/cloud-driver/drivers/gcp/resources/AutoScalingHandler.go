@@ -0,0 +1,494 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+const (
+	CBManagedInstanceNone    = "NONE"
+	CBManagedInstanceRunning = "RUNNING"
+	CBAutoscalerCoolDown     = 60 // seconds
+)
+
+// GCP는 Zone 단위 Managed Instance Group(MIG)을 Auto Scaling Group으로 사용한다.(ID = MIG 이름 = Instance Template 이름)
+// VirtualNetworkId는 연결 Region의 Subnetwork 이름, SecurityGroupIds는 Firewall의 Target Tag로 사용
+// MIG에는 최소/최대 크기가 없으므로 Description에 기록하고, Scaling Policy(Autoscaler)가 있으면 Autoscaler의 값을 사용한다.
+type GCPAutoScalingHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+func (asgHandler *GCPAutoScalingHandler) CreateAutoScalingGroup(groupReqInfo irs.AutoScalingGroupReqInfo) (irs.AutoScalingGroupInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	if err := checkAutoScalingGroupSize(groupReqInfo.DesiredSize, groupReqInfo.MinSize, groupReqInfo.MaxSize); err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	// 1. Instance Template 생성
+	instanceTemplate, err := asgHandler.getInstanceTemplate(groupReqInfo.Name, groupReqInfo.VMTemplate)
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	op, err := asgHandler.Client.InstanceTemplates.Insert(projectID, instanceTemplate).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	if err := WaitGlobalOperation(asgHandler.Client, asgHandler.Ctx, projectID, op.Name); err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	// 2. Managed Instance Group 생성
+	instanceGroupManager := &compute.InstanceGroupManager{
+		Name:             groupReqInfo.Name,
+		Description:      getAutoScalingGroupDescription(groupReqInfo.MinSize, groupReqInfo.MaxSize),
+		BaseInstanceName: groupReqInfo.Name,
+		InstanceTemplate: "global/instanceTemplates/" + groupReqInfo.Name,
+		TargetSize:       int64(groupReqInfo.DesiredSize),
+		ForceSendFields:  []string{"TargetSize"},
+	}
+	op, err = asgHandler.Client.InstanceGroupManagers.Insert(projectID, zone, instanceGroupManager).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		asgHandler.deleteInstanceTemplate(groupReqInfo.Name)
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	return asgHandler.GetAutoScalingGroup(groupReqInfo.Name)
+}
+
+func (asgHandler *GCPAutoScalingHandler) ListAutoScalingGroup() ([]*irs.AutoScalingGroupInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	var groupInfoList []*irs.AutoScalingGroupInfo
+	err := asgHandler.Client.InstanceGroupManagers.List(projectID, zone).Pages(asgHandler.Ctx, func(page *compute.InstanceGroupManagerList) error {
+		for _, instanceGroupManager := range page.Items {
+			groupInfo := asgHandler.mappingAutoScalingGroupInfo(instanceGroupManager)
+			groupInfoList = append(groupInfoList, &groupInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groupInfoList, nil
+}
+
+func (asgHandler *GCPAutoScalingHandler) GetAutoScalingGroup(groupID string) (irs.AutoScalingGroupInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	instanceGroupManager, err := asgHandler.Client.InstanceGroupManagers.Get(projectID, zone, groupID).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	return asgHandler.mappingAutoScalingGroupInfo(instanceGroupManager), nil
+}
+
+// Autoscaler -> Managed Instance Group(멤버 VM 포함) -> Instance Template 순서로 삭제한다.
+func (asgHandler *GCPAutoScalingHandler) DeleteAutoScalingGroup(groupID string) (bool, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	instanceGroupManager, err := asgHandler.Client.InstanceGroupManagers.Get(projectID, zone, groupID).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+
+	autoscaler, err := asgHandler.getAutoscaler(instanceGroupManager)
+	if err != nil {
+		return false, err
+	}
+	if autoscaler != nil {
+		if _, err := asgHandler.RemoveScalingPolicy(groupID, autoscaler.Name); err != nil {
+			return false, err
+		}
+	}
+
+	op, err := asgHandler.Client.InstanceGroupManagers.Delete(projectID, zone, groupID).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return false, err
+	}
+
+	asgHandler.deleteInstanceTemplate(getResourceName(instanceGroupManager.InstanceTemplate))
+	return true, nil
+}
+
+// Autoscaler가 있으면 최소/최대 크기를 Autoscaler에 반영한다.(Autoscaler가 MIG의 크기를 조정하므로 희망 크기는 최소 크기로 적용됨)
+func (asgHandler *GCPAutoScalingHandler) ChangeAutoScalingGroupSize(groupID string, desiredSize int, minSize int, maxSize int) (irs.AutoScalingGroupInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	if err := checkAutoScalingGroupSize(desiredSize, minSize, maxSize); err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	instanceGroupManager, err := asgHandler.Client.InstanceGroupManagers.Get(projectID, zone, groupID).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+
+	autoscaler, err := asgHandler.getAutoscaler(instanceGroupManager)
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	if autoscaler != nil {
+		autoscaler.AutoscalingPolicy.MinNumReplicas = int64(minSize)
+		autoscaler.AutoscalingPolicy.MaxNumReplicas = int64(maxSize)
+		op, err := asgHandler.Client.Autoscalers.Patch(projectID, zone, autoscaler).Autoscaler(autoscaler.Name).Context(asgHandler.Ctx).Do()
+		if err != nil {
+			return irs.AutoScalingGroupInfo{}, err
+		}
+		if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+			return irs.AutoScalingGroupInfo{}, err
+		}
+	} else {
+		op, err := asgHandler.Client.InstanceGroupManagers.Resize(projectID, zone, groupID, int64(desiredSize)).Context(asgHandler.Ctx).Do()
+		if err != nil {
+			return irs.AutoScalingGroupInfo{}, err
+		}
+		if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+			return irs.AutoScalingGroupInfo{}, err
+		}
+	}
+
+	// 최소/최대 크기 기록 갱신
+	patch := &compute.InstanceGroupManager{
+		Description: getAutoScalingGroupDescription(minSize, maxSize),
+	}
+	op, err := asgHandler.Client.InstanceGroupManagers.Patch(projectID, zone, groupID, patch).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.AutoScalingGroupInfo{}, err
+	}
+	return asgHandler.GetAutoScalingGroup(groupID)
+}
+
+func (asgHandler *GCPAutoScalingHandler) ListAutoScalingGroupMember(groupID string) ([]*irs.AutoScalingMemberInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	var memberInfoList []*irs.AutoScalingMemberInfo
+	err := asgHandler.Client.InstanceGroupManagers.ListManagedInstances(projectID, zone, groupID).Pages(asgHandler.Ctx, func(page *compute.InstanceGroupManagersListManagedInstancesResponse) error {
+		for _, managedInstance := range page.ManagedInstances {
+			memberInfo := irs.AutoScalingMemberInfo{
+				VMId:   getResourceName(managedInstance.Instance),
+				Status: getManagedInstanceStatus(managedInstance),
+				Zone:   zone,
+				KeyValueList: []irs.KeyValue{
+					{Key: "CurrentAction", Value: managedInstance.CurrentAction},
+					{Key: "InstanceStatus", Value: managedInstance.InstanceStatus},
+				},
+			}
+			memberInfoList = append(memberInfoList, &memberInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return memberInfoList, nil
+}
+
+// MIG당 하나의 Autoscaler만 생성 가능 (Autoscaler 이름 = Policy 이름)
+func (asgHandler *GCPAutoScalingHandler) AddScalingPolicy(groupID string, policyReqInfo irs.ScalingPolicyReqInfo) (irs.ScalingPolicyInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	instanceGroupManager, err := asgHandler.Client.InstanceGroupManagers.Get(projectID, zone, groupID).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+
+	autoscaler, err := asgHandler.getAutoscaler(instanceGroupManager)
+	if err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+	if autoscaler != nil {
+		return irs.ScalingPolicyInfo{}, errors.New("GCP managed instance group supports only one scaling policy, " + autoscaler.Name + " already exists")
+	}
+
+	groupInfo := asgHandler.mappingAutoScalingGroupInfo(instanceGroupManager)
+	autoscaler = &compute.Autoscaler{
+		Name:   policyReqInfo.Name,
+		Target: instanceGroupManager.SelfLink,
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:    int64(groupInfo.MinSize),
+			MaxNumReplicas:    int64(groupInfo.MaxSize),
+			CoolDownPeriodSec: CBAutoscalerCoolDown,
+			CpuUtilization: &compute.AutoscalingPolicyCpuUtilization{
+				UtilizationTarget: policyReqInfo.TargetCPUUtilization / 100,
+			},
+		},
+	}
+	op, err := asgHandler.Client.Autoscalers.Insert(projectID, zone, autoscaler).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+	if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+
+	autoscaler, err = asgHandler.Client.Autoscalers.Get(projectID, zone, policyReqInfo.Name).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return irs.ScalingPolicyInfo{}, err
+	}
+	return mappingScalingPolicyInfo(autoscaler), nil
+}
+
+func (asgHandler *GCPAutoScalingHandler) ListScalingPolicy(groupID string) ([]*irs.ScalingPolicyInfo, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	instanceGroupManager, err := asgHandler.Client.InstanceGroupManagers.Get(projectID, zone, groupID).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	autoscaler, err := asgHandler.getAutoscaler(instanceGroupManager)
+	if err != nil {
+		return nil, err
+	}
+
+	var policyInfoList []*irs.ScalingPolicyInfo
+	if autoscaler != nil {
+		policyInfo := mappingScalingPolicyInfo(autoscaler)
+		policyInfoList = append(policyInfoList, &policyInfo)
+	}
+	return policyInfoList, nil
+}
+
+func (asgHandler *GCPAutoScalingHandler) RemoveScalingPolicy(groupID string, policyName string) (bool, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	autoscaler, err := asgHandler.Client.Autoscalers.Get(projectID, zone, policyName).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if getResourceName(autoscaler.Target) != groupID {
+		return false, errors.New("scaling policy " + policyName + " does not belong to " + groupID)
+	}
+
+	op, err := asgHandler.Client.Autoscalers.Delete(projectID, zone, policyName).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitZoneOperation(asgHandler.Client, asgHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (asgHandler *GCPAutoScalingHandler) getInstanceTemplate(name string, vmTemplate irs.VMTemplateInfo) (*compute.InstanceTemplate, error) {
+	region := asgHandler.Region.Region
+
+	instanceProperties := &compute.InstanceProperties{
+		MachineType: vmTemplate.VMSpecId,
+		Disks: []*compute.AttachedDisk{
+			{
+				AutoDelete: true,
+				Boot:       true,
+				Type:       "PERSISTENT",
+				InitializeParams: &compute.AttachedDiskInitializeParams{
+					SourceImage: vmTemplate.ImageId,
+				},
+			},
+		},
+		NetworkInterfaces: []*compute.NetworkInterface{
+			{
+				Subnetwork: "regions/" + region + "/subnetworks/" + vmTemplate.VirtualNetworkId,
+				AccessConfigs: []*compute.AccessConfig{
+					{
+						Type: "ONE_TO_ONE_NAT",
+						Name: "External NAT",
+					},
+				},
+			},
+		},
+		Tags: &compute.Tags{
+			Items: vmTemplate.SecurityGroupIds,
+		},
+	}
+	if vmTemplate.KeyPairName != "" {
+		publicKey, err := GetPublicKey(asgHandler.Credential, vmTemplate.KeyPairName)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		sshKeys := CBVMUser + ":" + strings.TrimSpace(publicKey)
+		instanceProperties.Metadata = &compute.Metadata{
+			Items: []*compute.MetadataItems{
+				{Key: "ssh-keys", Value: &sshKeys},
+			},
+		}
+	}
+
+	instanceTemplate := &compute.InstanceTemplate{
+		Name:        name,
+		Description: vmTemplate.KeyPairName,
+		Properties:  instanceProperties,
+	}
+	return instanceTemplate, nil
+}
+
+func (asgHandler *GCPAutoScalingHandler) deleteInstanceTemplate(templateName string) {
+	projectID := asgHandler.Credential.ProjectID
+
+	op, err := asgHandler.Client.InstanceTemplates.Delete(projectID, templateName).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err := WaitGlobalOperation(asgHandler.Client, asgHandler.Ctx, projectID, op.Name); err != nil {
+		log.Println(err)
+	}
+}
+
+// MIG를 대상으로 하는 Autoscaler 조회 (없으면 nil)
+func (asgHandler *GCPAutoScalingHandler) getAutoscaler(instanceGroupManager *compute.InstanceGroupManager) (*compute.Autoscaler, error) {
+	projectID := asgHandler.Credential.ProjectID
+	zone := asgHandler.Region.Zone
+
+	var autoscaler *compute.Autoscaler
+	err := asgHandler.Client.Autoscalers.List(projectID, zone).Pages(asgHandler.Ctx, func(page *compute.AutoscalerList) error {
+		for _, item := range page.Items {
+			if getResourceName(item.Target) == instanceGroupManager.Name {
+				autoscaler = item
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return autoscaler, nil
+}
+
+func (asgHandler *GCPAutoScalingHandler) mappingAutoScalingGroupInfo(instanceGroupManager *compute.InstanceGroupManager) irs.AutoScalingGroupInfo {
+	projectID := asgHandler.Credential.ProjectID
+
+	minSize, maxSize := parseAutoScalingGroupDescription(instanceGroupManager.Description)
+	groupInfo := irs.AutoScalingGroupInfo{
+		Id:          instanceGroupManager.Name,
+		Name:        instanceGroupManager.Name,
+		DesiredSize: int(instanceGroupManager.TargetSize),
+		MinSize:     minSize,
+		MaxSize:     maxSize,
+		KeyValueList: []irs.KeyValue{
+			{Key: "SelfLink", Value: instanceGroupManager.SelfLink},
+			{Key: "InstanceTemplate", Value: instanceGroupManager.InstanceTemplate},
+			{Key: "CreationTimestamp", Value: instanceGroupManager.CreationTimestamp},
+		},
+	}
+
+	autoscaler, err := asgHandler.getAutoscaler(instanceGroupManager)
+	if err != nil {
+		log.Println(err)
+	} else if autoscaler != nil && autoscaler.AutoscalingPolicy != nil {
+		groupInfo.MinSize = int(autoscaler.AutoscalingPolicy.MinNumReplicas)
+		groupInfo.MaxSize = int(autoscaler.AutoscalingPolicy.MaxNumReplicas)
+	}
+
+	instanceTemplate, err := asgHandler.Client.InstanceTemplates.Get(projectID, getResourceName(instanceGroupManager.InstanceTemplate)).Context(asgHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return groupInfo
+	}
+	groupInfo.VMTemplate.KeyPairName = instanceTemplate.Description
+	if properties := instanceTemplate.Properties; properties != nil {
+		groupInfo.VMTemplate.VMSpecId = properties.MachineType
+		if len(properties.Disks) > 0 && properties.Disks[0].InitializeParams != nil {
+			groupInfo.VMTemplate.ImageId = properties.Disks[0].InitializeParams.SourceImage
+		}
+		if len(properties.NetworkInterfaces) > 0 {
+			groupInfo.VMTemplate.VirtualNetworkId = getResourceName(properties.NetworkInterfaces[0].Subnetwork)
+		}
+		if properties.Tags != nil {
+			groupInfo.VMTemplate.SecurityGroupIds = properties.Tags.Items
+		}
+	}
+	return groupInfo
+}
+
+func mappingScalingPolicyInfo(autoscaler *compute.Autoscaler) irs.ScalingPolicyInfo {
+	policyInfo := irs.ScalingPolicyInfo{
+		Id:   autoscaler.Name,
+		Name: autoscaler.Name,
+		KeyValueList: []irs.KeyValue{
+			{Key: "SelfLink", Value: autoscaler.SelfLink},
+			{Key: "Status", Value: autoscaler.Status},
+		},
+	}
+	if autoscaler.AutoscalingPolicy != nil && autoscaler.AutoscalingPolicy.CpuUtilization != nil {
+		policyInfo.TargetCPUUtilization = autoscaler.AutoscalingPolicy.CpuUtilization.UtilizationTarget * 100
+	}
+	return policyInfo
+}
+
+// CurrentAction이 NONE이고 RUNNING인 인스턴스만 InService로 판단
+func getManagedInstanceStatus(managedInstance *compute.ManagedInstance) irs.AutoScalingMemberStatus {
+	switch managedInstance.CurrentAction {
+	case CBManagedInstanceNone:
+		if managedInstance.InstanceStatus == CBManagedInstanceRunning {
+			return irs.AutoScalingMemberInService
+		}
+		return irs.AutoScalingMemberFailed
+	case "DELETING", "ABANDONING", "STOPPING", "SUSPENDING":
+		return irs.AutoScalingMemberTerminating
+	default:
+		return irs.AutoScalingMemberPending
+	}
+}
+
+func checkAutoScalingGroupSize(desiredSize int, minSize int, maxSize int) error {
+	if minSize > desiredSize || desiredSize > maxSize {
+		return fmt.Errorf("invalid size, it must be min(%d) <= desired(%d) <= max(%d)", minSize, desiredSize, maxSize)
+	}
+	return nil
+}
+
+// Description 형식: "MinSize=1,MaxSize=3"
+func getAutoScalingGroupDescription(minSize int, maxSize int) string {
+	return fmt.Sprintf("MinSize=%d,MaxSize=%d", minSize, maxSize)
+}
+
+func parseAutoScalingGroupDescription(description string) (int, int) {
+	var minSize, maxSize int
+	for _, item := range strings.Split(description, ",") {
+		keyValue := strings.SplitN(item, "=", 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+		size, err := strconv.Atoi(keyValue[1])
+		if err != nil {
+			continue
+		}
+		switch keyValue[0] {
+		case "MinSize":
+			minSize = size
+		case "MaxSize":
+			maxSize = size
+		}
+	}
+	return minSize, maxSize
+}
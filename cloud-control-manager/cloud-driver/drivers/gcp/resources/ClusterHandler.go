@@ -0,0 +1,496 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+	container "google.golang.org/api/container/v1"
+)
+
+type GCPClusterHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *container.Service
+	VMClient   *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GKE 클러스터는 Zone이 지정되어 있으면 Zonal 클러스터, 아니면 Regional 클러스터로 생성한다.
+// 클러스터 ID = 클러스터 이름, 노드 그룹 ID = Node Pool 이름
+func (clusterHandler *GCPClusterHandler) getLocationPath() string {
+	location := clusterHandler.Region.Zone
+	if location == "" {
+		location = clusterHandler.Region.Region
+	}
+	return "projects/" + clusterHandler.Credential.ProjectID + "/locations/" + location
+}
+
+func (clusterHandler *GCPClusterHandler) getClusterPath(clusterID string) string {
+	return clusterHandler.getLocationPath() + "/clusters/" + clusterID
+}
+
+func (clusterHandler *GCPClusterHandler) getNodePoolPath(clusterID string, nodeGroupID string) string {
+	return clusterHandler.getClusterPath(clusterID) + "/nodePools/" + nodeGroupID
+}
+
+func (clusterHandler *GCPClusterHandler) setterCluster(cluster *container.Cluster) (*irs.ClusterInfo, error) {
+	clusterInfo := &irs.ClusterInfo{
+		Id:          cluster.Name,
+		Name:        cluster.Name,
+		Version:     cluster.CurrentMasterVersion,
+		VPCId:       cluster.Network,
+		Endpoint:    cluster.Endpoint,
+		Status:      getClusterStatus(cluster.Status),
+		CreatedTime: cluster.CreateTime,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Location", Value: cluster.Location},
+			{Key: "Status", Value: cluster.Status},
+			{Key: "SelfLink", Value: cluster.SelfLink},
+		},
+	}
+	if cluster.Subnetwork != "" {
+		clusterInfo.SubnetIds = []string{cluster.Subnetwork}
+	}
+
+	for _, nodePool := range cluster.NodePools {
+		nodeGroupInfo, err := clusterHandler.setterNodeGroup(nodePool)
+		if err != nil {
+			return nil, err
+		}
+		clusterInfo.NodeGroupList = append(clusterInfo.NodeGroupList, *nodeGroupInfo)
+		// Security Group(방화벽)은 노드의 Network Tag로 적용되어 있음
+		if clusterInfo.SecurityGroupIds == nil && nodePool.Config != nil {
+			clusterInfo.SecurityGroupIds = nodePool.Config.Tags
+		}
+	}
+	return clusterInfo, nil
+}
+
+func (clusterHandler *GCPClusterHandler) setterNodeGroup(nodePool *container.NodePool) (*irs.NodeGroupInfo, error) {
+	nodeGroupInfo := &irs.NodeGroupInfo{
+		Id:              nodePool.Name,
+		Name:            nodePool.Name,
+		Status:          getClusterStatus(nodePool.Status),
+		DesiredNodeSize: int(nodePool.InitialNodeCount),
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: nodePool.Status},
+			{Key: "Version", Value: nodePool.Version},
+		},
+	}
+	if nodePool.Config != nil {
+		nodeGroupInfo.ImageName = nodePool.Config.ImageType
+		nodeGroupInfo.VMSpecName = nodePool.Config.MachineType
+		nodeGroupInfo.RootDiskSize = strconv.FormatInt(nodePool.Config.DiskSizeGb, 10)
+	}
+	if nodePool.Autoscaling != nil && nodePool.Autoscaling.Enabled {
+		nodeGroupInfo.OnAutoScaling = true
+		nodeGroupInfo.MinNodeSize = int(nodePool.Autoscaling.MinNodeCount)
+		nodeGroupInfo.MaxNodeSize = int(nodePool.Autoscaling.MaxNodeCount)
+	}
+
+	// Node Pool의 VM은 Managed Instance Group으로 관리됨
+	nodes, err := clusterHandler.getNodes(nodePool.InstanceGroupUrls)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroupInfo.Nodes = nodes
+	if len(nodes) > 0 {
+		nodeGroupInfo.DesiredNodeSize = len(nodes)
+	}
+	if !nodeGroupInfo.OnAutoScaling {
+		nodeGroupInfo.MinNodeSize = nodeGroupInfo.DesiredNodeSize
+		nodeGroupInfo.MaxNodeSize = nodeGroupInfo.DesiredNodeSize
+	}
+	return nodeGroupInfo, nil
+}
+
+// VPC는 Network, 첫 번째 Subnet은 Subnetwork로 사용하며
+// Security Group(방화벽)은 노드의 Network Tag로 지정한다.
+func (clusterHandler *GCPClusterHandler) CreateCluster(clusterReqInfo irs.ClusterReqInfo) (irs.ClusterInfo, error) {
+	if len(clusterReqInfo.NodeGroupList) == 0 {
+		return irs.ClusterInfo{}, errors.New("GKE cluster requires at least one node group")
+	}
+
+	cluster := &container.Cluster{
+		Name:                  clusterReqInfo.Name,
+		InitialClusterVersion: clusterReqInfo.Version,
+		Network:               clusterReqInfo.VPCId,
+	}
+	if len(clusterReqInfo.SubnetIds) != 0 {
+		cluster.Subnetwork = clusterReqInfo.SubnetIds[0]
+	}
+	for _, nodeGroupReqInfo := range clusterReqInfo.NodeGroupList {
+		nodePool, err := clusterHandler.getNodePool(nodeGroupReqInfo, clusterReqInfo.SecurityGroupIds)
+		if err != nil {
+			return irs.ClusterInfo{}, err
+		}
+		cluster.NodePools = append(cluster.NodePools, nodePool)
+	}
+
+	op, err := clusterHandler.Client.Projects.Locations.Clusters.Create(clusterHandler.getLocationPath(), &container.CreateClusterRequest{
+		Cluster: cluster,
+	}).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.ClusterInfo{}, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return irs.ClusterInfo{}, err
+	}
+
+	return clusterHandler.GetCluster(clusterReqInfo.Name)
+}
+
+func (clusterHandler *GCPClusterHandler) ListCluster() ([]*irs.ClusterInfo, error) {
+	result, err := clusterHandler.Client.Projects.Locations.Clusters.List(clusterHandler.getLocationPath()).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	var clusterList []*irs.ClusterInfo
+	for _, cluster := range result.Clusters {
+		clusterInfo, err := clusterHandler.setterCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+		clusterList = append(clusterList, clusterInfo)
+	}
+	return clusterList, nil
+}
+
+func (clusterHandler *GCPClusterHandler) GetCluster(clusterID string) (irs.ClusterInfo, error) {
+	cluster, err := clusterHandler.Client.Projects.Locations.Clusters.Get(clusterHandler.getClusterPath(clusterID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.ClusterInfo{}, err
+	}
+
+	clusterInfo, err := clusterHandler.setterCluster(cluster)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	return *clusterInfo, nil
+}
+
+// 클러스터를 삭제하면 Node Pool도 함께 삭제된다.
+func (clusterHandler *GCPClusterHandler) DeleteCluster(clusterID string) (bool, error) {
+	op, err := clusterHandler.Client.Projects.Locations.Clusters.Delete(clusterHandler.getClusterPath(clusterID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// 추가되는 Node Pool에는 클러스터의 기존 Node Pool과 같은 Network Tag(방화벽)를 적용한다.
+func (clusterHandler *GCPClusterHandler) AddNodeGroup(clusterID string, nodeGroupReqInfo irs.NodeGroupReqInfo) (irs.NodeGroupInfo, error) {
+	clusterInfo, err := clusterHandler.GetCluster(clusterID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	nodePool, err := clusterHandler.getNodePool(nodeGroupReqInfo, clusterInfo.SecurityGroupIds)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	op, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.Create(clusterHandler.getClusterPath(clusterID), &container.CreateNodePoolRequest{
+		NodePool: nodePool,
+	}).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupReqInfo.Name)
+}
+
+func (clusterHandler *GCPClusterHandler) ListNodeGroup(clusterID string) ([]*irs.NodeGroupInfo, error) {
+	result, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.List(clusterHandler.getClusterPath(clusterID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	var nodeGroupList []*irs.NodeGroupInfo
+	for _, nodePool := range result.NodePools {
+		nodeGroupInfo, err := clusterHandler.setterNodeGroup(nodePool)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupList = append(nodeGroupList, nodeGroupInfo)
+	}
+	return nodeGroupList, nil
+}
+
+func (clusterHandler *GCPClusterHandler) RemoveNodeGroup(clusterID string, nodeGroupID string) (bool, error) {
+	op, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.Delete(clusterHandler.getNodePoolPath(clusterID, nodeGroupID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Min/Max가 다르면 Autoscaling을 켜고, 같으면 Autoscaling을 끈 뒤 Desired 수로 크기를 변경한다.
+func (clusterHandler *GCPClusterHandler) ChangeNodeGroupScaling(clusterID string, nodeGroupID string, desiredNodeSize int, minNodeSize int, maxNodeSize int) (irs.NodeGroupInfo, error) {
+	nodePoolPath := clusterHandler.getNodePoolPath(clusterID, nodeGroupID)
+
+	autoscaling := &container.NodePoolAutoscaling{
+		Enabled: false,
+	}
+	if minNodeSize != maxNodeSize {
+		autoscaling.Enabled = true
+		autoscaling.MinNodeCount = int64(minNodeSize)
+		autoscaling.MaxNodeCount = int64(maxNodeSize)
+	}
+	op, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.SetAutoscaling(nodePoolPath, &container.SetNodePoolAutoscalingRequest{
+		Autoscaling: autoscaling,
+	}).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	op, err = clusterHandler.Client.Projects.Locations.Clusters.NodePools.SetSize(nodePoolPath, &container.SetNodePoolSizeRequest{
+		NodeCount: int64(desiredNodeSize),
+	}).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupID)
+}
+
+// Master를 먼저 업그레이드한 후 모든 Node Pool을 같은 버전으로 업그레이드한다.
+func (clusterHandler *GCPClusterHandler) UpgradeCluster(clusterID string, newVersion string) (irs.ClusterInfo, error) {
+	op, err := clusterHandler.Client.Projects.Locations.Clusters.Update(clusterHandler.getClusterPath(clusterID), &container.UpdateClusterRequest{
+		Update: &container.ClusterUpdate{
+			DesiredMasterVersion: newVersion,
+		},
+	}).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.ClusterInfo{}, err
+	}
+	err = clusterHandler.waitOperation(op.Name)
+	if err != nil {
+		log.Println(err)
+		return irs.ClusterInfo{}, err
+	}
+
+	result, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.List(clusterHandler.getClusterPath(clusterID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.ClusterInfo{}, err
+	}
+	for _, nodePool := range result.NodePools {
+		updateReq := &container.UpdateNodePoolRequest{
+			NodeVersion: newVersion,
+		}
+		if nodePool.Config != nil {
+			updateReq.ImageType = nodePool.Config.ImageType
+		}
+		op, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.Update(clusterHandler.getNodePoolPath(clusterID, nodePool.Name), updateReq).Context(clusterHandler.Ctx).Do()
+		if err != nil {
+			log.Println(err)
+			return irs.ClusterInfo{}, err
+		}
+		err = clusterHandler.waitOperation(op.Name)
+		if err != nil {
+			log.Println(err)
+			return irs.ClusterInfo{}, err
+		}
+	}
+
+	return clusterHandler.GetCluster(clusterID)
+}
+
+// 인증은 gke-gcloud-auth-plugin을 사용하는 kubeconfig를 생성한다.
+func (clusterHandler *GCPClusterHandler) GetKubeConfig(clusterID string) (string, error) {
+	cluster, err := clusterHandler.Client.Projects.Locations.Clusters.Get(clusterHandler.getClusterPath(clusterID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	if cluster.Endpoint == "" || cluster.MasterAuth == nil {
+		return "", errors.New("cluster " + clusterID + " is not ready")
+	}
+
+	contextName := "gke_" + clusterHandler.Credential.ProjectID + "_" + cluster.Location + "_" + cluster.Name
+	kubeConfig := fmt.Sprintf(gkeKubeConfigTemplate,
+		cluster.MasterAuth.ClusterCaCertificate, cluster.Endpoint, contextName,
+		contextName, contextName, contextName,
+		contextName, contextName)
+
+	return kubeConfig, nil
+}
+
+const gkeKubeConfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: https://%s
+  name: %s
+contexts:
+- context:
+    cluster: %s
+    user: %s
+  name: %s
+current-context: %s
+users:
+- name: %s
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: gke-gcloud-auth-plugin
+      provideClusterInfo: true
+`
+
+func (clusterHandler *GCPClusterHandler) getNodeGroup(clusterID string, nodeGroupID string) (irs.NodeGroupInfo, error) {
+	nodePool, err := clusterHandler.Client.Projects.Locations.Clusters.NodePools.Get(clusterHandler.getNodePoolPath(clusterID, nodeGroupID)).Context(clusterHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.NodeGroupInfo{}, err
+	}
+
+	nodeGroupInfo, err := clusterHandler.setterNodeGroup(nodePool)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	return *nodeGroupInfo, nil
+}
+
+func (clusterHandler *GCPClusterHandler) getNodePool(nodeGroupReqInfo irs.NodeGroupReqInfo, securityGroupIds []string) (*container.NodePool, error) {
+	nodePool := &container.NodePool{
+		Name:             nodeGroupReqInfo.Name,
+		InitialNodeCount: int64(nodeGroupReqInfo.DesiredNodeSize),
+		Config: &container.NodeConfig{
+			MachineType: nodeGroupReqInfo.VMSpecName,
+			ImageType:   nodeGroupReqInfo.ImageName,
+			Tags:        securityGroupIds,
+		},
+	}
+	if nodeGroupReqInfo.RootDiskSize != "" {
+		diskSize, err := strconv.ParseInt(nodeGroupReqInfo.RootDiskSize, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid root disk size " + nodeGroupReqInfo.RootDiskSize)
+		}
+		nodePool.Config.DiskSizeGb = diskSize
+	}
+	if nodeGroupReqInfo.KeyPairName != "" {
+		publicKey, err := GetPublicKey(clusterHandler.Credential, nodeGroupReqInfo.KeyPairName)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		nodePool.Config.Metadata = map[string]string{
+			"ssh-keys": CBVMUser + ":" + strings.TrimSpace(publicKey),
+		}
+	}
+	if nodeGroupReqInfo.OnAutoScaling {
+		nodePool.Autoscaling = &container.NodePoolAutoscaling{
+			Enabled:      true,
+			MinNodeCount: int64(nodeGroupReqInfo.MinNodeSize),
+			MaxNodeCount: int64(nodeGroupReqInfo.MaxNodeSize),
+		}
+	}
+	return nodePool, nil
+}
+
+// Instance Group URL 형식: https://.../projects/{project}/zones/{zone}/instanceGroupManagers/{name}
+func (clusterHandler *GCPClusterHandler) getNodes(instanceGroupUrls []string) ([]string, error) {
+	projectID := clusterHandler.Credential.ProjectID
+
+	var nodes []string
+	for _, instanceGroupUrl := range instanceGroupUrls {
+		urlArr := strings.Split(instanceGroupUrl, "/")
+		if len(urlArr) < 4 {
+			continue
+		}
+		zone := urlArr[len(urlArr)-3]
+		instanceGroupName := urlArr[len(urlArr)-1]
+
+		result, err := clusterHandler.VMClient.InstanceGroupManagers.ListManagedInstances(projectID, zone, instanceGroupName).Context(clusterHandler.Ctx).Do()
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		for _, instance := range result.ManagedInstances {
+			nodes = append(nodes, instance.Instance[strings.LastIndex(instance.Instance, "/")+1:])
+		}
+	}
+	return nodes, nil
+}
+
+// 클러스터 작업(Operation)이 완료될 때까지 대기
+func (clusterHandler *GCPClusterHandler) waitOperation(operationName string) error {
+	operationPath := clusterHandler.getLocationPath() + "/operations/" + operationName
+	for i := 0; i < CBOperationWaitTime/CBOperationCheckTime; i++ {
+		op, err := clusterHandler.Client.Projects.Locations.Operations.Get(operationPath).Context(clusterHandler.Ctx).Do()
+		if err != nil {
+			return err
+		}
+		if op.Status == "DONE" {
+			if op.Error != nil && op.Error.Message != "" {
+				return errors.New(op.Error.Message)
+			}
+			return nil
+		}
+		time.Sleep(time.Second * CBOperationCheckTime)
+	}
+	return errors.New("timeout waiting for the operation " + operationName)
+}
+
+func getClusterStatus(status string) irs.ClusterStatus {
+	switch status {
+	case "PROVISIONING":
+		return irs.ClusterCreating
+	case "RUNNING", "RUNNING_WITH_ERROR":
+		return irs.ClusterActive
+	case "RECONCILING":
+		return irs.ClusterUpdating
+	case "STOPPING":
+		return irs.ClusterDeleting
+	default:
+		return irs.ClusterFailed
+	}
+}
@@ -1,19 +1,27 @@
 package resources
 
 import (
+	"context"
 	"crypto/md5"
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
+	"time"
 
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	keyvault "github.com/cloud-barista/cb-spider/cloud-control-manager/key-vault"
+	compute "google.golang.org/api/compute/v1"
 )
 
 const (
-	CBVMUser      = "cscservice"
-	CBKeyPairPath = "/cloud-control-manager/cloud-driver/driver-libs/.ssh-gcp/"
+	CBVMUser         = "cscservice"
+	CBKeyPairPath    = "/cloud-control-manager/cloud-driver/driver-libs/.ssh-gcp/" // 기존 KeyPair 파일 경로, Key Vault로 이동됨
+	CBKeyVaultDomain = "gcp"
+
+	CBOperationWaitTime  = 600 // seconds
+	CBOperationCheckTime = 2   // seconds
 )
 
 func GetKeyValueList(i map[string]interface{}) []irs.KeyValue {
@@ -37,18 +45,82 @@ func CreateHashString(credentialInfo idrv.CredentialInfo) (string, error) {
 	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
 }
 
+// Key Vault의 KeyPair Scope(Credential 해시) 조회, 기존 KeyPair 파일이 있으면 Key Vault로 이동
+func GetKeyVaultScope(credentialInfo idrv.CredentialInfo) (string, error) {
+	hashString, err := CreateHashString(credentialInfo)
+	if err != nil {
+		return "", err
+	}
+
+	err = keyvault.MigrateKeyFiles(CBKeyVaultDomain, hashString, os.Getenv("CBSPIDER_ROOT")+CBKeyPairPath)
+	if err != nil {
+		return "", err
+	}
+	return hashString, nil
+}
+
 // Public KeyPair 정보 가져오기
 func GetPublicKey(credentialInfo idrv.CredentialInfo, keyPairName string) (string, error) {
-	keyPairPath := os.Getenv("CBSPIDER_ROOT") + CBKeyPairPath
-	hashString, err := CreateHashString(credentialInfo)
+	scope, err := GetKeyVaultScope(credentialInfo)
 	if err != nil {
 		return "", err
 	}
 
-	publicKeyPath := keyPairPath + hashString + "--" + keyPairName + ".pub"
-	publicKeyBytes, err := ioutil.ReadFile(publicKeyPath)
+	keyInfo, err := keyvault.GetKey(CBKeyVaultDomain, scope, keyPairName)
 	if err != nil {
 		return "", err
 	}
-	return string(publicKeyBytes), nil
+	return keyInfo.PublicKey, nil
+}
+
+// Zone 단위 작업(Operation)이 완료될 때까지 대기
+func WaitZoneOperation(client *compute.Service, ctx context.Context, projectID string, zone string, operationName string) error {
+	for i := 0; i < CBOperationWaitTime/CBOperationCheckTime; i++ {
+		op, err := client.ZoneOperations.Get(projectID, zone, operationName).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		if op.Status == "DONE" {
+			return getOperationError(op)
+		}
+		time.Sleep(time.Second * CBOperationCheckTime)
+	}
+	return errors.New("timeout waiting for the operation " + operationName)
+}
+
+// Region 단위 작업(Operation)이 완료될 때까지 대기 (ex: 서브넷)
+func WaitRegionOperation(client *compute.Service, ctx context.Context, projectID string, region string, operationName string) error {
+	for i := 0; i < CBOperationWaitTime/CBOperationCheckTime; i++ {
+		op, err := client.RegionOperations.Get(projectID, region, operationName).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		if op.Status == "DONE" {
+			return getOperationError(op)
+		}
+		time.Sleep(time.Second * CBOperationCheckTime)
+	}
+	return errors.New("timeout waiting for the operation " + operationName)
+}
+
+// Global 작업(Operation)이 완료될 때까지 대기 (ex: 스냅샷, 이미지)
+func WaitGlobalOperation(client *compute.Service, ctx context.Context, projectID string, operationName string) error {
+	for i := 0; i < CBOperationWaitTime/CBOperationCheckTime; i++ {
+		op, err := client.GlobalOperations.Get(projectID, operationName).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		if op.Status == "DONE" {
+			return getOperationError(op)
+		}
+		time.Sleep(time.Second * CBOperationCheckTime)
+	}
+	return errors.New("timeout waiting for the operation " + operationName)
+}
+
+func getOperationError(op *compute.Operation) error {
+	if op.Error == nil || len(op.Error.Errors) == 0 {
+		return nil
+	}
+	return errors.New(op.Error.Errors[0].Code + ": " + op.Error.Errors[0].Message)
 }
@@ -0,0 +1,315 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	dns "google.golang.org/api/dns/v1"
+)
+
+const (
+	CBDNSDefaultTTL      = 300 // seconds
+	CBDNSChangeDone      = "done"
+	CBDNSZoneNamePrefix  = "zone-"
+	CBDNSZoneVisibility  = "public"
+	CBDNSZoneDescription = "Managed by CB-Spider"
+)
+
+// Cloud DNS Managed Zone 이름을 ID로 사용 (도메인 이름의 '.'을 '-'로 변환, ex) example.com => example-com)
+// Record Set 변경은 Change 단위로 요청하며, Change 상태가 done이면 모든 Name Server에 반영된 것임
+type GCPDNSHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *dns.Service
+	Credential idrv.CredentialInfo
+}
+
+func setterZone(zone *dns.ManagedZone) *irs.DNSZoneInfo {
+	return &irs.DNSZoneInfo{
+		Id:          zone.Name,
+		Name:        strings.TrimSuffix(zone.DnsName, "."),
+		Description: zone.Description,
+		NameServers: zone.NameServers,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ZoneId", Value: strconv.FormatUint(zone.Id, 10)},
+			{Key: "Visibility", Value: zone.Visibility},
+			{Key: "CreationTime", Value: zone.CreationTime},
+		},
+	}
+}
+
+func setterRecordSet(recordSet *dns.ResourceRecordSet, zoneName string) *irs.DNSRecordInfo {
+	recordInfo := &irs.DNSRecordInfo{
+		Name:         getRelativeDNSName(recordSet.Name, zoneName),
+		Type:         irs.DNSRecordType(recordSet.Type),
+		TTL:          recordSet.Ttl,
+		ChangeStatus: irs.DNSChangeInSync,
+	}
+	for _, value := range recordSet.Rrdatas {
+		// TXT 레코드는 큰따옴표로 감싸져 있음
+		if recordInfo.Type == irs.DNSRecordTXT {
+			value = strings.Trim(value, "\"")
+		}
+		recordInfo.Values = append(recordInfo.Values, value)
+	}
+	return recordInfo
+}
+
+func (dnsHandler *GCPDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	// Description은 필수 항목
+	description := zoneReqInfo.Description
+	if description == "" {
+		description = CBDNSZoneDescription
+	}
+
+	zone, err := dnsHandler.Client.ManagedZones.Create(dnsHandler.Credential.ProjectID, &dns.ManagedZone{
+		Name:        getManagedZoneName(zoneReqInfo.Name),
+		DnsName:     zoneReqInfo.Name + ".",
+		Description: description,
+		Visibility:  CBDNSZoneVisibility,
+	}).Context(dnsHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.DNSZoneInfo{}, err
+	}
+
+	return *setterZone(zone), nil
+}
+
+func (dnsHandler *GCPDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	var zoneList []*irs.DNSZoneInfo
+	err := dnsHandler.Client.ManagedZones.List(dnsHandler.Credential.ProjectID).Pages(dnsHandler.Ctx, func(page *dns.ManagedZonesListResponse) error {
+		for _, zone := range page.ManagedZones {
+			zoneList = append(zoneList, setterZone(zone))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return zoneList, nil
+}
+
+func (dnsHandler *GCPDNSHandler) GetZone(zoneID string) (irs.DNSZoneInfo, error) {
+	zone, err := dnsHandler.Client.ManagedZones.Get(dnsHandler.Credential.ProjectID, zoneID).Context(dnsHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.DNSZoneInfo{}, err
+	}
+	return *setterZone(zone), nil
+}
+
+// NS, SOA 이외의 Record Set이 남아 있으면 Managed Zone을 삭제할 수 없으므로 Record Set을 먼저 삭제
+func (dnsHandler *GCPDNSHandler) DeleteZone(zoneID string) (bool, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return false, err
+	}
+
+	var deletions []*dns.ResourceRecordSet
+	err = dnsHandler.Client.ResourceRecordSets.List(dnsHandler.Credential.ProjectID, zoneID).Pages(dnsHandler.Ctx, func(page *dns.ResourceRecordSetsListResponse) error {
+		for _, recordSet := range page.Rrsets {
+			if (recordSet.Type == "NS" || recordSet.Type == "SOA") && getRelativeDNSName(recordSet.Name, zoneInfo.Name) == irs.DNSZoneApex {
+				continue
+			}
+			deletions = append(deletions, recordSet)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	if len(deletions) > 0 {
+		change, err := dnsHandler.Client.Changes.Create(dnsHandler.Credential.ProjectID, zoneID, &dns.Change{
+			Deletions: deletions,
+		}).Context(dnsHandler.Ctx).Do()
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		if _, err := dnsHandler.WaitForDNSChange(zoneID, change.Id); err != nil {
+			return false, err
+		}
+	}
+
+	err = dnsHandler.Client.ManagedZones.Delete(dnsHandler.Credential.ProjectID, zoneID).Context(dnsHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *GCPDNSHandler) CreateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	recordSet, err := getResourceRecordSet(recordReqInfo, zoneInfo.Name)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	return dnsHandler.changeRecordSet(zoneID, zoneInfo.Name, &dns.Change{
+		Additions: []*dns.ResourceRecordSet{recordSet},
+	})
+}
+
+func (dnsHandler *GCPDNSHandler) ListRecord(zoneID string) ([]*irs.DNSRecordInfo, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordList []*irs.DNSRecordInfo
+	err = dnsHandler.Client.ResourceRecordSets.List(dnsHandler.Credential.ProjectID, zoneID).Pages(dnsHandler.Ctx, func(page *dns.ResourceRecordSetsListResponse) error {
+		for _, recordSet := range page.Rrsets {
+			switch irs.DNSRecordType(recordSet.Type) {
+			case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordCNAME, irs.DNSRecordTXT:
+				recordList = append(recordList, setterRecordSet(recordSet, zoneInfo.Name))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return recordList, nil
+}
+
+// 기존 Record Set 삭제와 새 Record Set 추가를 하나의 Change로 요청
+func (dnsHandler *GCPDNSHandler) UpdateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	current, err := dnsHandler.Client.ResourceRecordSets.Get(dnsHandler.Credential.ProjectID, zoneID, getDNSRecordFQDN(recordReqInfo.Name, zoneInfo.Name), string(recordReqInfo.Type)).Context(dnsHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.DNSRecordInfo{}, err
+	}
+	recordSet, err := getResourceRecordSet(recordReqInfo, zoneInfo.Name)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	return dnsHandler.changeRecordSet(zoneID, zoneInfo.Name, &dns.Change{
+		Deletions: []*dns.ResourceRecordSet{current},
+		Additions: []*dns.ResourceRecordSet{recordSet},
+	})
+}
+
+func (dnsHandler *GCPDNSHandler) DeleteRecord(zoneID string, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	zoneInfo, err := dnsHandler.GetZone(zoneID)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = dnsHandler.Client.ResourceRecordSets.Delete(dnsHandler.Credential.ProjectID, zoneID, getDNSRecordFQDN(recordName, zoneInfo.Name), string(recordType)).Context(dnsHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *GCPDNSHandler) WaitForDNSChange(zoneID string, changeID string) (irs.DNSChangeStatus, error) {
+	for i := 0; i < CBOperationWaitTime/CBOperationCheckTime; i++ {
+		change, err := dnsHandler.Client.Changes.Get(dnsHandler.Credential.ProjectID, zoneID, changeID).Context(dnsHandler.Ctx).Do()
+		if err != nil {
+			log.Println(err)
+			return irs.DNSChangePending, err
+		}
+		if change.Status == CBDNSChangeDone {
+			return irs.DNSChangeInSync, nil
+		}
+		time.Sleep(time.Second * CBOperationCheckTime)
+	}
+	return irs.DNSChangePending, errors.New("timeout waiting for the change " + changeID)
+}
+
+func (dnsHandler *GCPDNSHandler) changeRecordSet(zoneID string, zoneName string, change *dns.Change) (irs.DNSRecordInfo, error) {
+	result, err := dnsHandler.Client.Changes.Create(dnsHandler.Credential.ProjectID, zoneID, change).Context(dnsHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.DNSRecordInfo{}, err
+	}
+
+	recordInfo := setterRecordSet(change.Additions[0], zoneName)
+	recordInfo.ChangeId = result.Id
+	recordInfo.ChangeStatus = irs.DNSChangePending
+	if result.Status == CBDNSChangeDone {
+		recordInfo.ChangeStatus = irs.DNSChangeInSync
+	}
+	return *recordInfo, nil
+}
+
+func getResourceRecordSet(recordReqInfo irs.DNSRecordReqInfo, zoneName string) (*dns.ResourceRecordSet, error) {
+	switch recordReqInfo.Type {
+	case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordCNAME, irs.DNSRecordTXT:
+	default:
+		errMsg := fmt.Sprintf("Type %s is not supported", recordReqInfo.Type)
+		return nil, errors.New(errMsg)
+	}
+	if len(recordReqInfo.Values) == 0 {
+		return nil, errors.New("Values is required")
+	}
+
+	ttl := recordReqInfo.TTL
+	if ttl == 0 {
+		ttl = CBDNSDefaultTTL
+	}
+	recordSet := &dns.ResourceRecordSet{
+		Name: getDNSRecordFQDN(recordReqInfo.Name, zoneName),
+		Type: string(recordReqInfo.Type),
+		Ttl:  ttl,
+	}
+	for _, value := range recordReqInfo.Values {
+		switch recordReqInfo.Type {
+		case irs.DNSRecordTXT:
+			value = "\"" + strings.Trim(value, "\"") + "\""
+		case irs.DNSRecordCNAME:
+			// CNAME 값은 FQDN(마지막 '.' 포함)이어야 함
+			value = strings.TrimSuffix(value, ".") + "."
+		}
+		recordSet.Rrdatas = append(recordSet.Rrdatas, value)
+	}
+	return recordSet, nil
+}
+
+// ex) example.com => example-com, 1example.com => zone-1example-com
+func getManagedZoneName(domainName string) string {
+	zoneName := strings.ToLower(strings.ReplaceAll(strings.TrimSuffix(domainName, "."), ".", "-"))
+	if zoneName == "" || zoneName[0] < 'a' || zoneName[0] > 'z' {
+		zoneName = CBDNSZoneNamePrefix + zoneName
+	}
+	return zoneName
+}
+
+// ex) www, example.com => www.example.com., @ => example.com.
+func getDNSRecordFQDN(recordName string, zoneName string) string {
+	if recordName == "" || recordName == irs.DNSZoneApex {
+		return zoneName + "."
+	}
+	return recordName + "." + zoneName + "."
+}
+
+// ex) www.example.com., example.com => www
+func getRelativeDNSName(fqdn string, zoneName string) string {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	if strings.EqualFold(fqdn, zoneName) {
+		return irs.DNSZoneApex
+	}
+	return strings.TrimSuffix(fqdn, "."+zoneName)
+}
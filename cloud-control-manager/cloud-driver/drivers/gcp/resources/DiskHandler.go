@@ -0,0 +1,236 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+type GCPDiskHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GCP의 Persistent Disk는 VM과 같이 Name을 ID로 사용한다.
+func (diskHandler *GCPDiskHandler) CreateDisk(diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	projectID := diskHandler.Credential.ProjectID
+	zone := diskHandler.getZone(diskReqInfo.Zone)
+
+	size, err := strconv.ParseInt(diskReqInfo.DiskSize, 10, 64)
+	if err != nil {
+		return irs.DiskInfo{}, errors.New("DiskSize " + diskReqInfo.DiskSize + " is not a valid number")
+	}
+
+	labels, err := mappingLabels(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	disk := &compute.Disk{
+		Name:   diskReqInfo.Name,
+		SizeGb: size,
+		Labels: labels,
+	}
+	// ex) pd-standard, pd-ssd
+	if diskReqInfo.DiskType != "" {
+		disk.Type = "zones/" + zone + "/diskTypes/" + diskReqInfo.DiskType
+	}
+
+	op, err := diskHandler.Client.Disks.Insert(projectID, zone, disk).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	if err := WaitZoneOperation(diskHandler.Client, diskHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.getDisk(zone, diskReqInfo.Name)
+}
+
+func (diskHandler *GCPDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
+	projectID := diskHandler.Credential.ProjectID
+	zone := diskHandler.Region.Zone
+
+	var diskInfoList []*irs.DiskInfo
+	err := diskHandler.Client.Disks.List(projectID, zone).Pages(diskHandler.Ctx, func(page *compute.DiskList) error {
+		for _, disk := range page.Items {
+			diskInfo := mappingDiskInfo(disk)
+			diskInfoList = append(diskInfoList, &diskInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return diskInfoList, nil
+}
+
+func (diskHandler *GCPDiskHandler) GetDisk(diskID string) (irs.DiskInfo, error) {
+	return diskHandler.getDisk(diskHandler.Region.Zone, diskID)
+}
+
+// 크기는 증가만 가능
+func (diskHandler *GCPDiskHandler) ChangeDiskSize(diskID string, size string) (bool, error) {
+	projectID := diskHandler.Credential.ProjectID
+	zone := diskHandler.Region.Zone
+
+	newSize, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return false, errors.New("size " + size + " is not a valid number")
+	}
+
+	op, err := diskHandler.Client.Disks.Resize(projectID, zone, diskID, &compute.DisksResizeRequest{SizeGb: newSize}).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitZoneOperation(diskHandler.Client, diskHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *GCPDiskHandler) DeleteDisk(diskID string) (bool, error) {
+	projectID := diskHandler.Credential.ProjectID
+	zone := diskHandler.Region.Zone
+
+	op, err := diskHandler.Client.Disks.Delete(projectID, zone, diskID).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitZoneOperation(diskHandler.Client, diskHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 디스크 이름을 DeviceName으로 사용하여 연결
+func (diskHandler *GCPDiskHandler) AttachDisk(diskID string, vmID string) (irs.DiskInfo, error) {
+	projectID := diskHandler.Credential.ProjectID
+	zone := diskHandler.Region.Zone
+
+	disk, err := diskHandler.Client.Disks.Get(projectID, zone, diskID).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	attachedDisk := &compute.AttachedDisk{
+		Source:     disk.SelfLink,
+		DeviceName: disk.Name,
+	}
+	op, err := diskHandler.Client.Instances.AttachDisk(projectID, zone, vmID, attachedDisk).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	if err := WaitZoneOperation(diskHandler.Client, diskHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.getDisk(zone, diskID)
+}
+
+func (diskHandler *GCPDiskHandler) DetachDisk(diskID string, vmID string) (bool, error) {
+	projectID := diskHandler.Credential.ProjectID
+	zone := diskHandler.Region.Zone
+
+	instance, err := diskHandler.Client.Instances.Get(projectID, zone, vmID).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+
+	// DetachDisk는 디스크 이름이 아닌 VM에서의 DeviceName을 사용
+	var deviceName string
+	for _, attachedDisk := range instance.Disks {
+		if getResourceName(attachedDisk.Source) == diskID {
+			deviceName = attachedDisk.DeviceName
+			break
+		}
+	}
+	if deviceName == "" {
+		return false, errors.New("Disk " + diskID + " is not attached to VM " + vmID)
+	}
+
+	op, err := diskHandler.Client.Instances.DetachDisk(projectID, zone, vmID, deviceName).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitZoneOperation(diskHandler.Client, diskHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *GCPDiskHandler) getDisk(zone string, diskID string) (irs.DiskInfo, error) {
+	projectID := diskHandler.Credential.ProjectID
+
+	disk, err := diskHandler.Client.Disks.Get(projectID, zone, diskID).Context(diskHandler.Ctx).Do()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return mappingDiskInfo(disk), nil
+}
+
+func (diskHandler *GCPDiskHandler) getZone(zone string) string {
+	if zone != "" {
+		return zone
+	}
+	return diskHandler.Region.Zone
+}
+
+func mappingDiskInfo(disk *compute.Disk) irs.DiskInfo {
+	diskInfo := irs.DiskInfo{
+		Id:       disk.Name,
+		Name:     disk.Name,
+		DiskType: getResourceName(disk.Type),
+		DiskSize: strconv.FormatInt(disk.SizeGb, 10),
+		Zone:     getResourceName(disk.Zone),
+	}
+	if len(disk.Users) > 0 {
+		diskInfo.OwnerVMId = getResourceName(disk.Users[0])
+	}
+	if createdTime, err := time.Parse(time.RFC3339, disk.CreationTimestamp); err == nil {
+		diskInfo.CreatedTime = createdTime
+	}
+
+	// Status : CREATING | RESTORING | FAILED | READY | DELETING
+	switch disk.Status {
+	case "CREATING", "RESTORING":
+		diskInfo.Status = irs.DiskCreating
+	case "DELETING":
+		diskInfo.Status = irs.DiskDeleting
+	case "FAILED":
+		diskInfo.Status = irs.DiskError
+	default:
+		if diskInfo.OwnerVMId != "" {
+			diskInfo.Status = irs.DiskAttached
+		} else {
+			diskInfo.Status = irs.DiskAvailable
+		}
+	}
+
+	diskInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Id", Value: strconv.FormatUint(disk.Id, 10)},
+		{Key: "Status", Value: disk.Status},
+		{Key: "SelfLink", Value: disk.SelfLink},
+	}
+	if disk.SourceSnapshot != "" {
+		diskInfo.KeyValueList = append(diskInfo.KeyValueList, irs.KeyValue{Key: "SourceSnapshot", Value: getResourceName(disk.SourceSnapshot)})
+	}
+	if disk.SourceImage != "" {
+		diskInfo.KeyValueList = append(diskInfo.KeyValueList, irs.KeyValue{Key: "SourceImage", Value: getResourceName(disk.SourceImage)})
+	}
+	return diskInfo
+}
+
+// ex) https://www.googleapis.com/compute/v1/projects/my-project/zones/asia-northeast1-b/disks/disk-01 => disk-01
+func getResourceName(url string) string {
+	urlArr := strings.Split(url, "/")
+	return urlArr[len(urlArr)-1]
+}
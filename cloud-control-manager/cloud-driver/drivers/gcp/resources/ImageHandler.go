@@ -2,10 +2,13 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -37,9 +40,61 @@ name, sourceDisk(sourceImage),storageLocations(배열 ex : ["asia"])
 
 */
 
+// VM의 부트 디스크로 이미지를 생성한다. (생성 완료를 기다리지 않으므로 GetImage로 상태를 확인)
+// 실행 중인 VM은 NoReboot(ForceCreate)가 true인 경우에만 생성 가능
 func (imageHandler *GCPImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
+	projectId := imageHandler.Credential.ProjectID
+	zone := imageHandler.Region.Zone
+
+	if imageReqInfo.SourceVMId == "" {
+		return irs.ImageInfo{}, errors.New("SourceVMId is required to create an image")
+	}
+
+	instance, err := imageHandler.Client.Instances.Get(projectId, zone, imageReqInfo.SourceVMId).Context(imageHandler.Ctx).Do()
+	if err != nil {
+		return irs.ImageInfo{}, err
+	}
+	var sourceDisk string
+	for _, attachedDisk := range instance.Disks {
+		if attachedDisk.Boot {
+			sourceDisk = attachedDisk.Source
+			break
+		}
+	}
+	if sourceDisk == "" {
+		return irs.ImageInfo{}, errors.New("VM " + imageReqInfo.SourceVMId + " has no boot disk")
+	}
+
+	image := &compute.Image{
+		Name:        imageReqInfo.Name,
+		Description: imageReqInfo.Description,
+		SourceDisk:  sourceDisk,
+		Labels:      map[string]string{sourceVMLabelKey: imageReqInfo.SourceVMId},
+	}
+	_, err = imageHandler.Client.Images.Insert(projectId, image).ForceCreate(imageReqInfo.NoReboot).Context(imageHandler.Ctx).Do()
+	if err != nil {
+		return irs.ImageInfo{}, err
+	}
 
-	return irs.ImageInfo{}, nil
+	return imageHandler.GetImage(imageReqInfo.Name)
+}
+
+// GCP의 프로젝트 이미지는 모두 계정 소유 이미지
+func (imageHandler *GCPImageHandler) ListMyImage() ([]*irs.ImageInfo, error) {
+	projectId := imageHandler.Credential.ProjectID
+
+	var imageList []*irs.ImageInfo
+	err := imageHandler.Client.Images.List(projectId).Pages(imageHandler.Ctx, func(page *compute.ImageList) error {
+		for _, item := range page.Items {
+			info := mappingImageInfo(item)
+			imageList = append(imageList, &info)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imageList, nil
 }
 
 func (imageHandler *GCPImageHandler) ListImage() ([]*irs.ImageInfo, error) {
@@ -60,15 +115,87 @@ func (imageHandler *GCPImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 	return imageList, err
 }
 
+// OS 별 퍼블릭 이미지 프로젝트
+var publicImageProjects = map[string]string{
+	"ubuntu":  "ubuntu-os-cloud",
+	"centos":  "centos-cloud",
+	"debian":  "debian-cloud",
+	"rhel":    "rhel-cloud",
+	"rocky":   "rocky-linux-cloud",
+	"sles":    "suse-cloud",
+	"windows": "windows-cloud",
+	"cos":     "cos-cloud",
+}
+
+// Owner는 이미지 프로젝트이며, 없으면 OSFamily의 퍼블릭 이미지 프로젝트(없으면 내 프로젝트)에서 조회한다.
+// 이름 조건은 서버 측 정규식 필터(name eq)로, 아키텍처는 조회 결과에서 거른다. (Deprecated 이미지 제외)
+func (imageHandler *GCPImageHandler) ListImageByFilter(filterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	osFamily := strings.ToLower(filterInfo.OSFamily)
+
+	projectId := imageHandler.Credential.ProjectID
+	if filterInfo.Owner != "" && filterInfo.Owner != "self" {
+		projectId = filterInfo.Owner
+	} else if filterInfo.Owner == "" && publicImageProjects[osFamily] != "" {
+		projectId = publicImageProjects[osFamily]
+	}
+
+	// GCP 이미지 이름은 버전에 "."이 없음, ex) ubuntu-2204-jammy-v20221018
+	var nameRegexp string
+	if osFamily != "" || filterInfo.OSVersion != "" {
+		version := strings.Replace(filterInfo.OSVersion, ".", "", -1)
+		nameRegexp = ".*" + regexp.QuoteMeta(osFamily) + ".*" + regexp.QuoteMeta(version) + ".*"
+	} else if filterInfo.NamePattern != "" {
+		nameRegexp = strings.Replace(regexp.QuoteMeta(strings.ToLower(filterInfo.NamePattern)), `\*`, ".*", -1)
+	}
+
+	call := imageHandler.Client.Images.List(projectId)
+	if nameRegexp != "" {
+		call = call.Filter("name eq '" + nameRegexp + "'")
+	}
+
+	var imageList []*irs.ImageInfo
+	err := call.Pages(imageHandler.Ctx, func(page *compute.ImageList) error {
+		for _, item := range page.Items {
+			if item.Deprecated != nil && item.Deprecated.State != "" && item.Deprecated.State != "ACTIVE" {
+				continue
+			}
+			if filterInfo.Architecture != "" && !matchArchitecture(item.Architecture, filterInfo.Architecture) {
+				continue
+			}
+			info := mappingImageInfo(item)
+			imageList = append(imageList, &info)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imageList, nil
+}
+
+// 이미지 아키텍처 : X86_64 | ARM64 (값이 없는 이전 이미지는 X86_64)
+func matchArchitecture(imageArch string, arch string) bool {
+	if imageArch == "" {
+		imageArch = "X86_64"
+	}
+	switch strings.ToLower(arch) {
+	case "amd64", "x64":
+		arch = "X86_64"
+	case "aarch64", "arm":
+		arch = "ARM64"
+	}
+	return strings.EqualFold(imageArch, arch)
+}
+
 func (imageHandler *GCPImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
 	projectId := imageHandler.Credential.ProjectID
 
 	image, err := imageHandler.Client.Images.Get(projectId, imageID).Do()
 	if err != nil {
-		log.Fatal(err)
+		return irs.ImageInfo{}, err
 	}
 	imageInfo := mappingImageInfo(image)
-	return imageInfo, err
+	return imageInfo, nil
 }
 
 func (imageHandler *GCPImageHandler) DeleteImage(imageID string) (bool, error) {
@@ -83,20 +210,51 @@ func (imageHandler *GCPImageHandler) DeleteImage(imageID string) (bool, error) {
 }
 
 func mappingImageInfo(imageInfo *compute.Image) irs.ImageInfo {
-	lArr := strings.Split(imageInfo.Licenses[0], "/")
-	os := lArr[len(lArr)-1]
+	var os string
+	if len(imageInfo.Licenses) > 0 {
+		lArr := strings.Split(imageInfo.Licenses[0], "/")
+		os = lArr[len(lArr)-1]
+	}
 	imageList := irs.ImageInfo{
 		Id:      strconv.FormatUint(imageInfo.Id, 10),
 		Name:    imageInfo.Name,
 		GuestOS: os,
-		Status:  imageInfo.Status,
 		KeyValueList: []irs.KeyValue{
+			{"Status", imageInfo.Status},
 			{"SourceType", imageInfo.SourceType},
 			{"SelfLink", imageInfo.SelfLink},
-			{"GuestOsFeature", imageInfo.GuestOsFeatures[0].Type},
 			{"DiskSizeGb", strconv.FormatInt(imageInfo.DiskSizeGb, 10)},
 		},
 	}
+	if len(imageInfo.GuestOsFeatures) > 0 {
+		imageList.KeyValueList = append(imageList.KeyValueList, irs.KeyValue{"GuestOsFeature", imageInfo.GuestOsFeatures[0].Type})
+	}
+	if imageInfo.SourceDisk != "" {
+		imageList.KeyValueList = append(imageList.KeyValueList, irs.KeyValue{"SourceDisk", getResourceName(imageInfo.SourceDisk)})
+	}
+	if vmID, ok := imageInfo.Labels[sourceVMLabelKey]; ok {
+		imageList.KeyValueList = append(imageList.KeyValueList, irs.KeyValue{"SourceVMId", vmID})
+	}
+	if imageInfo.Description != "" {
+		imageList.KeyValueList = append(imageList.KeyValueList, irs.KeyValue{"Description", imageInfo.Description})
+	}
+	if imageInfo.Architecture != "" {
+		imageList.KeyValueList = append(imageList.KeyValueList, irs.KeyValue{"Architecture", imageInfo.Architecture})
+	}
+	// ex) 2019-10-24T09:17:01.540-07:00
+	if createdTime, err := time.Parse(time.RFC3339, imageInfo.CreationTimestamp); err == nil {
+		imageList.KeyValueList = append(imageList.KeyValueList, irs.KeyValue{irs.ImageCreatedTimeKey, createdTime.Format(time.RFC3339)})
+	}
+
+	// Status : PENDING | READY | FAILED | DELETING
+	switch imageInfo.Status {
+	case "PENDING":
+		imageList.Status = irs.ImageCreating
+	case "READY":
+		imageList.Status = irs.ImageAvailable
+	default:
+		imageList.Status = irs.ImageUnavailable
+	}
 
 	return imageList
 
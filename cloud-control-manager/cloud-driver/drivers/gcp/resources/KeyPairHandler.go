@@ -1,45 +1,37 @@
 package resources
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"os"
 	"strings"
 
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	keyvault "github.com/cloud-barista/cb-spider/cloud-control-manager/key-vault"
 	"golang.org/x/crypto/ssh"
+	compute "google.golang.org/api/compute/v1"
 )
 
 type GCPKeyPairHandler struct {
 	CredentialInfo idrv.CredentialInfo
 	Region         idrv.RegionInfo
+	Ctx            context.Context
+	Client         *compute.Service
 }
 
 func (keyPairHandler *GCPKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
-	keyPairPath := os.Getenv("CBSPIDER_ROOT") + CBKeyPairPath
-	hashString, err := CreateHashString(keyPairHandler.CredentialInfo)
+	scope, err := GetKeyVaultScope(keyPairHandler.CredentialInfo)
 	if err != nil {
 		return irs.KeyPairInfo{}, err
 	}
-
-	savePrivateFileTo := keyPairPath + hashString + "--" + keyPairReqInfo.Name
-	savePublicFileTo := keyPairPath + hashString + "--" + keyPairReqInfo.Name + ".pub"
 	bitSize := 4096
 
-	// Check KeyPair Exists
-	if _, err := os.Stat(savePrivateFileTo); err == nil {
-		errMsg := fmt.Sprintf("KeyPair with name %s already exist", keyPairReqInfo.Name)
-		createErr := errors.New(errMsg)
-		return irs.KeyPairInfo{}, createErr
-	}
-
 	// 지정된 바이트크기의 RSA 형식 개인키(비공개키)를 만듬
 	privateKey, err := generatePrivateKey(bitSize)
 	if err != nil {
@@ -56,103 +48,184 @@ func (keyPairHandler *GCPKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPairReq
 		return irs.KeyPairInfo{}, err
 	}
 
-	// 파일에 private Key를 쓴다
-	err = writeKeyToFile(privateKeyBytes, savePrivateFileTo)
+	// Key Vault에 저장 (Private Key는 암호화하여 저장, 이미 존재하면 에러)
+	keyInfo := keyvault.KeyInfo{
+		Name:       keyPairReqInfo.Name,
+		PublicKey:  string(publicKeyBytes),
+		PrivateKey: string(privateKeyBytes),
+	}
+	err = keyvault.InsertKey(CBKeyVaultDomain, scope, keyInfo)
 	if err != nil {
 		return irs.KeyPairInfo{}, err
 	}
 
-	// 파일에 public Key를 쓴다
-	err = writeKeyToFile([]byte(publicKeyBytes), savePublicFileTo)
+	return mappingKeyPairInfo(keyInfo), nil
+}
+
+// 기존 OpenSSH 공개키(ssh-rsa, ssh-ed25519)를 KeyPair로 등록 (Private Key 없이 Public Key만 저장)
+func (keyPairHandler *GCPKeyPairHandler) ImportKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	scope, err := GetKeyVaultScope(keyPairHandler.CredentialInfo)
 	if err != nil {
 		return irs.KeyPairInfo{}, err
 	}
 
-	keyPairInfo := irs.KeyPairInfo{
-		Name:       keyPairReqInfo.Name,
-		PublicKey:  string(publicKeyBytes),
-		PrivateKey: string(privateKeyBytes),
+	publicKey, err := parseImportPublicKey(keyPairReqInfo.PublicKey)
+	if err != nil {
+		return irs.KeyPairInfo{}, err
+	}
+	publicKeyBytes := ssh.MarshalAuthorizedKey(publicKey)
+
+	// Key Vault에 저장 (이미 존재하면 에러)
+	keyInfo := keyvault.KeyInfo{
+		Name:      keyPairReqInfo.Name,
+		PublicKey: string(publicKeyBytes),
+	}
+	err = keyvault.InsertKey(CBKeyVaultDomain, scope, keyInfo)
+	if err != nil {
+		return irs.KeyPairInfo{}, err
 	}
-	return keyPairInfo, nil
+
+	// 프로젝트 메타데이터(ssh-keys)에 등록하여 프로젝트의 VM에 적용
+	err = keyPairHandler.setProjectSSHKey(keyPairReqInfo.Name, string(publicKeyBytes))
+	if err != nil {
+		keyvault.DeleteKey(CBKeyVaultDomain, scope, keyPairReqInfo.Name)
+		return irs.KeyPairInfo{}, err
+	}
+
+	return mappingKeyPairInfo(keyInfo), nil
 }
 
 func (keyPairHandler *GCPKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, error) {
-	keyPairPath := os.Getenv("CBSPIDER_ROOT") + CBKeyPairPath
-	hashString, err := CreateHashString(keyPairHandler.CredentialInfo)
+	scope, err := GetKeyVaultScope(keyPairHandler.CredentialInfo)
 	if err != nil {
 		return nil, err
 	}
 
-	var keyPairInfoList []*irs.KeyPairInfo
-
-	files, err := ioutil.ReadDir(keyPairPath)
+	keyInfoList, err := keyvault.ListKey(CBKeyVaultDomain, scope)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, f := range files {
-		if strings.Contains(f.Name(), ".pub") {
-			continue
-		}
-		if strings.Contains(f.Name(), hashString) {
-			fileNameArr := strings.Split(f.Name(), "--")
-			keypairInfo, err := keyPairHandler.GetKey(fileNameArr[1])
-			if err != nil {
-				return nil, err
-			}
-			keyPairInfoList = append(keyPairInfoList, &keypairInfo)
-		}
+	var keyPairInfoList []*irs.KeyPairInfo
+	for _, keyInfo := range keyInfoList {
+		keyPairInfo := mappingKeyPairInfo(*keyInfo)
+		keyPairInfoList = append(keyPairInfoList, &keyPairInfo)
 	}
-
 	return keyPairInfoList, nil
 }
 
 func (keyPairHandler *GCPKeyPairHandler) GetKey(keyPairName string) (irs.KeyPairInfo, error) {
-	keyPairPath := os.Getenv("CBSPIDER_ROOT") + CBKeyPairPath
-	hashString, err := CreateHashString(keyPairHandler.CredentialInfo)
-
-	privateKeyPath := keyPairPath + hashString + "--" + keyPairName
-	publicKeyPath := keyPairPath + hashString + "--" + keyPairName + ".pub"
-
-	// Private Key, Public Key 파일 정보 가져오기
-	privateKeyBytes, err := ioutil.ReadFile(privateKeyPath)
+	scope, err := GetKeyVaultScope(keyPairHandler.CredentialInfo)
 	if err != nil {
 		return irs.KeyPairInfo{}, err
 	}
-	publicKeyBytes, err := ioutil.ReadFile(publicKeyPath)
+
+	// ImportKey로 등록된 KeyPair는 Private Key 없음
+	keyInfo, err := keyvault.GetKey(CBKeyVaultDomain, scope, keyPairName)
 	if err != nil {
 		return irs.KeyPairInfo{}, err
 	}
-
-	keypairInfo := irs.KeyPairInfo{
-		Name:       keyPairName,
-		PublicKey:  string(publicKeyBytes),
-		PrivateKey: string(privateKeyBytes),
-	}
-	return keypairInfo, nil
+	return mappingKeyPairInfo(*keyInfo), nil
 }
 
 func (keyPairHandler *GCPKeyPairHandler) DeleteKey(keyPairName string) (bool, error) {
-	keyPairPath := os.Getenv("CBSPIDER_ROOT") + CBKeyPairPath
-	hashString, err := CreateHashString(keyPairHandler.CredentialInfo)
+	scope, err := GetKeyVaultScope(keyPairHandler.CredentialInfo)
 	if err != nil {
 		return false, err
 	}
 
-	privateKeyPath := keyPairPath + hashString + "--" + keyPairName
-	publicKeyPath := keyPairPath + hashString + "--" + keyPairName + ".pub"
-
-	// Private Key, Public Key 삭제
-	err = os.Remove(privateKeyPath)
+	// ImportKey로 프로젝트 메타데이터에 등록된 공개키 삭제
+	err = keyPairHandler.setProjectSSHKey(keyPairName, "")
 	if err != nil {
 		return false, err
 	}
-	err = os.Remove(publicKeyPath)
+
+	return keyvault.DeleteKey(CBKeyVaultDomain, scope, keyPairName)
+}
+
+func mappingKeyPairInfo(keyInfo keyvault.KeyInfo) irs.KeyPairInfo {
+	keyPairInfo := irs.KeyPairInfo{
+		Name:       keyInfo.Name,
+		PublicKey:  keyInfo.PublicKey,
+		PrivateKey: keyInfo.PrivateKey,
+	}
+	if publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyInfo.PublicKey)); err == nil {
+		keyPairInfo.Fingerprint = ssh.FingerprintSHA256(publicKey)
+	}
+	return keyPairInfo
+}
+
+// 프로젝트 메타데이터 ssh-keys의 KeyPair 항목("<VM User>:<공개키> <KeyPair 이름>")을 교체, publicKey가 없으면 삭제
+func (keyPairHandler *GCPKeyPairHandler) setProjectSSHKey(keyPairName string, publicKey string) error {
+	projectID := keyPairHandler.CredentialInfo.ProjectID
+
+	project, err := keyPairHandler.Client.Projects.Get(projectID).Context(keyPairHandler.Ctx).Do()
 	if err != nil {
-		return false, err
+		return err
+	}
+	metadata := project.CommonInstanceMetadata
+	if metadata == nil {
+		metadata = &compute.Metadata{}
+	}
+
+	var sshKeysItem *compute.MetadataItems
+	for _, item := range metadata.Items {
+		if item.Key == "ssh-keys" {
+			sshKeysItem = item
+			break
+		}
+	}
+	if sshKeysItem == nil {
+		if publicKey == "" {
+			return nil
+		}
+		sshKeysItem = &compute.MetadataItems{Key: "ssh-keys"}
+		metadata.Items = append(metadata.Items, sshKeysItem)
 	}
 
-	return true, nil
+	// 공개키의 comment를 KeyPair 이름으로 대체하여 항목 구분
+	var sshKeys []string
+	changed := false
+	if sshKeysItem.Value != nil {
+		for _, line := range strings.Split(*sshKeysItem.Value, "\n") {
+			fields := strings.Fields(line)
+			if strings.HasPrefix(line, CBVMUser+":") && len(fields) == 3 && fields[2] == keyPairName {
+				changed = true
+				continue
+			}
+			if strings.TrimSpace(line) != "" {
+				sshKeys = append(sshKeys, line)
+			}
+		}
+	}
+	if publicKey != "" {
+		fields := strings.Fields(publicKey)
+		sshKeys = append(sshKeys, CBVMUser+":"+fields[0]+" "+fields[1]+" "+keyPairName)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	sshKeysValue := strings.Join(sshKeys, "\n")
+	sshKeysItem.Value = &sshKeysValue
+	op, err := keyPairHandler.Client.Projects.SetCommonInstanceMetadata(projectID, metadata).Context(keyPairHandler.Ctx).Do()
+	if err != nil {
+		return err
+	}
+	return WaitGlobalOperation(keyPairHandler.Client, keyPairHandler.Ctx, projectID, op.Name)
+}
+
+// OpenSSH 형식 공개키 검증 (ssh-rsa, ssh-ed25519만 지원)
+func parseImportPublicKey(publicKeyString string) (ssh.PublicKey, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKeyString))
+	if err != nil {
+		return nil, errors.New("invalid OpenSSH public key: " + err.Error())
+	}
+	if publicKey.Type() != ssh.KeyAlgoRSA && publicKey.Type() != ssh.KeyAlgoED25519 {
+		return nil, errors.New("unsupported public key type: " + publicKey.Type())
+	}
+	return publicKey, nil
 }
 
 // 지정된 바이트크기의 RSA 형식 개인키(비공개키)를 만듬
@@ -208,17 +281,6 @@ func generatePublicKey(privatekey *rsa.PublicKey) ([]byte, error) {
 	return pubKeyBytes, nil
 }
 
-// 파일에 Key를 쓴다
-func writeKeyToFile(keyBytes []byte, saveFileTo string) error {
-	err := ioutil.WriteFile(saveFileTo, keyBytes, 0600)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("Key 저장위치: %s", saveFileTo)
-	return nil
-}
-
 // Credential 기반 hash 생성
 /*func createHashString(credentialInfo idrv.CredentialInfo) (string, error) {
 	keyString := credentialInfo.ClientId + credentialInfo.ClientSecret + credentialInfo.TenantId + credentialInfo.SubscriptionId
@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+const (
+	CBNATIpAllocateOption   = "AUTO_ONLY"
+	CBNATAllSubnetworks     = "ALL_SUBNETWORKS_ALL_IP_RANGES"
+	CBNATListOfSubnetworks  = "LIST_OF_SUBNETWORKS"
+	CBNATAllSubnetworkRange = "ALL_IP_RANGES"
+)
+
+type GCPNATGatewayHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GCP는 Cloud Router의 Cloud NAT 설정을 NAT Gateway로 사용한다.(NAT Gateway ID = Cloud Router 이름)
+// 서브넷을 지정하지 않으면 연결 Region의 모든 서브넷에 NAT가 적용되며, Public IP는 자동 할당(AUTO_ONLY)한다.
+func (natHandler *GCPNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayReqInfo) (irs.NATGatewayInfo, error) {
+	projectID := natHandler.Credential.ProjectID
+	region := natHandler.Region.Region
+
+	if natGatewayReqInfo.VPCId == "" {
+		return irs.NATGatewayInfo{}, errors.New("VPCId is required to create the cloud router of the NAT gateway")
+	}
+
+	nat := &compute.RouterNat{
+		Name:                          natGatewayReqInfo.Name,
+		NatIpAllocateOption:           CBNATIpAllocateOption,
+		SourceSubnetworkIpRangesToNat: CBNATAllSubnetworks,
+	}
+	if natGatewayReqInfo.SubnetId != "" {
+		nat.SourceSubnetworkIpRangesToNat = CBNATListOfSubnetworks
+		nat.Subnetworks = []*compute.RouterNatSubnetworkToNat{natHandler.getSubnetworkToNat(natGatewayReqInfo.SubnetId)}
+	}
+	router := &compute.Router{
+		Name:    natGatewayReqInfo.Name,
+		Network: "global/networks/" + natGatewayReqInfo.VPCId,
+		Nats:    []*compute.RouterNat{nat},
+	}
+
+	op, err := natHandler.Client.Routers.Insert(projectID, region, router).Context(natHandler.Ctx).Do()
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	if err := WaitRegionOperation(natHandler.Client, natHandler.Ctx, projectID, region, op.Name); err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.GetNATGateway(natGatewayReqInfo.Name)
+}
+
+// NAT 설정이 있는 Cloud Router만 조회한다.
+func (natHandler *GCPNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	projectID := natHandler.Credential.ProjectID
+	region := natHandler.Region.Region
+
+	var natGatewayInfoList []*irs.NATGatewayInfo
+	err := natHandler.Client.Routers.List(projectID, region).Pages(natHandler.Ctx, func(page *compute.RouterList) error {
+		for _, router := range page.Items {
+			if len(router.Nats) == 0 {
+				continue
+			}
+			natGatewayInfo := natHandler.mappingNATGatewayInfo(router)
+			natGatewayInfoList = append(natGatewayInfoList, &natGatewayInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return natGatewayInfoList, nil
+}
+
+func (natHandler *GCPNATGatewayHandler) GetNATGateway(natGatewayID string) (irs.NATGatewayInfo, error) {
+	router, err := natHandler.getRouter(natGatewayID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.mappingNATGatewayInfo(router), nil
+}
+
+// Cloud Router를 삭제하면 NAT 설정과 자동 할당된 Public IP도 함께 삭제된다.
+func (natHandler *GCPNATGatewayHandler) DeleteNATGateway(natGatewayID string) (bool, error) {
+	projectID := natHandler.Credential.ProjectID
+	region := natHandler.Region.Region
+
+	op, err := natHandler.Client.Routers.Delete(projectID, region, natGatewayID).Context(natHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitRegionOperation(natHandler.Client, natHandler.Ctx, projectID, region, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GCP Cloud NAT는 Route를 사용하지 않으므로 서브넷 목록에 추가한다.
+func (natHandler *GCPNATGatewayHandler) AssociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (irs.NATGatewayInfo, error) {
+	if associationInfo.SubnetId == "" {
+		return irs.NATGatewayInfo{}, errors.New("GCP cloud NAT is associated with the subnet, SubnetId is required")
+	}
+
+	router, err := natHandler.getRouter(natGatewayID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	if len(router.Nats) == 0 {
+		return irs.NATGatewayInfo{}, errors.New("cloud NAT is not found in the router " + natGatewayID)
+	}
+
+	nat := router.Nats[0]
+	for _, subnetwork := range nat.Subnetworks {
+		if getResourceName(subnetwork.Name) == associationInfo.SubnetId {
+			return natHandler.mappingNATGatewayInfo(router), nil
+		}
+	}
+	nat.SourceSubnetworkIpRangesToNat = CBNATListOfSubnetworks
+	nat.Subnetworks = append(nat.Subnetworks, natHandler.getSubnetworkToNat(associationInfo.SubnetId))
+
+	if err := natHandler.patchRouterNat(natGatewayID, nat); err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.GetNATGateway(natGatewayID)
+}
+
+// Cloud NAT는 최소 1개의 서브넷이 필요하므로 마지막 서브넷은 해제할 수 없다.(NAT Gateway 삭제)
+func (natHandler *GCPNATGatewayHandler) DisassociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (bool, error) {
+	if associationInfo.SubnetId == "" {
+		return false, errors.New("GCP cloud NAT is associated with the subnet, SubnetId is required")
+	}
+
+	router, err := natHandler.getRouter(natGatewayID)
+	if err != nil {
+		return false, err
+	}
+	if len(router.Nats) == 0 {
+		return false, errors.New("cloud NAT is not found in the router " + natGatewayID)
+	}
+
+	nat := router.Nats[0]
+	if nat.SourceSubnetworkIpRangesToNat != CBNATListOfSubnetworks {
+		return false, errors.New("cloud NAT " + natGatewayID + " is applied to all the subnets, disassociation is not supported")
+	}
+	subnetworkList := []*compute.RouterNatSubnetworkToNat{}
+	for _, subnetwork := range nat.Subnetworks {
+		if getResourceName(subnetwork.Name) != associationInfo.SubnetId {
+			subnetworkList = append(subnetworkList, subnetwork)
+		}
+	}
+	if len(subnetworkList) == len(nat.Subnetworks) {
+		return false, errors.New("subnet " + associationInfo.SubnetId + " is not associated with the cloud NAT " + natGatewayID)
+	}
+	if len(subnetworkList) == 0 {
+		return false, errors.New("the last subnet of the cloud NAT cannot be disassociated, delete the NAT gateway " + natGatewayID)
+	}
+	nat.Subnetworks = subnetworkList
+
+	if err := natHandler.patchRouterNat(natGatewayID, nat); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (natHandler *GCPNATGatewayHandler) getRouter(routerName string) (*compute.Router, error) {
+	projectID := natHandler.Credential.ProjectID
+	region := natHandler.Region.Region
+
+	return natHandler.Client.Routers.Get(projectID, region, routerName).Context(natHandler.Ctx).Do()
+}
+
+func (natHandler *GCPNATGatewayHandler) patchRouterNat(routerName string, nat *compute.RouterNat) error {
+	projectID := natHandler.Credential.ProjectID
+	region := natHandler.Region.Region
+
+	op, err := natHandler.Client.Routers.Patch(projectID, region, routerName, &compute.Router{Nats: []*compute.RouterNat{nat}}).Context(natHandler.Ctx).Do()
+	if err != nil {
+		return err
+	}
+	return WaitRegionOperation(natHandler.Client, natHandler.Ctx, projectID, region, op.Name)
+}
+
+func (natHandler *GCPNATGatewayHandler) getSubnetworkToNat(subnetID string) *compute.RouterNatSubnetworkToNat {
+	return &compute.RouterNatSubnetworkToNat{
+		Name:                "regions/" + natHandler.Region.Region + "/subnetworks/" + subnetID,
+		SourceIpRangesToNat: []string{CBNATAllSubnetworkRange},
+	}
+}
+
+func (natHandler *GCPNATGatewayHandler) mappingNATGatewayInfo(router *compute.Router) irs.NATGatewayInfo {
+	natGatewayInfo := irs.NATGatewayInfo{
+		Id:     router.Name,
+		Name:   router.Name,
+		VPCId:  getResourceName(router.Network),
+		Status: "Available",
+		KeyValueList: []irs.KeyValue{
+			{Key: "SelfLink", Value: router.SelfLink},
+			{Key: "CreationTimestamp", Value: router.CreationTimestamp},
+		},
+	}
+
+	for _, nat := range router.Nats {
+		natGatewayInfo.KeyValueList = append(natGatewayInfo.KeyValueList,
+			irs.KeyValue{Key: "NatName", Value: nat.Name},
+			irs.KeyValue{Key: "SourceSubnetworkIpRangesToNat", Value: nat.SourceSubnetworkIpRangesToNat},
+		)
+		for _, subnetwork := range nat.Subnetworks {
+			natGatewayInfo.SubnetIdList = append(natGatewayInfo.SubnetIdList, getResourceName(subnetwork.Name))
+		}
+	}
+
+	// 자동 할당된 Public IP는 Router Status로 조회
+	status, err := natHandler.Client.Routers.GetRouterStatus(natHandler.Credential.ProjectID, natHandler.Region.Region, router.Name).Context(natHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return natGatewayInfo
+	}
+	if status.Result != nil {
+		for _, natStatus := range status.Result.NatStatus {
+			if len(natStatus.AutoAllocatedNatIps) > 0 {
+				natGatewayInfo.PublicIP = natStatus.AutoAllocatedNatIps[0]
+			}
+		}
+	}
+	return natGatewayInfo
+}
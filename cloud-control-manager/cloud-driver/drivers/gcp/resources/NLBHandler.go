@@ -0,0 +1,330 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+const (
+	CBNLBHealthCheckSuffix           = "-healthcheck"
+	CBNLBLoadBalancingScheme         = "EXTERNAL"
+	CBNLBHealthy                     = "HEALTHY"
+	CBNLBDefaultHealthCheckPath      = "/"
+	CBNLBDefaultHealthCheckInterval  = 10 // seconds
+	CBNLBDefaultHealthCheckTimeout   = 5  // seconds
+	CBNLBDefaultHealthCheckThreshold = 3
+)
+
+type GCPNLBHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GCP는 Target Pool + Forwarding Rule을 NLB로 사용한다.(NLB ID = Target Pool 이름 = Forwarding Rule 이름)
+// Target Pool은 Legacy HTTP Health Check만 지원하므로 HealthChecker는 HTTP로 생성하며,
+// Forwarding Rule의 포트로 VM에 그대로 전달하므로 VMGroup 포트는 Listener 포트와 같아야 한다.
+func (nlbHandler *GCPNLBHandler) CreateNLB(nlbReqInfo irs.NLBReqInfo) (irs.NLBInfo, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	if nlbReqInfo.Type == irs.NLBInternal {
+		return irs.NLBInfo{}, errors.New("GCP target pool supports the external load balancer only")
+	}
+	if nlbReqInfo.VMGroup.Port != "" && nlbReqInfo.VMGroup.Port != nlbReqInfo.Listener.Port {
+		return irs.NLBInfo{}, errors.New("GCP target pool forwards to the listener port, VM group port must be the same as the listener port")
+	}
+	port, err := strconv.Atoi(nlbReqInfo.Listener.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("invalid listener port " + nlbReqInfo.Listener.Port)
+	}
+
+	// 1. Health Check 생성
+	healthCheck := &compute.HttpHealthCheck{
+		Name:               nlbReqInfo.Name + CBNLBHealthCheckSuffix,
+		Port:               int64(port),
+		RequestPath:        CBNLBDefaultHealthCheckPath,
+		CheckIntervalSec:   CBNLBDefaultHealthCheckInterval,
+		TimeoutSec:         CBNLBDefaultHealthCheckTimeout,
+		HealthyThreshold:   CBNLBDefaultHealthCheckThreshold,
+		UnhealthyThreshold: CBNLBDefaultHealthCheckThreshold,
+	}
+	if nlbReqInfo.HealthChecker.Port != "" {
+		healthCheckPort, err := strconv.Atoi(nlbReqInfo.HealthChecker.Port)
+		if err != nil {
+			return irs.NLBInfo{}, errors.New("invalid health checker port " + nlbReqInfo.HealthChecker.Port)
+		}
+		healthCheck.Port = int64(healthCheckPort)
+	}
+	if nlbReqInfo.HealthChecker.Interval > 0 {
+		healthCheck.CheckIntervalSec = int64(nlbReqInfo.HealthChecker.Interval)
+	}
+	if nlbReqInfo.HealthChecker.Timeout > 0 {
+		healthCheck.TimeoutSec = int64(nlbReqInfo.HealthChecker.Timeout)
+	}
+	if nlbReqInfo.HealthChecker.Threshold > 0 {
+		healthCheck.HealthyThreshold = int64(nlbReqInfo.HealthChecker.Threshold)
+		healthCheck.UnhealthyThreshold = int64(nlbReqInfo.HealthChecker.Threshold)
+	}
+	op, err := nlbHandler.Client.HttpHealthChecks.Insert(projectID, healthCheck).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := WaitGlobalOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, op.Name); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	// 2. Target Pool 생성 (VPC는 Target Pool에 저장되지 않으므로 Description에 기록)
+	targetPool := &compute.TargetPool{
+		Name:         nlbReqInfo.Name,
+		Description:  nlbReqInfo.VPCId,
+		HealthChecks: []string{"global/httpHealthChecks/" + healthCheck.Name},
+	}
+	for _, vmID := range nlbReqInfo.VMGroup.VMs {
+		targetPool.Instances = append(targetPool.Instances, nlbHandler.getInstanceURL(vmID))
+	}
+	op, err = nlbHandler.Client.TargetPools.Insert(projectID, region, targetPool).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := WaitRegionOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, region, op.Name); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	// 3. Forwarding Rule 생성
+	ipProtocol := "TCP"
+	if strings.ToUpper(nlbReqInfo.Listener.Protocol) == "UDP" {
+		ipProtocol = "UDP"
+	}
+	forwardingRule := &compute.ForwardingRule{
+		Name:                nlbReqInfo.Name,
+		IPProtocol:          ipProtocol,
+		PortRange:           nlbReqInfo.Listener.Port,
+		LoadBalancingScheme: CBNLBLoadBalancingScheme,
+		Target:              "regions/" + region + "/targetPools/" + nlbReqInfo.Name,
+	}
+	op, err = nlbHandler.Client.ForwardingRules.Insert(projectID, region, forwardingRule).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := WaitRegionOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, region, op.Name); err != nil {
+		return irs.NLBInfo{}, err
+	}
+	return nlbHandler.GetNLB(nlbReqInfo.Name)
+}
+
+func (nlbHandler *GCPNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	var nlbInfoList []*irs.NLBInfo
+	err := nlbHandler.Client.TargetPools.List(projectID, region).Pages(nlbHandler.Ctx, func(page *compute.TargetPoolList) error {
+		for _, targetPool := range page.Items {
+			nlbInfo := nlbHandler.mappingNLBInfo(targetPool)
+			nlbInfoList = append(nlbInfoList, &nlbInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nlbInfoList, nil
+}
+
+func (nlbHandler *GCPNLBHandler) GetNLB(nlbID string) (irs.NLBInfo, error) {
+	targetPool, err := nlbHandler.getTargetPool(nlbID)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	return nlbHandler.mappingNLBInfo(targetPool), nil
+}
+
+// Forwarding Rule -> Target Pool -> Health Check 순서로 삭제한다.
+func (nlbHandler *GCPNLBHandler) DeleteNLB(nlbID string) (bool, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	targetPool, err := nlbHandler.getTargetPool(nlbID)
+	if err != nil {
+		return false, err
+	}
+
+	op, err := nlbHandler.Client.ForwardingRules.Delete(projectID, region, nlbID).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+	} else if err := WaitRegionOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, region, op.Name); err != nil {
+		return false, err
+	}
+
+	op, err = nlbHandler.Client.TargetPools.Delete(projectID, region, nlbID).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitRegionOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, region, op.Name); err != nil {
+		return false, err
+	}
+
+	for _, healthCheck := range targetPool.HealthChecks {
+		op, err := nlbHandler.Client.HttpHealthChecks.Delete(projectID, getResourceName(healthCheck)).Context(nlbHandler.Ctx).Do()
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if err := WaitGlobalOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, op.Name); err != nil {
+			log.Println(err)
+		}
+	}
+	return true, nil
+}
+
+func (nlbHandler *GCPNLBHandler) AddVMs(nlbID string, vmIDs []string) (irs.VMGroupInfo, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	addInstanceRequest := &compute.TargetPoolsAddInstanceRequest{Instances: nlbHandler.getInstanceReferences(vmIDs)}
+	op, err := nlbHandler.Client.TargetPools.AddInstance(projectID, region, nlbID, addInstanceRequest).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	if err := WaitRegionOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, region, op.Name); err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+
+	nlbInfo, err := nlbHandler.GetNLB(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	return nlbInfo.VMGroup, nil
+}
+
+func (nlbHandler *GCPNLBHandler) RemoveVMs(nlbID string, vmIDs []string) (bool, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	removeInstanceRequest := &compute.TargetPoolsRemoveInstanceRequest{Instances: nlbHandler.getInstanceReferences(vmIDs)}
+	op, err := nlbHandler.Client.TargetPools.RemoveInstance(projectID, region, nlbID, removeInstanceRequest).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitRegionOperation(nlbHandler.Client, nlbHandler.Ctx, projectID, region, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Target Pool의 Health 상태는 VM(Instance) 단위로 조회한다.
+func (nlbHandler *GCPNLBHandler) GetVMGroupHealthInfo(nlbID string) (irs.HealthInfo, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	targetPool, err := nlbHandler.getTargetPool(nlbID)
+	if err != nil {
+		return irs.HealthInfo{}, err
+	}
+
+	healthInfo := irs.HealthInfo{}
+	for _, instance := range targetPool.Instances {
+		vmID := getResourceName(instance)
+		healthInfo.AllVMs = append(healthInfo.AllVMs, vmID)
+
+		health, err := nlbHandler.Client.TargetPools.GetHealth(projectID, region, nlbID, &compute.InstanceReference{Instance: instance}).Context(nlbHandler.Ctx).Do()
+		if err != nil {
+			return irs.HealthInfo{}, err
+		}
+		healthy := len(health.HealthStatus) > 0
+		for _, healthStatus := range health.HealthStatus {
+			if healthStatus.HealthState != CBNLBHealthy {
+				healthy = false
+			}
+		}
+		if healthy {
+			healthInfo.HealthyVMs = append(healthInfo.HealthyVMs, vmID)
+		} else {
+			healthInfo.UnHealthyVMs = append(healthInfo.UnHealthyVMs, vmID)
+		}
+	}
+	return healthInfo, nil
+}
+
+func (nlbHandler *GCPNLBHandler) getTargetPool(targetPoolName string) (*compute.TargetPool, error) {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	return nlbHandler.Client.TargetPools.Get(projectID, region, targetPoolName).Context(nlbHandler.Ctx).Do()
+}
+
+// VM은 연결 Zone의 Instance로 찾는다.
+func (nlbHandler *GCPNLBHandler) getInstanceURL(vmID string) string {
+	return "projects/" + nlbHandler.Credential.ProjectID + "/zones/" + nlbHandler.Region.Zone + "/instances/" + vmID
+}
+
+func (nlbHandler *GCPNLBHandler) getInstanceReferences(vmIDs []string) []*compute.InstanceReference {
+	var instanceReferences []*compute.InstanceReference
+	for _, vmID := range vmIDs {
+		instanceReferences = append(instanceReferences, &compute.InstanceReference{Instance: nlbHandler.getInstanceURL(vmID)})
+	}
+	return instanceReferences
+}
+
+func (nlbHandler *GCPNLBHandler) mappingNLBInfo(targetPool *compute.TargetPool) irs.NLBInfo {
+	projectID := nlbHandler.Credential.ProjectID
+	region := nlbHandler.Region.Region
+
+	nlbInfo := irs.NLBInfo{
+		Id:          targetPool.Name,
+		Name:        targetPool.Name,
+		VPCId:       targetPool.Description,
+		Type:        irs.NLBPublic,
+		CreatedTime: targetPool.CreationTimestamp,
+		KeyValueList: []irs.KeyValue{
+			{Key: "SelfLink", Value: targetPool.SelfLink},
+			{Key: "SessionAffinity", Value: targetPool.SessionAffinity},
+		},
+	}
+	for _, instance := range targetPool.Instances {
+		nlbInfo.VMGroup.VMs = append(nlbInfo.VMGroup.VMs, getResourceName(instance))
+	}
+
+	forwardingRule, err := nlbHandler.Client.ForwardingRules.Get(projectID, region, targetPool.Name).Context(nlbHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+	} else {
+		// PortRange는 "80-80" 형식
+		port := strings.Split(forwardingRule.PortRange, "-")[0]
+		nlbInfo.Listener = irs.ListenerInfo{
+			Protocol: forwardingRule.IPProtocol,
+			IP:       forwardingRule.IPAddress,
+			Port:     port,
+		}
+		nlbInfo.VMGroup.Protocol = forwardingRule.IPProtocol
+		nlbInfo.VMGroup.Port = port
+	}
+
+	for _, healthCheckURL := range targetPool.HealthChecks {
+		healthCheck, err := nlbHandler.Client.HttpHealthChecks.Get(projectID, getResourceName(healthCheckURL)).Context(nlbHandler.Ctx).Do()
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		nlbInfo.HealthChecker = irs.HealthCheckerInfo{
+			Protocol:  "HTTP",
+			Port:      strconv.FormatInt(healthCheck.Port, 10),
+			Interval:  int(healthCheck.CheckIntervalSec),
+			Timeout:   int(healthCheck.TimeoutSec),
+			Threshold: int(healthCheck.HealthyThreshold),
+			KeyValueList: []irs.KeyValue{
+				{Key: "Name", Value: healthCheck.Name},
+				{Key: "RequestPath", Value: healthCheck.RequestPath},
+			},
+		}
+	}
+	return nlbInfo
+}
@@ -0,0 +1,224 @@
+package resources
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"golang.org/x/oauth2/google"
+	storage "google.golang.org/api/storage/v1"
+)
+
+const (
+	CBStorageEndpoint = "https://storage.googleapis.com"
+)
+
+type GCPObjectStorageHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *storage.Service
+	Credential idrv.CredentialInfo
+}
+
+func setterBucket(bucket *storage.Bucket) *irs.BucketInfo {
+	return &irs.BucketInfo{
+		Name:        bucket.Name,
+		Region:      strings.ToLower(bucket.Location),
+		CreatedTime: bucket.TimeCreated,
+		KeyValueList: []irs.KeyValue{
+			{Key: "StorageClass", Value: bucket.StorageClass},
+		},
+	}
+}
+
+func setterObject(object *storage.Object) *irs.ObjectInfo {
+	return &irs.ObjectInfo{
+		Key:          object.Name,
+		Size:         int64(object.Size),
+		ETag:         object.Etag,
+		LastModified: object.Updated,
+		StorageClass: object.StorageClass,
+		ContentType:  object.ContentType,
+		KeyValueList: []irs.KeyValue{
+			{Key: "MediaLink", Value: object.MediaLink},
+		},
+	}
+}
+
+// Bucket은 연결 정보의 Region(예: asia-northeast3)을 Location으로 생성한다.
+func (storageHandler *GCPObjectStorageHandler) CreateBucket(bucketName string) (irs.BucketInfo, error) {
+	projectID := storageHandler.Credential.ProjectID
+
+	bucket := &storage.Bucket{
+		Name:     bucketName,
+		Location: storageHandler.Region.Region,
+	}
+	result, err := storageHandler.Client.Buckets.Insert(projectID, bucket).Context(storageHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.BucketInfo{}, err
+	}
+
+	return *setterBucket(result), nil
+}
+
+func (storageHandler *GCPObjectStorageHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	projectID := storageHandler.Credential.ProjectID
+
+	var bucketList []*irs.BucketInfo
+	err := storageHandler.Client.Buckets.List(projectID).Pages(storageHandler.Ctx, func(page *storage.Buckets) error {
+		for _, bucket := range page.Items {
+			bucketList = append(bucketList, setterBucket(bucket))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return bucketList, nil
+}
+
+func (storageHandler *GCPObjectStorageHandler) DeleteBucket(bucketName string) (bool, error) {
+	err := storageHandler.Client.Buckets.Delete(bucketName).Context(storageHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (storageHandler *GCPObjectStorageHandler) PutObject(bucketName string, objectKey string, body io.Reader, contentType string) (irs.ObjectInfo, error) {
+	object := &storage.Object{
+		Name:        objectKey,
+		ContentType: contentType,
+	}
+	result, err := storageHandler.Client.Objects.Insert(bucketName, object).Media(body).Context(storageHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return irs.ObjectInfo{}, err
+	}
+
+	return *setterObject(result), nil
+}
+
+func (storageHandler *GCPObjectStorageHandler) GetObject(bucketName string, objectKey string) (io.ReadCloser, irs.ObjectInfo, error) {
+	object, err := storageHandler.Client.Objects.Get(bucketName, objectKey).Context(storageHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	resp, err := storageHandler.Client.Objects.Get(bucketName, objectKey).Context(storageHandler.Ctx).Download()
+	if err != nil {
+		log.Println(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	return resp.Body, *setterObject(object), nil
+}
+
+func (storageHandler *GCPObjectStorageHandler) ListObject(bucketName string, prefix string) ([]*irs.ObjectInfo, error) {
+	var objectList []*irs.ObjectInfo
+	err := storageHandler.Client.Objects.List(bucketName).Prefix(prefix).Pages(storageHandler.Ctx, func(page *storage.Objects) error {
+		for _, object := range page.Items {
+			objectList = append(objectList, setterObject(object))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return objectList, nil
+}
+
+func (storageHandler *GCPObjectStorageHandler) DeleteObject(bucketName string, objectKey string) (bool, error) {
+	err := storageHandler.Client.Objects.Delete(bucketName, objectKey).Context(storageHandler.Ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Presigned URL은 Service Account의 Private Key로 서명한 V2 Signed URL로 생성한다.
+// (StringToSign = Method \n Content-MD5 \n Content-Type \n Expires \n /bucket/object)
+func (storageHandler *GCPObjectStorageHandler) GetPresignedURL(bucketName string, objectKey string, method string, expiresSeconds int) (string, error) {
+	method = strings.ToUpper(method)
+	if method != irs.PresignedURLGet && method != irs.PresignedURLPut {
+		return "", errors.New("unsupported method " + method + ", GET or PUT")
+	}
+
+	// GCP는 ClientSecret에 Service Account Key 파일 경로를 전달 받음
+	data, err := ioutil.ReadFile(storageHandler.Credential.ClientSecret)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	conf, err := google.JWTConfigFromJSON(data)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	privateKey, err := parseRSAPrivateKey(conf.PrivateKey)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	expires := time.Now().Add(time.Duration(expiresSeconds) * time.Second).Unix()
+	resource := (&url.URL{Path: "/" + bucketName + "/" + objectKey}).EscapedPath()
+	stringToSign := fmt.Sprintf("%s\n\n\n%d\n%s", method, expires, resource)
+
+	hashed := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("GoogleAccessId", conf.Email)
+	query.Set("Expires", fmt.Sprintf("%d", expires))
+	query.Set("Signature", base64.StdEncoding.EncodeToString(signature))
+
+	return CBStorageEndpoint + resource + "?" + query.Encode(), nil
+}
+
+// Service Account Key의 private_key는 PKCS#8 형식이며, PKCS#1 형식도 허용한다.
+func parseRSAPrivateKey(keyBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyBytes)
+	if block == nil {
+		return nil, errors.New("invalid private key, PEM block not found")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("private key is not a RSA key")
+		}
+		return rsaKey, nil
+	}
+
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
@@ -29,8 +29,13 @@ func (publicIpHandler *GCPPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.Pu
 	projectID := publicIpHandler.Credential.ProjectID
 	region := publicIpHandler.Region.Region
 	publicIpName := publicIPReqInfo.Name
+	labels, err := mappingLabels(publicIPReqInfo.TagList)
+	if err != nil {
+		return irs.PublicIPInfo{}, err
+	}
 	address := &compute.Address{
-		Name: publicIpName,
+		Name:   publicIpName,
+		Labels: labels,
 	}
 	publicIpHandler.Client.Addresses.Insert(projectID, region, address).Do()
 	time.Sleep(time.Second * 3)
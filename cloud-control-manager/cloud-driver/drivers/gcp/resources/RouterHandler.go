@@ -0,0 +1,234 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+const (
+	CBInternetGatewayCIDR = "0.0.0.0/0"
+	CBInternetGateway     = "global/gateways/default-internet-gateway"
+	CBRoutePriority       = 1000
+)
+
+type GCPRouterHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GCP는 별도의 Route Table이 없고 VPC Network에 Route가 속하므로 Network를 Router로 사용한다.(Router ID = VPC ID)
+// Route는 Network의 모든 서브넷에 적용되므로 서브넷 연결(Interface)은 지원하지 않는다.
+func (routerHandler *GCPRouterHandler) CreateRouter(routerReqInfo irs.RouterReqInfo) (irs.RouterInfo, error) {
+	if routerReqInfo.VPCId == "" {
+		return irs.RouterInfo{}, errors.New("GCP router is the VPC network, VPCId is required")
+	}
+	if routerReqInfo.InternetGateway {
+		return routerHandler.AttachInternetGateway(routerReqInfo.VPCId)
+	}
+	return routerHandler.GetRouter(routerReqInfo.VPCId)
+}
+
+func (routerHandler *GCPRouterHandler) ListRouter() ([]*irs.RouterInfo, error) {
+	projectID := routerHandler.Credential.ProjectID
+
+	var routerInfoList []*irs.RouterInfo
+	err := routerHandler.Client.Networks.List(projectID).Pages(routerHandler.Ctx, func(page *compute.NetworkList) error {
+		for _, network := range page.Items {
+			routerInfo, err := routerHandler.mappingRouterInfo(network)
+			if err != nil {
+				return err
+			}
+			routerInfoList = append(routerInfoList, &routerInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return routerInfoList, nil
+}
+
+func (routerHandler *GCPRouterHandler) GetRouter(routerID string) (irs.RouterInfo, error) {
+	projectID := routerHandler.Credential.ProjectID
+
+	network, err := routerHandler.Client.Networks.Get(projectID, routerID).Context(routerHandler.Ctx).Do()
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.mappingRouterInfo(network)
+}
+
+// Network는 VPCHandler로 삭제한다.
+func (routerHandler *GCPRouterHandler) DeleteRouter(routerID string) (bool, error) {
+	return false, errors.New("GCP router is the VPC network, delete the VPC " + routerID)
+}
+
+func (routerHandler *GCPRouterHandler) AddRoute(routerID string, routeInfo irs.RouteInfo) (irs.RouterInfo, error) {
+	projectID := routerHandler.Credential.ProjectID
+
+	route := &compute.Route{
+		Name:      getRouteName(routerID, routeInfo.DestinationCIDR),
+		Network:   "global/networks/" + routerID,
+		DestRange: routeInfo.DestinationCIDR,
+		Priority:  CBRoutePriority,
+	}
+	switch routeInfo.TargetType {
+	case irs.RouteTargetInternetGateway:
+		route.NextHopGateway = CBInternetGateway
+	case irs.RouteTargetIP:
+		route.NextHopIp = routeInfo.TargetId
+	case irs.RouteTargetVM:
+		route.NextHopInstance = "zones/" + routerHandler.Region.Zone + "/instances/" + routeInfo.TargetId
+	default:
+		return irs.RouterInfo{}, errors.New("GCP does not support the route target type " + string(routeInfo.TargetType))
+	}
+
+	op, err := routerHandler.Client.Routes.Insert(projectID, route).Context(routerHandler.Ctx).Do()
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	if err := WaitGlobalOperation(routerHandler.Client, routerHandler.Ctx, projectID, op.Name); err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.GetRouter(routerID)
+}
+
+// 기본 생성된 Route(default-route-...)도 삭제할 수 있도록 목적지 CIDR로 찾아서 삭제한다.
+func (routerHandler *GCPRouterHandler) RemoveRoute(routerID string, destinationCIDR string) (bool, error) {
+	return routerHandler.deleteRoutes(routerID, func(route *compute.Route) bool {
+		return route.DestRange == destinationCIDR && route.NextHopNetwork == ""
+	})
+}
+
+func (routerHandler *GCPRouterHandler) AddInterface(routerID string, subnetID string) (irs.RouterInfo, error) {
+	return irs.RouterInfo{}, errors.New("GCP routes are applied to all the subnets of the VPC network, AddInterface is not supported")
+}
+
+func (routerHandler *GCPRouterHandler) DeleteInterface(routerID string, subnetID string) (bool, error) {
+	return false, errors.New("GCP routes are applied to all the subnets of the VPC network, DeleteInterface is not supported")
+}
+
+func (routerHandler *GCPRouterHandler) AttachInternetGateway(routerID string) (irs.RouterInfo, error) {
+	routerInfo, err := routerHandler.GetRouter(routerID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	if routerInfo.InternetGateway {
+		return routerInfo, nil
+	}
+	return routerHandler.AddRoute(routerID, irs.RouteInfo{
+		DestinationCIDR: CBInternetGatewayCIDR,
+		TargetType:      irs.RouteTargetInternetGateway,
+	})
+}
+
+func (routerHandler *GCPRouterHandler) DetachInternetGateway(routerID string) (bool, error) {
+	return routerHandler.deleteRoutes(routerID, func(route *compute.Route) bool {
+		return route.DestRange == CBInternetGatewayCIDR && route.NextHopGateway != ""
+	})
+}
+
+// Route 이름은 Network 이름과 목적지 CIDR로 생성, ex) cb-vpc-192-168-0-0-16
+func getRouteName(networkName string, destinationCIDR string) string {
+	return networkName + "-" + strings.NewReplacer(".", "-", "/", "-").Replace(destinationCIDR)
+}
+
+func (routerHandler *GCPRouterHandler) listRoute(networkSelfLink string) ([]*compute.Route, error) {
+	projectID := routerHandler.Credential.ProjectID
+
+	var routeList []*compute.Route
+	err := routerHandler.Client.Routes.List(projectID).Filter("network eq "+networkSelfLink).Pages(routerHandler.Ctx, func(page *compute.RouteList) error {
+		routeList = append(routeList, page.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return routeList, nil
+}
+
+func (routerHandler *GCPRouterHandler) deleteRoutes(routerID string, match func(route *compute.Route) bool) (bool, error) {
+	projectID := routerHandler.Credential.ProjectID
+
+	network, err := routerHandler.Client.Networks.Get(projectID, routerID).Context(routerHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	routeList, err := routerHandler.listRoute(network.SelfLink)
+	if err != nil {
+		return false, err
+	}
+
+	deleted := false
+	for _, route := range routeList {
+		if !match(route) {
+			continue
+		}
+		op, err := routerHandler.Client.Routes.Delete(projectID, route.Name).Context(routerHandler.Ctx).Do()
+		if err != nil {
+			return false, err
+		}
+		if err := WaitGlobalOperation(routerHandler.Client, routerHandler.Ctx, projectID, op.Name); err != nil {
+			return false, err
+		}
+		deleted = true
+	}
+	if !deleted {
+		return false, errors.New("route is not found in the router " + routerID)
+	}
+	return true, nil
+}
+
+func (routerHandler *GCPRouterHandler) mappingRouterInfo(network *compute.Network) (irs.RouterInfo, error) {
+	routerInfo := irs.RouterInfo{
+		Id:    network.Name,
+		Name:  network.Name,
+		VPCId: network.Name,
+		KeyValueList: []irs.KeyValue{
+			{Key: "SelfLink", Value: network.SelfLink},
+		},
+	}
+
+	routeList, err := routerHandler.listRoute(network.SelfLink)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	for _, route := range routeList {
+		routeInfo := irs.RouteInfo{DestinationCIDR: route.DestRange, TargetType: irs.RouteTargetOther}
+		switch {
+		case route.NextHopGateway != "":
+			routeInfo.TargetType = irs.RouteTargetInternetGateway
+			if route.DestRange == CBInternetGatewayCIDR {
+				routerInfo.InternetGateway = true
+			}
+		case route.NextHopNetwork != "":
+			routeInfo.TargetType = irs.RouteTargetLocal
+		case route.NextHopInstance != "":
+			routeInfo.TargetType = irs.RouteTargetVM
+			routeInfo.TargetId = getResourceName(route.NextHopInstance)
+		case route.NextHopIp != "":
+			routeInfo.TargetType = irs.RouteTargetIP
+			routeInfo.TargetId = route.NextHopIp
+		}
+		routerInfo.RouteList = append(routerInfo.RouteList, routeInfo)
+	}
+
+	// 연결 Region의 서브넷은 모두 Network의 Route를 사용
+	err = routerHandler.Client.Subnetworks.List(routerHandler.Credential.ProjectID, routerHandler.Region.Region).Filter("network eq "+network.SelfLink).Pages(routerHandler.Ctx, func(page *compute.SubnetworkList) error {
+		for _, subnet := range page.Items {
+			routerInfo.SubnetIdList = append(routerInfo.SubnetIdList, subnet.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerInfo, nil
+}
@@ -2,17 +2,19 @@ package resources
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"errors"
 	"strconv"
 	"strings"
-	"time"
 
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	compute "google.golang.org/api/compute/v1"
 )
 
+// GCP Firewall은 방향, CIDR, Priority를 Firewall 단위로 가지므로
+// 하나의 Spider 보안그룹을 Rule 별 Firewall(<보안그룹 이름>-<번호>)로 나누어 생성하고,
+// 보안그룹 이름을 Network Tag로 사용하여 Firewall의 TargetTags와 VM의 Tags로 연결한다.
+// 보안그룹과 Firewall의 매핑은 Firewall 이름과 TargetTags에만 저장되므로 별도 저장소 없이 조회 가능하다.
 type GCPSecurityHandler struct {
 	Region     idrv.RegionInfo
 	Ctx        context.Context
@@ -21,110 +23,468 @@ type GCPSecurityHandler struct {
 }
 
 func (securityHandler *GCPSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
-	projectID := securityHandler.Credential.ProjectID
-	// @TODO: SecurityGroup 생성 요청 파라미터 정의 필요
-	ports := *securityReqInfo.SecurityRules
-	var firewallAllowed []*compute.FirewallAllowed
+	if securityReqInfo.SecurityRules == nil || len(*securityReqInfo.SecurityRules) == 0 {
+		return irs.SecurityInfo{}, errors.New("security rules are required")
+	}
+	// Firewall은 Label을 지원하지 않음
+	if len(securityReqInfo.TagList) > 0 {
+		return irs.SecurityInfo{}, getUnsupportedTagResourceError(irs.RSTypeSecurityGroup)
+	}
 
-	for _, item := range ports {
-		var port string
-		fp := item.FromPort
-		tp := item.ToPort
+	firewalls, err := securityHandler.listSecurityFirewalls(securityReqInfo.Name)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	if len(firewalls) > 0 {
+		return irs.SecurityInfo{}, errors.New("security group " + securityReqInfo.Name + " already exists")
+	}
 
-		if tp != "" && fp != "" {
-			port = fp + "-" + tp
-		}
-		if tp != "" && fp == "" {
-			port = tp
+	// Rule에 Direction이 없으면 보안그룹의 Direction 사용
+	var securityRules []irs.SecurityRuleInfo
+	for _, item := range *securityReqInfo.SecurityRules {
+		if item.Direction == "" {
+			item.Direction = securityReqInfo.Direction
 		}
-		if tp == "" && fp != "" {
-			port = fp
+		securityRules = append(securityRules, item)
+	}
+
+	// VPC를 지정하지 않으면 GCP 기본 Network(default)에 생성
+	network := "global/networks/default"
+	if securityReqInfo.VpcId != "" {
+		network = "global/networks/" + securityReqInfo.VpcId
+	}
+
+	err = securityHandler.insertSecurityFirewalls(securityReqInfo.Name, network, 1, securityRules)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	return securityHandler.GetSecurity(securityReqInfo.Name)
+}
+
+func (securityHandler *GCPSecurityHandler) ListSecurity() ([]*irs.SecurityInfo, error) {
+	projectID := securityHandler.Credential.ProjectID
+
+	var groupNames []string
+	groupFirewalls := map[string][]*compute.Firewall{}
+	err := securityHandler.Client.Firewalls.List(projectID).Pages(securityHandler.Ctx, func(page *compute.FirewallList) error {
+		for _, item := range page.Items {
+			groupName := getSecurityGroupName(item)
+			if _, ok := groupFirewalls[groupName]; !ok {
+				groupNames = append(groupNames, groupName)
+			}
+			groupFirewalls[groupName] = append(groupFirewalls[groupName], item)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		firewallAllowed = append(firewallAllowed, &compute.FirewallAllowed{
-			IPProtocol: item.IPProtocol,
-			Ports: []string{
-				port,
-			},
-		})
+	var securityInfo []*irs.SecurityInfo
+	for _, groupName := range groupNames {
+		secInfo := mappingSecurityInfo(groupName, groupFirewalls[groupName])
+		securityInfo = append(securityInfo, &secInfo)
 	}
-	fireWall := &compute.Firewall{
-		Allowed:   firewallAllowed,
-		Direction: securityReqInfo.Direction, //INGRESS(inbound), EGRESS(outbound)
-		SourceRanges: []string{
-			"0.0.0.0/0",
-		},
-		Name: securityReqInfo.Name,
+	return securityInfo, nil
+}
+
+// securityID: 보안그룹 이름(Network Tag)
+func (securityHandler *GCPSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
+	firewalls, err := securityHandler.listSecurityFirewalls(securityID)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	if len(firewalls) == 0 {
+		return irs.SecurityInfo{}, errors.New("security group " + securityID + " not found")
+	}
+	return mappingSecurityInfo(securityID, firewalls), nil
+}
+
+func (securityHandler *GCPSecurityHandler) DeleteSecurity(securityID string) (bool, error) {
+	firewalls, err := securityHandler.listSecurityFirewalls(securityID)
+	if err != nil {
+		return false, err
+	}
+	if len(firewalls) == 0 {
+		return false, errors.New("security group " + securityID + " not found")
 	}
 
-	res, err := securityHandler.Client.Firewalls.Insert(projectID, fireWall).Do()
+	var firewallNames []string
+	for _, item := range firewalls {
+		firewallNames = append(firewallNames, item.Name)
+	}
+	if err := securityHandler.deleteFirewalls(firewallNames); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Rule 별 Firewall을 보안그룹의 Network에 추가 생성 (이름 번호는 기존 Firewall 다음 번호부터 사용)
+func (securityHandler *GCPSecurityHandler) AddRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	firewalls, err := securityHandler.listSecurityFirewalls(securityID)
 	if err != nil {
-		log.Fatal(err)
+		return irs.SecurityInfo{}, err
+	}
+	if len(firewalls) == 0 {
+		return irs.SecurityInfo{}, errors.New("security group " + securityID + " not found")
+	}
+	if securityRules == nil {
+		return mappingSecurityInfo(securityID, firewalls), nil
 	}
-	fmt.Println("create result : ", res)
-	time.Sleep(time.Second * 3)
-	secInfo, err := securityHandler.GetSecurity(securityReqInfo.Name)
 
-	return secInfo, err
+	nextIndex := 1
+	for _, item := range firewalls {
+		index, err := strconv.Atoi(strings.TrimPrefix(item.Name, securityID+"-"))
+		if err == nil && index >= nextIndex {
+			nextIndex = index + 1
+		}
+	}
 
+	err = securityHandler.insertSecurityFirewalls(securityID, firewalls[0].Network, nextIndex, *securityRules)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	return securityHandler.GetSecurity(securityID)
 }
 
-func (securityHandler *GCPSecurityHandler) ListSecurity() ([]*irs.SecurityInfo, error) {
-	//result, err := securityHandler.Client.ListAll(securityHandler.Ctx)
+// 일치하는 Rule의 Firewall을 삭제 (Allowed가 여러 개인 Firewall은 해당 Allowed만 제외하여 업데이트)
+func (securityHandler *GCPSecurityHandler) RemoveRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	firewalls, err := securityHandler.listSecurityFirewalls(securityID)
+	if err != nil {
+		return false, err
+	}
+	if len(firewalls) == 0 {
+		return false, errors.New("security group " + securityID + " not found")
+	}
+	if securityRules == nil {
+		return true, nil
+	}
+
+	// 모든 Rule이 존재하는지 먼저 확인한 후 삭제
+	removedAllowed := map[string]map[int]bool{}
+	for _, rule := range *securityRules {
+		matched := false
+		for _, item := range firewalls {
+			for idx, allowed := range item.Allowed {
+				if !removedAllowed[item.Name][idx] && equalFirewallRule(item, allowed, rule) {
+					if removedAllowed[item.Name] == nil {
+						removedAllowed[item.Name] = map[int]bool{}
+					}
+					removedAllowed[item.Name][idx] = true
+					matched = true
+					break
+				}
+			}
+			if matched {
+				break
+			}
+		}
+		if !matched {
+			return false, errors.New("security group " + securityID + " has no rule for " + rule.IPProtocol + " " + rule.FromPort + "-" + rule.ToPort)
+		}
+	}
+
+	// 실패 시 이미 변경하거나 삭제한 Firewall을 이전 상태로 되돌림
+	var patchedFirewalls, deletedFirewalls []*compute.Firewall
+	rollback := func(err error) (bool, error) {
+		if rollbackErr := securityHandler.restoreFirewalls(patchedFirewalls, deletedFirewalls); rollbackErr != nil {
+			return false, errors.New(err.Error() + ", rollback failed: " + rollbackErr.Error())
+		}
+		return false, err
+	}
+
 	projectID := securityHandler.Credential.ProjectID
-	result, err := securityHandler.Client.Firewalls.List(projectID).Do()
-	var securityInfo []*irs.SecurityInfo
-	for _, item := range result.Items {
-		name := item.Name
-		secInfo, err := securityHandler.GetSecurity(name)
+	var deleteList []*compute.Firewall
+	for _, item := range firewalls {
+		if len(removedAllowed[item.Name]) == 0 {
+			continue
+		}
+		if len(removedAllowed[item.Name]) == len(item.Allowed) {
+			deleteList = append(deleteList, item)
+			continue
+		}
+
+		var firewallAllowed []*compute.FirewallAllowed
+		for idx, allowed := range item.Allowed {
+			if !removedAllowed[item.Name][idx] {
+				firewallAllowed = append(firewallAllowed, allowed)
+			}
+		}
+		op, err := securityHandler.Client.Firewalls.Patch(projectID, item.Name, &compute.Firewall{Allowed: firewallAllowed}).Context(securityHandler.Ctx).Do()
 		if err != nil {
-			log.Fatal(err)
+			return rollback(err)
+		}
+		patchedFirewalls = append(patchedFirewalls, item)
+		if err := WaitGlobalOperation(securityHandler.Client, securityHandler.Ctx, projectID, op.Name); err != nil {
+			return rollback(err)
 		}
-		securityInfo = append(securityInfo, &secInfo)
 	}
 
-	return securityInfo, err
+	for _, item := range deleteList {
+		if err := securityHandler.deleteFirewalls([]string{item.Name}); err != nil {
+			return rollback(err)
+		}
+		deletedFirewalls = append(deletedFirewalls, item)
+	}
+	return true, nil
 }
 
-func (securityHandler *GCPSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
+// RemoveRules에서 Patch한 Firewall의 Allowed를 되돌리고, 삭제한 Firewall을 다시 생성
+func (securityHandler *GCPSecurityHandler) restoreFirewalls(patchedFirewalls []*compute.Firewall, deletedFirewalls []*compute.Firewall) error {
+	projectID := securityHandler.Credential.ProjectID
+
+	for _, item := range patchedFirewalls {
+		op, err := securityHandler.Client.Firewalls.Patch(projectID, item.Name, &compute.Firewall{Allowed: item.Allowed}).Context(securityHandler.Ctx).Do()
+		if err != nil {
+			return err
+		}
+		if err := WaitGlobalOperation(securityHandler.Client, securityHandler.Ctx, projectID, op.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range deletedFirewalls {
+		// 조회 결과의 Output Only 필드(Id, SelfLink 등)는 제외
+		fireWall := &compute.Firewall{
+			Name:              item.Name,
+			Network:           item.Network,
+			Direction:         item.Direction,
+			Allowed:           item.Allowed,
+			Denied:            item.Denied,
+			TargetTags:        item.TargetTags,
+			SourceRanges:      item.SourceRanges,
+			SourceTags:        item.SourceTags,
+			DestinationRanges: item.DestinationRanges,
+			Description:       item.Description,
+			Priority:          item.Priority,
+		}
+		op, err := securityHandler.Client.Firewalls.Insert(projectID, fireWall).Context(securityHandler.Ctx).Do()
+		if err != nil {
+			return err
+		}
+		if err := WaitGlobalOperation(securityHandler.Client, securityHandler.Ctx, projectID, op.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 보안그룹에 속한 Firewall 조회
+func (securityHandler *GCPSecurityHandler) listSecurityFirewalls(securityID string) ([]*compute.Firewall, error) {
 	projectID := securityHandler.Credential.ProjectID
 
-	security, err := securityHandler.Client.Firewalls.Get(projectID, securityID).Do()
+	var firewalls []*compute.Firewall
+	err := securityHandler.Client.Firewalls.List(projectID).Pages(securityHandler.Ctx, func(page *compute.FirewallList) error {
+		for _, item := range page.Items {
+			if getSecurityGroupName(item) == securityID {
+				firewalls = append(firewalls, item)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	return firewalls, nil
+}
+
+// Rule 별 Firewall 생성, 실패 시 생성된 Firewall을 삭제하여 보안그룹을 이전 상태로 되돌림
+func (securityHandler *GCPSecurityHandler) insertSecurityFirewalls(securityID string, network string, startIndex int, securityRules []irs.SecurityRuleInfo) error {
+	projectID := securityHandler.Credential.ProjectID
+
+	var fireWalls []*compute.Firewall
+	for idx, item := range securityRules {
+		fireWall, err := getSecurityFirewall(securityID, network, startIndex+idx, item)
+		if err != nil {
+			return err
+		}
+		fireWalls = append(fireWalls, fireWall)
+	}
+
+	var createdNames []string
+	for _, fireWall := range fireWalls {
+		op, err := securityHandler.Client.Firewalls.Insert(projectID, fireWall).Context(securityHandler.Ctx).Do()
+		if err == nil {
+			createdNames = append(createdNames, fireWall.Name)
+			err = WaitGlobalOperation(securityHandler.Client, securityHandler.Ctx, projectID, op.Name)
+		}
+		if err != nil {
+			if rollbackErr := securityHandler.deleteFirewalls(createdNames); rollbackErr != nil {
+				return errors.New(err.Error() + ", rollback failed: " + rollbackErr.Error())
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (securityHandler *GCPSecurityHandler) deleteFirewalls(firewallNames []string) error {
+	projectID := securityHandler.Credential.ProjectID
+
+	for _, name := range firewallNames {
+		op, err := securityHandler.Client.Firewalls.Delete(projectID, name).Context(securityHandler.Ctx).Do()
+		if err != nil {
+			return err
+		}
+		if err := WaitGlobalOperation(securityHandler.Client, securityHandler.Ctx, projectID, op.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Firewall이 속한 보안그룹 이름: <보안그룹 이름>-<번호> 형식이고 TargetTags가 보안그룹 이름인 경우,
+// 그 외(Spider 외부에서 생성된 Firewall 등)는 Firewall 이름
+func getSecurityGroupName(fireWall *compute.Firewall) string {
+	if len(fireWall.TargetTags) == 1 {
+		tag := fireWall.TargetTags[0]
+		if _, err := strconv.Atoi(strings.TrimPrefix(fireWall.Name, tag+"-")); err == nil && strings.HasPrefix(fireWall.Name, tag+"-") {
+			return tag
+		}
+	}
+	return fireWall.Name
+}
+
+// INGRESS는 SourceRanges/SourceTags, EGRESS는 DestinationRanges를 사용 (CIDR이 없으면 0.0.0.0/0)
+// network: ex) global/networks/cb-vpc
+func getSecurityFirewall(securityID string, network string, index int, rule irs.SecurityRuleInfo) (*compute.Firewall, error) {
+	fireWall := &compute.Firewall{
+		Name:      securityID + "-" + strconv.Itoa(index),
+		Network:   network,
+		Direction: getFirewallDirection(rule.Direction),
+		Allowed: []*compute.FirewallAllowed{
+			{
+				IPProtocol: rule.IPProtocol,
+				Ports:      getFirewallPorts(rule),
+			},
+		},
+		TargetTags:  []string{securityID},
+		Description: rule.Description,
+		Priority:    int64(rule.Priority),
+	}
+
+	if fireWall.Direction == "EGRESS" {
+		if rule.SourceSecurityGroupId != "" {
+			return nil, errors.New("GCP egress firewall does not support the source security group")
+		}
+		fireWall.DestinationRanges = []string{getFirewallCIDR(rule)}
+		return fireWall, nil
+	}
 
+	if rule.SourceSecurityGroupId != "" {
+		fireWall.SourceTags = []string{rule.SourceSecurityGroupId}
+		if rule.CIDR != "" {
+			fireWall.SourceRanges = []string{rule.CIDR}
+		}
+	} else {
+		fireWall.SourceRanges = []string{getFirewallCIDR(rule)}
 	}
+	return fireWall, nil
+}
+
+func mappingSecurityInfo(securityID string, firewalls []*compute.Firewall) irs.SecurityInfo {
 	var securityRules []irs.SecurityRuleInfo
-	for _, item := range security.Allowed {
-		portArr := strings.Split(item.Ports[0], "-")
-		securityRules = append(securityRules, irs.SecurityRuleInfo{
-			FromPort:   portArr[0],
-			ToPort:     portArr[len(portArr)-1],
-			IPProtocol: item.IPProtocol,
-		})
-	}
-
-	securityInfo := irs.SecurityInfo{
-		Id:        strconv.FormatUint(security.Id, 10),
-		Name:      security.Name,
-		Direction: security.Direction,
+	var firewallNames []string
+	direction := ""
+	vpcID := ""
+	for idx, fireWall := range firewalls {
+		firewallNames = append(firewallNames, fireWall.Name)
+		if idx == 0 {
+			// Network URL의 마지막 항목이 VPC 이름, ex) .../global/networks/cb-vpc
+			vpcID = fireWall.Network[strings.LastIndex(fireWall.Network, "/")+1:]
+			direction = fireWall.Direction
+		} else if direction != fireWall.Direction {
+			direction = ""
+		}
+
+		for _, allowed := range fireWall.Allowed {
+			securityRules = append(securityRules, mappingSecurityRuleInfo(fireWall, allowed))
+		}
+	}
+
+	return irs.SecurityInfo{
+		Id:        securityID,
+		Name:      securityID,
+		Direction: direction, // 모든 Rule의 Direction이 같은 경우에만 설정
 		KeyValueList: []irs.KeyValue{
-			{"Priority", strconv.FormatInt(security.Priority, 10)},
-			{"SourceRanges", security.SourceRanges[0]},
+			{"NetworkTag", securityID},
+			{"Firewalls", strings.Join(firewallNames, ",")},
+			{"VpcId", vpcID},
 		},
 		SecurityRules: &securityRules,
 	}
+}
 
-	return securityInfo, nil
+func mappingSecurityRuleInfo(fireWall *compute.Firewall, allowed *compute.FirewallAllowed) irs.SecurityRuleInfo {
+	ruleInfo := irs.SecurityRuleInfo{
+		IPProtocol:  allowed.IPProtocol,
+		Direction:   fireWall.Direction,
+		Description: fireWall.Description,
+		Priority:    int(fireWall.Priority),
+	}
+	// Ports 형식: ["22"], ["1000-2000"], 없으면 전체 포트
+	if len(allowed.Ports) > 0 {
+		portArr := strings.Split(allowed.Ports[0], "-")
+		ruleInfo.FromPort = portArr[0]
+		ruleInfo.ToPort = portArr[len(portArr)-1]
+	}
+
+	if fireWall.Direction == "EGRESS" {
+		if len(fireWall.DestinationRanges) > 0 {
+			ruleInfo.CIDR = fireWall.DestinationRanges[0]
+		}
+	} else {
+		if len(fireWall.SourceRanges) > 0 {
+			ruleInfo.CIDR = fireWall.SourceRanges[0]
+		}
+		if len(fireWall.SourceTags) > 0 {
+			ruleInfo.SourceSecurityGroupId = fireWall.SourceTags[0]
+		}
+	}
+	return ruleInfo
 }
 
-func (securityHandler *GCPSecurityHandler) DeleteSecurity(securityID string) (bool, error) {
-	projectID := securityHandler.Credential.ProjectID
+func equalFirewallRule(fireWall *compute.Firewall, allowed *compute.FirewallAllowed, rule irs.SecurityRuleInfo) bool {
+	if fireWall.Direction != getFirewallDirection(rule.Direction) || !strings.EqualFold(allowed.IPProtocol, rule.IPProtocol) ||
+		strings.Join(allowed.Ports, ",") != strings.Join(getFirewallPorts(rule), ",") {
+		return false
+	}
 
-	res, err := securityHandler.Client.Firewalls.Delete(projectID, securityID).Do()
-	if err != nil {
-		log.Fatal(err)
+	ruleInfo := mappingSecurityRuleInfo(fireWall, allowed)
+	if rule.SourceSecurityGroupId != "" {
+		return ruleInfo.SourceSecurityGroupId == rule.SourceSecurityGroupId
+	}
+	return ruleInfo.SourceSecurityGroupId == "" && ruleInfo.CIDR == getFirewallCIDR(rule)
+}
+
+// inbound/ingress -> INGRESS, outbound/egress -> EGRESS
+func getFirewallDirection(direction string) string {
+	switch strings.ToLower(direction) {
+	case "outbound", "egress":
+		return "EGRESS"
+	default:
+		return "INGRESS"
+	}
+}
+
+func getFirewallCIDR(rule irs.SecurityRuleInfo) string {
+	if rule.CIDR == "" {
+		return "0.0.0.0/0"
+	}
+	return rule.CIDR
+}
+
+// Ports 형식: "22", "1000-2000", 포트가 없으면 전체 포트
+func getFirewallPorts(rule irs.SecurityRuleInfo) []string {
+	fromPort, toPort := rule.FromPort, rule.ToPort
+	if fromPort == "" {
+		fromPort = toPort
+	}
+	if fromPort == "" {
+		return nil
+	}
+	if toPort == "" || toPort == fromPort {
+		return []string{fromPort}
 	}
-	fmt.Println(res)
-	return true, err
+	return []string{fromPort + "-" + toPort}
 }
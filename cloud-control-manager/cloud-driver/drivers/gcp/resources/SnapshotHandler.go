@@ -0,0 +1,188 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+// GCP Label의 Key는 소문자만 가능
+const sourceVMLabelKey = "source-vm-id"
+
+type GCPSnapshotHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// 스냅샷은 Global 리소스이며 Name을 ID로 사용한다.
+// VM 스냅샷은 VM의 부트 디스크 스냅샷으로 생성하고, 원본 VM 이름은 Label로 보관한다.
+func (snapshotHandler *GCPSnapshotHandler) CreateSnapshot(snapshotReqInfo irs.SnapshotReqInfo) (irs.SnapshotInfo, error) {
+	projectID := snapshotHandler.Credential.ProjectID
+	zone := snapshotHandler.Region.Zone
+
+	diskName, err := snapshotHandler.getSourceDiskName(snapshotReqInfo)
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+
+	snapshot := &compute.Snapshot{
+		Name: snapshotReqInfo.Name,
+	}
+	if snapshotReqInfo.SourceType == irs.SnapshotSourceVM {
+		snapshot.Labels = map[string]string{sourceVMLabelKey: snapshotReqInfo.SourceId}
+	}
+
+	op, err := snapshotHandler.Client.Disks.CreateSnapshot(projectID, zone, diskName, snapshot).Context(snapshotHandler.Ctx).Do()
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+	if err := WaitZoneOperation(snapshotHandler.Client, snapshotHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+
+	return snapshotHandler.GetSnapshot(snapshotReqInfo.Name)
+}
+
+func (snapshotHandler *GCPSnapshotHandler) ListSnapshot() ([]*irs.SnapshotInfo, error) {
+	projectID := snapshotHandler.Credential.ProjectID
+
+	var snapshotInfoList []*irs.SnapshotInfo
+	err := snapshotHandler.Client.Snapshots.List(projectID).Pages(snapshotHandler.Ctx, func(page *compute.SnapshotList) error {
+		for _, snapshot := range page.Items {
+			snapshotInfo := mappingSnapshotInfo(snapshot)
+			snapshotInfoList = append(snapshotInfoList, &snapshotInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshotInfoList, nil
+}
+
+func (snapshotHandler *GCPSnapshotHandler) GetSnapshot(snapshotID string) (irs.SnapshotInfo, error) {
+	projectID := snapshotHandler.Credential.ProjectID
+
+	snapshot, err := snapshotHandler.Client.Snapshots.Get(projectID, snapshotID).Context(snapshotHandler.Ctx).Do()
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+	return mappingSnapshotInfo(snapshot), nil
+}
+
+func (snapshotHandler *GCPSnapshotHandler) DeleteSnapshot(snapshotID string) (bool, error) {
+	projectID := snapshotHandler.Credential.ProjectID
+
+	op, err := snapshotHandler.Client.Snapshots.Delete(projectID, snapshotID).Context(snapshotHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitGlobalOperation(snapshotHandler.Client, snapshotHandler.Ctx, projectID, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (snapshotHandler *GCPSnapshotHandler) CreateDiskFromSnapshot(snapshotID string, diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	projectID := snapshotHandler.Credential.ProjectID
+	diskHandler := GCPDiskHandler{snapshotHandler.Region, snapshotHandler.Ctx, snapshotHandler.Client, snapshotHandler.Credential}
+	zone := diskHandler.getZone(diskReqInfo.Zone)
+
+	labels, err := mappingLabels(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	disk := &compute.Disk{
+		Name:           diskReqInfo.Name,
+		SourceSnapshot: "global/snapshots/" + snapshotID,
+		Labels:         labels,
+	}
+	if diskReqInfo.DiskSize != "" {
+		size, err := strconv.ParseInt(diskReqInfo.DiskSize, 10, 64)
+		if err != nil {
+			return irs.DiskInfo{}, errors.New("DiskSize " + diskReqInfo.DiskSize + " is not a valid number")
+		}
+		disk.SizeGb = size
+	}
+	if diskReqInfo.DiskType != "" {
+		disk.Type = "zones/" + zone + "/diskTypes/" + diskReqInfo.DiskType
+	}
+
+	op, err := snapshotHandler.Client.Disks.Insert(projectID, zone, disk).Context(snapshotHandler.Ctx).Do()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	if err := WaitZoneOperation(snapshotHandler.Client, snapshotHandler.Ctx, projectID, zone, op.Name); err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	return diskHandler.getDisk(zone, diskReqInfo.Name)
+}
+
+// 스냅샷 대상 디스크 이름 (VM인 경우 부트 디스크)
+func (snapshotHandler *GCPSnapshotHandler) getSourceDiskName(snapshotReqInfo irs.SnapshotReqInfo) (string, error) {
+	switch snapshotReqInfo.SourceType {
+	case irs.SnapshotSourceDisk, "":
+		return snapshotReqInfo.SourceId, nil
+	case irs.SnapshotSourceVM:
+	default:
+		return "", errors.New("SourceType " + string(snapshotReqInfo.SourceType) + " is not valid")
+	}
+
+	projectID := snapshotHandler.Credential.ProjectID
+	zone := snapshotHandler.Region.Zone
+	instance, err := snapshotHandler.Client.Instances.Get(projectID, zone, snapshotReqInfo.SourceId).Context(snapshotHandler.Ctx).Do()
+	if err != nil {
+		return "", err
+	}
+	for _, attachedDisk := range instance.Disks {
+		if attachedDisk.Boot {
+			return getResourceName(attachedDisk.Source), nil
+		}
+	}
+	return "", errors.New("VM " + snapshotReqInfo.SourceId + " has no boot disk")
+}
+
+func mappingSnapshotInfo(snapshot *compute.Snapshot) irs.SnapshotInfo {
+	snapshotInfo := irs.SnapshotInfo{
+		Id:       snapshot.Name,
+		Name:     snapshot.Name,
+		DiskSize: strconv.FormatInt(snapshot.DiskSizeGb, 10),
+	}
+
+	// Status : CREATING | UPLOADING | READY | DELETING | FAILED
+	switch snapshot.Status {
+	case "CREATING", "UPLOADING":
+		snapshotInfo.Status = irs.SnapshotCreating
+	case "READY":
+		snapshotInfo.Status = irs.SnapshotAvailable
+	case "DELETING":
+		snapshotInfo.Status = irs.SnapshotDeleting
+	default:
+		snapshotInfo.Status = irs.SnapshotError
+	}
+
+	keyValueList := []irs.KeyValue{
+		{Key: irs.SnapshotSourceDiskKey, Value: getResourceName(snapshot.SourceDisk)},
+	}
+	if vmID, ok := snapshot.Labels[sourceVMLabelKey]; ok {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotSourceVMKey, Value: vmID})
+	}
+	if createdTime, err := time.Parse(time.RFC3339, snapshot.CreationTimestamp); err == nil {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: irs.SnapshotCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
+	}
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "Id", Value: strconv.FormatUint(snapshot.Id, 10)})
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "Status", Value: snapshot.Status})
+	keyValueList = append(keyValueList, irs.KeyValue{Key: "StorageBytes", Value: strconv.FormatInt(snapshot.StorageBytes, 10)})
+	snapshotInfo.KeyValueList = keyValueList
+
+	return snapshotInfo
+}
@@ -0,0 +1,237 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+// GCP는 Label을 Tag로 사용하며, 리소스의 Name을 ID로 사용한다.
+// Label의 Key와 Value는 소문자, 숫자, '-', '_'만 사용할 수 있다.
+// Label을 지원하는 VM(Instance), Disk, PublicIP(Address)만 지원한다.
+// (VPC, VNetwork, SecurityGroup(Firewall)은 Label을 지원하지 않음, Network Tag는 SecurityGroup에서 사용 중)
+type GCPTagHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// keyword가 Tag의 Key 또는 Value에 포함되면 true (""과 "*"은 모든 Tag)
+func matchTagKeyword(tag irs.KeyValue, keyword string) bool {
+	if keyword == "" || keyword == "*" {
+		return true
+	}
+	return strings.Contains(tag.Key, keyword) || strings.Contains(tag.Value, keyword)
+}
+
+func mappingTagList(labels map[string]string) []irs.KeyValue {
+	tagList := []irs.KeyValue{}
+	for key, value := range labels {
+		tagList = append(tagList, irs.KeyValue{Key: key, Value: value})
+	}
+	return tagList
+}
+
+func getUnsupportedTagResourceError(resType irs.RSType) error {
+	return errors.New("resource type " + string(resType) + " does not support labels on GCP")
+}
+
+// 리소스 생성 요청의 사용자 Tag(TagList)를 Label로 변환 (사용자 Tag가 없으면 nil)
+func mappingLabels(tagList []irs.KeyValue) (map[string]string, error) {
+	if len(tagList) == 0 {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	for _, tag := range tagList {
+		if tag.Key == "" {
+			return nil, errors.New("tag key is required")
+		}
+		labels[tag.Key] = tag.Value
+	}
+	return labels, nil
+}
+
+// 리소스의 Label과 Label 변경 시 필요한 Fingerprint를 조회
+func (tagHandler *GCPTagHandler) getLabels(resType irs.RSType, resId string) (map[string]string, string, error) {
+	projectID := tagHandler.Credential.ProjectID
+
+	switch resType {
+	case irs.RSTypeVM:
+		vm, err := tagHandler.Client.Instances.Get(projectID, tagHandler.Region.Zone, resId).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return vm.Labels, vm.LabelFingerprint, nil
+	case irs.RSTypeDisk:
+		disk, err := tagHandler.Client.Disks.Get(projectID, tagHandler.Region.Zone, resId).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return disk.Labels, disk.LabelFingerprint, nil
+	case irs.RSTypePublicIP:
+		address, err := tagHandler.Client.Addresses.Get(projectID, tagHandler.Region.Region, resId).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return address.Labels, address.LabelFingerprint, nil
+	default:
+		return nil, "", getUnsupportedTagResourceError(resType)
+	}
+}
+
+// Label은 전체를 교체하므로, 조회 시점의 Fingerprint로 동시 변경을 방지
+func (tagHandler *GCPTagHandler) setLabels(resType irs.RSType, resId string, labels map[string]string, fingerprint string) error {
+	projectID := tagHandler.Credential.ProjectID
+	zone := tagHandler.Region.Zone
+	region := tagHandler.Region.Region
+
+	switch resType {
+	case irs.RSTypeVM:
+		req := &compute.InstancesSetLabelsRequest{Labels: labels, LabelFingerprint: fingerprint}
+		op, err := tagHandler.Client.Instances.SetLabels(projectID, zone, resId, req).Do()
+		if err != nil {
+			return err
+		}
+		return WaitZoneOperation(tagHandler.Client, tagHandler.Ctx, projectID, zone, op.Name)
+	case irs.RSTypeDisk:
+		req := &compute.ZoneSetLabelsRequest{Labels: labels, LabelFingerprint: fingerprint}
+		op, err := tagHandler.Client.Disks.SetLabels(projectID, zone, resId, req).Do()
+		if err != nil {
+			return err
+		}
+		return WaitZoneOperation(tagHandler.Client, tagHandler.Ctx, projectID, zone, op.Name)
+	case irs.RSTypePublicIP:
+		req := &compute.RegionSetLabelsRequest{Labels: labels, LabelFingerprint: fingerprint}
+		op, err := tagHandler.Client.Addresses.SetLabels(projectID, region, resId, req).Do()
+		if err != nil {
+			return err
+		}
+		return WaitRegionOperation(tagHandler.Client, tagHandler.Ctx, projectID, region, op.Name)
+	default:
+		return getUnsupportedTagResourceError(resType)
+	}
+}
+
+// 같은 Key의 Label이 있으면 Value를 덮어 쓴다.
+func (tagHandler *GCPTagHandler) AddTag(resType irs.RSType, resId string, tag irs.KeyValue) (irs.KeyValue, error) {
+	if tag.Key == "" {
+		return irs.KeyValue{}, errors.New("tag key is required")
+	}
+
+	labels, fingerprint, err := tagHandler.getLabels(resType, resId)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[tag.Key] = tag.Value
+
+	err = tagHandler.setLabels(resType, resId, labels, fingerprint)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+	return tag, nil
+}
+
+func (tagHandler *GCPTagHandler) ListTag(resType irs.RSType, resId string) ([]irs.KeyValue, error) {
+	labels, _, err := tagHandler.getLabels(resType, resId)
+	if err != nil {
+		return nil, err
+	}
+	return mappingTagList(labels), nil
+}
+
+func (tagHandler *GCPTagHandler) GetTag(resType irs.RSType, resId string, key string) (irs.KeyValue, error) {
+	labels, _, err := tagHandler.getLabels(resType, resId)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+
+	value, ok := labels[key]
+	if !ok {
+		return irs.KeyValue{}, errors.New("label " + key + " of " + resId + " is not exist")
+	}
+	return irs.KeyValue{Key: key, Value: value}, nil
+}
+
+func (tagHandler *GCPTagHandler) RemoveTag(resType irs.RSType, resId string, key string) (bool, error) {
+	labels, fingerprint, err := tagHandler.getLabels(resType, resId)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := labels[key]; !ok {
+		return false, errors.New("label " + key + " of " + resId + " is not exist")
+	}
+	delete(labels, key)
+
+	err = tagHandler.setLabels(resType, resId, labels, fingerprint)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// keyword가 Key 또는 Value에 포함된 Label을 가진 리소스 목록 (리소스의 모든 Label을 함께 리턴)
+func (tagHandler *GCPTagHandler) FindTaggedResources(resType irs.RSType, keyword string) ([]*irs.TaggedResourceInfo, error) {
+	projectID := tagHandler.Credential.ProjectID
+
+	// 리소스 Name별 Label
+	var names []string
+	labelsMap := map[string]map[string]string{}
+
+	switch resType {
+	case irs.RSTypeVM:
+		err := tagHandler.Client.Instances.List(projectID, tagHandler.Region.Zone).Pages(tagHandler.Ctx, func(list *compute.InstanceList) error {
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+				labelsMap[item.Name] = item.Labels
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case irs.RSTypeDisk:
+		err := tagHandler.Client.Disks.List(projectID, tagHandler.Region.Zone).Pages(tagHandler.Ctx, func(list *compute.DiskList) error {
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+				labelsMap[item.Name] = item.Labels
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case irs.RSTypePublicIP:
+		err := tagHandler.Client.Addresses.List(projectID, tagHandler.Region.Region).Pages(tagHandler.Ctx, func(list *compute.AddressList) error {
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+				labelsMap[item.Name] = item.Labels
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, getUnsupportedTagResourceError(resType)
+	}
+
+	taggedResList := []*irs.TaggedResourceInfo{}
+	for _, name := range names {
+		tagList := mappingTagList(labelsMap[name])
+		for _, tag := range tagList {
+			if matchTagKeyword(tag, keyword) {
+				taggedResList = append(taggedResList, &irs.TaggedResourceInfo{ResType: resType, ResId: name, TagList: tagList})
+				break
+			}
+		}
+	}
+	return taggedResList, nil
+}
@@ -45,6 +45,12 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	// email을 어디다가 넣지? 이것또한 문제넹
 	clientEmail := vmHandler.Credential.ClientEmail
 
+	// 사용자 Tag는 Label로 VM 생성 시 함께 설정
+	labels, err := mappingLabels(vmReqInfo.TagList)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
 	// PublicIPHandler  불러서 처리 해야 함.
 	publicIpHandler := GCPPublicIPHandler{
 		vmHandler.Region, vmHandler.Ctx, vmHandler.Client, vmHandler.Credential}
@@ -83,6 +89,11 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 				Network: prefix + "/global/networks/" + vmReqInfo.VirtualNetworkId,
 			},
 		},
+		// 보안그룹 이름을 Network Tag로 지정하여 보안그룹의 Firewall 적용
+		Tags: &compute.Tags{
+			Items: vmReqInfo.SecurityGroupIds,
+		},
+		Labels: labels,
 		ServiceAccounts: []*compute.ServiceAccount{
 			{
 				Email: clientEmail,
@@ -283,6 +294,9 @@ func mappingServerInfo(server *compute.Instance) irs.VMInfo {
 		VirtualNetworkId:   server.NetworkInterfaces[0].Network,
 		// SubNetworkID:       server.NetworkInterfaces[0].Subnetwork,
 	}
+	if server.Tags != nil {
+		vmInfo.SecurityGroupIds = server.Tags.Items
+	}
 
 	return vmInfo
 }
@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+type GCPVMSpecHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GCP의 MachineType은 Zone 단위로 제공되므로 Region.Zone 기준으로 조회 함.
+func (vmSpecHandler *GCPVMSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	machineTypeList, err := vmSpecHandler.listMachineType()
+	if err != nil {
+		return nil, err
+	}
+
+	var vmSpecInfoList []*irs.VMSpecInfo
+	for _, machineType := range machineTypeList {
+		info := vmSpecHandler.mappingVMSpecInfo(machineType)
+		vmSpecInfoList = append(vmSpecInfoList, &info)
+	}
+	return vmSpecInfoList, nil
+}
+
+func (vmSpecHandler *GCPVMSpecHandler) GetVMSpec(name string) (irs.VMSpecInfo, error) {
+	projectID := vmSpecHandler.Credential.ProjectID
+	zone := vmSpecHandler.Region.Zone
+
+	machineType, err := vmSpecHandler.Client.MachineTypes.Get(projectID, zone, name).Context(vmSpecHandler.Ctx).Do()
+	if err != nil {
+		return irs.VMSpecInfo{}, err
+	}
+	return vmSpecHandler.mappingVMSpecInfo(machineType), nil
+}
+
+func (vmSpecHandler *GCPVMSpecHandler) ListOrgVMSpec() (string, error) {
+	machineTypeList, err := vmSpecHandler.listMachineType()
+	if err != nil {
+		return "", err
+	}
+
+	jsonBytes, err := json.Marshal(machineTypeList)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (vmSpecHandler *GCPVMSpecHandler) listMachineType() ([]*compute.MachineType, error) {
+	projectID := vmSpecHandler.Credential.ProjectID
+	zone := vmSpecHandler.Region.Zone
+
+	var machineTypeList []*compute.MachineType
+	err := vmSpecHandler.Client.MachineTypes.List(projectID, zone).Pages(vmSpecHandler.Ctx, func(page *compute.MachineTypeList) error {
+		machineTypeList = append(machineTypeList, page.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return machineTypeList, nil
+}
+
+func (vmSpecHandler *GCPVMSpecHandler) mappingVMSpecInfo(machineType *compute.MachineType) irs.VMSpecInfo {
+	vmSpecInfo := irs.VMSpecInfo{
+		Region: vmSpecHandler.Region.Region,
+		Name:   machineType.Name,
+		VCpu:   irs.VCpuInfo{Count: strconv.FormatInt(machineType.GuestCpus, 10)},
+		Mem:    strconv.FormatInt(machineType.MemoryMb, 10),
+		Disk:   "0", // GCP는 Persistent Disk만 사용
+	}
+
+	// ex) nvidia-tesla-t4 => {NVIDIA, nvidia-tesla-t4}
+	for _, accelerator := range machineType.Accelerators {
+		vmSpecInfo.Gpu = append(vmSpecInfo.Gpu, irs.GpuInfo{
+			Count: strconv.FormatInt(accelerator.GuestAcceleratorCount, 10),
+			Mfr:   strings.ToUpper(strings.Split(accelerator.GuestAcceleratorType, "-")[0]),
+			Model: accelerator.GuestAcceleratorType,
+		})
+	}
+
+	vmSpecInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Zone", Value: machineType.Zone},
+		{Key: "Description", Value: machineType.Description},
+		{Key: "IsSharedCpu", Value: strconv.FormatBool(machineType.IsSharedCpu)},
+		{Key: "MaximumPersistentDisks", Value: strconv.FormatInt(machineType.MaximumPersistentDisks, 10)},
+		{Key: "MaximumPersistentDisksSizeGb", Value: strconv.FormatInt(machineType.MaximumPersistentDisksSizeGb, 10)},
+	}
+	// ex) X86_64, ARM64 (ARCHITECTURE_UNSPECIFIED는 제외)
+	if machineType.Architecture != "" && machineType.Architecture != "ARCHITECTURE_UNSPECIFIED" {
+		vmSpecInfo.KeyValueList = append(vmSpecInfo.KeyValueList, irs.KeyValue{Key: "Architecture", Value: machineType.Architecture})
+	}
+	return vmSpecInfo
+}
@@ -29,6 +29,11 @@ type GCPVNetworkHandler struct {
 }
 
 func (vNetworkHandler *GCPVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VNetworkReqInfo) (irs.VNetworkInfo, error) {
+	// VNetwork(Network)는 Label을 지원하지 않음
+	if len(vNetworkReqInfo.TagList) > 0 {
+		return irs.VNetworkInfo{}, getUnsupportedTagResourceError(irs.RSTypeVNetwork)
+	}
+
 	// priject id
 	projectID := vNetworkHandler.Credential.ProjectID
 	name := vNetworkReqInfo.Name
@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	compute "google.golang.org/api/compute/v1"
+)
+
+type GCPVPCHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+// GCP의 VPC Network는 Global 자원으로 CIDR이 없으며, Name을 ID로 사용한다.
+// 서브넷은 Region 단위로 생성되며 연결 정보의 Region에 생성한다.
+func (vpcHandler *GCPVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCInfo, error) {
+	// VPC(Network)는 Label을 지원하지 않음
+	if len(vpcReqInfo.TagList) > 0 {
+		return irs.VPCInfo{}, getUnsupportedTagResourceError(irs.RSTypeVPC)
+	}
+
+	projectID := vpcHandler.Credential.ProjectID
+
+	// 서브넷을 직접 지정하기 위해 Custom mode로 생성
+	network := &compute.Network{
+		Name:                  vpcReqInfo.Name,
+		AutoCreateSubnetworks: false,
+		ForceSendFields:       []string{"AutoCreateSubnetworks"},
+	}
+	op, err := vpcHandler.Client.Networks.Insert(projectID, network).Context(vpcHandler.Ctx).Do()
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	if err := WaitGlobalOperation(vpcHandler.Client, vpcHandler.Ctx, projectID, op.Name); err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		if err := vpcHandler.createSubnet(vpcReqInfo.Name, subnetInfo); err != nil {
+			// 서브넷 생성 실패 시 생성한 VPC(Network) 삭제
+			if _, errDel := vpcHandler.DeleteVPC(vpcReqInfo.Name); errDel != nil {
+				log.Println(errDel)
+			}
+			return irs.VPCInfo{}, err
+		}
+	}
+
+	return vpcHandler.GetVPC(vpcReqInfo.Name)
+}
+
+func (vpcHandler *GCPVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
+	projectID := vpcHandler.Credential.ProjectID
+
+	var vpcInfoList []*irs.VPCInfo
+	err := vpcHandler.Client.Networks.List(projectID).Pages(vpcHandler.Ctx, func(page *compute.NetworkList) error {
+		for _, network := range page.Items {
+			vpcInfo, err := vpcHandler.mappingVPCInfo(network)
+			if err != nil {
+				return err
+			}
+			vpcInfoList = append(vpcInfoList, &vpcInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vpcInfoList, nil
+}
+
+func (vpcHandler *GCPVPCHandler) GetVPC(vpcID string) (irs.VPCInfo, error) {
+	projectID := vpcHandler.Credential.ProjectID
+
+	network, err := vpcHandler.Client.Networks.Get(projectID, vpcID).Context(vpcHandler.Ctx).Do()
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.mappingVPCInfo(network)
+}
+
+// 서브넷이 남아 있으면 Network를 삭제할 수 없으므로 연결 Region의 서브넷을 먼저 삭제한다.
+func (vpcHandler *GCPVPCHandler) DeleteVPC(vpcID string) (bool, error) {
+	projectID := vpcHandler.Credential.ProjectID
+
+	network, err := vpcHandler.Client.Networks.Get(projectID, vpcID).Context(vpcHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	subnetList, err := vpcHandler.listSubnet(network)
+	if err != nil {
+		return false, err
+	}
+	for _, subnet := range subnetList {
+		if _, err := vpcHandler.RemoveSubnet(vpcID, subnet.Name); err != nil {
+			return false, err
+		}
+	}
+
+	op, err := vpcHandler.Client.Networks.Delete(projectID, vpcID).Context(vpcHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitGlobalOperation(vpcHandler.Client, vpcHandler.Ctx, projectID, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *GCPVPCHandler) AddSubnet(vpcID string, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
+	if err := vpcHandler.createSubnet(vpcID, subnetInfo); err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.GetVPC(vpcID)
+}
+
+func (vpcHandler *GCPVPCHandler) RemoveSubnet(vpcID string, subnetID string) (bool, error) {
+	projectID := vpcHandler.Credential.ProjectID
+	region := vpcHandler.Region.Region
+
+	op, err := vpcHandler.Client.Subnetworks.Delete(projectID, region, subnetID).Context(vpcHandler.Ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if err := WaitRegionOperation(vpcHandler.Client, vpcHandler.Ctx, projectID, region, op.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *GCPVPCHandler) createSubnet(vpcID string, subnetInfo irs.SubnetInfo) error {
+	projectID := vpcHandler.Credential.ProjectID
+	region := vpcHandler.Region.Region
+
+	if subnetInfo.CIDR == "" {
+		return errors.New("CIDR is required to create the subnet " + subnetInfo.Name)
+	}
+
+	subnetwork := &compute.Subnetwork{
+		Name:        subnetInfo.Name,
+		IpCidrRange: subnetInfo.CIDR,
+		Network:     "global/networks/" + vpcID,
+	}
+	op, err := vpcHandler.Client.Subnetworks.Insert(projectID, region, subnetwork).Context(vpcHandler.Ctx).Do()
+	if err != nil {
+		return err
+	}
+	return WaitRegionOperation(vpcHandler.Client, vpcHandler.Ctx, projectID, region, op.Name)
+}
+
+// 연결 Region에 있는 Network의 서브넷 목록
+func (vpcHandler *GCPVPCHandler) listSubnet(network *compute.Network) ([]*compute.Subnetwork, error) {
+	projectID := vpcHandler.Credential.ProjectID
+	region := vpcHandler.Region.Region
+
+	var subnetList []*compute.Subnetwork
+	err := vpcHandler.Client.Subnetworks.List(projectID, region).Filter("network eq "+network.SelfLink).Pages(vpcHandler.Ctx, func(page *compute.SubnetworkList) error {
+		subnetList = append(subnetList, page.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subnetList, nil
+}
+
+func (vpcHandler *GCPVPCHandler) mappingVPCInfo(network *compute.Network) (irs.VPCInfo, error) {
+	vpcInfo := irs.VPCInfo{
+		Id:   network.Name,
+		Name: network.Name,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Id", Value: strconv.FormatUint(network.Id, 10)},
+			{Key: "AutoCreateSubnetworks", Value: strconv.FormatBool(network.AutoCreateSubnetworks)},
+			{Key: "SelfLink", Value: network.SelfLink},
+		},
+	}
+
+	subnetList, err := vpcHandler.listSubnet(network)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	for _, subnet := range subnetList {
+		vpcInfo.SubnetInfoList = append(vpcInfo.SubnetInfoList, irs.SubnetInfo{
+			Id:   subnet.Name,
+			Name: subnet.Name,
+			CIDR: subnet.IpCidrRange,
+			KeyValueList: []irs.KeyValue{
+				{Key: "Region", Value: getResourceName(subnet.Region)},
+				{Key: "GatewayAddress", Value: subnet.GatewayAddress},
+			},
+		})
+	}
+	return vpcInfo, nil
+}
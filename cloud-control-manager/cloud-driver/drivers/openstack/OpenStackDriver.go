@@ -11,6 +11,8 @@
 package openstack
 
 import (
+	"strings"
+
 	cblog "github.com/cloud-barista/cb-log"
 	oscon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -43,6 +45,17 @@ func (OpenStackDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.VNicHandler = false
 	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.DiskHandler = true
+	drvCapabilityInfo.SnapshotHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.RouterHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+	drvCapabilityInfo.NLBHandler = true
+	drvCapabilityInfo.ObjectStorageHandler = true
+	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.TagHandler = true
 
 	return drvCapabilityInfo
 }
@@ -83,8 +96,24 @@ func (driver *OpenStackDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo)
 	if err != nil {
 		cblogger.Error(err)
 	}
+	VolumeClient, err := getVolumeClient(connectionInfo)
+	if err != nil {
+		cblogger.Error(err)
+	}
+	ObjectStorageClient, err := getObjectStorageClient(connectionInfo)
+	if err != nil {
+		cblogger.Error(err)
+	}
+	ContainerInfraClient, err := getContainerInfraClient(connectionInfo)
+	if err != nil {
+		cblogger.Error(err)
+	}
+	DNSClient, err := getDNSClient(connectionInfo)
+	if err != nil {
+		cblogger.Error(err)
+	}
 
-	iConn := oscon.OpenStackCloudConnection{Client, ImageClient, NetworkClient}
+	iConn := oscon.OpenStackCloudConnection{connectionInfo.RegionInfo, Client, ImageClient, NetworkClient, VolumeClient, ObjectStorageClient, ContainerInfraClient, DNSClient}
 
 	return &iConn, nil // return type: (icon.CloudConnection, error)
 }
@@ -197,4 +226,134 @@ func getNetworkClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient,
 	return client, err
 }
 
+func getVolumeClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+
+	authOpts := gophercloud.AuthOptions{
+		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
+		Username:         connInfo.CredentialInfo.Username,
+		Password:         connInfo.CredentialInfo.Password,
+		DomainName:       connInfo.CredentialInfo.DomainName,
+		TenantID:         connInfo.CredentialInfo.ProjectID,
+	}
+
+	provider, err := openstack.AuthenticatedClient(authOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewBlockStorageV2(provider, gophercloud.EndpointOpts{
+		Region: connInfo.RegionInfo.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client, err
+}
+
+func getObjectStorageClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+
+	authOpts := gophercloud.AuthOptions{
+		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
+		Username:         connInfo.CredentialInfo.Username,
+		Password:         connInfo.CredentialInfo.Password,
+		DomainName:       connInfo.CredentialInfo.DomainName,
+		TenantID:         connInfo.CredentialInfo.ProjectID,
+	}
+
+	provider, err := openstack.AuthenticatedClient(authOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{
+		Region: connInfo.RegionInfo.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client, err
+}
+
+// gophercloud에 Magnum(container-infra) 클라이언트가 없어서 Service Catalog의 Endpoint로 직접 생성
+func getContainerInfraClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+
+	authOpts := gophercloud.AuthOptions{
+		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
+		Username:         connInfo.CredentialInfo.Username,
+		Password:         connInfo.CredentialInfo.Password,
+		DomainName:       connInfo.CredentialInfo.DomainName,
+		TenantID:         connInfo.CredentialInfo.ProjectID,
+	}
+
+	provider, err := openstack.AuthenticatedClient(authOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	eo := gophercloud.EndpointOpts{
+		Region: connInfo.RegionInfo.Region,
+	}
+	eo.ApplyDefaults("container-infra")
+	url, err := provider.EndpointLocator(eo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoint에 API 버전(/v1)이 없는 경우 추가
+	resourceBase := strings.TrimSuffix(url, "/") + "/"
+	if !strings.HasSuffix(resourceBase, "/v1/") {
+		resourceBase = resourceBase + "v1/"
+	}
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       url,
+		ResourceBase:   resourceBase,
+	}
+
+	return client, err
+}
+
+// gophercloud에 Designate(DNS) 클라이언트가 없어서 Service Catalog의 Endpoint로 직접 생성
+func getDNSClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+
+	authOpts := gophercloud.AuthOptions{
+		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
+		Username:         connInfo.CredentialInfo.Username,
+		Password:         connInfo.CredentialInfo.Password,
+		DomainName:       connInfo.CredentialInfo.DomainName,
+		TenantID:         connInfo.CredentialInfo.ProjectID,
+	}
+
+	provider, err := openstack.AuthenticatedClient(authOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	eo := gophercloud.EndpointOpts{
+		Region: connInfo.RegionInfo.Region,
+	}
+	eo.ApplyDefaults("dns")
+	url, err := provider.EndpointLocator(eo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoint에 API 버전(/v2)이 없는 경우 추가
+	resourceBase := strings.TrimSuffix(url, "/") + "/"
+	if !strings.HasSuffix(resourceBase, "/v2/") {
+		resourceBase = resourceBase + "v2/"
+	}
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       url,
+		ResourceBase:   resourceBase,
+	}
+
+	return client, err
+}
+
 var TestDriver OpenStackDriver
@@ -11,8 +11,11 @@
 package connect
 
 import (
+	"errors"
+
 	cblog "github.com/cloud-barista/cb-log"
 	osrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/rackspace/gophercloud"
 	"github.com/sirupsen/logrus"
@@ -27,9 +30,14 @@ func init() {
 
 // modified by powerkim, 2019.07.29
 type OpenStackCloudConnection struct {
-	Client        *gophercloud.ServiceClient
-	ImageClient   *gophercloud.ServiceClient
-	NetworkClient *gophercloud.ServiceClient
+	Region               idrv.RegionInfo
+	Client               *gophercloud.ServiceClient
+	ImageClient          *gophercloud.ServiceClient
+	NetworkClient        *gophercloud.ServiceClient
+	VolumeClient         *gophercloud.ServiceClient
+	ObjectStorageClient  *gophercloud.ServiceClient
+	ContainerInfraClient *gophercloud.ServiceClient
+	DNSClient            *gophercloud.ServiceClient
 }
 
 func (cloudConn *OpenStackCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
@@ -46,7 +54,7 @@ func (cloudConn *OpenStackCloudConnection) CreateImageHandler() (irs.ImageHandle
 
 func (cloudConn OpenStackCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
 	cblogger.Info("OpenStack Cloud Driver: called CreateSecurityHandler()!")
-	securityHandler := osrs.OpenStackSecurityHandler{cloudConn.Client}
+	securityHandler := osrs.OpenStackSecurityHandler{cloudConn.Client, cloudConn.NetworkClient}
 	return &securityHandler, nil
 }
 func (cloudConn *OpenStackCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
@@ -61,7 +69,7 @@ func (cloudConn *OpenStackCloudConnection) CreateVNicHandler() (irs.VNicHandler,
 }
 func (cloudConn OpenStackCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("OpenStack Cloud Driver: called CreatePublicIPHandler()!")
-	publicIPHandler := osrs.OpenStackPublicIPHandler{cloudConn.Client}
+	publicIPHandler := osrs.OpenStackPublicIPHandler{cloudConn.Client, cloudConn.NetworkClient}
 	return &publicIPHandler, nil
 }
 
@@ -83,6 +91,77 @@ func (cloudConn *OpenStackCloudConnection) CreateVMHandler() (irs.VMHandler, err
 	return &vmHandler, nil
 }
 
+func (cloudConn *OpenStackCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateVMSpecHandler()!")
+	vmSpecHandler := osrs.OpenStackVMSpecHandler{cloudConn.Region, cloudConn.Client}
+	return &vmSpecHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateDiskHandler()!")
+	diskHandler := osrs.OpenStackDiskHandler{cloudConn.Client, cloudConn.VolumeClient}
+	return &diskHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateSnapshotHandler() (irs.SnapshotHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateSnapshotHandler()!")
+	snapshotHandler := osrs.OpenStackSnapshotHandler{cloudConn.Client, cloudConn.VolumeClient}
+	return &snapshotHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateVPCHandler()!")
+	vpcHandler := osrs.OpenStackVPCHandler{cloudConn.NetworkClient}
+	return &vpcHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateRouterHandler() (irs.RouterHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateRouterHandler()!")
+	routerHandler := osrs.OpenStackRouterHandler{cloudConn.NetworkClient}
+	return &routerHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateNATGatewayHandler()!")
+	natGatewayHandler := osrs.OpenStackNATGatewayHandler{cloudConn.NetworkClient}
+	return &natGatewayHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateNLBHandler()!")
+	nlbHandler := osrs.OpenStackNLBHandler{cloudConn.NetworkClient}
+	return &nlbHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateObjectStorageHandler() (irs.ObjectStorageHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateObjectStorageHandler()!")
+	storageHandler := osrs.OpenStackObjectStorageHandler{cloudConn.Region, cloudConn.ObjectStorageClient}
+	return &storageHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateClusterHandler()!")
+	clusterHandler := osrs.OpenStackClusterHandler{cloudConn.Client, cloudConn.ContainerInfraClient}
+	return &clusterHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateDNSHandler()!")
+	dnsHandler := osrs.OpenStackDNSHandler{cloudConn.DNSClient}
+	return &dnsHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateAutoScalingHandler() (irs.AutoScalingHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateAutoScalingHandler()!")
+	return nil, errors.New("OpenStack Driver: not implemented")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateTagHandler()!")
+	tagHandler := osrs.OpenStackTagHandler{cloudConn.Client, cloudConn.VolumeClient, cloudConn.NetworkClient}
+	return &tagHandler, nil
+}
+
 func (OpenStackCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
@@ -3,7 +3,6 @@ package main
 import (
 	cblog "github.com/cloud-barista/cb-log"
 	osdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack"
-	osconn "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/connect"
 	osrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/resources"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -55,9 +54,7 @@ func testCreateVM() {
 	vmHandler, _ := cloudConnection.CreateVMHandler()
 	publicIPHandler, _ := cloudConnection.CreatePublicIPHandler()
 
-	// TODO: RouterHandler 인터페이스 추가
-	osConnection := cloudConnection.(*osconn.OpenStackCloudConnection)
-	routerHandler := osrs.OpenStackRouterHandler{Client: osConnection.NetworkClient}
+	routerHandler, _ := cloudConnection.CreateRouterHandler()
 
 	// 1. Virtual Network, Subnet 생성
 	vNetReqInfo := irs.VNetworkReqInfo{Name: config.Openstack.VirtualNetwork.Name}
@@ -68,18 +65,16 @@ func testCreateVM() {
 
 	// 2. Router 생성 및 인터페이스 등록
 	// Router 생성
-	routerReqInfo := osrs.RouterReqInfo{
-		Name:         config.Openstack.Router.Name,
-		GateWayId:    config.Openstack.Router.GateWayId,
-		AdminStateUp: config.Openstack.Router.AdminStateUp,
+	routerReqInfo := irs.RouterReqInfo{
+		Name:            config.Openstack.Router.Name,
+		InternetGateway: true,
 	}
 	router, err := routerHandler.CreateRouter(routerReqInfo)
 	if err != nil {
 		cblogger.Error(err)
 	}
 	// 인터페이스 등록(연결)
-	irReqInfo := osrs.InterfaceReqInfo{RouterId: router.Id, SubnetId: vNet.SubnetId}
-	_, err = routerHandler.AddInterface(irReqInfo)
+	_, err = routerHandler.AddInterface(router.Id, vNet.SubnetId)
 	if err != nil {
 		cblogger.Error(err)
 	}
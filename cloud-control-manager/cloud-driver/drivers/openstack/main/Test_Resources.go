@@ -4,8 +4,6 @@ import (
 	"fmt"
 	cblog "github.com/cloud-barista/cb-log"
 	osdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack"
-	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/connect"
-	osrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/resources"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/davecgh/go-spew/spew"
@@ -474,7 +472,7 @@ func testRouterHandler(config Config) {
 		cblogger.Error(err)
 	}
 
-	routerHandler := resourceHandler.(osrs.OpenStackRouterHandler)
+	routerHandler := resourceHandler.(irs.RouterHandler)
 
 	cblogger.Info("Test RouterHandler")
 	cblogger.Info("1. ListRouter()")
@@ -508,10 +506,9 @@ Loop:
 				cblogger.Info("Finish GetRouter()")
 			case 3:
 				cblogger.Info("Start CreateRouter() ...")
-				reqInfo := osrs.RouterReqInfo{
-					Name:         config.Openstack.Router.Name,
-					GateWayId:    config.Openstack.Router.GateWayId,
-					AdminStateUp: config.Openstack.Router.AdminStateUp,
+				reqInfo := irs.RouterReqInfo{
+					Name:            config.Openstack.Router.Name,
+					InternetGateway: true,
 				}
 				router, err := routerHandler.CreateRouter(reqInfo)
 				if err != nil {
@@ -525,11 +522,7 @@ Loop:
 				cblogger.Info("Finish DeleteRouter()")
 			case 5:
 				cblogger.Info("Start AddInterface() ...")
-				reqInfo := osrs.InterfaceReqInfo{
-					SubnetId: config.Openstack.Subnet.Id,
-					RouterId: routerId,
-				}
-				_, err := routerHandler.AddInterface(reqInfo)
+				_, err := routerHandler.AddInterface(routerId, config.Openstack.Subnet.Id)
 				if err != nil {
 					cblogger.Error(err)
 				}
@@ -586,13 +579,7 @@ func getResourceHandler(resourceType string) (interface{}, error) {
 	case "vnic":
 		resourceHandler, err = cloudConnection.CreateVNicHandler()
 	case "router":
-		osDriver := osdrv.OpenStackDriver{}
-		cloudConn, err := osDriver.ConnectCloud(connectionInfo)
-		if err != nil {
-			cblogger.Error(err)
-		}
-		osCloudConn := cloudConn.(*connect.OpenStackCloudConnection)
-		resourceHandler = osrs.OpenStackRouterHandler{Client: osCloudConn.NetworkClient}
+		resourceHandler, err = cloudConnection.CreateRouterHandler()
 	}
 
 	if err != nil {
@@ -0,0 +1,868 @@
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/secgroups"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+const (
+	ClusterWaitTime               = 3600 // seconds
+	CBClusterAPIVersion           = "container-infra latest"
+	CBClusterCOE                  = "kubernetes"
+	CBClusterNetworkDriver        = "calico"
+	CBClusterMasterCount          = 1
+	CBClusterSecurityGroupLabel   = "cb_security_group_ids"
+	CBClusterMasterNodeGroupRole  = "master"
+	CBClusterWorkerNodeGroupRole  = "worker"
+	CBClusterKubeConfigUser       = "admin"
+	CBClusterKubeConfigUserGroup  = "system:masters"
+	CBClusterDefaultTemplateLabel = "kube_tag"
+)
+
+// gophercloud에 Magnum(container-infra) API가 없어서 직접 요청
+// 클러스터 생성 시 클러스터 전용 Cluster Template을 생성하며 (클러스터 삭제 시 함께 삭제)
+// 첫 번째 노드 그룹은 Magnum의 기본 Worker 노드 그룹(default-worker)으로 생성된다.
+// Security Group은 Magnum이 생성하는 Security Group과 별도로 노드 VM에 추가 연결한다.
+type OpenStackClusterHandler struct {
+	Client               *gophercloud.ServiceClient
+	ContainerInfraClient *gophercloud.ServiceClient
+}
+
+type magnumClusterTemplate struct {
+	UUID              string            `mapstructure:"uuid"`
+	Name              string            `mapstructure:"name"`
+	ImageID           string            `mapstructure:"image_id"`
+	FlavorID          string            `mapstructure:"flavor_id"`
+	MasterFlavorID    string            `mapstructure:"master_flavor_id"`
+	KeyPairID         string            `mapstructure:"keypair_id"`
+	ExternalNetworkID string            `mapstructure:"external_network_id"`
+	FixedNetwork      string            `mapstructure:"fixed_network"`
+	FixedSubnet       string            `mapstructure:"fixed_subnet"`
+	NetworkDriver     string            `mapstructure:"network_driver"`
+	DockerVolumeSize  int               `mapstructure:"docker_volume_size"`
+	FloatingIPEnabled bool              `mapstructure:"floating_ip_enabled"`
+	Labels            map[string]string `mapstructure:"labels"`
+}
+
+type magnumCluster struct {
+	UUID              string            `mapstructure:"uuid"`
+	Name              string            `mapstructure:"name"`
+	Status            string            `mapstructure:"status"`
+	StatusReason      string            `mapstructure:"status_reason"`
+	ClusterTemplateID string            `mapstructure:"cluster_template_id"`
+	APIAddress        string            `mapstructure:"api_address"`
+	COEVersion        string            `mapstructure:"coe_version"`
+	KeyPair           string            `mapstructure:"keypair"`
+	StackID           string            `mapstructure:"stack_id"`
+	FixedNetwork      string            `mapstructure:"fixed_network"`
+	FixedSubnet       string            `mapstructure:"fixed_subnet"`
+	CreatedAt         string            `mapstructure:"created_at"`
+	Labels            map[string]string `mapstructure:"labels"`
+}
+
+type magnumNodeGroup struct {
+	UUID             string            `mapstructure:"uuid"`
+	Name             string            `mapstructure:"name"`
+	Role             string            `mapstructure:"role"`
+	Status           string            `mapstructure:"status"`
+	StatusReason     string            `mapstructure:"status_reason"`
+	ImageID          string            `mapstructure:"image_id"`
+	FlavorID         string            `mapstructure:"flavor_id"`
+	DockerVolumeSize int               `mapstructure:"docker_volume_size"`
+	NodeCount        int               `mapstructure:"node_count"`
+	MinNodeCount     int               `mapstructure:"min_node_count"`
+	MaxNodeCount     int               `mapstructure:"max_node_count"`
+	NodeAddresses    []string          `mapstructure:"node_addresses"`
+	IsDefault        bool              `mapstructure:"is_default"`
+	Version          string            `mapstructure:"version"`
+	Labels           map[string]string `mapstructure:"labels"`
+}
+
+// Magnum API는 Microversion 헤더가 있어야 노드 그룹, 업그레이드 API를 사용할 수 있음
+func getMagnumRequestOpts(okCodes ...int) *gophercloud.RequestOpts {
+	return &gophercloud.RequestOpts{
+		OkCodes:     okCodes,
+		MoreHeaders: map[string]string{"OpenStack-API-Version": CBClusterAPIVersion},
+	}
+}
+
+func (clusterHandler *OpenStackClusterHandler) setterCluster(cluster magnumCluster, template magnumClusterTemplate) (*irs.ClusterInfo, error) {
+	clusterInfo := &irs.ClusterInfo{
+		Id:          cluster.UUID,
+		Name:        cluster.Name,
+		Version:     cluster.COEVersion,
+		VPCId:       template.FixedNetwork,
+		Endpoint:    cluster.APIAddress,
+		Status:      getClusterStatus(cluster.Status),
+		CreatedTime: cluster.CreatedAt,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: cluster.Status},
+			{Key: "StatusReason", Value: cluster.StatusReason},
+			{Key: "ClusterTemplateId", Value: cluster.ClusterTemplateID},
+			{Key: "StackId", Value: cluster.StackID},
+			{Key: "KeyPair", Value: cluster.KeyPair},
+		},
+	}
+	if clusterInfo.Version == "" {
+		clusterInfo.Version = template.Labels[CBClusterDefaultTemplateLabel]
+	}
+	if template.FixedSubnet != "" {
+		clusterInfo.SubnetIds = []string{template.FixedSubnet}
+	}
+	if securityGroupIds := cluster.Labels[CBClusterSecurityGroupLabel]; securityGroupIds != "" {
+		clusterInfo.SecurityGroupIds = strings.Split(securityGroupIds, ",")
+	}
+
+	nodeGroupList, err := clusterHandler.ListNodeGroup(cluster.UUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, nodeGroupInfo := range nodeGroupList {
+		clusterInfo.NodeGroupList = append(clusterInfo.NodeGroupList, *nodeGroupInfo)
+	}
+	return clusterInfo, nil
+}
+
+func (clusterHandler *OpenStackClusterHandler) setterNodeGroup(nodeGroup magnumNodeGroup) (*irs.NodeGroupInfo, error) {
+	nodeGroupInfo := &irs.NodeGroupInfo{
+		Id:              nodeGroup.UUID,
+		Name:            nodeGroup.Name,
+		ImageName:       nodeGroup.ImageID,
+		VMSpecName:      nodeGroup.FlavorID,
+		Status:          getClusterStatus(nodeGroup.Status),
+		DesiredNodeSize: nodeGroup.NodeCount,
+		MinNodeSize:     nodeGroup.MinNodeCount,
+		MaxNodeSize:     nodeGroup.MaxNodeCount,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: nodeGroup.Status},
+			{Key: "StatusReason", Value: nodeGroup.StatusReason},
+			{Key: "Role", Value: nodeGroup.Role},
+			{Key: "IsDefault", Value: strconv.FormatBool(nodeGroup.IsDefault)},
+			{Key: "Version", Value: nodeGroup.Version},
+		},
+	}
+	if nodeGroup.DockerVolumeSize != 0 {
+		nodeGroupInfo.RootDiskSize = strconv.Itoa(nodeGroup.DockerVolumeSize)
+	}
+	if nodeGroup.Labels["auto_scaling_enabled"] == "true" {
+		nodeGroupInfo.OnAutoScaling = true
+	} else {
+		nodeGroupInfo.MinNodeSize = nodeGroup.NodeCount
+		nodeGroupInfo.MaxNodeSize = nodeGroup.NodeCount
+	}
+
+	nodes, err := clusterHandler.getNodes(nodeGroup.NodeAddresses)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroupInfo.Nodes = nodes
+	return nodeGroupInfo, nil
+}
+
+// VPC는 fixed_network, 첫 번째 Subnet은 fixed_subnet으로 사용하고 외부 네트워크(CBGateWayId)로 Floating IP를 할당한다.
+func (clusterHandler *OpenStackClusterHandler) CreateCluster(clusterReqInfo irs.ClusterReqInfo) (irs.ClusterInfo, error) {
+	if len(clusterReqInfo.NodeGroupList) == 0 {
+		return irs.ClusterInfo{}, errors.New("Magnum cluster requires at least one node group")
+	}
+	defaultNodeGroup := clusterReqInfo.NodeGroupList[0]
+	if defaultNodeGroup.ImageName == "" {
+		return irs.ClusterInfo{}, errors.New("ImageName of the first node group is required (Fedora CoreOS image)")
+	}
+
+	template := magnumClusterTemplate{
+		Name:              clusterReqInfo.Name + "-template",
+		ImageID:           defaultNodeGroup.ImageName,
+		FlavorID:          defaultNodeGroup.VMSpecName,
+		MasterFlavorID:    defaultNodeGroup.VMSpecName,
+		KeyPairID:         defaultNodeGroup.KeyPairName,
+		ExternalNetworkID: CBGateWayId,
+		FixedNetwork:      clusterReqInfo.VPCId,
+		NetworkDriver:     CBClusterNetworkDriver,
+		FloatingIPEnabled: true,
+		Labels:            map[string]string{},
+	}
+	if len(clusterReqInfo.SubnetIds) != 0 {
+		template.FixedSubnet = clusterReqInfo.SubnetIds[0]
+	}
+	if clusterReqInfo.Version != "" {
+		template.Labels[CBClusterDefaultTemplateLabel] = getKubeTag(clusterReqInfo.Version)
+	}
+	templateID, err := clusterHandler.createClusterTemplate(template)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	labels := getNodeGroupLabels(defaultNodeGroup)
+	if len(clusterReqInfo.SecurityGroupIds) != 0 {
+		labels[CBClusterSecurityGroupLabel] = strings.Join(clusterReqInfo.SecurityGroupIds, ",")
+	}
+	reqBody := map[string]interface{}{
+		"name":                clusterReqInfo.Name,
+		"cluster_template_id": templateID,
+		"keypair":             defaultNodeGroup.KeyPairName,
+		"master_count":        CBClusterMasterCount,
+		"node_count":          defaultNodeGroup.DesiredNodeSize,
+		"labels":              labels,
+		"merge_labels":        true,
+	}
+	if defaultNodeGroup.RootDiskSize != "" {
+		diskSize, err := strconv.Atoi(defaultNodeGroup.RootDiskSize)
+		if err != nil {
+			clusterHandler.deleteClusterTemplate(templateID)
+			errMsg := fmt.Sprintf("RootDiskSize %s is not a valid number", defaultNodeGroup.RootDiskSize)
+			return irs.ClusterInfo{}, errors.New(errMsg)
+		}
+		reqBody["docker_volume_size"] = diskSize
+	}
+
+	var result interface{}
+	_, err = clusterHandler.ContainerInfraClient.Post(clusterHandler.ContainerInfraClient.ServiceURL("clusters"), reqBody, &result, getMagnumRequestOpts(202))
+	if err != nil {
+		clusterHandler.deleteClusterTemplate(templateID)
+		return irs.ClusterInfo{}, err
+	}
+	var response struct {
+		UUID string `mapstructure:"uuid"`
+	}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	if err := clusterHandler.waitCluster(response.UUID); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	if err := clusterHandler.attachSecurityGroups(response.UUID, clusterReqInfo.SecurityGroupIds); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	// 두 번째 노드 그룹부터는 클러스터 생성 후 추가
+	for _, nodeGroupReqInfo := range clusterReqInfo.NodeGroupList[1:] {
+		if _, err := clusterHandler.AddNodeGroup(response.UUID, nodeGroupReqInfo); err != nil {
+			return irs.ClusterInfo{}, err
+		}
+	}
+
+	return clusterHandler.GetCluster(response.UUID)
+}
+
+func (clusterHandler *OpenStackClusterHandler) ListCluster() ([]*irs.ClusterInfo, error) {
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("clusters"), &result, getMagnumRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		Clusters []magnumCluster `mapstructure:"clusters"`
+	}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return nil, err
+	}
+
+	var clusterList []*irs.ClusterInfo
+	for _, cluster := range response.Clusters {
+		clusterInfo, err := clusterHandler.GetCluster(cluster.UUID)
+		if err != nil {
+			return nil, err
+		}
+		clusterList = append(clusterList, &clusterInfo)
+	}
+	return clusterList, nil
+}
+
+func (clusterHandler *OpenStackClusterHandler) GetCluster(clusterID string) (irs.ClusterInfo, error) {
+	cluster, err := clusterHandler.getCluster(clusterID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	template, err := clusterHandler.getClusterTemplate(cluster.ClusterTemplateID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	clusterInfo, err := clusterHandler.setterCluster(cluster, template)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	return *clusterInfo, nil
+}
+
+func (clusterHandler *OpenStackClusterHandler) DeleteCluster(clusterID string) (bool, error) {
+	cluster, err := clusterHandler.getCluster(clusterID)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = clusterHandler.ContainerInfraClient.Delete(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID), getMagnumRequestOpts(204))
+	if err != nil {
+		return false, err
+	}
+
+	// 클러스터 삭제가 완료되어야 Cluster Template을 삭제할 수 있음
+	err = gophercloud.WaitFor(ClusterWaitTime, func() (bool, error) {
+		current, err := clusterHandler.getCluster(clusterID)
+		if err != nil {
+			if isNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if current.Status == "DELETE_FAILED" {
+			return false, errors.New("cluster " + clusterID + " is in DELETE_FAILED status: " + current.StatusReason)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := clusterHandler.deleteClusterTemplate(cluster.ClusterTemplateID); err != nil {
+		cblogger.Error(err)
+	}
+	return true, nil
+}
+
+func (clusterHandler *OpenStackClusterHandler) AddNodeGroup(clusterID string, nodeGroupReqInfo irs.NodeGroupReqInfo) (irs.NodeGroupInfo, error) {
+	reqBody := map[string]interface{}{
+		"name":       nodeGroupReqInfo.Name,
+		"role":       CBClusterWorkerNodeGroupRole,
+		"flavor_id":  nodeGroupReqInfo.VMSpecName,
+		"node_count": nodeGroupReqInfo.DesiredNodeSize,
+		"labels":     getNodeGroupLabels(nodeGroupReqInfo),
+	}
+	if nodeGroupReqInfo.ImageName != "" {
+		reqBody["image_id"] = nodeGroupReqInfo.ImageName
+	}
+	if nodeGroupReqInfo.OnAutoScaling {
+		reqBody["min_node_count"] = nodeGroupReqInfo.MinNodeSize
+		reqBody["max_node_count"] = nodeGroupReqInfo.MaxNodeSize
+	}
+	if nodeGroupReqInfo.RootDiskSize != "" {
+		diskSize, err := strconv.Atoi(nodeGroupReqInfo.RootDiskSize)
+		if err != nil {
+			errMsg := fmt.Sprintf("RootDiskSize %s is not a valid number", nodeGroupReqInfo.RootDiskSize)
+			return irs.NodeGroupInfo{}, errors.New(errMsg)
+		}
+		reqBody["docker_volume_size"] = diskSize
+	}
+
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Post(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "nodegroups"), reqBody, &result, getMagnumRequestOpts(202))
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	nodeGroup, err := extractNodeGroup(result)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	if err := clusterHandler.waitNodeGroup(clusterID, nodeGroup.UUID); err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	if err := clusterHandler.attachClusterSecurityGroups(clusterID); err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	return clusterHandler.getNodeGroup(clusterID, nodeGroup.UUID)
+}
+
+// Master 노드 그룹은 노드 그룹 목록에서 제외
+func (clusterHandler *OpenStackClusterHandler) ListNodeGroup(clusterID string) ([]*irs.NodeGroupInfo, error) {
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "nodegroups"), &result, getMagnumRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		NodeGroups []magnumNodeGroup `mapstructure:"nodegroups"`
+	}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return nil, err
+	}
+
+	var nodeGroupList []*irs.NodeGroupInfo
+	for _, nodeGroup := range response.NodeGroups {
+		if nodeGroup.Role == CBClusterMasterNodeGroupRole {
+			continue
+		}
+		// 목록 조회 결과에는 노드 주소가 없어서 상세 조회
+		nodeGroupInfo, err := clusterHandler.getNodeGroup(clusterID, nodeGroup.UUID)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupList = append(nodeGroupList, &nodeGroupInfo)
+	}
+	return nodeGroupList, nil
+}
+
+// 기본 노드 그룹(default-worker, default-master)은 삭제할 수 없음
+func (clusterHandler *OpenStackClusterHandler) RemoveNodeGroup(clusterID string, nodeGroupID string) (bool, error) {
+	_, err := clusterHandler.ContainerInfraClient.Delete(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "nodegroups", nodeGroupID), getMagnumRequestOpts(204))
+	if err != nil {
+		return false, err
+	}
+
+	err = gophercloud.WaitFor(ClusterWaitTime, func() (bool, error) {
+		current, err := clusterHandler.getMagnumNodeGroup(clusterID, nodeGroupID)
+		if err != nil {
+			if isNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if current.Status == "DELETE_FAILED" {
+			return false, errors.New("node group " + nodeGroupID + " is in DELETE_FAILED status: " + current.StatusReason)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Auto Scaling(cluster-autoscaler)은 노드 그룹 생성 시 auto_scaling_enabled 라벨로만 설정할 수 있어서
+// 노드 수와 min/max 노드 수만 변경한다.
+func (clusterHandler *OpenStackClusterHandler) ChangeNodeGroupScaling(clusterID string, nodeGroupID string, desiredNodeSize int, minNodeSize int, maxNodeSize int) (irs.NodeGroupInfo, error) {
+	if minNodeSize > desiredNodeSize || desiredNodeSize > maxNodeSize {
+		errMsg := fmt.Sprintf("invalid node size: min(%d) <= desired(%d) <= max(%d)", minNodeSize, desiredNodeSize, maxNodeSize)
+		return irs.NodeGroupInfo{}, errors.New(errMsg)
+	}
+
+	reqBody := []map[string]interface{}{
+		{"op": "replace", "path": "/min_node_count", "value": minNodeSize},
+		{"op": "replace", "path": "/max_node_count", "value": maxNodeSize},
+		{"op": "replace", "path": "/node_count", "value": desiredNodeSize},
+	}
+	_, err := clusterHandler.ContainerInfraClient.Patch(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "nodegroups", nodeGroupID), reqBody, nil, getMagnumRequestOpts(202))
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	if err := clusterHandler.waitNodeGroup(clusterID, nodeGroupID); err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	if err := clusterHandler.attachClusterSecurityGroups(clusterID); err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	return clusterHandler.getNodeGroup(clusterID, nodeGroupID)
+}
+
+// 새 버전(kube_tag)의 Cluster Template을 생성하여 Rolling Upgrade 후 이전 Cluster Template은 삭제
+func (clusterHandler *OpenStackClusterHandler) UpgradeCluster(clusterID string, newVersion string) (irs.ClusterInfo, error) {
+	cluster, err := clusterHandler.getCluster(clusterID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	template, err := clusterHandler.getClusterTemplate(cluster.ClusterTemplateID)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	newTemplate := template
+	newTemplate.Name = cluster.Name + "-template-" + getKubeTag(newVersion)
+	newTemplate.Labels = map[string]string{}
+	for key, value := range template.Labels {
+		newTemplate.Labels[key] = value
+	}
+	newTemplate.Labels[CBClusterDefaultTemplateLabel] = getKubeTag(newVersion)
+	newTemplateID, err := clusterHandler.createClusterTemplate(newTemplate)
+	if err != nil {
+		return irs.ClusterInfo{}, err
+	}
+
+	reqBody := map[string]interface{}{
+		"cluster_template": newTemplateID,
+		"max_batch_size":   1,
+	}
+	_, err = clusterHandler.ContainerInfraClient.Post(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "actions", "upgrade"), reqBody, nil, getMagnumRequestOpts(202))
+	if err != nil {
+		clusterHandler.deleteClusterTemplate(newTemplateID)
+		return irs.ClusterInfo{}, err
+	}
+
+	if err := clusterHandler.waitCluster(clusterID); err != nil {
+		return irs.ClusterInfo{}, err
+	}
+	if err := clusterHandler.deleteClusterTemplate(template.UUID); err != nil {
+		cblogger.Error(err)
+	}
+	return clusterHandler.GetCluster(clusterID)
+}
+
+// 클라이언트 인증서는 CSR을 생성하여 클러스터 CA로 서명받아 사용한다. (system:masters 그룹)
+func (clusterHandler *OpenStackClusterHandler) GetKubeConfig(clusterID string) (string, error) {
+	cluster, err := clusterHandler.getCluster(clusterID)
+	if err != nil {
+		return "", err
+	}
+	if cluster.APIAddress == "" {
+		return "", errors.New("cluster " + clusterID + " is not ready")
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   CBClusterKubeConfigUser,
+			Organization: []string{CBClusterKubeConfigUserGroup},
+		},
+	}, privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	reqBody := map[string]interface{}{
+		"cluster_uuid": cluster.UUID,
+		"csr":          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+	}
+	var certResult interface{}
+	_, err = clusterHandler.ContainerInfraClient.Post(clusterHandler.ContainerInfraClient.ServiceURL("certificates"), reqBody, &certResult, getMagnumRequestOpts(201))
+	if err != nil {
+		return "", err
+	}
+	var caResult interface{}
+	_, err = clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("certificates", cluster.UUID), &caResult, getMagnumRequestOpts(200))
+	if err != nil {
+		return "", err
+	}
+
+	var cert, ca struct {
+		PEM string `mapstructure:"pem"`
+	}
+	if err := mapstructure.WeakDecode(certResult, &cert); err != nil {
+		return "", err
+	}
+	if err := mapstructure.WeakDecode(caResult, &ca); err != nil {
+		return "", err
+	}
+	key := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	kubeConfig := fmt.Sprintf(magnumKubeConfigTemplate,
+		base64.StdEncoding.EncodeToString([]byte(ca.PEM)), cluster.APIAddress, cluster.Name,
+		cluster.Name, CBClusterKubeConfigUser, cluster.Name,
+		cluster.Name, CBClusterKubeConfigUser,
+		base64.StdEncoding.EncodeToString([]byte(cert.PEM)), base64.StdEncoding.EncodeToString(key))
+
+	return kubeConfig, nil
+}
+
+const magnumKubeConfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: %s
+  name: %s
+contexts:
+- context:
+    cluster: %s
+    user: %s
+  name: %s
+current-context: %s
+users:
+- name: %s
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`
+
+func (clusterHandler *OpenStackClusterHandler) getCluster(clusterID string) (magnumCluster, error) {
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID), &result, getMagnumRequestOpts(200))
+	if err != nil {
+		return magnumCluster{}, err
+	}
+	var cluster magnumCluster
+	err = mapstructure.WeakDecode(result, &cluster)
+	return cluster, err
+}
+
+func (clusterHandler *OpenStackClusterHandler) getNodeGroup(clusterID string, nodeGroupID string) (irs.NodeGroupInfo, error) {
+	nodeGroup, err := clusterHandler.getMagnumNodeGroup(clusterID, nodeGroupID)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+
+	nodeGroupInfo, err := clusterHandler.setterNodeGroup(nodeGroup)
+	if err != nil {
+		return irs.NodeGroupInfo{}, err
+	}
+	return *nodeGroupInfo, nil
+}
+
+func (clusterHandler *OpenStackClusterHandler) getMagnumNodeGroup(clusterID string, nodeGroupID string) (magnumNodeGroup, error) {
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "nodegroups", nodeGroupID), &result, getMagnumRequestOpts(200))
+	if err != nil {
+		return magnumNodeGroup{}, err
+	}
+	return extractNodeGroup(result)
+}
+
+func (clusterHandler *OpenStackClusterHandler) createClusterTemplate(template magnumClusterTemplate) (string, error) {
+	reqBody := map[string]interface{}{
+		"name":                template.Name,
+		"coe":                 CBClusterCOE,
+		"image_id":            template.ImageID,
+		"flavor_id":           template.FlavorID,
+		"master_flavor_id":    template.MasterFlavorID,
+		"external_network_id": template.ExternalNetworkID,
+		"network_driver":      template.NetworkDriver,
+		"floating_ip_enabled": template.FloatingIPEnabled,
+		"labels":              template.Labels,
+	}
+	if template.KeyPairID != "" {
+		reqBody["keypair_id"] = template.KeyPairID
+	}
+	if template.FixedNetwork != "" {
+		reqBody["fixed_network"] = template.FixedNetwork
+	}
+	if template.FixedSubnet != "" {
+		reqBody["fixed_subnet"] = template.FixedSubnet
+	}
+	if template.DockerVolumeSize != 0 {
+		reqBody["docker_volume_size"] = template.DockerVolumeSize
+	}
+
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Post(clusterHandler.ContainerInfraClient.ServiceURL("clustertemplates"), reqBody, &result, getMagnumRequestOpts(201))
+	if err != nil {
+		return "", err
+	}
+	var created magnumClusterTemplate
+	if err := mapstructure.WeakDecode(result, &created); err != nil {
+		return "", err
+	}
+	return created.UUID, nil
+}
+
+func (clusterHandler *OpenStackClusterHandler) getClusterTemplate(templateID string) (magnumClusterTemplate, error) {
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("clustertemplates", templateID), &result, getMagnumRequestOpts(200))
+	if err != nil {
+		return magnumClusterTemplate{}, err
+	}
+	var template magnumClusterTemplate
+	err = mapstructure.WeakDecode(result, &template)
+	return template, err
+}
+
+func (clusterHandler *OpenStackClusterHandler) deleteClusterTemplate(templateID string) error {
+	_, err := clusterHandler.ContainerInfraClient.Delete(clusterHandler.ContainerInfraClient.ServiceURL("clustertemplates", templateID), getMagnumRequestOpts(204))
+	return err
+}
+
+// *_IN_PROGRESS 상태가 끝날 때까지 대기
+func (clusterHandler *OpenStackClusterHandler) waitCluster(clusterID string) error {
+	return gophercloud.WaitFor(ClusterWaitTime, func() (bool, error) {
+		current, err := clusterHandler.getCluster(clusterID)
+		if err != nil {
+			return false, err
+		}
+		if strings.HasSuffix(current.Status, "_FAILED") {
+			return false, errors.New("cluster " + clusterID + " is in " + current.Status + " status: " + current.StatusReason)
+		}
+		return !strings.HasSuffix(current.Status, "_IN_PROGRESS"), nil
+	})
+}
+
+func (clusterHandler *OpenStackClusterHandler) waitNodeGroup(clusterID string, nodeGroupID string) error {
+	return gophercloud.WaitFor(ClusterWaitTime, func() (bool, error) {
+		current, err := clusterHandler.getMagnumNodeGroup(clusterID, nodeGroupID)
+		if err != nil {
+			return false, err
+		}
+		if strings.HasSuffix(current.Status, "_FAILED") {
+			return false, errors.New("node group " + nodeGroupID + " is in " + current.Status + " status: " + current.StatusReason)
+		}
+		return !strings.HasSuffix(current.Status, "_IN_PROGRESS"), nil
+	})
+}
+
+func (clusterHandler *OpenStackClusterHandler) attachClusterSecurityGroups(clusterID string) error {
+	cluster, err := clusterHandler.getCluster(clusterID)
+	if err != nil {
+		return err
+	}
+	securityGroupIds := cluster.Labels[CBClusterSecurityGroupLabel]
+	if securityGroupIds == "" {
+		return nil
+	}
+	return clusterHandler.attachSecurityGroups(clusterID, strings.Split(securityGroupIds, ","))
+}
+
+// 클러스터의 모든 노드 VM(Master 포함)에 Security Group을 연결 (이미 연결된 경우 제외)
+func (clusterHandler *OpenStackClusterHandler) attachSecurityGroups(clusterID string, securityGroupIds []string) error {
+	if len(securityGroupIds) == 0 {
+		return nil
+	}
+
+	var result interface{}
+	_, err := clusterHandler.ContainerInfraClient.Get(clusterHandler.ContainerInfraClient.ServiceURL("clusters", clusterID, "nodegroups"), &result, getMagnumRequestOpts(200))
+	if err != nil {
+		return err
+	}
+	var response struct {
+		NodeGroups []magnumNodeGroup `mapstructure:"nodegroups"`
+	}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return err
+	}
+	var addresses []string
+	for _, nodeGroup := range response.NodeGroups {
+		detail, err := clusterHandler.getMagnumNodeGroup(clusterID, nodeGroup.UUID)
+		if err != nil {
+			return err
+		}
+		addresses = append(addresses, detail.NodeAddresses...)
+	}
+	nodes, err := clusterHandler.getNodes(addresses)
+	if err != nil {
+		return err
+	}
+
+	for _, securityGroupId := range securityGroupIds {
+		secGroup, err := secgroups.Get(clusterHandler.Client, securityGroupId).Extract()
+		if err != nil {
+			return err
+		}
+		for _, serverID := range nodes {
+			attached := false
+			pager := secgroups.ListByServer(clusterHandler.Client, serverID)
+			err := pager.EachPage(func(page pagination.Page) (bool, error) {
+				list, err := secgroups.ExtractSecurityGroups(page)
+				if err != nil {
+					return false, err
+				}
+				for _, s := range list {
+					if s.ID == secGroup.ID {
+						attached = true
+					}
+				}
+				return true, nil
+			})
+			if err != nil {
+				return err
+			}
+			if attached {
+				continue
+			}
+			if err := secgroups.AddServerToGroup(clusterHandler.Client, serverID, secGroup.Name).ExtractErr(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Magnum은 노드 주소(Floating IP 또는 Fixed IP)만 제공하므로 주소로 VM ID를 찾음
+func (clusterHandler *OpenStackClusterHandler) getNodes(nodeAddresses []string) ([]string, error) {
+	if len(nodeAddresses) == 0 {
+		return nil, nil
+	}
+	addressMap := map[string]bool{}
+	for _, address := range nodeAddresses {
+		addressMap[address] = true
+	}
+
+	var nodes []string
+	pager := servers.List(clusterHandler.Client, nil)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := servers.ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range list {
+			if serverHasAddress(s, addressMap) {
+				nodes = append(nodes, s.ID)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func serverHasAddress(server servers.Server, addressMap map[string]bool) bool {
+	for _, subnet := range server.Addresses {
+		addrList, ok := subnet.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, addr := range addrList {
+			addrMap, ok := addr.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if address, ok := addrMap["addr"].(string); ok && addressMap[address] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Auto Scaling은 cluster-autoscaler 라벨로 설정
+func getNodeGroupLabels(nodeGroupReqInfo irs.NodeGroupReqInfo) map[string]string {
+	labels := map[string]string{}
+	if nodeGroupReqInfo.OnAutoScaling {
+		labels["auto_scaling_enabled"] = "true"
+		labels["min_node_count"] = strconv.Itoa(nodeGroupReqInfo.MinNodeSize)
+		labels["max_node_count"] = strconv.Itoa(nodeGroupReqInfo.MaxNodeSize)
+	}
+	return labels
+}
+
+// ex) 1.18.16 => v1.18.16
+func getKubeTag(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+func extractNodeGroup(result interface{}) (magnumNodeGroup, error) {
+	var nodeGroup magnumNodeGroup
+	err := mapstructure.WeakDecode(result, &nodeGroup)
+	return nodeGroup, err
+}
+
+func isNotFound(err error) bool {
+	if responseErr, ok := err.(*gophercloud.UnexpectedResponseCodeError); ok {
+		return responseErr.Actual == 404
+	}
+	return false
+}
+
+// Status : CREATE_IN_PROGRESS | CREATE_COMPLETE | UPDATE_IN_PROGRESS | DELETE_IN_PROGRESS | *_FAILED ...
+func getClusterStatus(status string) irs.ClusterStatus {
+	switch {
+	case strings.HasSuffix(status, "_FAILED"):
+		return irs.ClusterFailed
+	case strings.HasPrefix(status, "CREATE_IN_PROGRESS"):
+		return irs.ClusterCreating
+	case strings.HasPrefix(status, "DELETE_IN_PROGRESS"):
+		return irs.ClusterDeleting
+	case strings.HasSuffix(status, "_IN_PROGRESS"):
+		return irs.ClusterUpdating
+	default:
+		return irs.ClusterActive
+	}
+}
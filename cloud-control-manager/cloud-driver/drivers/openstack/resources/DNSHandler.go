@@ -0,0 +1,427 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+)
+
+const (
+	DNSWaitTime            = 600 // seconds
+	CBDNSDefaultTTL        = 300 // seconds
+	CBDNSStatusActive      = "ACTIVE"
+	CBDNSStatusError       = "ERROR"
+	CBDNSZoneEmailUser     = "hostmaster"
+	CBDNSZoneListPageLimit = "1000"
+)
+
+// gophercloud에 Designate(DNS) API가 없어서 직접 요청
+// Zone/Record Set 변경은 PENDING 상태로 접수되어 Backend(BIND 등)에 반영되면 ACTIVE 상태가 되므로
+// Change ID는 Record Set ID를 사용한다.
+type OpenStackDNSHandler struct {
+	DNSClient *gophercloud.ServiceClient
+}
+
+type designateZone struct {
+	ID          string `mapstructure:"id"`
+	Name        string `mapstructure:"name"`
+	Email       string `mapstructure:"email"`
+	Description string `mapstructure:"description"`
+	TTL         int64  `mapstructure:"ttl"`
+	Serial      int64  `mapstructure:"serial"`
+	Status      string `mapstructure:"status"`
+	Type        string `mapstructure:"type"`
+	CreatedAt   string `mapstructure:"created_at"`
+}
+
+type designateRecordSet struct {
+	ID      string   `mapstructure:"id"`
+	Name    string   `mapstructure:"name"`
+	Type    string   `mapstructure:"type"`
+	TTL     int64    `mapstructure:"ttl"`
+	Records []string `mapstructure:"records"`
+	Status  string   `mapstructure:"status"`
+	Action  string   `mapstructure:"action"`
+}
+
+type designateLinks struct {
+	Next string `mapstructure:"next"`
+}
+
+func (dnsHandler *OpenStackDNSHandler) setterZone(zone designateZone) (*irs.DNSZoneInfo, error) {
+	zoneInfo := &irs.DNSZoneInfo{
+		Id:          zone.ID,
+		Name:        strings.TrimSuffix(zone.Name, "."),
+		Description: zone.Description,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Email", Value: zone.Email},
+			{Key: "Status", Value: zone.Status},
+			{Key: "Type", Value: zone.Type},
+			{Key: "CreatedAt", Value: zone.CreatedAt},
+		},
+	}
+
+	var result interface{}
+	_, err := dnsHandler.DNSClient.Get(dnsHandler.DNSClient.ServiceURL("zones", zone.ID, "nameservers"), &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		NameServers []struct {
+			HostName string `mapstructure:"hostname"`
+		} `mapstructure:"nameservers"`
+	}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return nil, err
+	}
+	for _, nameServer := range response.NameServers {
+		zoneInfo.NameServers = append(zoneInfo.NameServers, strings.TrimSuffix(nameServer.HostName, "."))
+	}
+	return zoneInfo, nil
+}
+
+func setterRecordSet(recordSet designateRecordSet, zoneName string) *irs.DNSRecordInfo {
+	recordInfo := &irs.DNSRecordInfo{
+		Name:         getRelativeDNSName(recordSet.Name, zoneName),
+		Type:         irs.DNSRecordType(recordSet.Type),
+		TTL:          recordSet.TTL,
+		ChangeId:     recordSet.ID,
+		ChangeStatus: irs.DNSChangePending,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Id", Value: recordSet.ID},
+			{Key: "Status", Value: recordSet.Status},
+			{Key: "Action", Value: recordSet.Action},
+		},
+	}
+	if recordSet.Status == CBDNSStatusActive {
+		recordInfo.ChangeStatus = irs.DNSChangeInSync
+	}
+	for _, value := range recordSet.Records {
+		// TXT 레코드는 큰따옴표로 감싸져 있음
+		if recordInfo.Type == irs.DNSRecordTXT {
+			value = strings.Trim(value, "\"")
+		}
+		recordInfo.Values = append(recordInfo.Values, value)
+	}
+	return recordInfo
+}
+
+// Zone 생성에는 관리자 Email이 필요하므로 hostmaster@{도메인}을 사용
+func (dnsHandler *OpenStackDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	domainName := strings.TrimSuffix(zoneReqInfo.Name, ".")
+	reqBody := map[string]interface{}{
+		"name":  domainName + ".",
+		"email": CBDNSZoneEmailUser + "@" + domainName,
+	}
+	if zoneReqInfo.Description != "" {
+		reqBody["description"] = zoneReqInfo.Description
+	}
+
+	var result interface{}
+	_, err := dnsHandler.DNSClient.Post(dnsHandler.DNSClient.ServiceURL("zones"), reqBody, &result, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	var zone designateZone
+	if err := mapstructure.WeakDecode(result, &zone); err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+
+	err = gophercloud.WaitFor(DNSWaitTime, func() (bool, error) {
+		current, err := dnsHandler.getZone(zone.ID)
+		if err != nil {
+			return false, err
+		}
+		if current.Status == CBDNSStatusError {
+			return false, errors.New("zone " + zone.ID + " is in ERROR status")
+		}
+		return current.Status == CBDNSStatusActive, nil
+	})
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	return dnsHandler.GetZone(zone.ID)
+}
+
+func (dnsHandler *OpenStackDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	var zoneList []*irs.DNSZoneInfo
+	nextURL := dnsHandler.DNSClient.ServiceURL("zones") + "?limit=" + CBDNSZoneListPageLimit
+	for nextURL != "" {
+		var result interface{}
+		_, err := dnsHandler.DNSClient.Get(nextURL, &result, nil)
+		if err != nil {
+			return nil, err
+		}
+		var response struct {
+			Zones []designateZone `mapstructure:"zones"`
+			Links designateLinks  `mapstructure:"links"`
+		}
+		if err := mapstructure.WeakDecode(result, &response); err != nil {
+			return nil, err
+		}
+		for _, zone := range response.Zones {
+			zoneInfo, err := dnsHandler.setterZone(zone)
+			if err != nil {
+				return nil, err
+			}
+			zoneList = append(zoneList, zoneInfo)
+		}
+		nextURL = response.Links.Next
+	}
+	return zoneList, nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) GetZone(zoneID string) (irs.DNSZoneInfo, error) {
+	zone, err := dnsHandler.getZone(zoneID)
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+
+	zoneInfo, err := dnsHandler.setterZone(zone)
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	return *zoneInfo, nil
+}
+
+// Zone을 삭제하면 Zone의 모든 Record Set도 함께 삭제됨
+func (dnsHandler *OpenStackDNSHandler) DeleteZone(zoneID string) (bool, error) {
+	_, err := dnsHandler.DNSClient.Delete(dnsHandler.DNSClient.ServiceURL("zones", zoneID), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) CreateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	zone, err := dnsHandler.getZone(zoneID)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	records, err := getDesignateRecords(recordReqInfo)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	ttl := recordReqInfo.TTL
+	if ttl == 0 {
+		ttl = CBDNSDefaultTTL
+	}
+	reqBody := map[string]interface{}{
+		"name":    getDNSRecordFQDN(recordReqInfo.Name, zone.Name),
+		"type":    string(recordReqInfo.Type),
+		"ttl":     ttl,
+		"records": records,
+	}
+
+	var result interface{}
+	_, err = dnsHandler.DNSClient.Post(dnsHandler.DNSClient.ServiceURL("zones", zoneID, "recordsets"), reqBody, &result, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	var recordSet designateRecordSet
+	if err := mapstructure.WeakDecode(result, &recordSet); err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	return *setterRecordSet(recordSet, zone.Name), nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) ListRecord(zoneID string) ([]*irs.DNSRecordInfo, error) {
+	zone, err := dnsHandler.getZone(zoneID)
+	if err != nil {
+		return nil, err
+	}
+	recordSets, err := dnsHandler.listRecordSet(zoneID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var recordList []*irs.DNSRecordInfo
+	for _, recordSet := range recordSets {
+		switch irs.DNSRecordType(recordSet.Type) {
+		case irs.DNSRecordA, irs.DNSRecordAAAA, irs.DNSRecordCNAME, irs.DNSRecordTXT:
+			recordList = append(recordList, setterRecordSet(recordSet, zone.Name))
+		}
+	}
+	return recordList, nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) UpdateRecord(zoneID string, recordReqInfo irs.DNSRecordReqInfo) (irs.DNSRecordInfo, error) {
+	zone, err := dnsHandler.getZone(zoneID)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	recordSet, err := dnsHandler.getRecordSet(zone, recordReqInfo.Name, recordReqInfo.Type)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	records, err := getDesignateRecords(recordReqInfo)
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+
+	ttl := recordReqInfo.TTL
+	if ttl == 0 {
+		ttl = CBDNSDefaultTTL
+	}
+	reqBody := map[string]interface{}{
+		"ttl":     ttl,
+		"records": records,
+	}
+
+	var result interface{}
+	_, err = dnsHandler.DNSClient.Put(dnsHandler.DNSClient.ServiceURL("zones", zoneID, "recordsets", recordSet.ID), reqBody, &result, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	var updated designateRecordSet
+	if err := mapstructure.WeakDecode(result, &updated); err != nil {
+		return irs.DNSRecordInfo{}, err
+	}
+	return *setterRecordSet(updated, zone.Name), nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) DeleteRecord(zoneID string, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	zone, err := dnsHandler.getZone(zoneID)
+	if err != nil {
+		return false, err
+	}
+	recordSet, err := dnsHandler.getRecordSet(zone, recordName, recordType)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = dnsHandler.DNSClient.Delete(dnsHandler.DNSClient.ServiceURL("zones", zoneID, "recordsets", recordSet.ID), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Record Set이 ACTIVE 상태가 되거나 (삭제된 경우) 조회되지 않을 때까지 대기
+func (dnsHandler *OpenStackDNSHandler) WaitForDNSChange(zoneID string, changeID string) (irs.DNSChangeStatus, error) {
+	err := gophercloud.WaitFor(DNSWaitTime, func() (bool, error) {
+		var result interface{}
+		_, err := dnsHandler.DNSClient.Get(dnsHandler.DNSClient.ServiceURL("zones", zoneID, "recordsets", changeID), &result, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		var recordSet designateRecordSet
+		if err := mapstructure.WeakDecode(result, &recordSet); err != nil {
+			return false, err
+		}
+		if recordSet.Status == CBDNSStatusError {
+			return false, errors.New("record set " + changeID + " is in ERROR status")
+		}
+		return recordSet.Status == CBDNSStatusActive, nil
+	})
+	if err != nil {
+		return irs.DNSChangePending, err
+	}
+	return irs.DNSChangeInSync, nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) getZone(zoneID string) (designateZone, error) {
+	var result interface{}
+	_, err := dnsHandler.DNSClient.Get(dnsHandler.DNSClient.ServiceURL("zones", zoneID), &result, nil)
+	if err != nil {
+		return designateZone{}, err
+	}
+	var zone designateZone
+	err = mapstructure.WeakDecode(result, &zone)
+	return zone, err
+}
+
+func (dnsHandler *OpenStackDNSHandler) listRecordSet(zoneID string, query string) ([]designateRecordSet, error) {
+	var recordSets []designateRecordSet
+	nextURL := dnsHandler.DNSClient.ServiceURL("zones", zoneID, "recordsets") + "?limit=" + CBDNSZoneListPageLimit + query
+	for nextURL != "" {
+		var result interface{}
+		_, err := dnsHandler.DNSClient.Get(nextURL, &result, nil)
+		if err != nil {
+			return nil, err
+		}
+		var response struct {
+			RecordSets []designateRecordSet `mapstructure:"recordsets"`
+			Links      designateLinks       `mapstructure:"links"`
+		}
+		if err := mapstructure.WeakDecode(result, &response); err != nil {
+			return nil, err
+		}
+		recordSets = append(recordSets, response.RecordSets...)
+		nextURL = response.Links.Next
+	}
+	return recordSets, nil
+}
+
+func (dnsHandler *OpenStackDNSHandler) getRecordSet(zone designateZone, recordName string, recordType irs.DNSRecordType) (designateRecordSet, error) {
+	query := "&name=" + getDNSRecordFQDN(recordName, zone.Name) + "&type=" + string(recordType)
+	recordSets, err := dnsHandler.listRecordSet(zone.ID, query)
+	if err != nil {
+		return designateRecordSet{}, err
+	}
+	if len(recordSets) == 0 {
+		errMsg := fmt.Sprintf("record %s %s does not exist", recordName, recordType)
+		return designateRecordSet{}, errors.New(errMsg)
+	}
+	return recordSets[0], nil
+}
+
+func getDesignateRecords(recordReqInfo irs.DNSRecordReqInfo) ([]string, error) {
+	if len(recordReqInfo.Values) == 0 {
+		return nil, errors.New("Values is required")
+	}
+
+	var records []string
+	for _, value := range recordReqInfo.Values {
+		switch recordReqInfo.Type {
+		case irs.DNSRecordA, irs.DNSRecordAAAA:
+		case irs.DNSRecordTXT:
+			value = "\"" + strings.Trim(value, "\"") + "\""
+		case irs.DNSRecordCNAME:
+			// CNAME 값은 FQDN(마지막 '.' 포함)이어야 함
+			value = strings.TrimSuffix(value, ".") + "."
+		default:
+			errMsg := fmt.Sprintf("Type %s is not supported", recordReqInfo.Type)
+			return nil, errors.New(errMsg)
+		}
+		records = append(records, value)
+	}
+	return records, nil
+}
+
+// ex) www, example.com. => www.example.com., @ => example.com.
+func getDNSRecordFQDN(recordName string, zoneName string) string {
+	zoneName = strings.TrimSuffix(zoneName, ".") + "."
+	if recordName == "" || recordName == irs.DNSZoneApex {
+		return zoneName
+	}
+	return recordName + "." + zoneName
+}
+
+// ex) www.example.com., example.com. => www
+func getRelativeDNSName(fqdn string, zoneName string) string {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	zoneName = strings.TrimSuffix(zoneName, ".")
+	if strings.EqualFold(fqdn, zoneName) {
+		return irs.DNSZoneApex
+	}
+	return strings.TrimSuffix(fqdn, "."+zoneName)
+}
@@ -0,0 +1,188 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/volumeattach"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+const (
+	VolumeWaitTime = 600 // seconds
+)
+
+type OpenStackDiskHandler struct {
+	Client       *gophercloud.ServiceClient
+	VolumeClient *gophercloud.ServiceClient
+}
+
+func setterDisk(volume volumes.Volume) *irs.DiskInfo {
+	diskInfo := &irs.DiskInfo{
+		Id:       volume.ID,
+		Name:     volume.Name,
+		DiskType: volume.VolumeType,
+		DiskSize: strconv.Itoa(volume.Size),
+		Zone:     volume.AvailabilityZone,
+	}
+	// ex) 2019-11-20T08:01:20.000000
+	if createdTime, err := time.Parse("2006-01-02T15:04:05.000000", volume.CreatedAt); err == nil {
+		diskInfo.CreatedTime = createdTime
+	}
+	for _, attachment := range volume.Attachments {
+		if serverID, ok := attachment["server_id"].(string); ok {
+			diskInfo.OwnerVMId = serverID
+		}
+	}
+
+	// Status : creating | available | attaching | in-use | deleting | error | extending ...
+	switch volume.Status {
+	case "creating", "downloading":
+		diskInfo.Status = irs.DiskCreating
+	case "available", "reserved", "detaching":
+		diskInfo.Status = irs.DiskAvailable
+	case "in-use", "attaching":
+		diskInfo.Status = irs.DiskAttached
+	case "deleting":
+		diskInfo.Status = irs.DiskDeleting
+	default:
+		diskInfo.Status = irs.DiskError
+	}
+
+	diskInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Status", Value: volume.Status},
+		{Key: "Bootable", Value: volume.Bootable},
+		{Key: "Description", Value: volume.Description},
+	}
+	if volume.SnapshotID != "" {
+		diskInfo.KeyValueList = append(diskInfo.KeyValueList, irs.KeyValue{Key: "SnapshotID", Value: volume.SnapshotID})
+	}
+	return diskInfo
+}
+
+func (diskHandler *OpenStackDiskHandler) CreateDisk(diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	size, err := strconv.Atoi(diskReqInfo.DiskSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("DiskSize %s is not a valid number", diskReqInfo.DiskSize)
+		return irs.DiskInfo{}, errors.New(errMsg)
+	}
+
+	metadata, err := getMetadata(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	createOpts := volumes.CreateOpts{
+		Name:             diskReqInfo.Name,
+		Size:             size,
+		VolumeType:       diskReqInfo.DiskType,
+		AvailabilityZone: diskReqInfo.Zone,
+		Metadata:         metadata,
+	}
+	volume, err := volumes.Create(diskHandler.VolumeClient, createOpts).Extract()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	if err := volumes.WaitForStatus(diskHandler.VolumeClient, volume.ID, "available", VolumeWaitTime); err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return diskHandler.GetDisk(volume.ID)
+}
+
+func (diskHandler *OpenStackDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
+	var diskList []*irs.DiskInfo
+
+	pager := volumes.List(diskHandler.VolumeClient, nil)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := volumes.ExtractVolumes(page)
+		if err != nil {
+			return false, err
+		}
+		for _, volume := range list {
+			diskInfo := setterDisk(volume)
+			diskList = append(diskList, diskInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return diskList, nil
+}
+
+func (diskHandler *OpenStackDiskHandler) GetDisk(diskID string) (irs.DiskInfo, error) {
+	volume, err := volumes.Get(diskHandler.VolumeClient, diskID).Extract()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	diskInfo := setterDisk(*volume)
+	return *diskInfo, nil
+}
+
+// gophercloud에 os-extend 액션이 없어서 직접 요청 (available 상태의 볼륨만 가능)
+func (diskHandler *OpenStackDiskHandler) ChangeDiskSize(diskID string, size string) (bool, error) {
+	newSize, err := strconv.Atoi(size)
+	if err != nil {
+		errMsg := fmt.Sprintf("size %s is not a valid number", size)
+		return false, errors.New(errMsg)
+	}
+
+	reqBody := map[string]interface{}{
+		"os-extend": map[string]interface{}{"new_size": newSize},
+	}
+	_, err = diskHandler.VolumeClient.Post(diskHandler.VolumeClient.ServiceURL("volumes", diskID, "action"), reqBody, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := volumes.WaitForStatus(diskHandler.VolumeClient, diskID, "available", VolumeWaitTime); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (diskHandler *OpenStackDiskHandler) DeleteDisk(diskID string) (bool, error) {
+	err := volumes.Delete(diskHandler.VolumeClient, diskID).ExtractErr()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Nova의 volume attachment로 연결 (디바이스 이름은 자동 할당)
+func (diskHandler *OpenStackDiskHandler) AttachDisk(diskID string, vmID string) (irs.DiskInfo, error) {
+	createOpts := volumeattach.CreateOpts{
+		VolumeID: diskID,
+	}
+	_, err := volumeattach.Create(diskHandler.Client, vmID, createOpts).Extract()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	if err := volumes.WaitForStatus(diskHandler.VolumeClient, diskID, "in-use", VolumeWaitTime); err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return diskHandler.GetDisk(diskID)
+}
+
+// Nova의 attachment ID는 볼륨 ID와 같음
+func (diskHandler *OpenStackDiskHandler) DetachDisk(diskID string, vmID string) (bool, error) {
+	err := volumeattach.Delete(diskHandler.Client, vmID, diskID).ExtractErr()
+	if err != nil {
+		return false, err
+	}
+
+	if err := volumes.WaitForStatus(diskHandler.VolumeClient, diskID, "available", VolumeWaitTime); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -5,12 +5,18 @@ import (
 	"errors"
 	"fmt"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/mitchellh/mapstructure"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/images"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
 	imgsvc "github.com/rackspace/gophercloud/openstack/imageservice/v2/images"
 	"github.com/rackspace/gophercloud/pagination"
 	"io/ioutil"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type OpenStackImageHandler struct {
@@ -20,9 +26,18 @@ type OpenStackImageHandler struct {
 
 func setterImage(image images.Image) *irs.ImageInfo {
 	imageInfo := &irs.ImageInfo{
-		Id:     image.ID,
-		Name:   image.Name,
-		Status: image.Status,
+		Id:   image.ID,
+		Name: image.Name,
+	}
+
+	// Status : SAVING | ACTIVE | ERROR | DELETED ...
+	switch image.Status {
+	case "SAVING", "QUEUED":
+		imageInfo.Status = irs.ImageCreating
+	case "ACTIVE":
+		imageInfo.Status = irs.ImageAvailable
+	default:
+		imageInfo.Status = irs.ImageUnavailable
 	}
 
 	// 메타 정보 등록
@@ -35,12 +50,38 @@ func setterImage(image images.Image) *irs.ImageInfo {
 		}
 		metadataList = append(metadataList, metadata)
 	}
+	metadataList = append(metadataList, irs.KeyValue{Key: "Status", Value: image.Status})
+	metadataList = append(metadataList, irs.KeyValue{Key: "Progress", Value: strconv.Itoa(image.Progress)})
+	// ex) 2019-11-20T08:01:20Z
+	if createdTime, err := time.Parse(time.RFC3339, image.Created); err == nil {
+		metadataList = append(metadataList, irs.KeyValue{Key: irs.ImageCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
+	}
 	imageInfo.KeyValueList = metadataList
 
 	return imageInfo
 }
 
+// VM 스냅샷 이미지 생성 (Nova createImage, 생성 완료를 기다리지 않으므로 GetImage로 상태를 확인)
+func (imageHandler *OpenStackImageHandler) createImageFromVM(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
+	createOpts := servers.CreateImageOpts{
+		Name:     imageReqInfo.Name,
+		Metadata: map[string]string{},
+	}
+	if imageReqInfo.Description != "" {
+		createOpts.Metadata["description"] = imageReqInfo.Description
+	}
+
+	imageID, err := servers.CreateImage(imageHandler.Client, imageReqInfo.SourceVMId, createOpts).ExtractImageID()
+	if err != nil {
+		return irs.ImageInfo{}, err
+	}
+	return imageHandler.GetImage(imageID)
+}
+
 func (imageHandler *OpenStackImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
+	if imageReqInfo.SourceVMId != "" {
+		return imageHandler.createImageFromVM(imageReqInfo)
+	}
 
 	// @TODO: Image 생성 요청 파라미터 정의 필요
 	type ImageReqInfo struct {
@@ -151,3 +192,126 @@ func (imageHandler *OpenStackImageHandler) DeleteImage(imageID string) (bool, er
 	}
 	return true, nil
 }
+
+// Nova로 VM에서 생성한 스냅샷 이미지(image_type: snapshot)만 조회
+func (imageHandler *OpenStackImageHandler) ListMyImage() ([]*irs.ImageInfo, error) {
+	var imageList []*irs.ImageInfo
+
+	pager := images.ListDetail(imageHandler.Client, images.ListOpts{})
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := images.ExtractImages(page)
+		if err != nil {
+			return false, err
+		}
+		for _, img := range list {
+			if img.Metadata["image_type"] != "snapshot" {
+				continue
+			}
+			imageInfo := setterImage(img)
+			imageList = append(imageList, imageInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return imageList, nil
+}
+
+// Glance v2 이미지 (gophercloud의 imgsvc.Image에는 os_distro 등 속성 필드가 없어서 직접 매핑)
+type glanceImage struct {
+	ID           string `mapstructure:"id"`
+	Name         string `mapstructure:"name"`
+	Status       string `mapstructure:"status"`
+	Owner        string `mapstructure:"owner"`
+	Visibility   string `mapstructure:"visibility"`
+	OSDistro     string `mapstructure:"os_distro"`
+	OSVersion    string `mapstructure:"os_version"`
+	Architecture string `mapstructure:"architecture"`
+	CreatedAt    string `mapstructure:"created_at"`
+}
+
+func setterGlanceImage(image glanceImage) *irs.ImageInfo {
+	imageInfo := &irs.ImageInfo{
+		Id:      image.ID,
+		Name:    image.Name,
+		GuestOS: strings.TrimSpace(image.OSDistro + " " + image.OSVersion),
+	}
+
+	// Status : queued | saving | active | killed | deleted | pending_delete | deactivated
+	switch image.Status {
+	case "queued", "saving":
+		imageInfo.Status = irs.ImageCreating
+	case "active":
+		imageInfo.Status = irs.ImageAvailable
+	default:
+		imageInfo.Status = irs.ImageUnavailable
+	}
+
+	imageInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Status", Value: image.Status},
+		{Key: "Owner", Value: image.Owner},
+		{Key: "Visibility", Value: image.Visibility},
+		{Key: "Architecture", Value: image.Architecture},
+	}
+	if createdTime, err := time.Parse(time.RFC3339, image.CreatedAt); err == nil {
+		imageInfo.KeyValueList = append(imageInfo.KeyValueList, irs.KeyValue{Key: irs.ImageCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
+	}
+	return imageInfo
+}
+
+// 필터를 Glance v2 이미지 목록의 속성(os_distro, os_version, architecture) 필터로 변환하여 조회
+// 이름 패턴은 Glance가 지원하지 않으므로 CB-Spider에서 적용
+func (imageHandler *OpenStackImageHandler) ListImageByFilter(filterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	query := url.Values{}
+	query.Set("status", "active")
+	query.Set("sort", "created_at:desc")
+	if filterInfo.OSFamily != "" {
+		query.Set("os_distro", strings.ToLower(filterInfo.OSFamily))
+	}
+	if filterInfo.OSVersion != "" {
+		query.Set("os_version", filterInfo.OSVersion)
+	}
+	if filterInfo.Architecture != "" {
+		query.Set("architecture", filterInfo.Architecture)
+	}
+	switch filterInfo.Owner {
+	case "":
+	case "self":
+		query.Set("visibility", "private")
+	default:
+		query.Set("owner", filterInfo.Owner)
+	}
+	if filterInfo.CreatedAfter != "" {
+		query.Set("created_at", "gte:"+filterInfo.CreatedAfter)
+	}
+
+	var imageList []*irs.ImageInfo
+	nextURL := imageHandler.ImageClient.ServiceURL("images") + "?" + query.Encode()
+	for nextURL != "" {
+		var result interface{}
+		_, err := imageHandler.ImageClient.Get(nextURL, &result, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Images []glanceImage `mapstructure:"images"`
+			Next   string        `mapstructure:"next"`
+		}
+		if err := mapstructure.WeakDecode(result, &response); err != nil {
+			return nil, err
+		}
+		for _, image := range response.Images {
+			imageList = append(imageList, setterGlanceImage(image))
+		}
+
+		// next : /v2/images?marker=...
+		nextURL = ""
+		if response.Next != "" {
+			nextURL = strings.TrimSuffix(imageHandler.ImageClient.ResourceBaseURL(), "/v2/") + response.Next
+		}
+	}
+	return imageList, nil
+}
@@ -1,10 +1,13 @@
 package resources
 
 import (
+	"errors"
+
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/rackspace/gophercloud/pagination"
+	"golang.org/x/crypto/ssh"
 )
 
 type OpenStackKeyPairHandler struct {
@@ -40,6 +43,30 @@ func (keyPairHandler *OpenStackKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyP
 	return keyPairInfo, nil
 }
 
+// 기존 OpenSSH 공개키(ssh-rsa, ssh-ed25519)를 KeyPair로 등록
+func (keyPairHandler *OpenStackKeyPairHandler) ImportKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	publicKey, err := parseImportPublicKey(keyPairReqInfo.PublicKey)
+	if err != nil {
+		return irs.KeyPairInfo{}, err
+	}
+
+	create0pts := keypairs.CreateOpts{
+		Name:      keyPairReqInfo.Name,
+		PublicKey: string(ssh.MarshalAuthorizedKey(publicKey)),
+	}
+	keyPair, err := keypairs.Create(keyPairHandler.Client, create0pts).Extract()
+	if err != nil {
+		return irs.KeyPairInfo{}, err
+	}
+
+	// 등록된 KeyPair 정보 리턴
+	keyPairInfo, err := keyPairHandler.GetKey(keyPair.Name)
+	if err != nil {
+		return irs.KeyPairInfo{}, err
+	}
+	return keyPairInfo, nil
+}
+
 func (keyPairHandler *OpenStackKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, error) {
 	var keyPairList []*irs.KeyPairInfo
 
@@ -82,3 +109,15 @@ func (keyPairHandler *OpenStackKeyPairHandler) DeleteKey(keyName string) (bool,
 	}
 	return true, nil
 }
+
+// OpenSSH 형식 공개키 검증 (ssh-rsa, ssh-ed25519만 지원)
+func parseImportPublicKey(publicKeyString string) (ssh.PublicKey, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKeyString))
+	if err != nil {
+		return nil, errors.New("invalid OpenSSH public key: " + err.Error())
+	}
+	if publicKey.Type() != ssh.KeyAlgoRSA && publicKey.Type() != ssh.KeyAlgoED25519 {
+		return nil, errors.New("unsupported public key type: " + publicKey.Type())
+	}
+	return publicKey, nil
+}
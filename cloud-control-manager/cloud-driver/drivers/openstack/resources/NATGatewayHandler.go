@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"errors"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/ports"
+)
+
+const (
+	CBRouterGatewayOwner = "network:router_gateway"
+)
+
+// OpenStack은 외부 네트워크(CBGateWayId) Gateway가 설정된 Router의 SNAT를 NAT Gateway로 사용 (NAT Gateway ID = Router ID)
+// 서브넷 연결은 Router Interface 추가/삭제로 처리
+type OpenStackNATGatewayHandler struct {
+	Client *gophercloud.ServiceClient
+}
+
+func (natHandler *OpenStackNATGatewayHandler) setterNATGateway(routerInfo irs.RouterInfo) (irs.NATGatewayInfo, error) {
+	natGatewayInfo := irs.NATGatewayInfo{
+		Id:           routerInfo.Id,
+		Name:         routerInfo.Name,
+		VPCId:        routerInfo.VPCId,
+		RouterIdList: []string{routerInfo.Id},
+		SubnetIdList: routerInfo.SubnetIdList,
+		KeyValueList: routerInfo.KeyValueList,
+	}
+	for _, keyValue := range routerInfo.KeyValueList {
+		if keyValue.Key == "Status" {
+			natGatewayInfo.Status = keyValue.Value
+		}
+	}
+
+	// Router Gateway Port의 외부 IP를 NAT Public IP로 사용
+	listOpts := ports.ListOpts{
+		DeviceID:    routerInfo.Id,
+		DeviceOwner: CBRouterGatewayOwner,
+	}
+	pager, err := ports.List(natHandler.Client, listOpts).AllPages()
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	portList, err := ports.ExtractPorts(pager)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	for _, port := range portList {
+		for _, fixedIP := range port.FixedIPs {
+			natGatewayInfo.SubnetId = fixedIP.SubnetID
+			natGatewayInfo.PublicIP = fixedIP.IPAddress
+		}
+	}
+	return natGatewayInfo, nil
+}
+
+func (natHandler *OpenStackNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayReqInfo) (irs.NATGatewayInfo, error) {
+	routerHandler := OpenStackRouterHandler{natHandler.Client}
+
+	routerInfo, err := routerHandler.CreateRouter(irs.RouterReqInfo{
+		Name:            natGatewayReqInfo.Name,
+		VPCId:           natGatewayReqInfo.VPCId,
+		InternetGateway: true,
+	})
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// 서브넷 정보가 있으면 생성 시 연결
+	if natGatewayReqInfo.SubnetId != "" {
+		return natHandler.AssociateNATGateway(routerInfo.Id, irs.NATAssociationInfo{SubnetId: natGatewayReqInfo.SubnetId})
+	}
+	return natHandler.setterNATGateway(routerInfo)
+}
+
+// 외부 네트워크 Gateway가 설정된 Router만 조회
+func (natHandler *OpenStackNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	routerHandler := OpenStackRouterHandler{natHandler.Client}
+
+	routerInfoList, err := routerHandler.ListRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	var natGatewayInfoList []*irs.NATGatewayInfo
+	for _, routerInfo := range routerInfoList {
+		if !routerInfo.InternetGateway {
+			continue
+		}
+		natGatewayInfo, err := natHandler.setterNATGateway(*routerInfo)
+		if err != nil {
+			return nil, err
+		}
+		natGatewayInfoList = append(natGatewayInfoList, &natGatewayInfo)
+	}
+	return natGatewayInfoList, nil
+}
+
+func (natHandler *OpenStackNATGatewayHandler) GetNATGateway(natGatewayID string) (irs.NATGatewayInfo, error) {
+	routerHandler := OpenStackRouterHandler{natHandler.Client}
+
+	routerInfo, err := routerHandler.GetRouter(natGatewayID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	if !routerInfo.InternetGateway {
+		return irs.NATGatewayInfo{}, errors.New("router " + natGatewayID + " has no external gateway, it is not a NAT gateway")
+	}
+	return natHandler.setterNATGateway(routerInfo)
+}
+
+// Router Interface를 삭제한 후 Router를 삭제
+func (natHandler *OpenStackNATGatewayHandler) DeleteNATGateway(natGatewayID string) (bool, error) {
+	routerHandler := OpenStackRouterHandler{natHandler.Client}
+
+	return routerHandler.DeleteRouter(natGatewayID)
+}
+
+func (natHandler *OpenStackNATGatewayHandler) AssociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (irs.NATGatewayInfo, error) {
+	if associationInfo.SubnetId == "" {
+		return irs.NATGatewayInfo{}, errors.New("OpenStack NAT gateway is the router interface of the subnet, SubnetId is required")
+	}
+	routerHandler := OpenStackRouterHandler{natHandler.Client}
+
+	routerInfo, err := routerHandler.AddInterface(natGatewayID, associationInfo.SubnetId)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return natHandler.setterNATGateway(routerInfo)
+}
+
+func (natHandler *OpenStackNATGatewayHandler) DisassociateNATGateway(natGatewayID string, associationInfo irs.NATAssociationInfo) (bool, error) {
+	if associationInfo.SubnetId == "" {
+		return false, errors.New("OpenStack NAT gateway is the router interface of the subnet, SubnetId is required")
+	}
+	routerHandler := OpenStackRouterHandler{natHandler.Client}
+
+	return routerHandler.DeleteInterface(natGatewayID, associationInfo.SubnetId)
+}
@@ -0,0 +1,618 @@
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/ports"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/subnets"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+const (
+	LoadBalancerWaitTime             = 600 // seconds
+	CBNLBActive                      = "ACTIVE"
+	CBNLBOnline                      = "ONLINE"
+	CBNLBDefaultHealthCheckInterval  = 10 // seconds
+	CBNLBDefaultHealthCheckTimeout   = 5  // seconds
+	CBNLBDefaultHealthCheckThreshold = 3
+	CBNLBDefaultHealthCheckPath      = "/"
+	CBNLBDefaultHealthCheckCodes     = "200"
+)
+
+// OpenStack은 LBaaS v2(Octavia) Load Balancer를 NLB로 사용 (NLB ID = Load Balancer ID)
+// Load Balancer/Listener/Pool/Health Monitor는 1개씩 생성하며, VM은 Pool Member(Name = VM ID)로 관리
+// PUBLIC 타입은 VIP Port에 외부 네트워크(CBGateWayId)의 Floating IP를 연결
+type OpenStackNLBHandler struct {
+	Client *gophercloud.ServiceClient
+}
+
+// Load Balancer Status Tree의 Member 상태 (SDK Member 구조체에 operating_status가 없어 별도 정의)
+type memberStatus struct {
+	ID              string `json:"id"`
+	OperatingStatus string `json:"operating_status"`
+}
+
+type loadBalancerStatuses struct {
+	Statuses struct {
+		LoadBalancer struct {
+			Listeners []struct {
+				Pools []struct {
+					Members []memberStatus `json:"members"`
+				} `json:"pools"`
+			} `json:"listeners"`
+		} `json:"loadbalancer"`
+	} `json:"statuses"`
+}
+
+func (nlbHandler *OpenStackNLBHandler) setterNLB(loadBalancer loadbalancers.LoadBalancer) (irs.NLBInfo, error) {
+	nlbInfo := irs.NLBInfo{
+		Id:   loadBalancer.ID,
+		Name: loadBalancer.Name,
+		Type: irs.NLBInternal,
+		Listener: irs.ListenerInfo{
+			IP: loadBalancer.VipAddress,
+		},
+		KeyValueList: []irs.KeyValue{
+			{Key: "VipSubnetID", Value: loadBalancer.VipSubnetID},
+			{Key: "VipAddress", Value: loadBalancer.VipAddress},
+			{Key: "ProvisioningStatus", Value: loadBalancer.ProvisioningStatus},
+			{Key: "OperatingStatus", Value: loadBalancer.OperatingStatus},
+			{Key: "Provider", Value: loadBalancer.Provider},
+		},
+	}
+
+	subnet, err := subnets.Get(nlbHandler.Client, loadBalancer.VipSubnetID).Extract()
+	if err != nil {
+		cblogger.Error(err)
+	} else {
+		nlbInfo.VPCId = subnet.NetworkID
+	}
+
+	// VIP Port에 Floating IP가 있으면 PUBLIC
+	floatingIP, err := nlbHandler.getFloatingIP(loadBalancer)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if floatingIP != nil {
+		nlbInfo.Type = irs.NLBPublic
+		nlbInfo.Listener.IP = floatingIP.FloatingIP
+		nlbInfo.Listener.KeyValueList = []irs.KeyValue{{Key: "VipAddress", Value: loadBalancer.VipAddress}}
+	}
+
+	listener, err := nlbHandler.getListener(loadBalancer.ID)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if listener != nil {
+		nlbInfo.Listener.Protocol = listener.Protocol
+		nlbInfo.Listener.Port = strconv.Itoa(listener.ProtocolPort)
+	}
+
+	pool, err := nlbHandler.getPool(loadBalancer.ID)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if pool == nil {
+		return nlbInfo, nil
+	}
+	nlbInfo.VMGroup.Protocol = pool.Protocol
+	nlbInfo.VMGroup.KeyValueList = []irs.KeyValue{{Key: "PoolID", Value: pool.ID}, {Key: "LBMethod", Value: pool.LBMethod}}
+
+	memberList, err := nlbHandler.listMember(pool.ID)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	for _, member := range memberList {
+		nlbInfo.VMGroup.VMs = append(nlbInfo.VMGroup.VMs, member.Name)
+		nlbInfo.VMGroup.Port = strconv.Itoa(member.ProtocolPort)
+	}
+
+	if pool.MonitorID != "" {
+		monitor, err := monitors.Get(nlbHandler.Client, pool.MonitorID).Extract()
+		if err != nil {
+			return irs.NLBInfo{}, err
+		}
+		nlbInfo.HealthChecker = irs.HealthCheckerInfo{
+			Protocol:     monitor.Type,
+			Port:         nlbInfo.VMGroup.Port,
+			Interval:     monitor.Delay,
+			Timeout:      monitor.Timeout,
+			Threshold:    monitor.MaxRetries,
+			KeyValueList: []irs.KeyValue{{Key: "MonitorID", Value: monitor.ID}},
+		}
+	}
+	return nlbInfo, nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) CreateNLB(nlbReqInfo irs.NLBReqInfo) (irs.NLBInfo, error) {
+	if strings.ToUpper(nlbReqInfo.Listener.Protocol) == "UDP" {
+		return irs.NLBInfo{}, errors.New("OpenStack LBaaS v2 listener supports TCP only")
+	}
+	listenerPort, err := strconv.Atoi(nlbReqInfo.Listener.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("invalid listener port " + nlbReqInfo.Listener.Port)
+	}
+	vmGroupPort, err := strconv.Atoi(nlbReqInfo.VMGroup.Port)
+	if err != nil {
+		return irs.NLBInfo{}, errors.New("invalid VM group port " + nlbReqInfo.VMGroup.Port)
+	}
+	if nlbReqInfo.HealthChecker.Port != "" && nlbReqInfo.HealthChecker.Port != nlbReqInfo.VMGroup.Port {
+		return irs.NLBInfo{}, errors.New("OpenStack health monitor checks the member port, health checker port must be the same as the VM group port")
+	}
+
+	// VIP는 VPC의 첫번째 서브넷에 할당
+	vpcHandler := OpenStackVPCHandler{nlbHandler.Client}
+	subnetList, err := vpcHandler.listSubnet(nlbReqInfo.VPCId)
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if len(subnetList) < 1 {
+		return irs.NLBInfo{}, errors.New("subnet is not found in the VPC " + nlbReqInfo.VPCId)
+	}
+	subnetID := subnetList[0].ID
+
+	// 1. Load Balancer 생성
+	loadBalancer, err := loadbalancers.Create(nlbHandler.Client, loadbalancers.CreateOpts{
+		Name:         nlbReqInfo.Name,
+		VipSubnetID:  subnetID,
+		AdminStateUp: loadbalancers.Up,
+	}).Extract()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := nlbHandler.waitLoadBalancerActive(loadBalancer.ID); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	// 2. Listener 생성
+	listener, err := listeners.Create(nlbHandler.Client, listeners.CreateOpts{
+		Name:           nlbReqInfo.Name,
+		Protocol:       listeners.ProtocolTCP,
+		ProtocolPort:   listenerPort,
+		LoadbalancerID: loadBalancer.ID,
+		AdminStateUp:   listeners.Up,
+	}).Extract()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := nlbHandler.waitLoadBalancerActive(loadBalancer.ID); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	// 3. Pool 생성
+	pool, err := pools.Create(nlbHandler.Client, pools.CreateOpts{
+		Name:         nlbReqInfo.Name,
+		Protocol:     pools.ProtocolTCP,
+		LBMethod:     pools.LBMethodRoundRobin,
+		ListenerID:   listener.ID,
+		AdminStateUp: pools.Up,
+	}).Extract()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := nlbHandler.waitLoadBalancerActive(loadBalancer.ID); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	// 4. Health Monitor 생성
+	monitorOpts := monitors.CreateOpts{
+		Name:       nlbReqInfo.Name,
+		PoolID:     pool.ID,
+		Type:       monitors.TypeTCP,
+		Delay:      CBNLBDefaultHealthCheckInterval,
+		Timeout:    CBNLBDefaultHealthCheckTimeout,
+		MaxRetries: CBNLBDefaultHealthCheckThreshold,
+	}
+	if strings.ToUpper(nlbReqInfo.HealthChecker.Protocol) == "HTTP" {
+		monitorOpts.Type = monitors.TypeHTTP
+		monitorOpts.URLPath = CBNLBDefaultHealthCheckPath
+		monitorOpts.ExpectedCodes = CBNLBDefaultHealthCheckCodes
+	}
+	if nlbReqInfo.HealthChecker.Interval > 0 {
+		monitorOpts.Delay = nlbReqInfo.HealthChecker.Interval
+	}
+	if nlbReqInfo.HealthChecker.Timeout > 0 {
+		monitorOpts.Timeout = nlbReqInfo.HealthChecker.Timeout
+	}
+	if nlbReqInfo.HealthChecker.Threshold > 0 {
+		monitorOpts.MaxRetries = nlbReqInfo.HealthChecker.Threshold
+	}
+	if _, err := monitors.Create(nlbHandler.Client, monitorOpts).Extract(); err != nil {
+		return irs.NLBInfo{}, err
+	}
+	if err := nlbHandler.waitLoadBalancerActive(loadBalancer.ID); err != nil {
+		return irs.NLBInfo{}, err
+	}
+
+	// 5. VM(Member) 추가
+	for _, vmID := range nlbReqInfo.VMGroup.VMs {
+		if err := nlbHandler.addMember(loadBalancer.ID, pool.ID, subnetID, vmID, vmGroupPort); err != nil {
+			return irs.NLBInfo{}, err
+		}
+	}
+
+	// 6. PUBLIC 타입은 VIP Port에 Floating IP 연결
+	if nlbReqInfo.Type != irs.NLBInternal {
+		vipPortID, err := nlbHandler.getVipPortID(*loadBalancer)
+		if err != nil {
+			return irs.NLBInfo{}, err
+		}
+		_, err = floatingips.Create(nlbHandler.Client, floatingips.CreateOpts{
+			FloatingNetworkID: CBGateWayId,
+			PortID:            vipPortID,
+		}).Extract()
+		if err != nil {
+			return irs.NLBInfo{}, err
+		}
+	}
+	return nlbHandler.GetNLB(loadBalancer.ID)
+}
+
+func (nlbHandler *OpenStackNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
+	var nlbInfoList []*irs.NLBInfo
+
+	pager := loadbalancers.List(nlbHandler.Client, loadbalancers.ListOpts{})
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := loadbalancers.ExtractLoadbalancers(page)
+		if err != nil {
+			return false, err
+		}
+		for _, loadBalancer := range list {
+			nlbInfo, err := nlbHandler.setterNLB(loadBalancer)
+			if err != nil {
+				return false, err
+			}
+			nlbInfoList = append(nlbInfoList, &nlbInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nlbInfoList, nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) GetNLB(nlbID string) (irs.NLBInfo, error) {
+	loadBalancer, err := loadbalancers.Get(nlbHandler.Client, nlbID).Extract()
+	if err != nil {
+		return irs.NLBInfo{}, err
+	}
+	return nlbHandler.setterNLB(*loadBalancer)
+}
+
+// Floating IP -> Health Monitor -> Member -> Pool -> Listener -> Load Balancer 순서로 삭제
+func (nlbHandler *OpenStackNLBHandler) DeleteNLB(nlbID string) (bool, error) {
+	loadBalancer, err := loadbalancers.Get(nlbHandler.Client, nlbID).Extract()
+	if err != nil {
+		return false, err
+	}
+
+	floatingIP, err := nlbHandler.getFloatingIP(*loadBalancer)
+	if err != nil {
+		return false, err
+	}
+	if floatingIP != nil {
+		if err := floatingips.Delete(nlbHandler.Client, floatingIP.ID).ExtractErr(); err != nil {
+			return false, err
+		}
+	}
+
+	pool, err := nlbHandler.getPool(nlbID)
+	if err != nil {
+		return false, err
+	}
+	if pool != nil {
+		if pool.MonitorID != "" {
+			if err := monitors.Delete(nlbHandler.Client, pool.MonitorID).ExtractErr(); err != nil {
+				return false, err
+			}
+			if err := nlbHandler.waitLoadBalancerActive(nlbID); err != nil {
+				return false, err
+			}
+		}
+		memberList, err := nlbHandler.listMember(pool.ID)
+		if err != nil {
+			return false, err
+		}
+		for _, member := range memberList {
+			if err := pools.DeleteMember(nlbHandler.Client, pool.ID, member.ID).ExtractErr(); err != nil {
+				return false, err
+			}
+			if err := nlbHandler.waitLoadBalancerActive(nlbID); err != nil {
+				return false, err
+			}
+		}
+		if err := pools.Delete(nlbHandler.Client, pool.ID).ExtractErr(); err != nil {
+			return false, err
+		}
+		if err := nlbHandler.waitLoadBalancerActive(nlbID); err != nil {
+			return false, err
+		}
+	}
+
+	listener, err := nlbHandler.getListener(nlbID)
+	if err != nil {
+		return false, err
+	}
+	if listener != nil {
+		if err := listeners.Delete(nlbHandler.Client, listener.ID).ExtractErr(); err != nil {
+			return false, err
+		}
+		if err := nlbHandler.waitLoadBalancerActive(nlbID); err != nil {
+			return false, err
+		}
+	}
+
+	if err := loadbalancers.Delete(nlbHandler.Client, nlbID).ExtractErr(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) AddVMs(nlbID string, vmIDs []string) (irs.VMGroupInfo, error) {
+	nlbInfo, err := nlbHandler.GetNLB(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	pool, err := nlbHandler.getPool(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	if pool == nil {
+		return irs.VMGroupInfo{}, errors.New("pool is not found in the load balancer " + nlbID)
+	}
+
+	// Member가 없으면 VM 그룹 포트를 알 수 없으므로 Listener 포트 사용
+	port := nlbInfo.VMGroup.Port
+	if port == "" {
+		port = nlbInfo.Listener.Port
+	}
+	vmGroupPort, err := strconv.Atoi(port)
+	if err != nil {
+		return irs.VMGroupInfo{}, errors.New("invalid VM group port " + port)
+	}
+	loadBalancer, err := loadbalancers.Get(nlbHandler.Client, nlbID).Extract()
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	for _, vmID := range vmIDs {
+		if nlbHandler.hasVM(nlbInfo.VMGroup.VMs, vmID) {
+			continue
+		}
+		if err := nlbHandler.addMember(nlbID, pool.ID, loadBalancer.VipSubnetID, vmID, vmGroupPort); err != nil {
+			return irs.VMGroupInfo{}, err
+		}
+	}
+
+	nlbInfo, err = nlbHandler.GetNLB(nlbID)
+	if err != nil {
+		return irs.VMGroupInfo{}, err
+	}
+	return nlbInfo.VMGroup, nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) RemoveVMs(nlbID string, vmIDs []string) (bool, error) {
+	pool, err := nlbHandler.getPool(nlbID)
+	if err != nil {
+		return false, err
+	}
+	if pool == nil {
+		return false, errors.New("pool is not found in the load balancer " + nlbID)
+	}
+
+	memberList, err := nlbHandler.listMember(pool.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range memberList {
+		if !nlbHandler.hasVM(vmIDs, member.Name) {
+			continue
+		}
+		if err := pools.DeleteMember(nlbHandler.Client, pool.ID, member.ID).ExtractErr(); err != nil {
+			return false, err
+		}
+		if err := nlbHandler.waitLoadBalancerActive(nlbID); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Member의 operating_status는 Load Balancer Status Tree에서 조회
+func (nlbHandler *OpenStackNLBHandler) GetVMGroupHealthInfo(nlbID string) (irs.HealthInfo, error) {
+	pool, err := nlbHandler.getPool(nlbID)
+	if err != nil {
+		return irs.HealthInfo{}, err
+	}
+	if pool == nil {
+		return irs.HealthInfo{}, errors.New("pool is not found in the load balancer " + nlbID)
+	}
+	memberList, err := nlbHandler.listMember(pool.ID)
+	if err != nil {
+		return irs.HealthInfo{}, err
+	}
+
+	result := loadbalancers.GetStatuses(nlbHandler.Client, nlbID)
+	if result.Err != nil {
+		return irs.HealthInfo{}, result.Err
+	}
+	body, err := json.Marshal(result.Body)
+	if err != nil {
+		return irs.HealthInfo{}, err
+	}
+	var statuses loadBalancerStatuses
+	if err := json.Unmarshal(body, &statuses); err != nil {
+		return irs.HealthInfo{}, err
+	}
+	operatingStatusMap := map[string]string{}
+	for _, listener := range statuses.Statuses.LoadBalancer.Listeners {
+		for _, pool := range listener.Pools {
+			for _, member := range pool.Members {
+				operatingStatusMap[member.ID] = member.OperatingStatus
+			}
+		}
+	}
+
+	healthInfo := irs.HealthInfo{}
+	for _, member := range memberList {
+		healthInfo.AllVMs = append(healthInfo.AllVMs, member.Name)
+		if operatingStatusMap[member.ID] == CBNLBOnline {
+			healthInfo.HealthyVMs = append(healthInfo.HealthyVMs, member.Name)
+		} else {
+			healthInfo.UnHealthyVMs = append(healthInfo.UnHealthyVMs, member.Name)
+		}
+	}
+	return healthInfo, nil
+}
+
+// Load Balancer 하위 리소스 변경은 provisioning_status가 ACTIVE일 때만 가능
+func (nlbHandler *OpenStackNLBHandler) waitLoadBalancerActive(nlbID string) error {
+	return gophercloud.WaitFor(LoadBalancerWaitTime, func() (bool, error) {
+		loadBalancer, err := loadbalancers.Get(nlbHandler.Client, nlbID).Extract()
+		if err != nil {
+			return false, err
+		}
+		if loadBalancer.ProvisioningStatus == "ERROR" {
+			return false, errors.New("load balancer " + nlbID + " provisioning status is ERROR")
+		}
+		return loadBalancer.ProvisioningStatus == CBNLBActive, nil
+	})
+}
+
+// Member 주소는 VM Port의 VIP 서브넷 IP (없으면 첫번째 Fixed IP)
+func (nlbHandler *OpenStackNLBHandler) addMember(nlbID string, poolID string, subnetID string, vmID string, port int) error {
+	pager, err := ports.List(nlbHandler.Client, ports.ListOpts{DeviceID: vmID}).AllPages()
+	if err != nil {
+		return err
+	}
+	portList, err := ports.ExtractPorts(pager)
+	if err != nil {
+		return err
+	}
+	var address string
+	for _, vmPort := range portList {
+		for _, fixedIP := range vmPort.FixedIPs {
+			if address == "" || fixedIP.SubnetID == subnetID {
+				address = fixedIP.IPAddress
+			}
+		}
+	}
+	if address == "" {
+		return errors.New("IP address is not found in the VM " + vmID)
+	}
+
+	_, err = pools.CreateAssociateMember(nlbHandler.Client, poolID, pools.MemberCreateOpts{
+		Name:         vmID,
+		Address:      address,
+		ProtocolPort: port,
+		SubnetID:     subnetID,
+		AdminStateUp: pools.Up,
+	}).ExtractMember()
+	if err != nil {
+		return err
+	}
+	return nlbHandler.waitLoadBalancerActive(nlbID)
+}
+
+func (nlbHandler *OpenStackNLBHandler) getListener(nlbID string) (*listeners.Listener, error) {
+	pager, err := listeners.List(nlbHandler.Client, listeners.ListOpts{LoadbalancerID: nlbID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	listenerList, err := listeners.ExtractListeners(pager)
+	if err != nil {
+		return nil, err
+	}
+	if len(listenerList) == 0 {
+		return nil, nil
+	}
+	return &listenerList[0], nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) getPool(nlbID string) (*pools.Pool, error) {
+	pager, err := pools.List(nlbHandler.Client, pools.ListOpts{LoadbalancerID: nlbID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	poolList, err := pools.ExtractPools(pager)
+	if err != nil {
+		return nil, err
+	}
+	if len(poolList) == 0 {
+		return nil, nil
+	}
+	return &poolList[0], nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) listMember(poolID string) ([]pools.Member, error) {
+	pager, err := pools.ListAssociateMembers(nlbHandler.Client, poolID, pools.MemberListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return pools.ExtractMembers(pager)
+}
+
+// VIP Port는 VIP 서브넷에서 VIP 주소를 가진 Port
+func (nlbHandler *OpenStackNLBHandler) getVipPortID(loadBalancer loadbalancers.LoadBalancer) (string, error) {
+	subnet, err := subnets.Get(nlbHandler.Client, loadBalancer.VipSubnetID).Extract()
+	if err != nil {
+		return "", err
+	}
+	pager, err := ports.List(nlbHandler.Client, ports.ListOpts{NetworkID: subnet.NetworkID}).AllPages()
+	if err != nil {
+		return "", err
+	}
+	portList, err := ports.ExtractPorts(pager)
+	if err != nil {
+		return "", err
+	}
+	for _, port := range portList {
+		for _, fixedIP := range port.FixedIPs {
+			if fixedIP.SubnetID == loadBalancer.VipSubnetID && fixedIP.IPAddress == loadBalancer.VipAddress {
+				return port.ID, nil
+			}
+		}
+	}
+	return "", errors.New("VIP port is not found in the load balancer " + loadBalancer.ID)
+}
+
+func (nlbHandler *OpenStackNLBHandler) getFloatingIP(loadBalancer loadbalancers.LoadBalancer) (*floatingips.FloatingIP, error) {
+	pager, err := floatingips.List(nlbHandler.Client, floatingips.ListOpts{FixedIP: loadBalancer.VipAddress}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	floatingIPList, err := floatingips.ExtractFloatingIPs(pager)
+	if err != nil {
+		return nil, err
+	}
+	vipPortID, err := nlbHandler.getVipPortID(loadBalancer)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, nil
+	}
+	for _, floatingIP := range floatingIPList {
+		if floatingIP.PortID == vipPortID {
+			return &floatingIP, nil
+		}
+	}
+	return nil, nil
+}
+
+func (nlbHandler *OpenStackNLBHandler) hasVM(vmIDs []string, vmID string) bool {
+	for _, id := range vmIDs {
+		if id == vmID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/rackspace/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/rackspace/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// OpenStack은 Swift Container를 Bucket으로 사용 (Bucket 이름 = Container 이름)
+// Presigned URL은 Swift TempURL로 생성하며, 계정에 Temp-URL-Key가 없으면 새로 생성하여 등록
+type OpenStackObjectStorageHandler struct {
+	Region idrv.RegionInfo
+	Client *gophercloud.ServiceClient
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) setterBucket(container containers.Container) *irs.BucketInfo {
+	bucketInfo := &irs.BucketInfo{
+		Name:   container.Name,
+		Region: storageHandler.Region.Region,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Bytes", Value: strconv.Itoa(container.Bytes)},
+			{Key: "Count", Value: strconv.Itoa(container.Count)},
+		},
+	}
+	return bucketInfo
+}
+
+func setterObject(object objects.Object) *irs.ObjectInfo {
+	objectInfo := &irs.ObjectInfo{
+		Key:          object.Name,
+		Size:         object.Bytes,
+		ETag:         object.Hash,
+		LastModified: object.LastModified,
+		ContentType:  object.ContentType,
+	}
+	return objectInfo
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) CreateBucket(bucketName string) (irs.BucketInfo, error) {
+	_, err := containers.Create(storageHandler.Client, bucketName, nil).Extract()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+
+	bucketList, err := storageHandler.ListBucket()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	for _, bucketInfo := range bucketList {
+		if bucketInfo.Name == bucketName {
+			return *bucketInfo, nil
+		}
+	}
+	return irs.BucketInfo{}, errors.New("failed to get container " + bucketName)
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	var bucketList []*irs.BucketInfo
+
+	pager := containers.List(storageHandler.Client, containers.ListOpts{Full: true})
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := containers.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+		for _, container := range list {
+			bucketInfo := storageHandler.setterBucket(container)
+			bucketList = append(bucketList, bucketInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bucketList, nil
+}
+
+// Swift는 비어 있는 Container만 삭제 가능 (409 Conflict)
+func (storageHandler *OpenStackObjectStorageHandler) DeleteBucket(bucketName string) (bool, error) {
+	_, err := containers.Delete(storageHandler.Client, bucketName).Extract()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) PutObject(bucketName string, objectKey string, body io.Reader, contentType string) (irs.ObjectInfo, error) {
+	// gophercloud의 objects.Create는 io.ReadSeeker만 지원하므로 필요한 경우 메모리에 버퍼링
+	content, ok := body.(io.ReadSeeker)
+	if !ok {
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			return irs.ObjectInfo{}, err
+		}
+		content = bytes.NewReader(data)
+	}
+
+	createOpts := objects.CreateOpts{
+		ContentType: contentType,
+	}
+	_, err := objects.Create(storageHandler.Client, bucketName, objectKey, content, createOpts).Extract()
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+
+	header, err := objects.Get(storageHandler.Client, bucketName, objectKey, nil).Extract()
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+
+	objectInfo := irs.ObjectInfo{
+		Key:          objectKey,
+		Size:         header.ContentLength,
+		ETag:         header.ETag,
+		LastModified: header.LastModified.Format(time.RFC3339),
+		ContentType:  header.ContentType,
+	}
+	return objectInfo, nil
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) GetObject(bucketName string, objectKey string) (io.ReadCloser, irs.ObjectInfo, error) {
+	result := objects.Download(storageHandler.Client, bucketName, objectKey, nil)
+	header, err := result.Extract()
+	if err != nil {
+		if result.Body != nil {
+			result.Body.Close()
+		}
+		return nil, irs.ObjectInfo{}, err
+	}
+
+	objectInfo := irs.ObjectInfo{
+		Key:          objectKey,
+		Size:         header.ContentLength,
+		ETag:         header.ETag,
+		LastModified: header.LastModified.Format(time.RFC3339),
+		ContentType:  header.ContentType,
+	}
+	return result.Body, objectInfo, nil
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) ListObject(bucketName string, prefix string) ([]*irs.ObjectInfo, error) {
+	var objectList []*irs.ObjectInfo
+
+	pager := objects.List(storageHandler.Client, bucketName, objects.ListOpts{Full: true, Prefix: prefix})
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := objects.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+		for _, object := range list {
+			objectInfo := setterObject(object)
+			objectList = append(objectList, objectInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objectList, nil
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) DeleteObject(bucketName string, objectKey string) (bool, error) {
+	_, err := objects.Delete(storageHandler.Client, bucketName, objectKey, nil).Extract()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (storageHandler *OpenStackObjectStorageHandler) GetPresignedURL(bucketName string, objectKey string, method string, expiresSeconds int) (string, error) {
+	method = strings.ToUpper(method)
+	if method != irs.PresignedURLGet && method != irs.PresignedURLPut {
+		return "", errors.New("unsupported method " + method + ", GET or PUT")
+	}
+
+	if err := storageHandler.setTempURLKey(); err != nil {
+		return "", err
+	}
+
+	tempURL, err := objects.CreateTempURL(storageHandler.Client, bucketName, objectKey, objects.CreateTempURLOpts{
+		Method: objects.HTTPMethod(method),
+		TTL:    expiresSeconds,
+	})
+	if err != nil {
+		return "", err
+	}
+	return tempURL, nil
+}
+
+// 계정에 Temp-URL-Key가 등록되어 있지 않으면 임의의 키를 생성하여 등록
+func (storageHandler *OpenStackObjectStorageHandler) setTempURLKey() error {
+	header, err := accounts.Get(storageHandler.Client, nil).Extract()
+	if err != nil {
+		return err
+	}
+	if header.TempURLKey != "" {
+		return nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	_, err = accounts.Update(storageHandler.Client, accounts.UpdateOpts{TempURLKey: hex.EncodeToString(key)}).Extract()
+	return err
+}
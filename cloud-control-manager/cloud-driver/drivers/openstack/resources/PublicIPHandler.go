@@ -8,7 +8,8 @@ import (
 )
 
 type OpenStackPublicIPHandler struct {
-	Client *gophercloud.ServiceClient
+	Client        *gophercloud.ServiceClient
+	NetworkClient *gophercloud.ServiceClient
 }
 
 func setterPublicIP(publicIP floatingip.FloatingIP) *irs.PublicIPInfo {
@@ -21,6 +22,9 @@ func setterPublicIP(publicIP floatingip.FloatingIP) *irs.PublicIPInfo {
 }
 
 func (publicIPHandler *OpenStackPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
+	if err := checkTagList(publicIPReqInfo.TagList); err != nil {
+		return irs.PublicIPInfo{}, err
+	}
 
 	createOpts := floatingip.CreateOpts{
 		Pool: CBPublicIPPool,
@@ -30,6 +34,12 @@ func (publicIPHandler *OpenStackPublicIPHandler) CreatePublicIP(publicIPReqInfo
 		return irs.PublicIPInfo{}, err
 	}
 
+	// Tag 설정 실패 시 생성한 PublicIP 삭제
+	if err := addNeutronTagList(publicIPHandler.NetworkClient, irs.RSTypePublicIP, publicIP.ID, publicIPReqInfo.TagList); err != nil {
+		floatingip.Delete(publicIPHandler.Client, publicIP.ID)
+		return irs.PublicIPInfo{}, err
+	}
+
 	// 생성된 PublicIP 정보 리턴
 	publicIPInfo, err := publicIPHandler.GetPublicIP(publicIP.ID)
 	if err != nil {
@@ -1,94 +1,81 @@
 package resources
 
 import (
-	//irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/ports"
 	"github.com/rackspace/gophercloud/pagination"
 )
 
+const (
+	CBRouterInterfaceOwner = "network:router_interface"
+)
+
+// Router는 Neutron Router, Route는 Router의 Extra Route(Next Hop IP), Internet Gateway는 외부 네트워크(CBGateWayId) Gateway로 매핑
 type OpenStackRouterHandler struct {
 	Client *gophercloud.ServiceClient
 }
 
-// @TODO: Router 생성 요청 파라미터 정의 필요
-type RouterReqInfo struct {
-	Name         string
-	GateWayId    string
-	AdminStateUp bool
-}
-
-// @TODO: Router 리소스 프로퍼티 정의 필요
-type RouteInfo struct {
-	NextHop         string
-	DestinationCIDR string
-}
-type RouterInfo struct {
-	Id           string
-	Name         string
-	TenantId     string
-	AdminStateUp bool
-	Distributed  bool
-	Routes       []RouteInfo
-}
-
-// @TODO: Interface 생성 요청 파라미터 정의 필요
-type InterfaceReqInfo struct {
-	RouterId string
-	SubnetId string
-}
-
-// @TODO: Interface 리소스 프로퍼티 정의 필요
-type InterfaceInfo struct {
-	Id   string
-	Name string
-}
-
-func (routerInfo *RouterInfo) setter(router routers.Router) *RouterInfo {
-	routerInfo.Id = router.ID
-	routerInfo.Name = router.Name
-	router.TenantID = router.TenantID
-	router.AdminStateUp = router.AdminStateUp
-	router.Distributed = router.Distributed
-
-	var routeArr []RouteInfo
+func (routerHandler *OpenStackRouterHandler) setterRouter(router routers.Router) (irs.RouterInfo, error) {
+	routerInfo := irs.RouterInfo{
+		Id:              router.ID,
+		Name:            router.Name,
+		InternetGateway: router.GatewayInfo.NetworkID != "",
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: router.Status},
+			{Key: "GatewayNetworkId", Value: router.GatewayInfo.NetworkID},
+			{Key: "Distributed", Value: fmt.Sprintf("%v", router.Distributed)},
+		},
+	}
 
 	for _, route := range router.Routes {
-		routeInfo := RouteInfo{
-			NextHop:         route.NextHop,
+		routerInfo.RouteList = append(routerInfo.RouteList, irs.RouteInfo{
 			DestinationCIDR: route.DestinationCIDR,
-		}
-		routeArr = append(routeArr, routeInfo)
+			TargetType:      irs.RouteTargetIP,
+			TargetId:        route.NextHop,
+		})
 	}
 
-	routerInfo.Routes = routeArr
-
-	return routerInfo
+	// Router Interface(Port)에 연결된 서브넷 목록
+	portList, err := routerHandler.listInterfacePort(router.ID)
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	for _, port := range portList {
+		routerInfo.VPCId = port.NetworkID
+		for _, fixedIP := range port.FixedIPs {
+			routerInfo.SubnetIdList = append(routerInfo.SubnetIdList, fixedIP.SubnetID)
+		}
+	}
+	return routerInfo, nil
 }
 
-func (routerHandler *OpenStackRouterHandler) CreateRouter(routerReqInfo RouterReqInfo) (RouterInfo, error) {
-
+func (routerHandler *OpenStackRouterHandler) CreateRouter(routerReqInfo irs.RouterReqInfo) (irs.RouterInfo, error) {
 	createOpts := routers.CreateOpts{
 		Name:         routerReqInfo.Name,
-		AdminStateUp: &routerReqInfo.AdminStateUp,
-		GatewayInfo: &routers.GatewayInfo{
-			NetworkID: routerReqInfo.GateWayId,
-		},
+		AdminStateUp: to.BoolPtr(true),
+	}
+	if routerReqInfo.InternetGateway {
+		createOpts.GatewayInfo = &routers.GatewayInfo{
+			NetworkID: CBGateWayId,
+		}
 	}
 
 	// Create Router
 	router, err := routers.Create(routerHandler.Client, createOpts).Extract()
 	if err != nil {
-		return RouterInfo{}, err
+		return irs.RouterInfo{}, err
 	}
-
-	spew.Dump(router)
-	return RouterInfo{Id: router.ID, Name: router.Name}, nil
+	return routerHandler.setterRouter(*router)
 }
 
-func (routerHandler *OpenStackRouterHandler) ListRouter() ([]*RouterInfo, error) {
-	var routerInfoList []*RouterInfo
+func (routerHandler *OpenStackRouterHandler) ListRouter() ([]*irs.RouterInfo, error) {
+	var routerInfoList []*irs.RouterInfo
 
 	pager := routers.List(routerHandler.Client, routers.ListOpts{})
 	err := pager.EachPage(func(page pagination.Page) (b bool, e error) {
@@ -98,52 +85,111 @@ func (routerHandler *OpenStackRouterHandler) ListRouter() ([]*RouterInfo, error)
 			return false, err
 		}
 		for _, r := range list {
-			routerInfo := new(RouterInfo).setter(r)
-			routerInfoList = append(routerInfoList, routerInfo)
+			routerInfo, err := routerHandler.setterRouter(r)
+			if err != nil {
+				return false, err
+			}
+			routerInfoList = append(routerInfoList, &routerInfo)
 		}
 		return true, nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	return routerInfoList, nil
+}
+
+func (routerHandler *OpenStackRouterHandler) GetRouter(routerID string) (irs.RouterInfo, error) {
+	router, err := routers.Get(routerHandler.Client, routerID).Extract()
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.setterRouter(*router)
+}
+
+// Router Interface가 남아 있으면 삭제할 수 없으므로 먼저 Interface를 삭제
+func (routerHandler *OpenStackRouterHandler) DeleteRouter(routerID string) (bool, error) {
+	routerInfo, err := routerHandler.GetRouter(routerID)
+	if err != nil {
+		return false, err
+	}
+	for _, subnetID := range routerInfo.SubnetIdList {
+		if _, err := routerHandler.DeleteInterface(routerID, subnetID); err != nil {
+			return false, err
+		}
+	}
 
-	spew.Dump(routerInfoList)
-	return nil, nil
+	err = routers.Delete(routerHandler.Client, routerID).ExtractErr()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (routerHandler *OpenStackRouterHandler) GetRouter(routerID string) (RouterInfo, error) {
+// OpenStack Extra Route는 Next Hop IP만 지원하므로 IP Type만 허용
+func (routerHandler *OpenStackRouterHandler) AddRoute(routerID string, routeInfo irs.RouteInfo) (irs.RouterInfo, error) {
+	if routeInfo.TargetType != irs.RouteTargetIP {
+		return irs.RouterInfo{}, errors.New(fmt.Sprintf("OpenStack does not support the route target type %s, use %s with the next hop IP", routeInfo.TargetType, irs.RouteTargetIP))
+	}
+
 	router, err := routers.Get(routerHandler.Client, routerID).Extract()
 	if err != nil {
-		return RouterInfo{}, err
+		return irs.RouterInfo{}, err
 	}
 
-	routerInfo := new(RouterInfo).setter(*router)
+	// 같은 목적지의 Route는 교체
+	routeList := []routers.Route{}
+	for _, route := range router.Routes {
+		if route.DestinationCIDR != routeInfo.DestinationCIDR {
+			routeList = append(routeList, route)
+		}
+	}
+	routeList = append(routeList, routers.Route{
+		DestinationCIDR: routeInfo.DestinationCIDR,
+		NextHop:         routeInfo.TargetId,
+	})
 
-	spew.Dump(routerInfo)
-	return RouterInfo{}, nil
+	router, err = routers.Update(routerHandler.Client, routerID, routers.UpdateOpts{Routes: routeList}).Extract()
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.setterRouter(*router)
 }
 
-func (routerHandler *OpenStackRouterHandler) DeleteRouter(routerID string) (bool, error) {
-	err := routers.Delete(routerHandler.Client, routerID).ExtractErr()
+func (routerHandler *OpenStackRouterHandler) RemoveRoute(routerID string, destinationCIDR string) (bool, error) {
+	router, err := routers.Get(routerHandler.Client, routerID).Extract()
+	if err != nil {
+		return false, err
+	}
+
+	routeList := []routers.Route{}
+	for _, route := range router.Routes {
+		if route.DestinationCIDR != destinationCIDR {
+			routeList = append(routeList, route)
+		}
+	}
+	if len(routeList) == len(router.Routes) {
+		return false, errors.New(fmt.Sprintf("route to %s is not found in the router %s", destinationCIDR, routerID))
+	}
+
+	_, err = routers.Update(routerHandler.Client, routerID, routers.UpdateOpts{Routes: routeList}).Extract()
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (routerHandler *OpenStackRouterHandler) AddInterface(interfaceReqInfo InterfaceReqInfo) (InterfaceInfo, error) {
+func (routerHandler *OpenStackRouterHandler) AddInterface(routerID string, subnetID string) (irs.RouterInfo, error) {
 	createOpts := routers.InterfaceOpts{
-		SubnetID: interfaceReqInfo.SubnetId,
+		SubnetID: subnetID,
 	}
 
 	// Add Interface
-	ir, err := routers.AddInterface(routerHandler.Client, interfaceReqInfo.RouterId, createOpts).Extract()
+	_, err := routers.AddInterface(routerHandler.Client, routerID, createOpts).Extract()
 	if err != nil {
-		return InterfaceInfo{}, err
+		return irs.RouterInfo{}, err
 	}
-
-	spew.Dump(ir)
-	return InterfaceInfo{}, nil
+	return routerHandler.GetRouter(routerID)
 }
 
 func (routerHandler *OpenStackRouterHandler) DeleteInterface(routerID string, subnetID string) (bool, error) {
@@ -152,11 +198,57 @@ func (routerHandler *OpenStackRouterHandler) DeleteInterface(routerID string, su
 	}
 
 	// Delete Interface
-	ir, err := routers.RemoveInterface(routerHandler.Client, routerID, deleteOpts).Extract()
+	_, err := routers.RemoveInterface(routerHandler.Client, routerID, deleteOpts).Extract()
 	if err != nil {
 		return false, err
 	}
+	return true, nil
+}
+
+func (routerHandler *OpenStackRouterHandler) AttachInternetGateway(routerID string) (irs.RouterInfo, error) {
+	router, err := routers.Get(routerHandler.Client, routerID).Extract()
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
 
-	spew.Dump(ir)
+	// Update 요청 시 Routes가 항상 전달되므로 기존 Route를 유지
+	routeList := []routers.Route{}
+	routeList = append(routeList, router.Routes...)
+	updateOpts := routers.UpdateOpts{
+		GatewayInfo: &routers.GatewayInfo{NetworkID: CBGateWayId},
+		Routes:      routeList,
+	}
+	router, err = routers.Update(routerHandler.Client, routerID, updateOpts).Extract()
+	if err != nil {
+		return irs.RouterInfo{}, err
+	}
+	return routerHandler.setterRouter(*router)
+}
+
+// routers.UpdateOpts로는 Gateway를 비울 수 없으므로 빈 external_gateway_info를 직접 요청
+func (routerHandler *OpenStackRouterHandler) DetachInternetGateway(routerID string) (bool, error) {
+	reqBody := map[string]interface{}{
+		"router": map[string]interface{}{
+			"external_gateway_info": map[string]interface{}{},
+		},
+	}
+	_, err := routerHandler.Client.Put(routerHandler.Client.ServiceURL("routers", routerID), reqBody, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return false, err
+	}
 	return true, nil
 }
+
+func (routerHandler *OpenStackRouterHandler) listInterfacePort(routerID string) ([]ports.Port, error) {
+	listOpts := ports.ListOpts{
+		DeviceID:    routerID,
+		DeviceOwner: CBRouterInterfaceOwner,
+	}
+	pager, err := ports.List(routerHandler.Client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ports.ExtractPorts(pager)
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/rackspace/gophercloud/pagination"
 	"strconv"
+	"strings"
 )
 
 type OpenStackSecurityHandler struct {
-	Client *gophercloud.ServiceClient
+	Client        *gophercloud.ServiceClient
+	NetworkClient *gophercloud.ServiceClient
 }
 
 func setterSeg(secGroup secgroups.SecurityGroup) *irs.SecurityInfo {
@@ -27,6 +29,10 @@ func setterSeg(secGroup secgroups.SecurityGroup) *irs.SecurityInfo {
 			FromPort:   strconv.Itoa(rule.FromPort),
 			ToPort:     strconv.Itoa(rule.ToPort),
 			IPProtocol: rule.IPProtocol,
+			Direction:  "inbound",
+			CIDR:       rule.IPRange.CIDR,
+			// Nova API는 Source 보안그룹의 이름만 제공
+			SourceSecurityGroupId: rule.Group.Name,
 		}
 		secRuleList = append(secRuleList, ruleInfo)
 	}
@@ -49,6 +55,9 @@ func (securityHandler *OpenStackSecurityHandler) CreateSecurity(securityReqInfo
 			return irs.SecurityInfo{}, createErr
 		}
 	}
+	if err := checkTagList(securityReqInfo.TagList); err != nil {
+		return irs.SecurityInfo{}, err
+	}
 
 	// Create SecurityGroup
 	createOpts := secgroups.CreateOpts{
@@ -60,23 +69,16 @@ func (securityHandler *OpenStackSecurityHandler) CreateSecurity(securityReqInfo
 		return irs.SecurityInfo{}, err
 	}
 
-	// Create SecurityGroup Rules
-	for _, rule := range *securityReqInfo.SecurityRules {
-		fromPort, _ := strconv.Atoi(rule.FromPort)
-		toPort, _ := strconv.Atoi(rule.ToPort)
-
-		createRuleOpts := secgroups.CreateRuleOpts{
-			FromPort:      fromPort,
-			ToPort:        toPort,
-			IPProtocol:    rule.IPProtocol,
-			CIDR:          "0.0.0.0/0",
-			ParentGroupID: group.ID,
-		}
+	// Tag 설정 실패 시 생성한 SecurityGroup 삭제
+	if err := addNeutronTagList(securityHandler.NetworkClient, irs.RSTypeSecurityGroup, group.ID, securityReqInfo.TagList); err != nil {
+		secgroups.Delete(securityHandler.Client, group.ID)
+		return irs.SecurityInfo{}, err
+	}
 
-		_, err := secgroups.CreateRule(securityHandler.Client, createRuleOpts).Extract()
-		if err != nil {
-			return irs.SecurityInfo{}, err
-		}
+	// Create SecurityGroup Rules
+	err = securityHandler.createRules(group.ID, securityReqInfo.SecurityRules)
+	if err != nil {
+		return irs.SecurityInfo{}, err
 	}
 
 	// 생성된 SecurityGroup 정보 리턴
@@ -127,3 +129,107 @@ func (securityHandler *OpenStackSecurityHandler) DeleteSecurity(securityID strin
 	}
 	return true, nil
 }
+
+func (securityHandler *OpenStackSecurityHandler) AddRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	err := securityHandler.createRules(securityID, securityRules)
+	if err != nil {
+		return irs.SecurityInfo{}, err
+	}
+	return securityHandler.GetSecurity(securityID)
+}
+
+func (securityHandler *OpenStackSecurityHandler) RemoveRules(securityID string, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	securityGroup, err := secgroups.Get(securityHandler.Client, securityID).Extract()
+	if err != nil {
+		return false, err
+	}
+	if securityRules == nil {
+		return true, nil
+	}
+
+	// 삭제할 룰 ID 조회
+	var ruleIds []string
+	for _, rule := range *securityRules {
+		sourceGroupName := ""
+		if rule.SourceSecurityGroupId != "" {
+			sourceGroup, err := secgroups.Get(securityHandler.Client, rule.SourceSecurityGroupId).Extract()
+			if err != nil {
+				return false, err
+			}
+			sourceGroupName = sourceGroup.Name
+		}
+
+		ruleId := ""
+		for _, sgRule := range securityGroup.Rules {
+			if !strings.EqualFold(sgRule.IPProtocol, rule.IPProtocol) ||
+				strconv.Itoa(sgRule.FromPort) != rule.FromPort || strconv.Itoa(sgRule.ToPort) != rule.ToPort {
+				continue
+			}
+			if sourceGroupName != "" {
+				if sgRule.Group.Name == sourceGroupName {
+					ruleId = sgRule.ID
+				}
+			} else if sgRule.IPRange.CIDR == getRuleCIDR(rule) {
+				ruleId = sgRule.ID
+			}
+			if ruleId != "" {
+				break
+			}
+		}
+		if ruleId == "" {
+			errMsg := fmt.Sprintf("Security Group %s has no rule for %s %s-%s", securityID, rule.IPProtocol, rule.FromPort, rule.ToPort)
+			return false, errors.New(errMsg)
+		}
+		ruleIds = append(ruleIds, ruleId)
+	}
+
+	// 보안그룹 룰 삭제
+	for _, ruleId := range ruleIds {
+		result := secgroups.DeleteRule(securityHandler.Client, ruleId)
+		if result.Err != nil {
+			return false, result.Err
+		}
+	}
+	return true, nil
+}
+
+func (securityHandler *OpenStackSecurityHandler) createRules(securityID string, securityRules *[]irs.SecurityRuleInfo) error {
+	if securityRules == nil {
+		return nil
+	}
+
+	for _, rule := range *securityRules {
+		// Nova 보안그룹은 Inbound 룰만 지원
+		if strings.EqualFold(rule.Direction, "outbound") || strings.EqualFold(rule.Direction, "egress") {
+			return errors.New("OpenStack security group supports the inbound rules only")
+		}
+
+		fromPort, _ := strconv.Atoi(rule.FromPort)
+		toPort, _ := strconv.Atoi(rule.ToPort)
+
+		createRuleOpts := secgroups.CreateRuleOpts{
+			FromPort:      fromPort,
+			ToPort:        toPort,
+			IPProtocol:    rule.IPProtocol,
+			ParentGroupID: securityID,
+		}
+		if rule.SourceSecurityGroupId != "" {
+			createRuleOpts.FromGroupID = rule.SourceSecurityGroupId
+		} else {
+			createRuleOpts.CIDR = getRuleCIDR(rule)
+		}
+
+		_, err := secgroups.CreateRule(securityHandler.Client, createRuleOpts).Extract()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getRuleCIDR(rule irs.SecurityRuleInfo) string {
+	if rule.CIDR == "" {
+		return "0.0.0.0/0"
+	}
+	return rule.CIDR
+}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/volumeattach"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+type OpenStackSnapshotHandler struct {
+	Client       *gophercloud.ServiceClient
+	VolumeClient *gophercloud.ServiceClient
+}
+
+// gophercloud에는 Cinder v1 스냅샷만 있어서 v2 응답(name, description)을 직접 매핑
+type volumeSnapshot struct {
+	ID          string            `mapstructure:"id"`
+	Name        string            `mapstructure:"name"`
+	Description string            `mapstructure:"description"`
+	VolumeID    string            `mapstructure:"volume_id"`
+	Status      string            `mapstructure:"status"`
+	Size        int               `mapstructure:"size"`
+	CreatedAt   string            `mapstructure:"created_at"`
+	Metadata    map[string]string `mapstructure:"metadata"`
+}
+
+func setterSnapshot(snapshot volumeSnapshot) *irs.SnapshotInfo {
+	snapshotInfo := &irs.SnapshotInfo{
+		Id:       snapshot.ID,
+		Name:     snapshot.Name,
+		DiskSize: strconv.Itoa(snapshot.Size),
+	}
+
+	// Status : creating | available | deleting | error | error_deleting ...
+	switch snapshot.Status {
+	case "creating":
+		snapshotInfo.Status = irs.SnapshotCreating
+	case "available":
+		snapshotInfo.Status = irs.SnapshotAvailable
+	case "deleting":
+		snapshotInfo.Status = irs.SnapshotDeleting
+	default:
+		snapshotInfo.Status = irs.SnapshotError
+	}
+
+	snapshotInfo.KeyValueList = []irs.KeyValue{
+		{Key: irs.SnapshotSourceDiskKey, Value: snapshot.VolumeID},
+	}
+	if vmID, ok := snapshot.Metadata[irs.SnapshotSourceVMKey]; ok {
+		snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: irs.SnapshotSourceVMKey, Value: vmID})
+	}
+	// ex) 2019-11-20T08:01:20.000000
+	if createdTime, err := time.Parse("2006-01-02T15:04:05.000000", snapshot.CreatedAt); err == nil {
+		snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: irs.SnapshotCreatedTimeKey, Value: createdTime.Format(time.RFC3339)})
+	}
+	snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: "Status", Value: snapshot.Status})
+	snapshotInfo.KeyValueList = append(snapshotInfo.KeyValueList, irs.KeyValue{Key: "Description", Value: snapshot.Description})
+	return snapshotInfo
+}
+
+// VM 스냅샷은 VM의 부트 볼륨 스냅샷으로 생성 (볼륨으로 부팅한 VM만 가능)
+func (snapshotHandler *OpenStackSnapshotHandler) CreateSnapshot(snapshotReqInfo irs.SnapshotReqInfo) (irs.SnapshotInfo, error) {
+	volumeID, err := snapshotHandler.getSourceVolumeID(snapshotReqInfo)
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+
+	metadata := map[string]string{}
+	if snapshotReqInfo.SourceType == irs.SnapshotSourceVM {
+		metadata[irs.SnapshotSourceVMKey] = snapshotReqInfo.SourceId
+	}
+	reqBody := map[string]interface{}{
+		"snapshot": map[string]interface{}{
+			"volume_id": volumeID,
+			"name":      snapshotReqInfo.Name,
+			"force":     true, // in-use 볼륨도 허용
+			"metadata":  metadata,
+		},
+	}
+
+	var result interface{}
+	_, err = snapshotHandler.VolumeClient.Post(snapshotHandler.VolumeClient.ServiceURL("snapshots"), reqBody, &result, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+	snapshot, err := extractSnapshot(result)
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+
+	err = gophercloud.WaitFor(VolumeWaitTime, func() (bool, error) {
+		current, err := snapshotHandler.getSnapshot(snapshot.ID)
+		if err != nil {
+			return false, err
+		}
+		if current.Status == "error" {
+			return false, errors.New("snapshot " + snapshot.ID + " is in error status")
+		}
+		return current.Status == "available", nil
+	})
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+	return snapshotHandler.GetSnapshot(snapshot.ID)
+}
+
+func (snapshotHandler *OpenStackSnapshotHandler) ListSnapshot() ([]*irs.SnapshotInfo, error) {
+	var result interface{}
+	_, err := snapshotHandler.VolumeClient.Get(snapshotHandler.VolumeClient.ServiceURL("snapshots", "detail"), &result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Snapshots []volumeSnapshot `mapstructure:"snapshots"`
+	}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return nil, err
+	}
+
+	var snapshotList []*irs.SnapshotInfo
+	for _, snapshot := range response.Snapshots {
+		snapshotInfo := setterSnapshot(snapshot)
+		snapshotList = append(snapshotList, snapshotInfo)
+	}
+	return snapshotList, nil
+}
+
+func (snapshotHandler *OpenStackSnapshotHandler) GetSnapshot(snapshotID string) (irs.SnapshotInfo, error) {
+	snapshot, err := snapshotHandler.getSnapshot(snapshotID)
+	if err != nil {
+		return irs.SnapshotInfo{}, err
+	}
+
+	snapshotInfo := setterSnapshot(snapshot)
+	return *snapshotInfo, nil
+}
+
+func (snapshotHandler *OpenStackSnapshotHandler) DeleteSnapshot(snapshotID string) (bool, error) {
+	_, err := snapshotHandler.VolumeClient.Delete(snapshotHandler.VolumeClient.ServiceURL("snapshots", snapshotID), nil)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (snapshotHandler *OpenStackSnapshotHandler) CreateDiskFromSnapshot(snapshotID string, diskReqInfo irs.DiskReqInfo) (irs.DiskInfo, error) {
+	snapshot, err := snapshotHandler.getSnapshot(snapshotID)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	size := snapshot.Size
+	if diskReqInfo.DiskSize != "" {
+		size, err = strconv.Atoi(diskReqInfo.DiskSize)
+		if err != nil {
+			errMsg := fmt.Sprintf("DiskSize %s is not a valid number", diskReqInfo.DiskSize)
+			return irs.DiskInfo{}, errors.New(errMsg)
+		}
+	}
+
+	metadata, err := getMetadata(diskReqInfo.TagList)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	createOpts := volumes.CreateOpts{
+		Name:             diskReqInfo.Name,
+		Size:             size,
+		VolumeType:       diskReqInfo.DiskType,
+		AvailabilityZone: diskReqInfo.Zone,
+		SnapshotID:       snapshotID,
+		Metadata:         metadata,
+	}
+	volume, err := volumes.Create(snapshotHandler.VolumeClient, createOpts).Extract()
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	diskHandler := OpenStackDiskHandler{snapshotHandler.Client, snapshotHandler.VolumeClient}
+	if err := volumes.WaitForStatus(snapshotHandler.VolumeClient, volume.ID, "available", VolumeWaitTime); err != nil {
+		return irs.DiskInfo{}, err
+	}
+	return diskHandler.GetDisk(volume.ID)
+}
+
+func (snapshotHandler *OpenStackSnapshotHandler) getSnapshot(snapshotID string) (volumeSnapshot, error) {
+	var result interface{}
+	_, err := snapshotHandler.VolumeClient.Get(snapshotHandler.VolumeClient.ServiceURL("snapshots", snapshotID), &result, nil)
+	if err != nil {
+		return volumeSnapshot{}, err
+	}
+	return extractSnapshot(result)
+}
+
+// 스냅샷 대상 볼륨 ID (VM인 경우 루트 디바이스(/dev/vda 등)에 연결된 볼륨)
+func (snapshotHandler *OpenStackSnapshotHandler) getSourceVolumeID(snapshotReqInfo irs.SnapshotReqInfo) (string, error) {
+	switch snapshotReqInfo.SourceType {
+	case irs.SnapshotSourceDisk, "":
+		return snapshotReqInfo.SourceId, nil
+	case irs.SnapshotSourceVM:
+	default:
+		errMsg := fmt.Sprintf("SourceType %s is not valid", snapshotReqInfo.SourceType)
+		return "", errors.New(errMsg)
+	}
+
+	var volumeID string
+	pager := volumeattach.List(snapshotHandler.Client, snapshotReqInfo.SourceId)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := volumeattach.ExtractVolumeAttachments(page)
+		if err != nil {
+			return false, err
+		}
+		for _, attachment := range list {
+			if strings.HasSuffix(attachment.Device, "da") {
+				volumeID = attachment.VolumeID
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if volumeID == "" {
+		errMsg := fmt.Sprintf("VM %s is not booted from a volume", snapshotReqInfo.SourceId)
+		return "", errors.New(errMsg)
+	}
+	return volumeID, nil
+}
+
+func extractSnapshot(result interface{}) (volumeSnapshot, error) {
+	var response struct {
+		Snapshot volumeSnapshot `mapstructure:"snapshot"`
+	}
+	err := mapstructure.WeakDecode(result, &response)
+	return response.Snapshot, err
+}
@@ -0,0 +1,336 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Neutron Tag는 Key/Value 구분이 없는 문자열이므로 "Key=Value" 형식으로 저장
+const CBNeutronTagSeparator = "="
+
+// VM: Server Metadata, Disk: Volume Metadata
+// VPC(Network), VNetwork(Subnet), SecurityGroup, PublicIP(Floating IP): Neutron Tag (standard-attr-tag 확장 필요)
+type OpenStackTagHandler struct {
+	Client        *gophercloud.ServiceClient
+	VolumeClient  *gophercloud.ServiceClient
+	NetworkClient *gophercloud.ServiceClient
+}
+
+// Neutron 리소스의 URL 경로와 응답의 단수, 복수 키
+type neutronTagResource struct {
+	Path      string
+	SingleKey string
+	PluralKey string
+}
+
+var neutronTagResources = map[irs.RSType]neutronTagResource{
+	irs.RSTypeVPC:           {"networks", "network", "networks"},
+	irs.RSTypeVNetwork:      {"subnets", "subnet", "subnets"},
+	irs.RSTypeSecurityGroup: {"security-groups", "security_group", "security_groups"},
+	irs.RSTypePublicIP:      {"floatingips", "floatingip", "floatingips"},
+}
+
+type neutronTaggedResource struct {
+	ID   string   `mapstructure:"id"`
+	Tags []string `mapstructure:"tags"`
+}
+
+// keyword가 Tag의 Key 또는 Value에 포함되면 true (""과 "*"은 모든 Tag)
+func matchTagKeyword(tag irs.KeyValue, keyword string) bool {
+	if keyword == "" || keyword == "*" {
+		return true
+	}
+	return strings.Contains(tag.Key, keyword) || strings.Contains(tag.Value, keyword)
+}
+
+func mappingMetadataTagList(metadata map[string]string) []irs.KeyValue {
+	tagList := []irs.KeyValue{}
+	for key, value := range metadata {
+		tagList = append(tagList, irs.KeyValue{Key: key, Value: value})
+	}
+	return tagList
+}
+
+func mappingNeutronTagList(tags []string) []irs.KeyValue {
+	tagList := []irs.KeyValue{}
+	for _, tag := range tags {
+		keyValue := strings.SplitN(tag, CBNeutronTagSeparator, 2)
+		if len(keyValue) == 2 {
+			tagList = append(tagList, irs.KeyValue{Key: keyValue[0], Value: keyValue[1]})
+		} else {
+			tagList = append(tagList, irs.KeyValue{Key: keyValue[0]})
+		}
+	}
+	return tagList
+}
+
+// 리소스 생성 요청의 사용자 Tag(TagList)를 Server, Volume Metadata로 변환 (사용자 Tag가 없으면 nil)
+func getMetadata(tagList []irs.KeyValue) (map[string]string, error) {
+	if len(tagList) == 0 {
+		return nil, nil
+	}
+	metadata := map[string]string{}
+	for _, tag := range tagList {
+		if tag.Key == "" {
+			return nil, errors.New("tag key is required")
+		}
+		metadata[tag.Key] = tag.Value
+	}
+	return metadata, nil
+}
+
+func checkTagList(tagList []irs.KeyValue) error {
+	_, err := getMetadata(tagList)
+	return err
+}
+
+// Neutron 리소스는 생성 요청에 Tag를 전달할 수 없어서 생성 직후 사용자 Tag를 설정
+func addNeutronTagList(networkClient *gophercloud.ServiceClient, resType irs.RSType, resId string, tagList []irs.KeyValue) error {
+	tagHandler := OpenStackTagHandler{NetworkClient: networkClient}
+	for _, tag := range tagList {
+		if err := tagHandler.putNeutronTag(resType, resId, tag.Key+CBNeutronTagSeparator+tag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getNeutronTagResource(resType irs.RSType) (neutronTagResource, error) {
+	resource, ok := neutronTagResources[resType]
+	if !ok {
+		return neutronTagResource{}, errors.New(fmt.Sprintf("resource type %s is not supported", resType))
+	}
+	return resource, nil
+}
+
+func (tagHandler *OpenStackTagHandler) listTagMap(resType irs.RSType, resId string) (map[string]string, error) {
+	if resId == "" {
+		return nil, errors.New("resource id is required")
+	}
+
+	switch resType {
+	case irs.RSTypeVM:
+		return servers.Metadata(tagHandler.Client, resId).Extract()
+	case irs.RSTypeDisk:
+		volume, err := volumes.Get(tagHandler.VolumeClient, resId).Extract()
+		if err != nil {
+			return nil, err
+		}
+		return volume.Metadata, nil
+	default:
+		tags, err := tagHandler.getNeutronTags(resType, resId)
+		if err != nil {
+			return nil, err
+		}
+		tagMap := map[string]string{}
+		for _, tag := range mappingNeutronTagList(tags) {
+			tagMap[tag.Key] = tag.Value
+		}
+		return tagMap, nil
+	}
+}
+
+func (tagHandler *OpenStackTagHandler) getNeutronTags(resType irs.RSType, resId string) ([]string, error) {
+	resource, err := getNeutronTagResource(resType)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	_, err = tagHandler.NetworkClient.Get(tagHandler.NetworkClient.ServiceURL(resource.Path, resId), &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	response := map[string]neutronTaggedResource{}
+	if err := mapstructure.WeakDecode(result, &response); err != nil {
+		return nil, err
+	}
+	return response[resource.SingleKey].Tags, nil
+}
+
+func (tagHandler *OpenStackTagHandler) putNeutronTag(resType irs.RSType, resId string, tag string) error {
+	resource, err := getNeutronTagResource(resType)
+	if err != nil {
+		return err
+	}
+	_, err = tagHandler.NetworkClient.Put(tagHandler.NetworkClient.ServiceURL(resource.Path, resId, "tags", url.PathEscape(tag)), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func (tagHandler *OpenStackTagHandler) deleteNeutronTag(resType irs.RSType, resId string, tag string) error {
+	resource, err := getNeutronTagResource(resType)
+	if err != nil {
+		return err
+	}
+	_, err = tagHandler.NetworkClient.Delete(tagHandler.NetworkClient.ServiceURL(resource.Path, resId, "tags", url.PathEscape(tag)), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+// 같은 Key의 Tag가 있으면 Value를 덮어 씀
+func (tagHandler *OpenStackTagHandler) AddTag(resType irs.RSType, resId string, tag irs.KeyValue) (irs.KeyValue, error) {
+	if tag.Key == "" {
+		return irs.KeyValue{}, errors.New("tag key is required")
+	}
+
+	switch resType {
+	case irs.RSTypeVM:
+		_, err := servers.UpdateMetadata(tagHandler.Client, resId, servers.MetadataOpts{tag.Key: tag.Value}).Extract()
+		if err != nil {
+			return irs.KeyValue{}, err
+		}
+	case irs.RSTypeDisk:
+		// gophercloud에 Volume Metadata API가 없어서 직접 요청 (기존 Metadata에 병합)
+		reqBody := map[string]interface{}{
+			"metadata": map[string]string{tag.Key: tag.Value},
+		}
+		_, err := tagHandler.VolumeClient.Post(tagHandler.VolumeClient.ServiceURL("volumes", resId, "metadata"), reqBody, nil, &gophercloud.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return irs.KeyValue{}, err
+		}
+	default:
+		tags, err := tagHandler.getNeutronTags(resType, resId)
+		if err != nil {
+			return irs.KeyValue{}, err
+		}
+		for _, oldTag := range tags {
+			if mappingNeutronTagList([]string{oldTag})[0].Key == tag.Key {
+				if err := tagHandler.deleteNeutronTag(resType, resId, oldTag); err != nil {
+					return irs.KeyValue{}, err
+				}
+			}
+		}
+		if err := tagHandler.putNeutronTag(resType, resId, tag.Key+CBNeutronTagSeparator+tag.Value); err != nil {
+			return irs.KeyValue{}, err
+		}
+	}
+
+	return tag, nil
+}
+
+func (tagHandler *OpenStackTagHandler) ListTag(resType irs.RSType, resId string) ([]irs.KeyValue, error) {
+	tagMap, err := tagHandler.listTagMap(resType, resId)
+	if err != nil {
+		return nil, err
+	}
+	return mappingMetadataTagList(tagMap), nil
+}
+
+func (tagHandler *OpenStackTagHandler) GetTag(resType irs.RSType, resId string, key string) (irs.KeyValue, error) {
+	tagMap, err := tagHandler.listTagMap(resType, resId)
+	if err != nil {
+		return irs.KeyValue{}, err
+	}
+
+	value, ok := tagMap[key]
+	if !ok {
+		return irs.KeyValue{}, errors.New(fmt.Sprintf("tag %s of %s is not exist", key, resId))
+	}
+	return irs.KeyValue{Key: key, Value: value}, nil
+}
+
+func (tagHandler *OpenStackTagHandler) RemoveTag(resType irs.RSType, resId string, key string) (bool, error) {
+	tag, err := tagHandler.GetTag(resType, resId, key)
+	if err != nil {
+		return false, err
+	}
+
+	switch resType {
+	case irs.RSTypeVM:
+		err = servers.DeleteMetadatum(tagHandler.Client, resId, key).ExtractErr()
+	case irs.RSTypeDisk:
+		_, err = tagHandler.VolumeClient.Delete(tagHandler.VolumeClient.ServiceURL("volumes", resId, "metadata", url.PathEscape(key)), &gophercloud.RequestOpts{
+			OkCodes: []int{200},
+		})
+	default:
+		err = tagHandler.deleteNeutronTag(resType, resId, tag.Key+CBNeutronTagSeparator+tag.Value)
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// keyword가 Key 또는 Value에 포함된 Tag를 가진 리소스 목록 (리소스의 모든 Tag를 함께 리턴)
+func (tagHandler *OpenStackTagHandler) FindTaggedResources(resType irs.RSType, keyword string) ([]*irs.TaggedResourceInfo, error) {
+	var resInfoList []*irs.TaggedResourceInfo
+
+	switch resType {
+	case irs.RSTypeVM:
+		pager := servers.List(tagHandler.Client, servers.ListOpts{})
+		err := pager.EachPage(func(page pagination.Page) (bool, error) {
+			list, err := servers.ExtractServers(page)
+			if err != nil {
+				return false, err
+			}
+			for _, server := range list {
+				metadata := map[string]string{}
+				for key, value := range server.Metadata {
+					metadata[key] = fmt.Sprint(value)
+				}
+				resInfoList = append(resInfoList, &irs.TaggedResourceInfo{ResType: resType, ResId: server.ID, TagList: mappingMetadataTagList(metadata)})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case irs.RSTypeDisk:
+		pager := volumes.List(tagHandler.VolumeClient, volumes.ListOpts{})
+		err := pager.EachPage(func(page pagination.Page) (bool, error) {
+			list, err := volumes.ExtractVolumes(page)
+			if err != nil {
+				return false, err
+			}
+			for _, volume := range list {
+				resInfoList = append(resInfoList, &irs.TaggedResourceInfo{ResType: resType, ResId: volume.ID, TagList: mappingMetadataTagList(volume.Metadata)})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		resource, err := getNeutronTagResource(resType)
+		if err != nil {
+			return nil, err
+		}
+		var result interface{}
+		_, err = tagHandler.NetworkClient.Get(tagHandler.NetworkClient.ServiceURL(resource.Path), &result, nil)
+		if err != nil {
+			return nil, err
+		}
+		response := map[string][]neutronTaggedResource{}
+		if err := mapstructure.WeakDecode(result, &response); err != nil {
+			return nil, err
+		}
+		for _, res := range response[resource.PluralKey] {
+			resInfoList = append(resInfoList, &irs.TaggedResourceInfo{ResType: resType, ResId: res.ID, TagList: mappingNeutronTagList(res.Tags)})
+		}
+	}
+
+	taggedResList := []*irs.TaggedResourceInfo{}
+	for _, resInfo := range resInfoList {
+		for _, tag := range resInfo.TagList {
+			if matchTagKeyword(tag, keyword) {
+				taggedResList = append(taggedResList, resInfo)
+				break
+			}
+		}
+	}
+	return taggedResList, nil
+}
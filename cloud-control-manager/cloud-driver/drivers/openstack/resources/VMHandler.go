@@ -48,8 +48,14 @@ func (vmHandler *OpenStackVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInf
 		return irs.VMInfo{}, err
 	}
 
+	metadata, err := getMetadata(vmReqInfo.TagList)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
 	serverCreateOpts := servers.CreateOpts{
 		Name:      vmReqInfo.VMName,
+		Metadata:  metadata,
 		ImageRef:  vmReqInfo.ImageId,
 		FlavorRef: vmReqInfo.VMSpecId,
 		Networks: []servers.Network{
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/flavors"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+type OpenStackVMSpecHandler struct {
+	Region idrv.RegionInfo
+	Client *gophercloud.ServiceClient
+}
+
+// VMReqInfo.VMSpecId로 Flavor ID를 사용하므로, VMSpecInfo.Name에 Flavor ID를 설정
+// Flavor에는 CPU 아키텍처 정보가 없으므로 아키텍처 KeyValue를 설정하지 않음 (Spec 추천에서 제외됨)
+func setterVMSpec(region string, flavor flavors.Flavor) *irs.VMSpecInfo {
+	vmSpecInfo := &irs.VMSpecInfo{
+		Region: region,
+		Name:   flavor.ID,
+		VCpu:   irs.VCpuInfo{Count: strconv.Itoa(flavor.VCPUs)},
+		Mem:    strconv.Itoa(flavor.RAM),
+		Disk:   strconv.Itoa(flavor.Disk),
+	}
+
+	vmSpecInfo.KeyValueList = []irs.KeyValue{
+		{Key: "FlavorName", Value: flavor.Name},
+		{Key: "Swap", Value: strconv.Itoa(flavor.Swap)},
+		{Key: "RxTxFactor", Value: strconv.FormatFloat(flavor.RxTxFactor, 'f', -1, 64)},
+	}
+
+	return vmSpecInfo
+}
+
+func (vmSpecHandler *OpenStackVMSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	flavorList, err := vmSpecHandler.listFlavor()
+	if err != nil {
+		return nil, err
+	}
+
+	var vmSpecInfoList []*irs.VMSpecInfo
+	for _, flavor := range flavorList {
+		vmSpecInfo := setterVMSpec(vmSpecHandler.Region.Region, flavor)
+		vmSpecInfoList = append(vmSpecInfoList, vmSpecInfo)
+	}
+	return vmSpecInfoList, nil
+}
+
+// name: Flavor ID 또는 Flavor 이름
+func (vmSpecHandler *OpenStackVMSpecHandler) GetVMSpec(name string) (irs.VMSpecInfo, error) {
+	flavorList, err := vmSpecHandler.listFlavor()
+	if err != nil {
+		return irs.VMSpecInfo{}, err
+	}
+
+	for _, flavor := range flavorList {
+		if flavor.ID == name || flavor.Name == name {
+			vmSpecInfo := setterVMSpec(vmSpecHandler.Region.Region, flavor)
+			return *vmSpecInfo, nil
+		}
+	}
+
+	errMsg := fmt.Sprintf("VMSpec with name %s not exist", name)
+	return irs.VMSpecInfo{}, errors.New(errMsg)
+}
+
+func (vmSpecHandler *OpenStackVMSpecHandler) ListOrgVMSpec() (string, error) {
+	flavorList, err := vmSpecHandler.listFlavor()
+	if err != nil {
+		return "", err
+	}
+
+	jsonBytes, err := json.Marshal(flavorList)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (vmSpecHandler *OpenStackVMSpecHandler) listFlavor() ([]flavors.Flavor, error) {
+	var flavorList []flavors.Flavor
+
+	pager := flavors.ListDetail(vmSpecHandler.Client, nil)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := flavors.ExtractFlavors(page)
+		if err != nil {
+			return false, err
+		}
+		flavorList = append(flavorList, list...)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return flavorList, nil
+}
@@ -27,6 +27,10 @@ func setterVNet(vNet subnets.Subnet) *irs.VNetworkInfo {
 }
 
 func (vNetworkHandler *OpenStackVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VNetworkReqInfo) (irs.VNetworkInfo, error) {
+	if err := checkTagList(vNetworkReqInfo.TagList); err != nil {
+		return irs.VNetworkInfo{}, err
+	}
+
 	// Check VNet Exists
 	// 기본 가상 네트워크가 생성되지 않았을 경우 디폴트 네트워크 생성 (CB-VNet)
 	var isVNetCreated = true
@@ -74,6 +78,12 @@ func (vNetworkHandler *OpenStackVNetworkHandler) CreateVNetwork(vNetworkReqInfo
 		return irs.VNetworkInfo{}, err
 	}
 
+	// Tag 설정 실패 시 생성한 Subnet 삭제
+	if err := addNeutronTagList(vNetworkHandler.Client, irs.RSTypeVNetwork, subnet.ID, vNetworkReqInfo.TagList); err != nil {
+		subnets.Delete(vNetworkHandler.Client, subnet.ID)
+		return irs.VNetworkInfo{}, err
+	}
+
 	// Create Router (Internet Gateway Router)
 	var routerId string
 	if !isVNetCreated {
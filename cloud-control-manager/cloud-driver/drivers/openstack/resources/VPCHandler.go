@@ -0,0 +1,301 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/networks"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/subnets"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// VPC는 Network, VPC 서브넷은 Network의 Subnet으로 매핑
+// 외부 통신을 위해 VPC 별로 Router(<VPC 이름>-Router)를 생성하여 서브넷을 연결함
+type OpenStackVPCHandler struct {
+	Client *gophercloud.ServiceClient
+}
+
+func setterSubnet(subnet subnets.Subnet) irs.SubnetInfo {
+	subnetInfo := irs.SubnetInfo{
+		Id:   subnet.ID,
+		Name: subnet.Name,
+		CIDR: subnet.CIDR,
+		KeyValueList: []irs.KeyValue{
+			{Key: "GatewayIP", Value: subnet.GatewayIP},
+		},
+	}
+	return subnetInfo
+}
+
+func (vpcHandler *OpenStackVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCInfo, error) {
+	// Check VPC Exists
+	networkId, _ := networks.IDFromName(vpcHandler.Client, vpcReqInfo.Name)
+	if networkId != "" {
+		errMsg := fmt.Sprintf("VPC with name %s already exist", vpcReqInfo.Name)
+		return irs.VPCInfo{}, errors.New(errMsg)
+	}
+	if err := checkTagList(vpcReqInfo.TagList); err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	// Create Network
+	createOpts := networks.CreateOpts{
+		Name:         vpcReqInfo.Name,
+		AdminStateUp: to.BoolPtr(true),
+	}
+	network, err := networks.Create(vpcHandler.Client, createOpts).Extract()
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	// Tag 설정 실패 시 생성한 Network 삭제
+	if err := addNeutronTagList(vpcHandler.Client, irs.RSTypeVPC, network.ID, vpcReqInfo.TagList); err != nil {
+		networks.Delete(vpcHandler.Client, network.ID)
+		return irs.VPCInfo{}, err
+	}
+
+	// Create Router (Internet Gateway Router)
+	routerCreateOpts := routers.CreateOpts{
+		Name:         getVPCRouterName(vpcReqInfo.Name),
+		AdminStateUp: to.BoolPtr(true),
+		GatewayInfo: &routers.GatewayInfo{
+			NetworkID: CBGateWayId,
+		},
+	}
+	_, err = routers.Create(vpcHandler.Client, routerCreateOpts).Extract()
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	// Create Subnets
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		if err := vpcHandler.createSubnet(*network, subnetInfo); err != nil {
+			// Subnet 생성 실패 시 생성한 Network, Router 삭제
+			if _, errDel := vpcHandler.DeleteVPC(network.ID); errDel != nil {
+				cblogger.Error(errDel)
+			}
+			return irs.VPCInfo{}, err
+		}
+	}
+
+	return vpcHandler.GetVPC(network.ID)
+}
+
+func (vpcHandler *OpenStackVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
+	var vpcInfoList []*irs.VPCInfo
+
+	pager := networks.List(vpcHandler.Client, networks.ListOpts{})
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get Network
+		list, err := networks.ExtractNetworks(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, n := range list {
+			// 외부 네트워크는 VPC가 아니므로 제외
+			if n.ID == CBGateWayId {
+				continue
+			}
+			vpcInfo, err := vpcHandler.setterVPC(n)
+			if err != nil {
+				return false, err
+			}
+			vpcInfoList = append(vpcInfoList, &vpcInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vpcInfoList, nil
+}
+
+func (vpcHandler *OpenStackVPCHandler) GetVPC(vpcID string) (irs.VPCInfo, error) {
+	network, err := networks.Get(vpcHandler.Client, vpcID).Extract()
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.setterVPC(*network)
+}
+
+func (vpcHandler *OpenStackVPCHandler) DeleteVPC(vpcID string) (bool, error) {
+	network, err := networks.Get(vpcHandler.Client, vpcID).Extract()
+	if err != nil {
+		return false, err
+	}
+
+	// Delete Subnets (Router Interface 포함)
+	subnetList, err := vpcHandler.listSubnet(vpcID)
+	if err != nil {
+		return false, err
+	}
+	for _, subnet := range subnetList {
+		if ok, err := vpcHandler.RemoveSubnet(vpcID, subnet.ID); !ok {
+			return false, err
+		}
+	}
+
+	// Delete Router
+	routerId, err := vpcHandler.getRouterID(network.Name)
+	if err != nil {
+		return false, err
+	}
+	if routerId != "" {
+		err = routers.Delete(vpcHandler.Client, routerId).ExtractErr()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// Delete Network
+	err = networks.Delete(vpcHandler.Client, vpcID).ExtractErr()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *OpenStackVPCHandler) AddSubnet(vpcID string, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
+	network, err := networks.Get(vpcHandler.Client, vpcID).Extract()
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	if err := vpcHandler.createSubnet(*network, subnetInfo); err != nil {
+		return irs.VPCInfo{}, err
+	}
+	return vpcHandler.GetVPC(vpcID)
+}
+
+func (vpcHandler *OpenStackVPCHandler) RemoveSubnet(vpcID string, subnetID string) (bool, error) {
+	network, err := networks.Get(vpcHandler.Client, vpcID).Extract()
+	if err != nil {
+		return false, err
+	}
+
+	// Delete Interface
+	routerId, err := vpcHandler.getRouterID(network.Name)
+	if err != nil {
+		return false, err
+	}
+	if routerId != "" {
+		deleteOpts := routers.InterfaceOpts{
+			SubnetID: subnetID,
+		}
+		_, err = routers.RemoveInterface(vpcHandler.Client, routerId, deleteOpts).Extract()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// Delete Subnet
+	err = subnets.Delete(vpcHandler.Client, subnetID).ExtractErr()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (vpcHandler *OpenStackVPCHandler) createSubnet(network networks.Network, subnetInfo irs.SubnetInfo) error {
+	if subnetInfo.CIDR == "" {
+		return errors.New(fmt.Sprintf("CIDR is required to create the subnet %s", subnetInfo.Name))
+	}
+
+	// Create Subnet
+	subnetCreateOpts := subnets.CreateOpts{
+		NetworkID:      network.ID,
+		CIDR:           subnetInfo.CIDR,
+		IPVersion:      subnets.IPv4,
+		Name:           subnetInfo.Name,
+		DNSNameservers: []string{DNSNameservers},
+	}
+	subnet, err := subnets.Create(vpcHandler.Client, subnetCreateOpts).Extract()
+	if err != nil {
+		return err
+	}
+
+	// Create Router Interface
+	routerId, err := vpcHandler.getRouterID(network.Name)
+	if err != nil {
+		return err
+	}
+	if routerId == "" {
+		return errors.New(fmt.Sprintf("failed to get router by name, name: %s", getVPCRouterName(network.Name)))
+	}
+	createOpts := routers.InterfaceOpts{
+		SubnetID: subnet.ID,
+	}
+	_, err = routers.AddInterface(vpcHandler.Client, routerId, createOpts).Extract()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (vpcHandler *OpenStackVPCHandler) listSubnet(vpcID string) ([]subnets.Subnet, error) {
+	var subnetList []subnets.Subnet
+
+	listOpts := subnets.ListOpts{
+		NetworkID: vpcID,
+	}
+	pager := subnets.List(vpcHandler.Client, listOpts)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		list, err := subnets.ExtractSubnets(page)
+		if err != nil {
+			return false, err
+		}
+		subnetList = append(subnetList, list...)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subnetList, nil
+}
+
+// Router 이름 기준 ID 정보 조회 (Router가 없으면 빈 문자열)
+func (vpcHandler *OpenStackVPCHandler) getRouterID(vpcName string) (string, error) {
+	listOpts := routers.ListOpts{
+		Name: getVPCRouterName(vpcName),
+	}
+	pager, err := routers.List(vpcHandler.Client, listOpts).AllPages()
+	if err != nil {
+		return "", err
+	}
+	routerList, err := routers.ExtractRouters(pager)
+	if err != nil {
+		return "", err
+	}
+	if len(routerList) < 1 {
+		return "", nil
+	}
+	return routerList[0].ID, nil
+}
+
+func (vpcHandler *OpenStackVPCHandler) setterVPC(network networks.Network) (irs.VPCInfo, error) {
+	vpcInfo := irs.VPCInfo{
+		Id:   network.ID,
+		Name: network.Name,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Status", Value: network.Status},
+			{Key: "Shared", Value: fmt.Sprintf("%v", network.Shared)},
+		},
+	}
+
+	subnetList, err := vpcHandler.listSubnet(network.ID)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	for _, subnet := range subnetList {
+		vpcInfo.SubnetInfoList = append(vpcInfo.SubnetInfoList, setterSubnet(subnet))
+	}
+	return vpcInfo, nil
+}
+
+func getVPCRouterName(vpcName string) string {
+	return vpcName + "-Router"
+}
@@ -16,13 +16,25 @@ import (
 )
 
 type DriverCapabilityInfo struct {
-	ImageHandler    bool // support: true, do not support: false
-	VNetworkHandler bool // support: true, do not support: false
-	SecurityHandler bool // support: true, do not support: false
-	KeyPairHandler  bool // support: true, do not support: false
-	VNicHandler     bool // support: true, do not support: false
-	PublicIPHandler bool // support: true, do not support: false
-	VMHandler       bool // support: true, do not support: false
+	ImageHandler         bool // support: true, do not support: false
+	VNetworkHandler      bool // support: true, do not support: false
+	SecurityHandler      bool // support: true, do not support: false
+	KeyPairHandler       bool // support: true, do not support: false
+	VNicHandler          bool // support: true, do not support: false
+	PublicIPHandler      bool // support: true, do not support: false
+	VMHandler            bool // support: true, do not support: false
+	VMSpecHandler        bool // support: true, do not support: false
+	DiskHandler          bool // support: true, do not support: false
+	SnapshotHandler      bool // support: true, do not support: false
+	VPCHandler           bool // support: true, do not support: false
+	RouterHandler        bool // support: true, do not support: false
+	NATGatewayHandler    bool // support: true, do not support: false
+	NLBHandler           bool // support: true, do not support: false
+	ObjectStorageHandler bool // support: true, do not support: false
+	ClusterHandler       bool // support: true, do not support: false
+	DNSHandler           bool // support: true, do not support: false
+	AutoScalingHandler   bool // support: true, do not support: false
+	TagHandler           bool // support: true, do not support: false
 }
 
 type CredentialInfo struct {
@@ -24,6 +24,18 @@ type CloudConnection interface {
 	CreatePublicIPHandler() (irs.PublicIPHandler, error)
 
 	CreateVMHandler() (irs.VMHandler, error)
+	CreateVMSpecHandler() (irs.VMSpecHandler, error)
+	CreateDiskHandler() (irs.DiskHandler, error)
+	CreateSnapshotHandler() (irs.SnapshotHandler, error)
+	CreateVPCHandler() (irs.VPCHandler, error)
+	CreateRouterHandler() (irs.RouterHandler, error)
+	CreateNATGatewayHandler() (irs.NATGatewayHandler, error)
+	CreateNLBHandler() (irs.NLBHandler, error)
+	CreateObjectStorageHandler() (irs.ObjectStorageHandler, error)
+	CreateClusterHandler() (irs.ClusterHandler, error)
+	CreateDNSHandler() (irs.DNSHandler, error)
+	CreateAutoScalingHandler() (irs.AutoScalingHandler, error)
+	CreateTagHandler() (irs.TagHandler, error)
 
 	IsConnected() (bool, error)
 	Close() error
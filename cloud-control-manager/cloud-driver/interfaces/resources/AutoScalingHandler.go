@@ -0,0 +1,99 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// Auto Scaling Group is the CSP native group of identical VMs, which are launched from the VM template
+// and scaled in/out by the desired size and the scaling policies.
+// AWS: Auto Scaling Group(Launch Template), Azure: VM Scale Set, GCP: Managed Instance Group(Instance Template),
+// Alibaba: ESS Scaling Group(Scaling Configuration)
+
+type AutoScalingMemberStatus string
+
+const (
+	AutoScalingMemberPending     AutoScalingMemberStatus = "Pending"
+	AutoScalingMemberInService   AutoScalingMemberStatus = "InService"
+	AutoScalingMemberTerminating AutoScalingMemberStatus = "Terminating"
+	AutoScalingMemberFailed      AutoScalingMemberStatus = "Failed"
+)
+
+// VM template of the members, same as VMReqInfo without the VM specific resources(VMName, NetworkInterfaceId, PublicIPId).
+type VMTemplateInfo struct {
+	ImageId          string
+	VMSpecId         string
+	VirtualNetworkId string // Subnet ID of the members
+	SecurityGroupIds []string
+
+	KeyPairName  string
+	VMUserId     string
+	VMUserPasswd string
+}
+
+type AutoScalingGroupReqInfo struct {
+	Name       string
+	VMTemplate VMTemplateInfo
+
+	DesiredSize int
+	MinSize     int
+	MaxSize     int
+
+	KeyValueList []KeyValue
+}
+
+type AutoScalingGroupInfo struct {
+	Id         string
+	Name       string
+	VMTemplate VMTemplateInfo
+
+	DesiredSize int
+	MinSize     int
+	MaxSize     int
+
+	KeyValueList []KeyValue
+}
+
+type AutoScalingMemberInfo struct {
+	VMId   string // AWS, GCP, Alibaba: VM ID of VMHandler, Azure: instance ID in the VM Scale Set
+	Status AutoScalingMemberStatus
+	Zone   string
+
+	KeyValueList []KeyValue // includes the CSP specific status
+}
+
+// Scaling policy keeps the average CPU utilization of the members around the target value.
+// AWS, Alibaba: Target Tracking Scaling Policy, GCP: Autoscaler, Azure: Autoscale Setting(scale-out/in rules)
+// GCP and Azure support only one policy per Auto Scaling Group.
+type ScalingPolicyReqInfo struct {
+	Name                 string
+	TargetCPUUtilization float64 // percent, ex) 60
+}
+
+type ScalingPolicyInfo struct {
+	Id                   string
+	Name                 string
+	TargetCPUUtilization float64
+
+	KeyValueList []KeyValue
+}
+
+type AutoScalingHandler interface {
+	CreateAutoScalingGroup(groupReqInfo AutoScalingGroupReqInfo) (AutoScalingGroupInfo, error)
+	ListAutoScalingGroup() ([]*AutoScalingGroupInfo, error)
+	GetAutoScalingGroup(groupID string) (AutoScalingGroupInfo, error)
+	DeleteAutoScalingGroup(groupID string) (bool, error) // terminates all the members
+
+	// scales in/out the members to the desired size
+	ChangeAutoScalingGroupSize(groupID string, desiredSize int, minSize int, maxSize int) (AutoScalingGroupInfo, error)
+	ListAutoScalingGroupMember(groupID string) ([]*AutoScalingMemberInfo, error)
+
+	AddScalingPolicy(groupID string, policyReqInfo ScalingPolicyReqInfo) (ScalingPolicyInfo, error)
+	ListScalingPolicy(groupID string) ([]*ScalingPolicyInfo, error)
+	RemoveScalingPolicy(groupID string, policyName string) (bool, error)
+}
@@ -0,0 +1,108 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// Cluster is the managed Kubernetes cluster with node groups.
+// AWS: EKS, Azure: AKS, GCP: GKE, Alibaba: ACK, OpenStack: Magnum
+// The cluster network refers to the VPC(VNetwork), Subnets and SecurityGroups created by Spider.
+
+type ClusterStatus string
+
+const (
+	ClusterCreating ClusterStatus = "Creating"
+	ClusterActive   ClusterStatus = "Active"
+	ClusterUpdating ClusterStatus = "Updating"
+	ClusterDeleting ClusterStatus = "Deleting"
+	ClusterFailed   ClusterStatus = "Failed"
+)
+
+// Keys of the KeyValueList for the CSP specific options
+const (
+	ClusterRoleArnKey = "ClusterRoleArn" // AWS: IAM Role ARN of the control plane, required
+	NodeRoleArnKey    = "NodeRoleArn"    // AWS: IAM Role ARN of the node group, required for the first node group
+)
+
+type NodeGroupReqInfo struct {
+	Name         string
+	ImageName    string // optional, OpenStack: required(Fedora CoreOS image)
+	VMSpecName   string
+	RootDiskSize string // GB, optional
+	KeyPairName  string
+
+	OnAutoScaling   bool
+	DesiredNodeSize int
+	MinNodeSize     int
+	MaxNodeSize     int
+
+	KeyValueList []KeyValue
+}
+
+type NodeGroupInfo struct {
+	Id           string
+	Name         string
+	ImageName    string
+	VMSpecName   string
+	RootDiskSize string
+	KeyPairName  string
+	Status       ClusterStatus
+
+	OnAutoScaling   bool
+	DesiredNodeSize int
+	MinNodeSize     int
+	MaxNodeSize     int
+
+	Nodes []string // VM IDs
+
+	KeyValueList []KeyValue
+}
+
+type ClusterReqInfo struct {
+	Name             string
+	Version          string // Kubernetes version, ex) 1.18
+	VPCId            string
+	SubnetIds        []string
+	SecurityGroupIds []string
+
+	NodeGroupList []NodeGroupReqInfo
+
+	KeyValueList []KeyValue
+}
+
+type ClusterInfo struct {
+	Id               string
+	Name             string
+	Version          string
+	VPCId            string
+	SubnetIds        []string
+	SecurityGroupIds []string
+	Endpoint         string // API server endpoint
+	Status           ClusterStatus
+	CreatedTime      string
+
+	NodeGroupList []NodeGroupInfo
+
+	KeyValueList []KeyValue
+}
+
+type ClusterHandler interface {
+	CreateCluster(clusterReqInfo ClusterReqInfo) (ClusterInfo, error)
+	ListCluster() ([]*ClusterInfo, error)
+	GetCluster(clusterID string) (ClusterInfo, error)
+	DeleteCluster(clusterID string) (bool, error)
+
+	AddNodeGroup(clusterID string, nodeGroupReqInfo NodeGroupReqInfo) (NodeGroupInfo, error)
+	ListNodeGroup(clusterID string) ([]*NodeGroupInfo, error)
+	RemoveNodeGroup(clusterID string, nodeGroupID string) (bool, error)
+	ChangeNodeGroupScaling(clusterID string, nodeGroupID string, desiredNodeSize int, minNodeSize int, maxNodeSize int) (NodeGroupInfo, error)
+
+	UpgradeCluster(clusterID string, newVersion string) (ClusterInfo, error)
+	GetKubeConfig(clusterID string) (string, error)
+}
@@ -0,0 +1,83 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// DNS Zone is the public hosted zone of a domain, and its records are managed as record sets(Name + Type).
+// AWS: Route 53 Hosted Zone, Azure: DNS Zone, GCP: Cloud DNS Managed Zone,
+// OpenStack: Designate Zone, Alibaba: Alibaba Cloud DNS Domain
+// Record changes are propagated to the name servers asynchronously on some CSPs,
+// so the ChangeId of the record can be passed to WaitForDNSChange().
+
+type DNSRecordType string
+
+const (
+	DNSRecordA     DNSRecordType = "A"
+	DNSRecordAAAA  DNSRecordType = "AAAA"
+	DNSRecordCNAME DNSRecordType = "CNAME"
+	DNSRecordTXT   DNSRecordType = "TXT"
+)
+
+type DNSChangeStatus string
+
+const (
+	DNSChangePending DNSChangeStatus = "Pending"
+	DNSChangeInSync  DNSChangeStatus = "InSync"
+)
+
+const DNSZoneApex = "@" // record name of the zone itself
+
+type DNSZoneReqInfo struct {
+	Name        string // domain name, ex) example.com
+	Description string
+}
+
+type DNSZoneInfo struct {
+	Id          string // AWS: Hosted Zone ID, Azure, Alibaba: domain name, GCP: Managed Zone name, OpenStack: Zone ID
+	Name        string // domain name, ex) example.com
+	Description string
+	NameServers []string // name servers to be delegated from the domain registrar
+
+	KeyValueList []KeyValue
+}
+
+type DNSRecordReqInfo struct {
+	Name   string // relative name in the zone, ex) www, "@" or "": the zone apex
+	Type   DNSRecordType
+	TTL    int64    // seconds, default: 300
+	Values []string // A, AAAA: IP addresses, CNAME: one domain name, TXT: text strings
+}
+
+type DNSRecordInfo struct {
+	Name   string // relative name in the zone, "@": the zone apex
+	Type   DNSRecordType
+	TTL    int64
+	Values []string
+
+	ChangeId     string // ID to wait for the propagation of the last change
+	ChangeStatus DNSChangeStatus
+
+	KeyValueList []KeyValue
+}
+
+type DNSHandler interface {
+	CreateZone(zoneReqInfo DNSZoneReqInfo) (DNSZoneInfo, error)
+	ListZone() ([]*DNSZoneInfo, error)
+	GetZone(zoneID string) (DNSZoneInfo, error)
+	DeleteZone(zoneID string) (bool, error)
+
+	CreateRecord(zoneID string, recordReqInfo DNSRecordReqInfo) (DNSRecordInfo, error)
+	ListRecord(zoneID string) ([]*DNSRecordInfo, error)
+	UpdateRecord(zoneID string, recordReqInfo DNSRecordReqInfo) (DNSRecordInfo, error)
+	DeleteRecord(zoneID string, recordName string, recordType DNSRecordType) (bool, error)
+
+	// blocks until the change is propagated to all the name servers of the zone
+	WaitForDNSChange(zoneID string, changeID string) (DNSChangeStatus, error)
+}
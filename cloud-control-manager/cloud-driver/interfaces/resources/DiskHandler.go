@@ -0,0 +1,60 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"time"
+)
+
+type DiskReqInfo struct {
+	Name     string
+	DiskType string     // ex) AWS: gp2, Azure: Standard_LRS, GCP: pd-standard, OpenStack: volume type, Alibaba: cloud_efficiency, "": default of the cloud
+	DiskSize string     // GB, ex) 100
+	Zone     string     // ex) ap-northeast-2a, "": zone of the connection
+	TagList  []KeyValue // user tags, ex) owner, cost-center, environment
+
+	KeyValueList []KeyValue
+}
+
+// GO do not support Enum. So, define like this.
+type DiskStatus string
+
+const (
+	DiskCreating  DiskStatus = "CREATING"
+	DiskAvailable DiskStatus = "AVAILABLE" // not attached
+	DiskAttached  DiskStatus = "ATTACHED"
+	DiskDeleting  DiskStatus = "DELETING"
+	DiskError     DiskStatus = "ERROR"
+)
+
+type DiskInfo struct {
+	Id          string
+	Name        string
+	DiskType    string
+	DiskSize    string // GB
+	Zone        string
+	Status      DiskStatus
+	OwnerVMId   string // "": not attached
+	CreatedTime time.Time
+
+	KeyValueList []KeyValue
+}
+
+type DiskHandler interface {
+	CreateDisk(diskReqInfo DiskReqInfo) (DiskInfo, error)
+	ListDisk() ([]*DiskInfo, error)
+	GetDisk(diskID string) (DiskInfo, error)
+	ChangeDiskSize(diskID string, size string) (bool, error) // size: GB, only increase
+	DeleteDisk(diskID string) (bool, error)
+
+	AttachDisk(diskID string, vmID string) (DiskInfo, error)
+	DetachDisk(diskID string, vmID string) (bool, error)
+}
@@ -15,21 +15,52 @@ package resources
 type ImageReqInfo struct {
 	Name string
 	Id   string
-	// @todo
+
+	// for capturing a custom image(MyImage) from a VM
+	SourceVMId  string
+	Description string
+	NoReboot    bool // true: capture without stopping the VM (AWS, GCP)
+}
+
+// Status of the image. Native state and progress of the cloud are in KeyValueList.
+const (
+	ImageCreating    = "creating"
+	ImageAvailable   = "available"
+	ImageUnavailable = "unavailable"
+)
+
+// Filter of ListImageByFilter. Empty fields are not applied.
+// Drivers translate the fields into the cloud's native filters as far as the cloud supports,
+// the remaining conditions(NamePattern, CreatedAfter, CreatedBefore, Latest) are applied by CB-Spider.
+type ImageFilterInfo struct {
+	OSFamily      string // ubuntu, centos, windows etc.
+	OSVersion     string // 22.04, 7, 2019 etc.
+	Architecture  string // x86_64, arm64
+	Owner         string // self, or the image owner of the cloud(AWS: amazon or account ID, GCP: project, Alibaba: system, marketplace etc.)
+	NamePattern   string // * is wildcard, ex) ubuntu*22.04*
+	CreatedAfter  string // RFC3339, ex) 2022-10-01T00:00:00Z
+	CreatedBefore string // RFC3339
+	Latest        bool   // true: the most recently created image only
 }
 
+// Key of the image creation time(RFC3339) in ImageInfo.KeyValueList
+const ImageCreatedTimeKey = "CreatedTime"
+
 type ImageInfo struct {
      Id   string
      Name string
      GuestOS string // Windows7, Ubuntu etc.
-     Status string  // available, unavailable
+     Status string  // creating, available, unavailable
 
      KeyValueList []KeyValue 
 }
 
 type ImageHandler interface {
-	CreateImage(imageReqInfo ImageReqInfo) (ImageInfo, error)
+	CreateImage(imageReqInfo ImageReqInfo) (ImageInfo, error) // with SourceVMId: returns without waiting, check the Status with GetImage
 	ListImage() ([]*ImageInfo, error)
 	GetImage(imageID string) (ImageInfo, error)
 	DeleteImage(imageID string) (bool, error)
+
+	ListMyImage() ([]*ImageInfo, error) // images owned by the account
+	ListImageByFilter(filterInfo ImageFilterInfo) ([]*ImageInfo, error)
 }
@@ -12,6 +12,7 @@ package resources
 
 type KeyPairReqInfo struct {
         Name     string
+        PublicKey string // ImportKey only, OpenSSH format public key(ssh-rsa, ssh-ed25519)
 }
 
 type KeyPairInfo struct {
@@ -26,6 +27,7 @@ type KeyPairInfo struct {
 
 type KeyPairHandler interface {
 	CreateKey(keyPairReqInfo KeyPairReqInfo) (KeyPairInfo, error)
+	ImportKey(keyPairReqInfo KeyPairReqInfo) (KeyPairInfo, error) // registers the existing PublicKey, no PrivateKey returned
 	ListKey() ([]*KeyPairInfo, error)
 	GetKey(keyName string) (KeyPairInfo, error) // AWS는 keyPairName
 	DeleteKey(keyName string) (bool, error)     // AWS는 keyPairName
@@ -0,0 +1,50 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// NAT Gateway gives the VMs in the private subnets the internet egress without their own public IPs.
+// AWS: NAT Gateway, Azure: NAT Gateway, GCP: Cloud NAT of the Cloud Router,
+// OpenStack: SNAT of the Router(external gateway), Alibaba: NAT Gateway with SNAT entries
+
+type NATGatewayReqInfo struct {
+	Name     string
+	VPCId    string
+	SubnetId string // AWS, Alibaba: public subnet where the NAT gateway is placed, others: associated on create(optional)
+}
+
+type NATAssociationInfo struct {
+	RouterId string // AWS, Alibaba: route table that routes 0.0.0.0/0 to the NAT gateway
+	SubnetId string // subnet whose egress goes through the NAT gateway, AWS: the route table of the subnet is used if RouterId is empty
+}
+
+type NATGatewayInfo struct {
+	Id       string
+	Name     string
+	VPCId    string
+	SubnetId string // subnet where the NAT gateway is placed
+	PublicIP string // public IP allocated on create, released on delete
+	Status   string
+
+	RouterIdList []string // route tables routing to the NAT gateway
+	SubnetIdList []string // subnets using the NAT gateway
+
+	KeyValueList []KeyValue
+}
+
+type NATGatewayHandler interface {
+	CreateNATGateway(natGatewayReqInfo NATGatewayReqInfo) (NATGatewayInfo, error)
+	ListNATGateway() ([]*NATGatewayInfo, error)
+	GetNATGateway(natGatewayID string) (NATGatewayInfo, error)
+	DeleteNATGateway(natGatewayID string) (bool, error)
+
+	AssociateNATGateway(natGatewayID string, associationInfo NATAssociationInfo) (NATGatewayInfo, error)
+	DisassociateNATGateway(natGatewayID string, associationInfo NATAssociationInfo) (bool, error)
+}
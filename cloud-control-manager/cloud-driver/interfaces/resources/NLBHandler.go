@@ -0,0 +1,90 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// NLB is the L4(TCP/UDP) network load balancer with a listener, a target VM group and a health checker.
+// AWS: Network Load Balancer, Azure: Load Balancer, GCP: Target Pool with a Forwarding Rule,
+// OpenStack: Octavia(LBaaS v2) Load Balancer, Alibaba: SLB(Server Load Balancer)
+
+type NLBType string
+
+const (
+	NLBPublic   NLBType = "PUBLIC"
+	NLBInternal NLBType = "INTERNAL"
+)
+
+type ListenerInfo struct {
+	Protocol string // TCP|UDP
+	IP       string // assigned by the cloud
+	Port     string // 1-65535
+	DNSName  string // assigned by the cloud, if supported
+
+	KeyValueList []KeyValue
+}
+
+type VMGroupInfo struct {
+	Protocol string   // TCP|UDP
+	Port     string   // 1-65535
+	VMs      []string // VM IDs
+
+	KeyValueList []KeyValue
+}
+
+type HealthCheckerInfo struct {
+	Protocol  string // TCP|HTTP
+	Port      string // 1-65535, default is the VM group port
+	Interval  int    // seconds between the checks
+	Timeout   int    // seconds to wait for the response
+	Threshold int    // number of the consecutive checks to change the health status
+
+	KeyValueList []KeyValue
+}
+
+type HealthInfo struct {
+	AllVMs       []string
+	HealthyVMs   []string
+	UnHealthyVMs []string
+}
+
+type NLBReqInfo struct {
+	Name  string
+	VPCId string
+	Type  NLBType // PUBLIC(default)|INTERNAL
+
+	Listener      ListenerInfo
+	VMGroup       VMGroupInfo
+	HealthChecker HealthCheckerInfo
+}
+
+type NLBInfo struct {
+	Id    string
+	Name  string
+	VPCId string
+	Type  NLBType
+
+	Listener      ListenerInfo
+	VMGroup       VMGroupInfo
+	HealthChecker HealthCheckerInfo
+
+	CreatedTime  string
+	KeyValueList []KeyValue
+}
+
+type NLBHandler interface {
+	CreateNLB(nlbReqInfo NLBReqInfo) (NLBInfo, error)
+	ListNLB() ([]*NLBInfo, error)
+	GetNLB(nlbID string) (NLBInfo, error)
+	DeleteNLB(nlbID string) (bool, error)
+
+	AddVMs(nlbID string, vmIDs []string) (VMGroupInfo, error)
+	RemoveVMs(nlbID string, vmIDs []string) (bool, error)
+	GetVMGroupHealthInfo(nlbID string) (HealthInfo, error)
+}
@@ -0,0 +1,57 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+import (
+	"io"
+)
+
+// Object Storage uses the same credential and region with the compute connection.
+// AWS: S3, Azure: Blob Storage(Container), GCP: Cloud Storage,
+// OpenStack: Swift(Container), Alibaba: OSS
+
+const (
+	PresignedURLGet = "GET"
+	PresignedURLPut = "PUT"
+)
+
+type BucketInfo struct {
+	Name        string
+	Region      string
+	CreatedTime string
+
+	KeyValueList []KeyValue
+}
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ETag         string
+	LastModified string
+	StorageClass string
+	ContentType  string
+
+	KeyValueList []KeyValue
+}
+
+type ObjectStorageHandler interface {
+	CreateBucket(bucketName string) (BucketInfo, error)
+	ListBucket() ([]*BucketInfo, error)
+	DeleteBucket(bucketName string) (bool, error)
+
+	PutObject(bucketName string, objectKey string, body io.Reader, contentType string) (ObjectInfo, error)
+	GetObject(bucketName string, objectKey string) (io.ReadCloser, ObjectInfo, error)
+	ListObject(bucketName string, prefix string) ([]*ObjectInfo, error)
+	DeleteObject(bucketName string, objectKey string) (bool, error)
+
+	// method: GET(download) | PUT(upload)
+	GetPresignedURL(bucketName string, objectKey string, method string, expiresSeconds int) (string, error)
+}
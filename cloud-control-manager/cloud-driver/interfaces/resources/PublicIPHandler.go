@@ -12,6 +12,7 @@ package resources
 
 type PublicIPReqInfo struct {
 	Name         string
+	TagList      []KeyValue // user tags, ex) owner, cost-center, environment
 	KeyValueList []KeyValue
 }
 
@@ -0,0 +1,66 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// Router is the route table of a VPC.
+// AWS: Route Table, Azure: Route Table, GCP: routes of the VPC network(router ID = VPC ID),
+// OpenStack: Router, Alibaba: Route Table of the VRouter
+
+type RouterReqInfo struct {
+	Name            string
+	VPCId           string // AWS, Azure, Alibaba: VPC of the router, OpenStack: not used
+	InternetGateway bool   // true: attach the internet gateway(0.0.0.0/0) on create
+}
+
+// GO do not support Enum. So, define like this.
+type RouteTargetType string
+
+const (
+	RouteTargetInternetGateway RouteTargetType = "INTERNET_GATEWAY"
+	RouteTargetNATGateway      RouteTargetType = "NAT_GATEWAY" // TargetId: NAT Gateway ID
+	RouteTargetVM              RouteTargetType = "VM"          // TargetId: VM ID
+	RouteTargetIP              RouteTargetType = "IP"          // TargetId: next hop IP, ex) 10.0.1.10
+	RouteTargetLocal           RouteTargetType = "LOCAL"       // route in the VPC, listed only
+	RouteTargetOther           RouteTargetType = "OTHER"       // not mapped target of the cloud, listed only
+)
+
+type RouteInfo struct {
+	DestinationCIDR string // ex) 0.0.0.0/0, 192.168.0.0/16
+	TargetType      RouteTargetType
+	TargetId        string
+}
+
+type RouterInfo struct {
+	Id              string
+	Name            string
+	VPCId           string
+	InternetGateway bool // routes 0.0.0.0/0 to the internet gateway
+	RouteList       []RouteInfo
+	SubnetIdList    []string // associated subnets, OpenStack: subnets of the router interfaces
+
+	KeyValueList []KeyValue
+}
+
+type RouterHandler interface {
+	CreateRouter(routerReqInfo RouterReqInfo) (RouterInfo, error)
+	ListRouter() ([]*RouterInfo, error)
+	GetRouter(routerID string) (RouterInfo, error)
+	DeleteRouter(routerID string) (bool, error)
+
+	AddRoute(routerID string, routeInfo RouteInfo) (RouterInfo, error)
+	RemoveRoute(routerID string, destinationCIDR string) (bool, error)
+
+	AddInterface(routerID string, subnetID string) (RouterInfo, error) // associates the subnet with the router
+	DeleteInterface(routerID string, subnetID string) (bool, error)
+
+	AttachInternetGateway(routerID string) (RouterInfo, error)
+	DetachInternetGateway(routerID string) (bool, error)
+}
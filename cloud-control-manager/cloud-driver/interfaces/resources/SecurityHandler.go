@@ -12,8 +12,10 @@ package resources
 
 type SecurityReqInfo struct {
 	Name          string
-	Direction     string // GCP 는 하나에 한개의 Direction만 생성/조회 가능
+	Direction     string // Rule에 Direction이 없을 때 사용하는 기본 Direction
 	SecurityRules *[]SecurityRuleInfo
+	TagList       []KeyValue // user tags, ex) owner, cost-center, environment
+	VpcId         string     // VPC of the firewalls, GCP only(default: "default" network)
 }
 
 type SecurityRuleInfo struct {
@@ -21,12 +23,17 @@ type SecurityRuleInfo struct {
 	ToPort     string
 	IPProtocol string
 	Direction  string
+
+	CIDR                  string // ex) 10.0.0.0/16, default: 0.0.0.0/0
+	SourceSecurityGroupId string // allows the members of this security group instead of the CIDR (GCP: network tag)
+	Description           string
+	Priority              int // lower is evaluated first, 0: CSP default (Azure, GCP, Alibaba only)
 }
 
 type SecurityInfo struct {
 	Id            string
 	Name          string
-	Direction     string // GCP 는 하나에 한개의 Direction만 생성/조회 가능
+	Direction     string // 모든 Rule의 Direction이 같은 경우에만 설정될 수 있음
 	SecurityRules *[]SecurityRuleInfo
 
 	KeyValueList []KeyValue
@@ -37,4 +44,9 @@ type SecurityHandler interface {
 	ListSecurity() ([]*SecurityInfo, error)
 	GetSecurity(securityID string) (SecurityInfo, error)
 	DeleteSecurity(securityID string) (bool, error)
+
+	// adds/removes the rules to/from the existing security group without recreating it.
+	// the rules to remove are matched by Direction, IPProtocol, FromPort, ToPort and CIDR(or SourceSecurityGroupId).
+	AddRules(securityID string, securityRules *[]SecurityRuleInfo) (SecurityInfo, error)
+	RemoveRules(securityID string, securityRules *[]SecurityRuleInfo) (bool, error)
 }
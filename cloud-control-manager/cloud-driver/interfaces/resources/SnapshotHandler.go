@@ -0,0 +1,62 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// GO do not support Enum. So, define like this.
+type SnapshotSourceType string
+
+const (
+	SnapshotSourceDisk SnapshotSourceType = "DISK"
+	SnapshotSourceVM   SnapshotSourceType = "VM" // snapshot of the VM's boot disk
+)
+
+type SnapshotReqInfo struct {
+	Name       string
+	SourceType SnapshotSourceType // DISK | VM
+	SourceId   string             // Disk ID or VM ID
+
+	KeyValueList []KeyValue
+}
+
+type SnapshotStatus string
+
+const (
+	SnapshotCreating  SnapshotStatus = "CREATING"
+	SnapshotAvailable SnapshotStatus = "AVAILABLE"
+	SnapshotDeleting  SnapshotStatus = "DELETING"
+	SnapshotError     SnapshotStatus = "ERROR"
+)
+
+// Keys of the snapshot lineage in SnapshotInfo.KeyValueList
+const (
+	SnapshotSourceDiskKey  = "SourceDiskId"
+	SnapshotSourceVMKey    = "SourceVMId"  // only for VM snapshots
+	SnapshotCreatedTimeKey = "CreatedTime" // RFC3339
+)
+
+type SnapshotInfo struct {
+	Id       string
+	Name     string
+	DiskSize string // GB, size of the source disk
+	Status   SnapshotStatus
+
+	KeyValueList []KeyValue // lineage: SourceDiskId, SourceVMId, CreatedTime, ...
+}
+
+type SnapshotHandler interface {
+	CreateSnapshot(snapshotReqInfo SnapshotReqInfo) (SnapshotInfo, error)
+	ListSnapshot() ([]*SnapshotInfo, error)
+	GetSnapshot(snapshotID string) (SnapshotInfo, error)
+	DeleteSnapshot(snapshotID string) (bool, error)
+
+	// diskReqInfo.DiskSize: "" means the size of the snapshot
+	CreateDiskFromSnapshot(snapshotID string, diskReqInfo DiskReqInfo) (DiskInfo, error)
+}
@@ -0,0 +1,47 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// Tag is the user Key/Value attached to a resource, ex) owner, cost-center, environment.
+// AWS: EC2 Tags, Azure: Resource Tags, GCP: Labels(lower case keys and values only),
+// OpenStack: Server/Volume Metadata, Alibaba: ECS/VPC Tags
+// Cloudit: not supported, the Cloudit API has no tags.
+//   CreateTagHandler() returns an error, and the create requests with TagList are rejected.
+// The resources are identified by the same Id of their handlers(ex: VMInfo.Id, SecurityInfo.Id).
+// The tags used by the drivers(ex: AWS Name, Alibaba cbCate) are not the user tags.
+// The TagList of the create requests(ex: VMReqInfo.TagList) is set by the drivers when they create the resources.
+
+type RSType string
+
+const (
+	RSTypeVM            RSType = "VM"
+	RSTypeVPC           RSType = "VPC"
+	RSTypeVNetwork      RSType = "VNetwork" // subnet
+	RSTypeSecurityGroup RSType = "SecurityGroup"
+	RSTypeDisk          RSType = "Disk"
+	RSTypePublicIP      RSType = "PublicIP"
+)
+
+type TaggedResourceInfo struct {
+	ResType RSType
+	ResId   string
+	TagList []KeyValue
+}
+
+type TagHandler interface {
+	AddTag(resType RSType, resId string, tag KeyValue) (KeyValue, error) // overwrites the value of the same key
+	ListTag(resType RSType, resId string) ([]KeyValue, error)
+	GetTag(resType RSType, resId string, key string) (KeyValue, error)
+	RemoveTag(resType RSType, resId string, key string) (bool, error)
+
+	// keyword: matched with the keys and values of the tags, "" or "*": all the tagged resources
+	FindTaggedResources(resType RSType, keyword string) ([]*TaggedResourceInfo, error)
+}
@@ -28,6 +28,8 @@ type VMReqInfo struct {
         KeyPairName  string
         VMUserId  string
         VMUserPasswd string
+
+        TagList []KeyValue // user tags, ex) owner, cost-center, environment
 }
 
 type VMStatusInfo struct {
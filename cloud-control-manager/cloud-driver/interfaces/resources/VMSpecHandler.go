@@ -0,0 +1,44 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+type VMSpecInfo struct {
+	Region string // ex) ap-northeast-2
+	Name   string // VMReqInfo.VMSpecId, ex) t2.micro, Standard_B1ls, f1-micro, m1.small or flavor ID
+
+	VCpu VCpuInfo
+	Mem  string // MB, ex) 1024
+	Gpu  []GpuInfo
+
+	Disk    string // GB, local(instance store or root) disk, "0": network disk only, ex) 20
+	Network string // ex) "Low to Moderate", "Up to 5 Gigabit", "10" (Gbps)
+
+	KeyValueList []KeyValue // native attributes of the spec
+}
+
+type VCpuInfo struct {
+	Count string // ex) 2
+	Clock string // GHz, ex) 2.5, "": unknown
+}
+
+type GpuInfo struct {
+	Count string // ex) 1
+	Mfr   string // ex) NVIDIA
+	Model string // ex) Tesla V100
+	Mem   string // MB, ex) 16384
+}
+
+type VMSpecHandler interface {
+	ListVMSpec() ([]*VMSpecInfo, error)       // specs of the region of the connection
+	GetVMSpec(name string) (VMSpecInfo, error) // name: VMSpecInfo.Name
+
+	ListOrgVMSpec() (string, error) // native spec list of the cloud, JSON string
+}
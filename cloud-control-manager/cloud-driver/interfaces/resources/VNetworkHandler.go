@@ -12,6 +12,7 @@ package resources
 
 type VNetworkReqInfo struct { 
      Name string
+     TagList []KeyValue // user tags, ex) owner, cost-center, environment
 }
 
 type VNetworkInfo struct {
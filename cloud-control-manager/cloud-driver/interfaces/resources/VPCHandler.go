@@ -0,0 +1,50 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by agent@local, 2026.10.
+
+package resources
+
+// VPC and its subnets with the user's own address plan.
+// VNetworkHandler remains as the compatibility mode,
+// it manages the subnets of a hidden CB default VPC created by the driver.
+
+type VPCReqInfo struct {
+	Name           string
+	CIDR           string       // ex) 10.0.0.0/16, not used by the clouds without VPC CIDR(GCP, OpenStack)
+	SubnetInfoList []SubnetInfo // Name, CIDR and Zone of the subnets to create with the VPC
+	TagList        []KeyValue   // user tags, ex) owner, cost-center, environment
+}
+
+type VPCInfo struct {
+	Id             string
+	Name           string
+	CIDR           string
+	SubnetInfoList []SubnetInfo
+
+	KeyValueList []KeyValue
+}
+
+type SubnetInfo struct {
+	Id   string
+	Name string
+	CIDR string // ex) 10.0.1.0/24
+	Zone string // empty: default zone of the connection, not used by the clouds with regional subnets(Azure, GCP, OpenStack)
+
+	KeyValueList []KeyValue
+}
+
+type VPCHandler interface {
+	CreateVPC(vpcReqInfo VPCReqInfo) (VPCInfo, error)
+	ListVPC() ([]*VPCInfo, error)
+	GetVPC(vpcID string) (VPCInfo, error)
+	DeleteVPC(vpcID string) (bool, error) // deletes the subnets of the VPC too
+
+	AddSubnet(vpcID string, subnetInfo SubnetInfo) (VPCInfo, error)
+	RemoveSubnet(vpcID string, subnetID string) (bool, error)
+}
@@ -0,0 +1,180 @@
+// IPAM Pool & Allocation <-> CB-Store Handler of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by agent@local, 2026.10.
+
+package ipam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-store"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+	"github.com/cloud-barista/cb-store/utils"
+)
+
+var store icbs.Store
+
+func init() {
+	store = cbstore.GetStore()
+}
+
+// format
+// /cloud-control-spaces/ipam/pools/<PoolName>/CIDR [10.0.0.0/8]
+// /cloud-control-spaces/ipam/allocations/<PoolName>/<CIDR>/{key1} [value1]
+// /cloud-control-spaces/ipam/allocations/<PoolName>/<ParentCIDR>/subnets/<CIDR>/{key1} [value1]
+// ex)
+// /cloud-control-spaces/ipam/pools/cb-pool01/CIDR [10.0.0.0/8]
+// /cloud-control-spaces/ipam/allocations/cb-pool01/10.0.0.0_16/ParentCIDR []
+// /cloud-control-spaces/ipam/allocations/cb-pool01/10.0.0.0_16/ConnectionName [aws-config01]
+// /cloud-control-spaces/ipam/allocations/cb-pool01/10.0.0.0_16/ResourceName [mcb-vpc01]
+// /cloud-control-spaces/ipam/allocations/cb-pool01/10.0.0.0_16/ResourceId [vpc-0a1b2c3d4e5f60001]
+// /cloud-control-spaces/ipam/allocations/cb-pool01/10.0.0.0_16/subnets/10.0.1.0_24/ParentCIDR [10.0.0.0/16]
+
+const (
+	poolKey       = "/cloud-control-spaces/ipam/pools"
+	allocationKey = "/cloud-control-spaces/ipam/allocations"
+	subnetsNode   = "subnets"
+)
+
+// "/" of the CIDR can not be a part of the key.
+// ex) 10.0.0.0/16 <-> 10.0.0.0_16
+func cidrToNode(cidr string) string {
+	return strings.Replace(cidr, "/", "_", 1)
+}
+
+func nodeToCIDR(node string) string {
+	return strings.Replace(node, "_", "/", 1)
+}
+
+func insertPoolInfo(poolInfo PoolInfo) error {
+	return store.Put(poolKey+"/"+poolInfo.PoolName+"/CIDR", poolInfo.CIDR)
+}
+
+// 1. get key-value list
+// 2. create PoolInfo List & return
+func listPoolInfo() ([]*PoolInfo, error) {
+	keyValueList, err := store.GetList(poolKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var poolInfoList []*PoolInfo
+	for _, kv := range keyValueList {
+		if utils.GetNodeValue(kv.Key, 5) != "CIDR" {
+			continue
+		}
+		poolInfoList = append(poolInfoList, &PoolInfo{utils.GetNodeValue(kv.Key, 4), kv.Value})
+	}
+	return poolInfoList, nil
+}
+
+func getPoolInfo(poolName string) (*PoolInfo, error) {
+	// key is not the key of cb-store, so we have to use GetList()
+	keyValueList, err := store.GetList(poolKey+"/"+poolName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kv := range keyValueList {
+		// keyValueList may have ~/pool01/CIDR and ~/pool01-01/CIDR,
+		// so we have to check the sameness of poolName.
+		if utils.GetNodeValue(kv.Key, 4) == poolName && utils.GetNodeValue(kv.Key, 5) == "CIDR" {
+			return &PoolInfo{poolName, kv.Value}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: is not exist!", poolName)
+}
+
+func deletePoolInfo(poolName string) error {
+	return store.Delete(poolKey + "/" + poolName + "/CIDR")
+}
+
+// The subnets are stored under their VPC,
+// so a subnet with the same CIDR as its VPC does not overwrite the VPC.
+func allocationFormat(poolName string, parentCIDR string, cidr string) string {
+	if parentCIDR == "" {
+		return allocationKey + "/" + poolName + "/" + cidrToNode(cidr)
+	}
+	return allocationKey + "/" + poolName + "/" + cidrToNode(parentCIDR) + "/" + subnetsNode + "/" + cidrToNode(cidr)
+}
+
+func insertAllocationInfo(poolName string, allocInfo AllocationInfo) error {
+	format := allocationFormat(poolName, allocInfo.ParentCIDR, allocInfo.CIDR)
+
+	keyValueList := []icbs.KeyValue{
+		{Key: "ParentCIDR", Value: allocInfo.ParentCIDR},
+		{Key: "ConnectionName", Value: allocInfo.ConnectionName},
+		{Key: "ResourceName", Value: allocInfo.ResourceName},
+		{Key: "ResourceId", Value: allocInfo.ResourceId},
+	}
+	for _, kv := range keyValueList {
+		err := store.Put(format+"/"+kv.Key, kv.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 1. get key-value list
+// 2. create AllocationInfo List & return
+func listAllocationInfo(poolName string) ([]*AllocationInfo, error) {
+	keyValueList, err := store.GetList(allocationKey+"/"+poolName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var allocInfoList []*AllocationInfo
+	allocInfoMap := map[string]*AllocationInfo{}
+	for _, kv := range keyValueList {
+		// keyValueList may have ~/pool01/... and ~/pool01-01/...,
+		// so we have to check the sameness of poolName.
+		if utils.GetNodeValue(kv.Key, 4) != poolName {
+			continue
+		}
+		// ~/<VPC CIDR>/{key} or ~/<VPC CIDR>/subnets/<CIDR>/{key}
+		node := utils.GetNodeValue(kv.Key, 5)
+		cidrNode, keyIdx := node, 6
+		if utils.GetNodeValue(kv.Key, 6) == subnetsNode {
+			cidrNode, keyIdx = utils.GetNodeValue(kv.Key, 7), 8
+			node = node + "/" + cidrNode
+		}
+		allocInfo, ok := allocInfoMap[node]
+		if !ok {
+			allocInfo = &AllocationInfo{CIDR: nodeToCIDR(cidrNode)}
+			allocInfoMap[node] = allocInfo
+			allocInfoList = append(allocInfoList, allocInfo)
+		}
+		setValue(allocInfo, utils.GetNodeValue(kv.Key, keyIdx), kv.Value)
+	}
+	return allocInfoList, nil
+}
+
+func deleteAllocationInfo(poolName string, parentCIDR string, cidr string) error {
+	format := allocationFormat(poolName, parentCIDR, cidr)
+	for _, key := range []string{"ParentCIDR", "ConnectionName", "ResourceName", "ResourceId"} {
+		err := store.Delete(format + "/" + key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setValue(allocInfo *AllocationInfo, key string, value string) {
+	switch key {
+	case "ParentCIDR":
+		allocInfo.ParentCIDR = value
+	case "ConnectionName":
+		allocInfo.ConnectionName = value
+	case "ResourceName":
+		allocInfo.ResourceName = value
+	case "ResourceId":
+		allocInfo.ResourceId = value
+	}
+}
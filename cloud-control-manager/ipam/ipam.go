@@ -0,0 +1,362 @@
+// Package for IP Address Management(IPAM) of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Allocates non-overlapping CIDRs of the VPCs and subnets from the pools
+// shared by all the clouds, so the VPCs can be peered or connected by VPN later.
+//
+// by agent@local, 2026.10.
+
+package ipam
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/cloud-barista/cb-store/config"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultVPCPrefixLen    = 16
+	DefaultSubnetPrefixLen = 24
+)
+
+var cblog *logrus.Logger
+
+// serializes the read-check-write of the allocations.
+var ipamLock sync.Mutex
+
+func init() {
+	cblog = config.Cblogger
+}
+
+//====================================================================
+type PoolInfo struct {
+	PoolName string // ex) "cb-pool01"
+	CIDR     string // ex) "10.0.0.0/8", must not overlap the other pools
+}
+
+type AllocationInfo struct {
+	CIDR           string // ex) "10.0.1.0/24"
+	ParentCIDR     string // empty: CIDR of a VPC, VPC's CIDR: CIDR of a subnet of the VPC
+	ConnectionName string // ex) "aws-config01"
+	ResourceName   string // name of the VPC or the subnet
+	ResourceId     string // id of the VPC or the subnet, set after the creation
+}
+
+//====================================================================
+
+// 1. check params
+// 2. check overlap with the other pools
+// 3. insert it into cb-store
+func CreatePool(poolName string, cidr string) (*PoolInfo, error) {
+	cblog.Info("call CreatePool()")
+
+	if poolName == "" {
+		return nil, fmt.Errorf("PoolName is empty!")
+	}
+	poolNet, err := parseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	ipamLock.Lock()
+	defer ipamLock.Unlock()
+
+	poolInfoList, err := listPoolInfo()
+	if err != nil {
+		return nil, err
+	}
+	for _, poolInfo := range poolInfoList {
+		if poolInfo.PoolName == poolName {
+			return nil, fmt.Errorf("%s: is already exist!", poolName)
+		}
+		otherNet, err := parseCIDR(poolInfo.CIDR)
+		if err != nil {
+			return nil, err
+		}
+		if overlaps(poolNet, otherNet) {
+			return nil, fmt.Errorf("%s(%s) overlaps the pool %s(%s)", poolName, cidr, poolInfo.PoolName, poolInfo.CIDR)
+		}
+	}
+
+	poolInfo := &PoolInfo{poolName, poolNet.String()}
+	if err := insertPoolInfo(*poolInfo); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return poolInfo, nil
+}
+
+func ListPool() ([]*PoolInfo, error) {
+	cblog.Info("call ListPool()")
+
+	return listPoolInfo()
+}
+
+func GetPool(poolName string) (*PoolInfo, error) {
+	cblog.Info("call GetPool()")
+
+	if poolName == "" {
+		return nil, fmt.Errorf("PoolName is empty!")
+	}
+	return getPoolInfo(poolName)
+}
+
+// The pool with allocations can not be deleted.
+func DeletePool(poolName string) (bool, error) {
+	cblog.Info("call DeletePool()")
+
+	ipamLock.Lock()
+	defer ipamLock.Unlock()
+
+	if _, err := getPoolInfo(poolName); err != nil {
+		return false, err
+	}
+	allocInfoList, err := listAllocationInfo(poolName)
+	if err != nil {
+		return false, err
+	}
+	if len(allocInfoList) > 0 {
+		return false, fmt.Errorf("%s: has %d allocations!", poolName, len(allocInfoList))
+	}
+
+	if err := deletePoolInfo(poolName); err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func ListAllocation(poolName string) ([]*AllocationInfo, error) {
+	cblog.Info("call ListAllocation()")
+
+	if _, err := getPoolInfo(poolName); err != nil {
+		return nil, err
+	}
+	return listAllocationInfo(poolName)
+}
+
+// Allocates the first free block with prefixLen.
+// allocInfo.CIDR: empty - allocate automatically, not empty - reserve the given CIDR.
+// allocInfo.ParentCIDR: empty - a VPC in the pool, not empty - a subnet in the VPC's CIDR.
+func Allocate(poolName string, prefixLen int, allocInfo AllocationInfo) (*AllocationInfo, error) {
+	cblog.Info("call Allocate()")
+
+	ipamLock.Lock()
+	defer ipamLock.Unlock()
+
+	poolInfo, err := getPoolInfo(poolName)
+	if err != nil {
+		return nil, err
+	}
+	allocInfoList, err := listAllocationInfo(poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	// range to allocate & the siblings allocated in the range
+	rangeCIDR := poolInfo.CIDR
+	if allocInfo.ParentCIDR != "" {
+		if findAllocation(allocInfoList, "", allocInfo.ParentCIDR) == nil {
+			return nil, fmt.Errorf("%s: is not allocated in the pool %s!", allocInfo.ParentCIDR, poolName)
+		}
+		rangeCIDR = allocInfo.ParentCIDR
+	}
+	rangeNet, err := parseCIDR(rangeCIDR)
+	if err != nil {
+		return nil, err
+	}
+	var usedList []*net.IPNet
+	for _, used := range allocInfoList {
+		if used.ParentCIDR != allocInfo.ParentCIDR {
+			continue
+		}
+		usedNet, err := parseCIDR(used.CIDR)
+		if err != nil {
+			return nil, err
+		}
+		usedList = append(usedList, usedNet)
+	}
+
+	var allocNet *net.IPNet
+	if allocInfo.CIDR != "" {
+		allocNet, err = reserveBlock(rangeNet, usedList, allocInfo.CIDR)
+	} else {
+		allocNet, err = findFreeBlock(rangeNet, usedList, prefixLen)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	allocInfo.CIDR = allocNet.String()
+	if err := insertAllocationInfo(poolName, allocInfo); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return &allocInfo, nil
+}
+
+// sets the id of the VPC or the subnet created with the allocated CIDR.
+// parentCIDR: empty - a VPC, not empty - a subnet in the VPC's CIDR.
+func SetResourceId(poolName string, parentCIDR string, cidr string, resourceId string) error {
+	cblog.Info("call SetResourceId()")
+
+	ipamLock.Lock()
+	defer ipamLock.Unlock()
+
+	allocInfoList, err := listAllocationInfo(poolName)
+	if err != nil {
+		return err
+	}
+	allocInfo := findAllocation(allocInfoList, parentCIDR, cidr)
+	if allocInfo == nil {
+		return fmt.Errorf("%s: is not allocated in the pool %s!", cidr, poolName)
+	}
+	allocInfo.ResourceId = resourceId
+	return insertAllocationInfo(poolName, *allocInfo)
+}
+
+// returns the pool name & allocation of the VPC or the subnet, nil if it is not allocated by IPAM.
+func FindAllocation(connectionName string, resourceId string) (string, *AllocationInfo, error) {
+	cblog.Info("call FindAllocation()")
+
+	poolInfoList, err := listPoolInfo()
+	if err != nil {
+		return "", nil, err
+	}
+	for _, poolInfo := range poolInfoList {
+		allocInfoList, err := listAllocationInfo(poolInfo.PoolName)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, allocInfo := range allocInfoList {
+			if allocInfo.ConnectionName == connectionName && allocInfo.ResourceId == resourceId {
+				return poolInfo.PoolName, allocInfo, nil
+			}
+		}
+	}
+	return "", nil, nil
+}
+
+// releases the allocation and the allocations of its subnets.
+// parentCIDR: empty - a VPC, not empty - a subnet in the VPC's CIDR.
+func Release(poolName string, parentCIDR string, cidr string) (bool, error) {
+	cblog.Info("call Release()")
+
+	ipamLock.Lock()
+	defer ipamLock.Unlock()
+
+	allocInfoList, err := listAllocationInfo(poolName)
+	if err != nil {
+		return false, err
+	}
+	if findAllocation(allocInfoList, parentCIDR, cidr) == nil {
+		return false, fmt.Errorf("%s: is not allocated in the pool %s!", cidr, poolName)
+	}
+
+	for _, allocInfo := range allocInfoList {
+		released := allocInfo.ParentCIDR == parentCIDR && allocInfo.CIDR == cidr
+		// subnets of the released VPC
+		if parentCIDR == "" && allocInfo.ParentCIDR == cidr {
+			released = true
+		}
+		if released {
+			if err := deleteAllocationInfo(poolName, allocInfo.ParentCIDR, allocInfo.CIDR); err != nil {
+				cblog.Error(err)
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+//----------------
+
+func findAllocation(allocInfoList []*AllocationInfo, parentCIDR string, cidr string) *AllocationInfo {
+	for _, allocInfo := range allocInfoList {
+		if allocInfo.ParentCIDR == parentCIDR && allocInfo.CIDR == cidr {
+			return allocInfo
+		}
+	}
+	return nil
+}
+
+// IPv4 only, ex) 10.0.0.0/16
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf("%s: is not an IPv4 CIDR!", cidr)
+	}
+	return ipNet, nil
+}
+
+func overlaps(a *net.IPNet, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// first and last address of the block as numbers.
+func blockRange(ipNet *net.IPNet) (uint32, uint32) {
+	first := binary.BigEndian.Uint32(ipNet.IP.To4())
+	ones, _ := ipNet.Mask.Size()
+	return first, first + uint32(uint64(1)<<uint(32-ones)-1)
+}
+
+func reserveBlock(rangeNet *net.IPNet, usedList []*net.IPNet, cidr string) (*net.IPNet, error) {
+	ipNet, err := parseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	rangeOnes, _ := rangeNet.Mask.Size()
+	ones, _ := ipNet.Mask.Size()
+	if ones < rangeOnes || !rangeNet.Contains(ipNet.IP) {
+		return nil, fmt.Errorf("%s: is out of %s!", cidr, rangeNet.String())
+	}
+	for _, used := range usedList {
+		if overlaps(ipNet, used) {
+			return nil, fmt.Errorf("%s: overlaps the allocated %s!", cidr, used.String())
+		}
+	}
+	return ipNet, nil
+}
+
+// The candidates are aligned to the block size,
+// a candidate overlapped with an allocation skips to the end of the allocation.
+func findFreeBlock(rangeNet *net.IPNet, usedList []*net.IPNet, prefixLen int) (*net.IPNet, error) {
+	rangeOnes, _ := rangeNet.Mask.Size()
+	if prefixLen < rangeOnes || prefixLen > 30 {
+		return nil, fmt.Errorf("prefix length /%d: is not allowed in %s!", prefixLen, rangeNet.String())
+	}
+
+	blockSize := uint64(1) << uint(32-prefixLen)
+	rangeFirst, rangeLast := blockRange(rangeNet)
+	for candidate := uint64(rangeFirst); candidate+blockSize-1 <= uint64(rangeLast); {
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, uint32(candidate))
+		candidateNet := &net.IPNet{IP: ip, Mask: net.CIDRMask(prefixLen, 32)}
+
+		next := uint64(0)
+		for _, used := range usedList {
+			if overlaps(candidateNet, used) {
+				_, usedLast := blockRange(used)
+				if uint64(usedLast)+1 > next {
+					next = uint64(usedLast) + 1
+				}
+			}
+		}
+		if next == 0 {
+			return candidateNet, nil
+		}
+		// align up to the block size
+		candidate = (next + blockSize - 1) / blockSize * blockSize
+	}
+	return nil, fmt.Errorf("no free /%d block in %s!", prefixLen, rangeNet.String())
+}
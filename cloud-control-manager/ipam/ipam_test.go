@@ -0,0 +1,177 @@
+// Test of IPAM of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by agent@local, 2026.10.
+
+package ipam
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// memStore is an in-memory cb-store.
+// Get() of a missing key returns an error like the NUTSDB store.
+type memStore struct {
+	kvMap map[string]string
+}
+
+func newMemStore() *memStore {
+	return &memStore{kvMap: map[string]string{}}
+}
+
+func (s *memStore) Put(key string, value string) error {
+	s.kvMap[key] = value
+	return nil
+}
+
+func (s *memStore) Get(key string) (*icbs.KeyValue, error) {
+	value, ok := s.kvMap[key]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return &icbs.KeyValue{Key: key, Value: value}, nil
+}
+
+func (s *memStore) GetList(key string, sortAscend bool) ([]*icbs.KeyValue, error) {
+	var keyList []string
+	for k := range s.kvMap {
+		if strings.HasPrefix(k, key) {
+			keyList = append(keyList, k)
+		}
+	}
+	sort.Strings(keyList)
+	if !sortAscend {
+		sort.Sort(sort.Reverse(sort.StringSlice(keyList)))
+	}
+
+	var kvList []*icbs.KeyValue
+	for _, k := range keyList {
+		kvList = append(kvList, &icbs.KeyValue{Key: k, Value: s.kvMap[k]})
+	}
+	return kvList, nil
+}
+
+func (s *memStore) Delete(key string) error {
+	delete(s.kvMap, key)
+	return nil
+}
+
+func TestCreatePoolIntoEmptyStore(t *testing.T) {
+	store = newMemStore()
+
+	if _, err := GetPool("cb-pool01"); err == nil {
+		t.Fatal("GetPool() of a missing pool: want an error")
+	}
+
+	poolInfo, err := CreatePool("cb-pool01", "10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("CreatePool(): %v", err)
+	}
+	if poolInfo.CIDR != "10.0.0.0/8" {
+		t.Errorf("CIDR: got %s, want 10.0.0.0/8", poolInfo.CIDR)
+	}
+
+	got, err := GetPool("cb-pool01")
+	if err != nil {
+		t.Fatalf("GetPool(): %v", err)
+	}
+	if *got != *poolInfo {
+		t.Errorf("GetPool(): got %v, want %v", got, poolInfo)
+	}
+
+	// GetList() of cb-pool0 has the keys of cb-pool01 too.
+	if _, err := GetPool("cb-pool0"); err == nil {
+		t.Error("GetPool() of a prefix of a pool: want an error")
+	}
+}
+
+func TestAllocateFromEmptyPool(t *testing.T) {
+	store = newMemStore()
+
+	if _, err := Allocate("cb-pool01", DefaultVPCPrefixLen, AllocationInfo{}); err == nil {
+		t.Fatal("Allocate() from a missing pool: want an error")
+	}
+
+	if _, err := CreatePool("cb-pool01", "10.0.0.0/8"); err != nil {
+		t.Fatalf("CreatePool(): %v", err)
+	}
+	vpcAlloc, err := Allocate("cb-pool01", DefaultVPCPrefixLen, AllocationInfo{ConnectionName: "aws-config01", ResourceName: "mcb-vpc01"})
+	if err != nil {
+		t.Fatalf("Allocate(): %v", err)
+	}
+	if vpcAlloc.CIDR != "10.0.0.0/16" {
+		t.Errorf("CIDR: got %s, want 10.0.0.0/16", vpcAlloc.CIDR)
+	}
+
+	if _, err := DeletePool("cb-pool01"); err == nil {
+		t.Error("DeletePool() of a pool with allocations: want an error")
+	}
+}
+
+func TestAllocateSubnetOfWholeVPC(t *testing.T) {
+	store = newMemStore()
+
+	if _, err := CreatePool("cb-pool01", "10.0.0.0/8"); err != nil {
+		t.Fatalf("CreatePool(): %v", err)
+	}
+	vpcAlloc, err := Allocate("cb-pool01", DefaultVPCPrefixLen, AllocationInfo{ConnectionName: "aws-config01", ResourceName: "mcb-vpc01"})
+	if err != nil {
+		t.Fatalf("Allocate(): %v", err)
+	}
+	// the subnet covers the whole VPC.
+	subnetAlloc, err := Allocate("cb-pool01", DefaultVPCPrefixLen, AllocationInfo{ParentCIDR: vpcAlloc.CIDR, ConnectionName: "aws-config01", ResourceName: "mcb-subnet01"})
+	if err != nil {
+		t.Fatalf("Allocate() of a subnet: %v", err)
+	}
+	if subnetAlloc.CIDR != vpcAlloc.CIDR {
+		t.Fatalf("CIDR: got %s, want %s", subnetAlloc.CIDR, vpcAlloc.CIDR)
+	}
+
+	allocInfoList, err := ListAllocation("cb-pool01")
+	if err != nil {
+		t.Fatalf("ListAllocation(): %v", err)
+	}
+	if len(allocInfoList) != 2 {
+		t.Fatalf("ListAllocation(): got %d allocations, want 2", len(allocInfoList))
+	}
+
+	if err := SetResourceId("cb-pool01", "", vpcAlloc.CIDR, "vpc-01"); err != nil {
+		t.Fatalf("SetResourceId() of the VPC: %v", err)
+	}
+	if err := SetResourceId("cb-pool01", vpcAlloc.CIDR, subnetAlloc.CIDR, "subnet-01"); err != nil {
+		t.Fatalf("SetResourceId() of the subnet: %v", err)
+	}
+	_, vpcFound, err := FindAllocation("aws-config01", "vpc-01")
+	if err != nil || vpcFound == nil || vpcFound.ParentCIDR != "" {
+		t.Errorf("FindAllocation() of the VPC: got %v, %v", vpcFound, err)
+	}
+
+	// the VPC's CIDR is not allocated again.
+	nextAlloc, err := Allocate("cb-pool01", DefaultVPCPrefixLen, AllocationInfo{ConnectionName: "aws-config01", ResourceName: "mcb-vpc02"})
+	if err != nil {
+		t.Fatalf("Allocate(): %v", err)
+	}
+	if nextAlloc.CIDR != "10.1.0.0/16" {
+		t.Errorf("CIDR: got %s, want 10.1.0.0/16", nextAlloc.CIDR)
+	}
+
+	// releasing the subnet keeps the VPC.
+	if _, err := Release("cb-pool01", vpcAlloc.CIDR, subnetAlloc.CIDR); err != nil {
+		t.Fatalf("Release() of the subnet: %v", err)
+	}
+	allocInfoList, _ = ListAllocation("cb-pool01")
+	if len(allocInfoList) != 2 {
+		t.Errorf("ListAllocation() after releasing the subnet: got %d allocations, want 2", len(allocInfoList))
+	}
+	if findAllocation(allocInfoList, "", vpcAlloc.CIDR) == nil {
+		t.Error("the VPC is released with its subnet")
+	}
+}
@@ -0,0 +1,117 @@
+// Key Vault <-> CB-Store Handler of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by agent@local, 2026.10.
+
+package keyvault
+
+import (
+	"github.com/cloud-barista/cb-store"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+	"github.com/cloud-barista/cb-store/utils"
+)
+
+var store icbs.Store
+
+func init() {
+	store = cbstore.GetStore()
+}
+
+// format
+// /cloud-control-spaces/key-vault/<Domain>/<Scope>/<KeyPairName>/PublicKey [public key]
+// /cloud-control-spaces/key-vault/<Domain>/<Scope>/<KeyPairName>/PrivateKey [encrypted private key]
+// ex)
+// /cloud-control-spaces/key-vault/gcp/0a1b2c3d.../mcb-keypair01/PublicKey [ssh-rsa AAAA...]
+// /cloud-control-spaces/key-vault/gcp/0a1b2c3d.../mcb-keypair01/PrivateKey [base64(nonce+AES-GCM sealed PEM)]
+
+const keyVaultKey = "/cloud-control-spaces/key-vault"
+
+func insertKeyInfo(domain string, scope string, keyInfo KeyInfo, encryptedPrivateKey string) error {
+	format := keyVaultKey + "/" + domain + "/" + scope + "/" + keyInfo.Name
+
+	err := store.Put(format+"/PublicKey", keyInfo.PublicKey)
+	if err != nil {
+		return err
+	}
+	// imported keys have no private key.
+	if encryptedPrivateKey == "" {
+		return nil
+	}
+	return store.Put(format+"/PrivateKey", encryptedPrivateKey)
+}
+
+// 1. get key-value list
+// 2. create KeyInfo List & return (PrivateKey is still encrypted)
+func listKeyInfo(domain string, scope string) ([]*KeyInfo, error) {
+	keyValueList, err := store.GetList(keyVaultKey+"/"+domain+"/"+scope, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyInfoList []*KeyInfo
+	keyInfoMap := map[string]*KeyInfo{}
+	for _, kv := range keyValueList {
+		// keyValueList may have ~/scope01/... and ~/scope01-01/...,
+		// so we have to check the sameness of scope.
+		if utils.GetNodeValue(kv.Key, 4) != scope {
+			continue
+		}
+		name := utils.GetNodeValue(kv.Key, 5)
+		keyInfo, ok := keyInfoMap[name]
+		if !ok {
+			keyInfo = &KeyInfo{Name: name}
+			keyInfoMap[name] = keyInfo
+			keyInfoList = append(keyInfoList, keyInfo)
+		}
+		switch utils.GetNodeValue(kv.Key, 6) {
+		case "PublicKey":
+			keyInfo.PublicKey = kv.Value
+		case "PrivateKey":
+			keyInfo.PrivateKey = kv.Value
+		}
+	}
+	return keyInfoList, nil
+}
+
+// returns nil if the key does not exist. (PrivateKey is still encrypted)
+func getKeyInfo(domain string, scope string, name string) (*KeyInfo, error) {
+	// key is not the key of cb-store, so we have to use GetList()
+	keyValueList, err := store.GetList(keyVaultKey+"/"+domain+"/"+scope+"/"+name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyInfo *KeyInfo
+	for _, kv := range keyValueList {
+		// keyValueList may have ~/key01/... and ~/key01-01/...,
+		// so we have to check the sameness of scope and name.
+		if utils.GetNodeValue(kv.Key, 4) != scope || utils.GetNodeValue(kv.Key, 5) != name {
+			continue
+		}
+		if keyInfo == nil {
+			keyInfo = &KeyInfo{Name: name}
+		}
+		switch utils.GetNodeValue(kv.Key, 6) {
+		case "PublicKey":
+			keyInfo.PublicKey = kv.Value
+		case "PrivateKey":
+			keyInfo.PrivateKey = kv.Value
+		}
+	}
+	return keyInfo, nil
+}
+
+func deleteKeyInfo(domain string, scope string, name string, hasPrivateKey bool) error {
+	format := keyVaultKey + "/" + domain + "/" + scope + "/" + name
+
+	if hasPrivateKey {
+		err := store.Delete(format + "/PrivateKey")
+		if err != nil {
+			return err
+		}
+	}
+	return store.Delete(format + "/PublicKey")
+}
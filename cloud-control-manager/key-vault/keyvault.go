@@ -0,0 +1,268 @@
+// Package for Key Vault of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Keeps the key pairs generated or imported by the drivers(ex: GCP, Azure) in cb-store,
+// so all the Spider nodes can share them. Private keys are encrypted with AES-256-GCM
+// by the secret of CBSPIDER_KEYVAULT_SECRET.
+//
+// by agent@local, 2026.10.
+
+package keyvault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cloud-barista/cb-store/config"
+	"github.com/sirupsen/logrus"
+)
+
+const secretEnvName = "CBSPIDER_KEYVAULT_SECRET"
+
+var cblog *logrus.Logger
+
+// serializes the read-check-write of the keys.
+var keyVaultLock sync.Mutex
+
+// <Domain>/<Scope> of the migrated key file directories.
+var migratedScopes = map[string]bool{}
+
+func init() {
+	cblog = config.Cblogger
+}
+
+//====================================================================
+type KeyInfo struct {
+	Name       string // key pair name
+	PublicKey  string // OpenSSH format, ex) "ssh-rsa AAAA..."
+	PrivateKey string // PEM format, empty: imported public key only
+}
+
+//====================================================================
+
+// domain: driver name, ex) "gcp", "azure"
+// scope: credential hash of the driver, keys are separated by the credentials.
+func InsertKey(domain string, scope string, keyInfo KeyInfo) error {
+	cblog.Info("call InsertKey()")
+
+	if domain == "" || scope == "" || keyInfo.Name == "" || keyInfo.PublicKey == "" {
+		return fmt.Errorf("domain, scope, key name and public key are required")
+	}
+
+	keyVaultLock.Lock()
+	defer keyVaultLock.Unlock()
+
+	oldKeyInfo, err := getKeyInfo(domain, scope, keyInfo.Name)
+	if err != nil {
+		return err
+	}
+	if oldKeyInfo != nil {
+		return fmt.Errorf("KeyPair with name %s already exist", keyInfo.Name)
+	}
+
+	encryptedPrivateKey := ""
+	if keyInfo.PrivateKey != "" {
+		encryptedPrivateKey, err = encrypt(keyInfo.PrivateKey)
+		if err != nil {
+			return err
+		}
+	}
+	return insertKeyInfo(domain, scope, keyInfo, encryptedPrivateKey)
+}
+
+func ListKey(domain string, scope string) ([]*KeyInfo, error) {
+	cblog.Info("call ListKey()")
+
+	keyInfoList, err := listKeyInfo(domain, scope)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyInfo := range keyInfoList {
+		if keyInfo.PrivateKey == "" {
+			continue
+		}
+		keyInfo.PrivateKey, err = decrypt(keyInfo.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyInfoList, nil
+}
+
+func GetKey(domain string, scope string, name string) (*KeyInfo, error) {
+	cblog.Info("call GetKey()")
+
+	keyInfo, err := getKeyInfo(domain, scope, name)
+	if err != nil {
+		return nil, err
+	}
+	if keyInfo == nil {
+		return nil, fmt.Errorf("%s: is not exist!", name)
+	}
+	if keyInfo.PrivateKey != "" {
+		keyInfo.PrivateKey, err = decrypt(keyInfo.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyInfo, nil
+}
+
+func DeleteKey(domain string, scope string, name string) (bool, error) {
+	cblog.Info("call DeleteKey()")
+
+	keyVaultLock.Lock()
+	defer keyVaultLock.Unlock()
+
+	keyInfo, err := getKeyInfo(domain, scope, name)
+	if err != nil {
+		return false, err
+	}
+	if keyInfo == nil {
+		return false, fmt.Errorf("%s: is not exist!", name)
+	}
+
+	err = deleteKeyInfo(domain, scope, name, keyInfo.PrivateKey != "")
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// moves the key files of the old drivers into the vault once.
+// key files: <keyFileDir>/<scope>--<KeyPairName>(private key), <keyFileDir>/<scope>--<KeyPairName>.pub(public key)
+// 1. insert the keys into cb-store (skip the keys already in the vault)
+// 2. remove the migrated key files
+func MigrateKeyFiles(domain string, scope string, keyFileDir string) error {
+	keyVaultLock.Lock()
+	defer keyVaultLock.Unlock()
+
+	if migratedScopes[domain+"/"+scope] {
+		return nil
+	}
+
+	files, err := ioutil.ReadDir(keyFileDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			migratedScopes[domain+"/"+scope] = true
+			return nil
+		}
+		return err
+	}
+
+	for _, f := range files {
+		if !strings.HasPrefix(f.Name(), scope+"--") || !strings.HasSuffix(f.Name(), ".pub") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(f.Name(), scope+"--"), ".pub")
+		publicKeyPath := filepath.Join(keyFileDir, f.Name())
+		privateKeyPath := strings.TrimSuffix(publicKeyPath, ".pub")
+
+		publicKeyBytes, err := ioutil.ReadFile(publicKeyPath)
+		if err != nil {
+			return err
+		}
+		// imported keys have no private key file.
+		privateKeyBytes, err := ioutil.ReadFile(privateKeyPath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		oldKeyInfo, err := getKeyInfo(domain, scope, name)
+		if err != nil {
+			return err
+		}
+		if oldKeyInfo == nil {
+			encryptedPrivateKey := ""
+			if len(privateKeyBytes) > 0 {
+				encryptedPrivateKey, err = encrypt(string(privateKeyBytes))
+				if err != nil {
+					return err
+				}
+			}
+			keyInfo := KeyInfo{Name: name, PublicKey: string(publicKeyBytes)}
+			err = insertKeyInfo(domain, scope, keyInfo, encryptedPrivateKey)
+			if err != nil {
+				return err
+			}
+			cblog.Infof("KeyPair %s/%s is migrated into the key vault", domain, name)
+		} else {
+			cblog.Infof("KeyPair %s/%s is already in the key vault, remove the key files only", domain, name)
+		}
+
+		if err := os.Remove(publicKeyPath); err != nil {
+			return err
+		}
+		if err := os.Remove(privateKeyPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	migratedScopes[domain+"/"+scope] = true
+	return nil
+}
+
+//====================================================================
+
+// AES-256 key from the secret of CBSPIDER_KEYVAULT_SECRET
+func getCipher() (cipher.AEAD, error) {
+	secret := os.Getenv(secretEnvName)
+	if secret == "" {
+		return nil, fmt.Errorf("%s is not set, the key vault can not encrypt the private keys", secretEnvName)
+	}
+	key := sha256.Sum256([]byte(secret))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// base64(nonce + sealed text)
+func encrypt(plainText string) (string, error) {
+	gcm, err := getCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plainText), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func decrypt(encryptedText string) (string, error) {
+	gcm, err := getCipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encryptedText)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted private key")
+	}
+	plainText, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("can not decrypt the private key, check %s: %v", secretEnvName, err)
+	}
+	return string(plainText), nil
+}
@@ -0,0 +1,103 @@
+// Package for VM Image Filtering of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Applies the image filter conditions that the clouds can not filter natively
+// to the result of ImageHandler.ListImageByFilter().
+//
+// by agent@local, 2026.10.
+
+package vmimage
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+//====================================================================
+
+// Filter returns the images matched with NamePattern and the creation time range.
+// The images without creation time are excluded when CreatedAfter or CreatedBefore is set.
+// With Latest, only the most recently created image is returned.
+// Call with a nil imageList to validate the filter.
+func Filter(imageList []*irs.ImageInfo, filterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	createdAfter, err := parseTime("CreatedAfter", filterInfo.CreatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	createdBefore, err := parseTime("CreatedBefore", filterInfo.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	var nameRegexp *regexp.Regexp
+	if filterInfo.NamePattern != "" {
+		nameRegexp = wildcardRegexp(filterInfo.NamePattern)
+	}
+
+	var resultList []*irs.ImageInfo
+	for _, imageInfo := range imageList {
+		if nameRegexp != nil && !nameRegexp.MatchString(imageInfo.Name) {
+			continue
+		}
+		if !createdAfter.IsZero() || !createdBefore.IsZero() {
+			createdTime, ok := createdTimeOf(imageInfo)
+			if !ok {
+				continue
+			}
+			if !createdAfter.IsZero() && createdTime.Before(createdAfter) {
+				continue
+			}
+			if !createdBefore.IsZero() && createdTime.After(createdBefore) {
+				continue
+			}
+		}
+		resultList = append(resultList, imageInfo)
+	}
+
+	if filterInfo.Latest && len(resultList) > 1 {
+		// newest first, the images without creation time go last
+		sort.SliceStable(resultList, func(i, j int) bool {
+			iTime, _ := createdTimeOf(resultList[i])
+			jTime, _ := createdTimeOf(resultList[j])
+			return iTime.After(jTime)
+		})
+		resultList = resultList[:1]
+	}
+	return resultList, nil
+}
+
+//====================================================================
+
+func parseTime(name string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s(%s) is not a RFC3339 time, ex) 2022-10-01T00:00:00Z", name, value)
+	}
+	return t, nil
+}
+
+func createdTimeOf(imageInfo *irs.ImageInfo) (time.Time, bool) {
+	for _, kv := range imageInfo.KeyValueList {
+		if kv.Key == irs.ImageCreatedTimeKey {
+			t, err := time.Parse(time.RFC3339, kv.Value)
+			return t, err == nil
+		}
+	}
+	return time.Time{}, false
+}
+
+// ex) ubuntu*22.04* => (?i)^ubuntu.*22\.04.*$
+func wildcardRegexp(pattern string) *regexp.Regexp {
+	quoted := strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1)
+	return regexp.MustCompile("(?i)^" + quoted + "$")
+}
@@ -0,0 +1,166 @@
+// Package for VM Spec Recommendation of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Ranks the specs of a cloud(VMSpecHandler.ListVMSpec()) by
+// how closely they fit the requirements(vCPU, memory, architecture).
+//
+// by agent@local, 2026.10.
+
+package vmspec
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const DefaultLimit = 5
+
+// native attributes with the CPU architecture of a spec.
+// ex) AWS: "x86_64,arm64", Azure: "x64", GCP: "ARM64", Alibaba: "X86"
+var archKeyList = []string{"SupportedArchitectures", "CpuArchitectureType", "Architecture", "CpuArchitecture"}
+
+//====================================================================
+type RequirementInfo struct {
+	VCpu  string // ex) 2, minimum count
+	Mem   string // MB, ex) 4096, minimum size
+	Arch  string // ex) x86_64(default), arm64
+	Gpu   bool   // true: GPU specs only, false: specs without GPU first
+	Limit int    // max count of the result, default: 5
+}
+
+type RecommendedSpecInfo struct {
+	Score  float64 // 0: exact fit, larger: more over-provisioned
+	VMSpec irs.VMSpecInfo
+}
+
+//====================================================================
+
+// Recommend returns the specs that satisfy the requirements, best fit first.
+// The specs with unknown architecture are excluded, ex) OpenStack flavors.
+func Recommend(specList []*irs.VMSpecInfo, req RequirementInfo) ([]*RecommendedSpecInfo, error) {
+	vCpu, err := parseNumber("VCpu", req.VCpu)
+	if err != nil {
+		return nil, err
+	}
+	mem, err := parseNumber("Mem", req.Mem)
+	if err != nil {
+		return nil, err
+	}
+	arch := normalizeArch(req.Arch)
+	if arch == "" {
+		arch = "x86_64"
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	var resultList []*RecommendedSpecInfo
+	for _, spec := range specList {
+		if spec == nil {
+			continue
+		}
+		specVCpu, err := strconv.ParseFloat(spec.VCpu.Count, 64)
+		if err != nil || specVCpu < vCpu {
+			continue
+		}
+		specMem, err := strconv.ParseFloat(spec.Mem, 64)
+		if err != nil || specMem < mem {
+			continue
+		}
+		if !hasArch(spec, arch) {
+			continue
+		}
+		hasGpu := gpuCount(spec) > 0
+		if req.Gpu && !hasGpu {
+			continue
+		}
+
+		score := overRatio(specVCpu, vCpu) + overRatio(specMem, mem)
+		if !req.Gpu && hasGpu {
+			score += 1 // an unrequested GPU is the most expensive over-provisioning
+		}
+		resultList = append(resultList, &RecommendedSpecInfo{Score: score, VMSpec: *spec})
+	}
+
+	sort.SliceStable(resultList, func(i, j int) bool {
+		if resultList[i].Score != resultList[j].Score {
+			return resultList[i].Score < resultList[j].Score
+		}
+		return resultList[i].VMSpec.Name < resultList[j].VMSpec.Name
+	})
+
+	if len(resultList) > limit {
+		resultList = resultList[:limit]
+	}
+	return resultList, nil
+}
+
+//----------------------------------------------
+
+func parseNumber(name string, value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("%s: %s is not a valid number!", name, value)
+	}
+	return number, nil
+}
+
+// ex) spec: 4, req: 2 => 1.0
+func overRatio(spec float64, req float64) float64 {
+	if req == 0 {
+		return 0 // no requirement
+	}
+	return (spec - req) / req
+}
+
+func gpuCount(spec *irs.VMSpecInfo) int {
+	count := 0
+	for _, gpu := range spec.Gpu {
+		num, err := strconv.Atoi(gpu.Count)
+		if err != nil {
+			continue
+		}
+		count += num
+	}
+	return count
+}
+
+func hasArch(spec *irs.VMSpecInfo, arch string) bool {
+	for _, kv := range spec.KeyValueList {
+		for _, key := range archKeyList {
+			if kv.Key != key || kv.Value == "" {
+				continue
+			}
+			for _, specArch := range strings.Split(kv.Value, ",") {
+				if normalizeArch(specArch) == arch {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
+
+// ex) x64, amd64, X86 => x86_64, aarch64, Arm64 => arm64
+func normalizeArch(arch string) string {
+	arch = strings.ToLower(strings.TrimSpace(arch))
+	switch arch {
+	case "x64", "x86", "amd64", "x86_64", "x86_64_mac":
+		return "x86_64"
+	case "aarch64", "arm64", "arm64_mac", "arm":
+		return "arm64"
+	}
+	return arch
+}
@@ -0,0 +1,400 @@
+// Connection Pool of VM's SSH and SCP of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Keeps the SSH connections of servers and multiplexes sessions on them.
+// Connections are keyed by host, user, key fingerprint and jump hosts.
+//
+// by agent@local, 2026.10.
+
+package sshrun
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bramvdbogaerde/go-scp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+//====================================================================
+type PoolConfig struct {
+	KeepAliveInterval time.Duration // interval of keepalive & health check, ex) 30s
+	KeepAliveTimeout  time.Duration // a connection without keepalive reply is closed, ex) 10s
+	IdleTimeout       time.Duration // a connection without sessions is closed after this, ex) 5m
+	MaxSessions       int           // max sessions per connection, ex) 10(OpenSSH MaxSessions)
+}
+
+type PoolStatus struct {
+	Name     string // ex) "root@node12:22,ubuntu@13.125.43.21:22"(server, jump hosts)
+	Sessions int
+	LastUsed time.Time
+}
+
+//====================================================================
+
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		KeepAliveInterval: 30 * time.Second,
+		KeepAliveTimeout:  10 * time.Second,
+		IdleTimeout:       5 * time.Minute,
+		MaxSessions:       10,
+	}
+}
+
+// SSHRun, SSHCopy and the transfer functions use this pool.
+var DefaultPool = NewSSHPool(DefaultPoolConfig())
+
+type SSHPool struct {
+	config PoolConfig
+
+	lock    sync.Mutex
+	connMap map[string][]*pooledConn // key => connections
+	done    chan struct{}
+}
+
+type pooledConn struct {
+	key      string
+	name     string // key without secrets
+	client   *ssh.Client
+	config   *ssh.ClientConfig
+	sessions int
+	lastUsed time.Time
+	broken   bool // do not make new sessions, close after the last session.
+}
+
+func NewSSHPool(config PoolConfig) *SSHPool {
+	if config.MaxSessions <= 0 {
+		config.MaxSessions = 1
+	}
+
+	pool := &SSHPool{
+		config:  config,
+		connMap: map[string][]*pooledConn{},
+		done:    make(chan struct{}),
+	}
+	if config.KeepAliveInterval > 0 {
+		go pool.keepAlive()
+	}
+	return pool
+}
+
+// Run runs cmd with a pooled session.
+func (pool *SSHPool) Run(sshInfo SSHInfo, cmd string) (string, error) {
+	session, release, err := pool.NewSession(sshInfo)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	return runSession(session, cmd)
+}
+
+// Copy copies a local file into remotePath with a pooled session.
+func (pool *SSHPool) Copy(sshInfo SSHInfo, sourcePath string, remotePath string) error {
+	client, release, err := pool.NewSCPClient(sshInfo)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return Copy(client, sourcePath, remotePath)
+}
+
+// NewSCPClient makes a scp.Client with a pooled session.
+// Do not call Close() of the scp.Client, call release() instead.
+func (pool *SSHPool) NewSCPClient(sshInfo SSHInfo) (scp.Client, func(), error) {
+	conn, err := pool.acquire(sshInfo)
+	if err != nil {
+		return scp.Client{}, nil, err
+	}
+
+	session, err := pool.newSession(conn)
+	if err != nil {
+		return scp.Client{}, nil, err
+	}
+
+	if sshInfo.AgentForwarding {
+		if err := agent.RequestAgentForwarding(session); err != nil {
+			session.Close()
+			pool.release(conn)
+			return scp.Client{}, nil, err
+		}
+	}
+
+	client := scp.NewClient(sshInfo.ServerPort, conn.config)
+	client.Conn = conn.client.Conn
+	client.Session = session
+	return client, func() {
+		session.Close()
+		pool.release(conn)
+	}, nil
+}
+
+// NewSession makes a pooled session.
+// release() closes the session and returns the connection to the pool.
+func (pool *SSHPool) NewSession(sshInfo SSHInfo) (*ssh.Session, func(), error) {
+	conn, err := pool.acquire(sshInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session, err := pool.newSession(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sshInfo.AgentForwarding {
+		if err := agent.RequestAgentForwarding(session); err != nil {
+			session.Close()
+			pool.release(conn)
+			return nil, nil, err
+		}
+	}
+	return session, func() {
+		session.Close()
+		pool.release(conn)
+	}, nil
+}
+
+// NewClient lends a pooled *ssh.Client, ex) for SFTP.
+// The client should not be closed, call release() instead.
+func (pool *SSHPool) NewClient(sshInfo SSHInfo) (*ssh.Client, func(), error) {
+	conn, err := pool.acquire(sshInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn.client, func() { pool.release(conn) }, nil
+}
+
+// List returns the status of the pooled connections.
+func (pool *SSHPool) List() []*PoolStatus {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	var statusList []*PoolStatus
+	for _, connList := range pool.connMap {
+		for _, conn := range connList {
+			statusList = append(statusList, &PoolStatus{Name: conn.name, Sessions: conn.sessions, LastUsed: conn.lastUsed})
+		}
+	}
+	return statusList
+}
+
+// Close closes all connections and stops the keepalive.
+func (pool *SSHPool) Close() {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	select {
+	case <-pool.done:
+		return
+	default:
+		close(pool.done)
+	}
+	for key, connList := range pool.connMap {
+		for _, conn := range connList {
+			conn.client.Close()
+		}
+		delete(pool.connMap, key)
+	}
+}
+
+//----------------------------------------------
+
+// 0. set the default bastion, it is cached for bastionCacheTTL
+// 1. find a healthy connection with a free session slot
+// 2. dial a new connection if not exists
+func (pool *SSHPool) acquire(sshInfo SSHInfo) (*pooledConn, error) {
+	sshInfo, err := setDefaultBastion(sshInfo)
+	if err != nil {
+		return nil, err
+	}
+	key, name, err := poolKey(sshInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.lock.Lock()
+	for _, conn := range pool.connMap[key] {
+		if !conn.broken && conn.sessions < pool.config.MaxSessions {
+			conn.sessions++
+			conn.lastUsed = time.Now()
+			pool.lock.Unlock()
+			return conn, nil
+		}
+	}
+	pool.lock.Unlock()
+
+	cblog.Info("new pooled connection: " + name)
+	client, clientConfig, err := dial(sshInfo)
+	if err != nil {
+		return nil, err
+	}
+	conn := &pooledConn{key: key, name: name, client: client, config: clientConfig, sessions: 1, lastUsed: time.Now()}
+
+	pool.lock.Lock()
+	pool.connMap[key] = append(pool.connMap[key], conn)
+	pool.lock.Unlock()
+	return conn, nil
+}
+
+// newSession opens a session, a broken connection is released and removed.
+func (pool *SSHPool) newSession(conn *pooledConn) (*ssh.Session, error) {
+	session, err := conn.client.NewSession()
+	if err != nil {
+		pool.lock.Lock()
+		conn.broken = true
+		pool.lock.Unlock()
+		pool.release(conn)
+		return nil, err
+	}
+	return session, nil
+}
+
+func (pool *SSHPool) release(conn *pooledConn) {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	conn.sessions--
+	conn.lastUsed = time.Now()
+	if conn.broken && conn.sessions <= 0 {
+		pool.remove(conn)
+	}
+}
+
+// remove should be called with the lock.
+func (pool *SSHPool) remove(conn *pooledConn) {
+	connList := pool.connMap[conn.key]
+	for i, cur := range connList {
+		if cur == conn {
+			pool.connMap[conn.key] = append(connList[:i], connList[i+1:]...)
+			break
+		}
+	}
+	if len(pool.connMap[conn.key]) == 0 {
+		delete(pool.connMap, conn.key)
+	}
+	go conn.client.Close()
+}
+
+// keepAlive sends keepalive requests, closes idle or unhealthy connections.
+func (pool *SSHPool) keepAlive() {
+	ticker := time.NewTicker(pool.config.KeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pool.done:
+			return
+		case <-ticker.C:
+		}
+
+		var idleList []*pooledConn
+		var checkList []*pooledConn
+		pool.lock.Lock()
+		for _, connList := range pool.connMap {
+			for _, conn := range connList {
+				if conn.sessions <= 0 && pool.config.IdleTimeout > 0 && time.Since(conn.lastUsed) > pool.config.IdleTimeout {
+					idleList = append(idleList, conn)
+				} else {
+					checkList = append(checkList, conn)
+				}
+			}
+		}
+		for _, conn := range idleList {
+			cblog.Info("close idle pooled connection: " + conn.name)
+			conn.broken = true
+			pool.remove(conn)
+		}
+		pool.lock.Unlock()
+
+		for _, conn := range checkList {
+			if err := pool.checkHealth(conn); err != nil {
+				cblog.Error(fmt.Sprintf("close unhealthy pooled connection: %s: %v", conn.name, err))
+				pool.lock.Lock()
+				conn.broken = true
+				if conn.sessions <= 0 {
+					pool.remove(conn)
+				}
+				pool.lock.Unlock()
+			}
+		}
+	}
+}
+
+func (pool *SSHPool) checkHealth(conn *pooledConn) error {
+	errCh := make(chan error, 1)
+	go func() {
+		_, _, err := conn.client.SendRequest("keepalive@openssh.com", true, nil)
+		errCh <- err
+	}()
+
+	timeout := pool.config.KeepAliveTimeout
+	if timeout <= 0 {
+		timeout = pool.config.KeepAliveInterval
+	}
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("keepalive timeout")
+	}
+}
+
+// key: ex) root@node12:22/SHA256:abc...,ubuntu@13.125.43.21:22/SHA256:def...
+// name: ex) root@node12:22,ubuntu@13.125.43.21:22, without secrets for logs.
+func poolKey(sshInfo SSHInfo) (string, string, error) {
+	key, err := hostKey(sshInfo.UserName, sshInfo.PrivateKey, sshInfo.Password, sshInfo.ServerPort, sshInfo.HostKey)
+	if err != nil {
+		return "", "", err
+	}
+	if sshInfo.AgentForwarding {
+		key += "/agent"
+	}
+	keyList := []string{key}
+	nameList := []string{sshInfo.UserName + "@" + sshInfo.ServerPort}
+	for _, jumpHost := range sshInfo.JumpHosts {
+		key, err := hostKey(jumpHost.UserName, jumpHost.PrivateKey, jumpHost.Password, jumpHost.ServerPort, jumpHost.HostKey)
+		if err != nil {
+			return "", "", err
+		}
+		keyList = append(keyList, key)
+		nameList = append(nameList, jumpHost.UserName+"@"+jumpHost.ServerPort)
+	}
+	return strings.Join(keyList, ","), strings.Join(nameList, ","), nil
+}
+
+// the password is hashed, it is never kept in the key.
+func hostKey(userName string, privateKey []byte, password string, serverPort string, hostKey HostKeyInfo) (string, error) {
+	key := userName + "@" + serverPort
+	if len(privateKey) > 0 {
+		signer, err := ssh.ParsePrivateKey(privateKey)
+		if err != nil {
+			return "", err
+		}
+		key += "/" + ssh.FingerprintSHA256(signer.PublicKey())
+	}
+	if password != "" {
+		hash := sha256.Sum256([]byte(userName + "@" + serverPort + ":" + password))
+		key += "/PASSWORD:" + hex.EncodeToString(hash[:])
+	}
+	return key + "/" + strings.ToUpper(hostKey.Policy) + hostKey.HostKey + hostKey.KnownHostsPath, nil
+}
+
+func runSession(session *ssh.Session, cmd string) (string, error) {
+	var stdout bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = os.Stderr
+
+	err := session.Run(cmd)
+	return strings.Trim(stdout.String(), "\n"), err
+}
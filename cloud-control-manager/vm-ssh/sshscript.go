@@ -0,0 +1,245 @@
+// Script Execution of VM's SSH and SCP of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Renders a script with Go templates, copies it into the server with
+// SSHCopy and runs it(bash or PowerShell) with a pooled session.
+//
+// by agent@local, 2026.10.
+
+package sshrun
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	ShellBash       = "bash"
+	ShellPowerShell = "powershell"
+)
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+//====================================================================
+type ScriptOption struct {
+	Shell        string            // "bash"(default), "powershell"
+	Args         []string          // ex) ["--port", "8080"], arguments of the script
+	Env          map[string]string // ex) {"AGENT_VERSION": "0.1"}
+	UseSudo      bool              // bash only, run the script with sudo
+	SudoUser     string            // ex) "cb-user", sudo -u, default: root
+	SudoPassword string            // sudo -S with this password, never logged. "sudo -n" if empty
+	RemoteDir    string            // ex) "/tmp"(bash default), directory to copy the script into
+	KeepScript   bool              // do not remove the script after running
+}
+
+type ScriptResult struct {
+	ScriptPath string // ex) "/tmp/cb-spider-script-1a2b3c4d.sh"
+	ExitCode   int    // -1: killed by a signal or no exit status
+	Stdout     string
+	Stderr     string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+//====================================================================
+
+// RenderScript renders a Go template script with data.
+// ex) "echo {{.VM.PublicIP}} {{.Vars.version}}"
+// A missing key is an error, not "<no value>".
+func RenderScript(name string, script string, data interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(script)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// RunScript copies the script into the server and runs it.
+// A non-zero exit code is returned in the result, not as an error.
+func RunScript(sshInfo SSHInfo, script string, option ScriptOption) (*ScriptResult, error) {
+	cblog.Info("call RunScript()")
+
+	shell := strings.ToLower(option.Shell)
+	if shell == "" {
+		shell = ShellBash
+	}
+	if shell != ShellBash && shell != ShellPowerShell {
+		return nil, fmt.Errorf("%s: is not a supported shell!", option.Shell)
+	}
+	if shell == ShellPowerShell && option.UseSudo {
+		return nil, fmt.Errorf("UseSudo is not supported with PowerShell!")
+	}
+	for key := range option.Env {
+		if !envNameRegexp.MatchString(key) {
+			return nil, fmt.Errorf("%s: is not a valid environment variable name!", key)
+		}
+	}
+
+	scriptPath, err := scriptPath(shell, option.RemoteDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. copy the script
+	if shell == ShellPowerShell {
+		script = strings.Replace(script, "\r\n", "\n", -1)
+		script = strings.Replace(script, "\n", "\r\n", -1)
+	}
+	// the script of "sudo -u" should be readable by the other user.
+	perm := os.FileMode(0600)
+	if option.UseSudo && option.SudoUser != "" {
+		perm = 0644
+	}
+	if err := copyScript(sshInfo, script, scriptPath, perm); err != nil {
+		return nil, err
+	}
+	if !option.KeepScript {
+		defer removeScript(sshInfo, shell, scriptPath)
+	}
+
+	// 2. run the script
+	session, release, err := DefaultPool.NewSession(sshInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+	if option.UseSudo && option.SudoPassword != "" {
+		session.Stdin = strings.NewReader(option.SudoPassword + "\n")
+	}
+
+	result := &ScriptResult{ScriptPath: scriptPath, StartTime: time.Now()}
+	err = session.Run(scriptCommand(shell, scriptPath, option))
+	result.EndTime = time.Now()
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+
+	switch err := err.(type) {
+	case nil:
+		result.ExitCode = 0
+	case *ssh.ExitError:
+		result.ExitCode = err.ExitStatus()
+	case *ssh.ExitMissingError:
+		result.ExitCode = -1
+	default:
+		return nil, err
+	}
+	return result, nil
+}
+
+//----------------------------------------------
+
+// ex) /tmp/cb-spider-script-1a2b3c4d.sh, cb-spider-script-1a2b3c4d.ps1(home directory)
+func scriptPath(shell string, remoteDir string) (string, error) {
+	random := make([]byte, 4)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	name := "cb-spider-script-" + hex.EncodeToString(random)
+
+	if shell == ShellPowerShell {
+		name += ".ps1"
+		if remoteDir == "" {
+			return name, nil
+		}
+	} else {
+		name += ".sh"
+		if remoteDir == "" {
+			remoteDir = "/tmp"
+		}
+	}
+	return strings.TrimRight(remoteDir, "/") + "/" + name, nil
+}
+
+// the script is copied with SSHCopy through a local temp file.
+func copyScript(sshInfo SSHInfo, script string, scriptPath string, perm os.FileMode) error {
+	tmpFile, err := ioutil.TempFile("", "cb-spider-script-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString(script)
+	tmpFile.Close()
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), perm); err != nil {
+		return err
+	}
+
+	return DefaultPool.Copy(sshInfo, tmpFile.Name(), scriptPath)
+}
+
+func removeScript(sshInfo SSHInfo, shell string, scriptPath string) {
+	cmd := "rm -f " + ShellQuote(scriptPath)
+	if shell == ShellPowerShell {
+		cmd = "powershell -NoProfile -NonInteractive -Command Remove-Item -Force " + psQuote(scriptPath)
+	}
+	if _, err := DefaultPool.Run(sshInfo, cmd); err != nil {
+		cblog.Error(fmt.Sprintf("failed to remove the script %s: %v", scriptPath, err))
+	}
+}
+
+// ex) sudo -n -u cb-user env AGENT_VERSION='0.1' bash '/tmp/cb-spider-script-1a2b3c4d.sh' '--port' '8080'
+func scriptCommand(shell string, scriptPath string, option ScriptOption) string {
+	var cmdList []string
+	if shell == ShellPowerShell {
+		for key, value := range option.Env {
+			cmdList = append(cmdList, "$env:"+key+"="+psQuote(value)+";")
+		}
+		cmdList = append(cmdList, "& "+psQuote(scriptPath))
+		for _, arg := range option.Args {
+			cmdList = append(cmdList, psQuote(arg))
+		}
+		return "powershell -NoProfile -NonInteractive -ExecutionPolicy Bypass -Command " + strings.Join(cmdList, " ")
+	}
+
+	if option.UseSudo {
+		if option.SudoPassword != "" {
+			cmdList = append(cmdList, "sudo", "-S", "-p", "''")
+		} else {
+			cmdList = append(cmdList, "sudo", "-n")
+		}
+		if option.SudoUser != "" {
+			cmdList = append(cmdList, "-u", ShellQuote(option.SudoUser))
+		}
+	}
+	if len(option.Env) > 0 {
+		cmdList = append(cmdList, "env")
+		for key, value := range option.Env {
+			cmdList = append(cmdList, key+"="+ShellQuote(value))
+		}
+	}
+	cmdList = append(cmdList, "bash", ShellQuote(scriptPath))
+	for _, arg := range option.Args {
+		cmdList = append(cmdList, ShellQuote(arg))
+	}
+	return strings.Join(cmdList, " ")
+}
+
+// PowerShell single-quoted string, ex) 'it”s'
+func psQuote(str string) string {
+	return "'" + strings.Replace(str, "'", "''", -1) + "'"
+}
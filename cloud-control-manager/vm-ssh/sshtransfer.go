@@ -0,0 +1,441 @@
+// File Transfer of VM's SSH and SCP of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Upload, Download and Directory Copy on top of SSHCopy(scp.Client).
+// SFTP can be used instead of SCP with TransferOption.UseSFTP.
+//
+// by agent@local, 2026.10.
+
+package sshrun
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const defaultPermissions = "0644"
+
+// ProgressFunc is called while transferring a file.
+// total is -1 when the size is not known before the transfer.
+type ProgressFunc func(filePath string, transferred int64, total int64)
+
+//====================================================================
+type TransferOption struct {
+	Permissions string // ex) "0755", default: source file's mode or "0644"
+	Owner       string // ex) "cb-user", chown after transfer if not empty
+	Group       string // ex) "cb-group"
+	UseSudo     bool   // run chown with sudo
+	Checksum    bool   // verify sha256 of the remote file after transfer
+	UseSFTP     bool   // use SFTP instead of SCP
+
+	Progress ProgressFunc
+}
+
+type TransferResult struct {
+	SourcePath string // ex) "/tmp/farmoni_agent"
+	TargetPath string // ex) "/root/farmoni_agent"
+	Size       int64
+	Checksum   string // sha256, hex string
+}
+
+//====================================================================
+
+// Upload copies the contents of reader into remotePath.
+func Upload(sshInfo SSHInfo, reader io.Reader, size int64, remotePath string, option TransferOption) (TransferResult, error) {
+	cblog.Info("call Upload()")
+
+	perm := option.Permissions
+	if perm == "" {
+		perm = defaultPermissions
+	}
+	if err := checkPermissions(perm); err != nil {
+		return TransferResult{}, err
+	}
+
+	hash := sha256.New()
+	counter := &progressCounter{filePath: remotePath, total: size, progress: option.Progress}
+	tee := io.TeeReader(io.TeeReader(reader, hash), counter)
+
+	var err error
+	if option.UseSFTP {
+		err = sftpUpload(sshInfo, tee, remotePath, perm)
+	} else {
+		err = scpUpload(sshInfo, tee, size, remotePath, perm)
+	}
+	if err != nil {
+		return TransferResult{}, err
+	}
+
+	result := TransferResult{
+		TargetPath: remotePath,
+		Size:       counter.transferred,
+		Checksum:   hex.EncodeToString(hash.Sum(nil)),
+	}
+
+	if err := changeOwner(sshInfo, remotePath, option); err != nil {
+		return result, err
+	}
+	if option.Checksum {
+		if err := verifyChecksum(sshInfo, remotePath, result.Checksum); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// UploadFile copies a local file into remotePath.
+// The permissions of the local file are kept if option.Permissions is empty.
+func UploadFile(sshInfo SSHInfo, sourcePath string, remotePath string, option TransferOption) (TransferResult, error) {
+	cblog.Info("call UploadFile()")
+
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return TransferResult{}, err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return TransferResult{}, err
+	}
+	if fileInfo.IsDir() {
+		return TransferResult{}, fmt.Errorf("%s: is a directory, use CopyDir()", sourcePath)
+	}
+	if option.Permissions == "" {
+		option.Permissions = toPermString(fileInfo.Mode())
+	}
+
+	result, err := Upload(sshInfo, file, fileInfo.Size(), remotePath, option)
+	result.SourcePath = sourcePath
+	return result, err
+}
+
+// Download copies the contents of remotePath into writer.
+func Download(sshInfo SSHInfo, remotePath string, writer io.Writer, option TransferOption) (TransferResult, error) {
+	cblog.Info("call Download()")
+
+	hash := sha256.New()
+	counter := &progressCounter{filePath: remotePath, total: -1, progress: option.Progress}
+	multi := io.MultiWriter(writer, hash, counter)
+
+	var err error
+	if option.UseSFTP {
+		err = sftpDownload(sshInfo, remotePath, multi, counter)
+	} else {
+		err = scpDownload(sshInfo, remotePath, multi, counter)
+	}
+	if err != nil {
+		return TransferResult{}, err
+	}
+
+	result := TransferResult{
+		SourcePath: remotePath,
+		Size:       counter.transferred,
+		Checksum:   hex.EncodeToString(hash.Sum(nil)),
+	}
+	if option.Checksum {
+		if err := verifyChecksum(sshInfo, remotePath, result.Checksum); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// DownloadFile copies remotePath into a local file.
+func DownloadFile(sshInfo SSHInfo, remotePath string, targetPath string, option TransferOption) (TransferResult, error) {
+	cblog.Info("call DownloadFile()")
+
+	file, err := os.Create(targetPath)
+	if err != nil {
+		return TransferResult{}, err
+	}
+	defer file.Close()
+
+	result, err := Download(sshInfo, remotePath, file, option)
+	result.TargetPath = targetPath
+	return result, err
+}
+
+// CopyDir copies a local directory into remoteDir recursively.
+// ex) CopyDir(sshInfo, "/tmp/agent", "/opt/agent", option)
+//     /tmp/agent/bin/agent => /opt/agent/bin/agent
+func CopyDir(sshInfo SSHInfo, sourceDir string, remoteDir string, option TransferOption) ([]*TransferResult, error) {
+	cblog.Info("call CopyDir()")
+
+	var dirList []string
+	var fileList []string
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			dirList = append(dirList, path.Join(remoteDir, filepath.ToSlash(relPath)))
+		} else if info.Mode().IsRegular() {
+			fileList = append(fileList, relPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := MakeDirs(sshInfo, dirList, option); err != nil {
+		return nil, err
+	}
+
+	var resultList []*TransferResult
+	for _, relPath := range fileList {
+		remotePath := path.Join(remoteDir, filepath.ToSlash(relPath))
+		result, err := UploadFile(sshInfo, filepath.Join(sourceDir, relPath), remotePath, option)
+		if err != nil {
+			return resultList, err
+		}
+		resultList = append(resultList, &result)
+	}
+	return resultList, nil
+}
+
+// MakeDirs creates remote directories with their parents(mkdir -p).
+func MakeDirs(sshInfo SSHInfo, dirList []string, option TransferOption) error {
+	if len(dirList) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(dirList))
+	for i, dir := range dirList {
+		quoted[i] = ShellQuote(dir)
+	}
+	cmd := "mkdir -p " + strings.Join(quoted, " ")
+	if option.Owner != "" {
+		cmd += " && " + chownCommand(option) + " " + strings.Join(quoted, " ")
+	}
+	_, err := SSHRun(sshInfo, cmd)
+	return err
+}
+
+// ShellQuote quotes a string for the remote shell.
+// ex) /tmp/my file => '/tmp/my file'
+func ShellQuote(str string) string {
+	return "'" + strings.Replace(str, "'", `'\''`, -1) + "'"
+}
+
+//---------------------------------------------- SCP
+
+func scpUpload(sshInfo SSHInfo, reader io.Reader, size int64, remotePath string, perm string) error {
+	sshCli, release, err := DefaultPool.NewSCPClient(sshInfo)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return sshCli.Copy(reader, remotePath, perm, size)
+}
+
+// scp 'sink' side of the protocol: run "scp -f" on the server and read one file.
+func scpDownload(sshInfo SSHInfo, remotePath string, writer io.Writer, counter *progressCounter) error {
+	session, release, err := DefaultPool.NewSession(sshInfo)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return scpReceive(session, remotePath, writer, counter)
+}
+
+func scpReceive(session *ssh.Session, remotePath string, writer io.Writer, counter *progressCounter) error {
+	in, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := session.Start("scp -f " + ShellQuote(remotePath)); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(out)
+
+	// ready to receive
+	if _, err := in.Write([]byte{0}); err != nil {
+		return err
+	}
+
+	// ex) C0644 1024 filename
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if len(header) == 0 || header[0] != 'C' {
+		return fmt.Errorf("scp: %s: %s", remotePath, strings.TrimSpace(strings.TrimLeft(header, "\x01\x02")))
+	}
+	fields := strings.SplitN(strings.TrimSpace(header[1:]), " ", 3)
+	if len(fields) != 3 {
+		return fmt.Errorf("scp: invalid header: %q", header)
+	}
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("scp: invalid size: %q", header)
+	}
+	counter.total = size
+
+	if _, err := in.Write([]byte{0}); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(writer, reader, size); err != nil {
+		return err
+	}
+
+	// end of file
+	if ack, err := reader.ReadByte(); err != nil {
+		return err
+	} else if ack != 0 {
+		return fmt.Errorf("scp: %s: transfer failed", remotePath)
+	}
+	if _, err := in.Write([]byte{0}); err != nil {
+		return err
+	}
+	in.Close()
+
+	return session.Wait()
+}
+
+//---------------------------------------------- SFTP
+
+func sftpUpload(sshInfo SSHInfo, reader io.Reader, remotePath string, perm string) error {
+	sshCli, release, err := DefaultPool.NewClient(sshInfo)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return err
+	}
+	defer sftpCli.Close()
+
+	file, err := sftpCli.Create(remotePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, reader); err != nil {
+		return err
+	}
+
+	mode, _ := strconv.ParseUint(perm, 8, 32)
+	return file.Chmod(os.FileMode(mode))
+}
+
+func sftpDownload(sshInfo SSHInfo, remotePath string, writer io.Writer, counter *progressCounter) error {
+	sshCli, release, err := DefaultPool.NewClient(sshInfo)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return err
+	}
+	defer sftpCli.Close()
+
+	file, err := sftpCli.Open(remotePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if fileInfo, err := file.Stat(); err == nil {
+		counter.total = fileInfo.Size()
+	}
+	_, err = io.Copy(writer, file)
+	return err
+}
+
+//---------------------------------------------- common
+
+func changeOwner(sshInfo SSHInfo, remotePath string, option TransferOption) error {
+	if option.Owner == "" {
+		return nil
+	}
+	_, err := SSHRun(sshInfo, chownCommand(option)+" "+ShellQuote(remotePath))
+	return err
+}
+
+func chownCommand(option TransferOption) string {
+	owner := option.Owner
+	if option.Group != "" {
+		owner += ":" + option.Group
+	}
+	cmd := "chown -R " + ShellQuote(owner)
+	if option.UseSudo {
+		cmd = "sudo -n " + cmd
+	}
+	return cmd
+}
+
+func verifyChecksum(sshInfo SSHInfo, remotePath string, checksum string) error {
+	result, err := SSHRun(sshInfo, "sha256sum "+ShellQuote(remotePath))
+	if err != nil {
+		return err
+	}
+	// ex) 9f86d08...  /tmp/farmoni_agent
+	fields := strings.Fields(result)
+	if len(fields) < 1 || fields[0] != checksum {
+		return fmt.Errorf("%s: checksum mismatch (local: %s, remote: %s)", remotePath, checksum, result)
+	}
+	return nil
+}
+
+func checkPermissions(perm string) error {
+	if len(perm) != 4 {
+		return fmt.Errorf("%q: permissions should be 4 octal digits, ex) 0644", perm)
+	}
+	if _, err := strconv.ParseUint(perm, 8, 32); err != nil {
+		return fmt.Errorf("%q: permissions should be 4 octal digits, ex) 0644", perm)
+	}
+	return nil
+}
+
+// ex) -rwxr-xr-x => "0755"
+func toPermString(mode os.FileMode) string {
+	return fmt.Sprintf("%04o", mode.Perm())
+}
+
+type progressCounter struct {
+	filePath    string
+	transferred int64
+	total       int64
+	progress    ProgressFunc
+}
+
+func (counter *progressCounter) Write(p []byte) (int, error) {
+	counter.transferred += int64(len(p))
+	if counter.progress != nil {
+		counter.progress(counter.filePath, counter.transferred, counter.total)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,46 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// pooled connection test for sshrun
+//
+// by agent@local, 2026.10.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/vm-ssh"
+)
+
+func main() {
+
+	// server connection info
+	privateKey, err := ioutil.ReadFile("/root/.ssh/id_rsa")
+	if err != nil {
+		fmt.Println("Error while reading private key ", err)
+		return
+	}
+
+	sshInfo := sshrun.SSHInfo{
+		UserName:   "root",
+		PrivateKey: privateKey,
+		ServerPort: "node12:22",
+	}
+
+	// the 1st call dials, the others reuse the pooled connection.
+	for i := 0; i < 5; i++ {
+		start := time.Now()
+		result, err := sshrun.SSHRun(sshInfo, "uptime")
+		if err != nil {
+			fmt.Println("Error while running cmd: uptime", err)
+		}
+		fmt.Printf("[%d] %s (%v)\n", i, result, time.Since(start))
+	}
+
+	for _, status := range sshrun.DefaultPool.List() {
+		fmt.Println(*status)
+	}
+	sshrun.DefaultPool.Close()
+}
@@ -0,0 +1,61 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// upload, download & directory copy test for sshrun
+//
+// by agent@local, 2026.10.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cloud-barista/cb-spider/cloud-control-manager/vm-ssh"
+)
+
+func main() {
+
+	// server connection info
+	privateKey, err := ioutil.ReadFile("/root/.ssh/id_rsa")
+	if err != nil {
+		fmt.Println("Error while reading private key ", err)
+		return
+	}
+
+	sshInfo := sshrun.SSHInfo{
+		UserName:   "root",
+		PrivateKey: privateKey,
+		ServerPort: "node12:22",
+	}
+
+	option := sshrun.TransferOption{
+		Checksum: true,
+		Progress: func(filePath string, transferred int64, total int64) {
+			fmt.Printf("%s: %d/%d\n", filePath, transferred, total)
+		},
+	}
+
+	// upload a file
+	result, err := sshrun.UploadFile(sshInfo, "/root/go/src/farmoni/farmoni_agent/farmoni_agent", "/tmp/farmoni_agent", option)
+	if err != nil {
+		fmt.Println("Error while uploading file ", err)
+	}
+	fmt.Println(result)
+
+	// download the file with SFTP
+	option.UseSFTP = true
+	result, err = sshrun.DownloadFile(sshInfo, "/tmp/farmoni_agent", "/tmp/farmoni_agent.download", option)
+	if err != nil {
+		fmt.Println("Error while downloading file ", err)
+	}
+	fmt.Println(result)
+
+	// copy a directory
+	resultList, err := sshrun.CopyDir(sshInfo, "/root/go/src/farmoni/farmoni_agent", "/tmp/farmoni_agent_dir", option)
+	if err != nil {
+		fmt.Println("Error while copying directory ", err)
+	}
+	for _, result := range resultList {
+		fmt.Println(*result)
+	}
+}
@@ -29,6 +29,30 @@ type ConnectionConfigInfo struct {
 	CredentialName	string	// ex) "credential01"
 	RegionName	string	// ex) "region01"
 }
+
+// default bastion(jump host) VM of a connection config.
+// SSH operations with the connection config tunnel through this VM.
+type BastionInfo struct {
+	ConfigName	string	// ex) "config01"
+	VMId		string	// ex) "i-0b65e9b9d0e8e7b0c", the PublicIP of this VM is used.
+	ServerPort	string	// ex) "13.125.43.21:22", used instead of VMId if not empty
+	UserName	string	// ex) "ubuntu"
+	HostKey		string	// ex) "ssh-rsa AAAAB3NzaC1yc2E...", ignore the host key if empty
+}
+
+// error of GetBastion() if the connection config has no bastion.
+type bastionNotExistError struct {
+	configName	string
+}
+
+func (e *bastionNotExistError) Error() string {
+	return e.configName + ": bastion is not exist!"
+}
+
+func IsBastionNotExist(err error) bool {
+	_, ok := err.(*bastionNotExistError)
+	return ok
+}
 //====================================================================
 
 
@@ -104,9 +128,84 @@ func DeleteConnectionConfig(configName string) (bool, error) {
                 return false, err
         }
 
+	// remove the bastion of this config, if exists.
+	if _, err := deleteBastionInfo(configName); err != nil {
+		cblog.Error(err)
+	}
+
         return result, nil
 }
 
+// 1. check params
+// 2. check the connection config
+// 3. insert them into cb-store
+func SetBastion(bastionInfo BastionInfo) (*BastionInfo, error) {
+	cblog.Info("call SetBastion()")
+
+	if bastionInfo.ConfigName == "" {
+		return nil, fmt.Errorf("configName is empty!")
+	}
+	if bastionInfo.VMId == "" && bastionInfo.ServerPort == "" {
+		return nil, fmt.Errorf("VMId and ServerPort are empty!")
+	}
+	if bastionInfo.UserName == "" {
+		return nil, fmt.Errorf("userName is empty!")
+	}
+
+	if _, err := getInfo(bastionInfo.ConfigName); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// replace the old one
+	if _, err := deleteBastionInfo(bastionInfo.ConfigName); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err := insertBastionInfo(bastionInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &bastionInfo, nil
+}
+
+func GetBastion(configName string) (*BastionInfo, error) {
+	cblog.Info("call GetBastion()")
+
+	if configName == "" {
+		return nil, fmt.Errorf("configName is empty!")
+	}
+
+	bastionInfo, err := getBastionInfo(configName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return bastionInfo, nil
+}
+
+func DeleteBastion(configName string) (bool, error) {
+	cblog.Info("call DeleteBastion()")
+
+	if configName == "" {
+		return false, fmt.Errorf("configName is empty!")
+	}
+
+	result, err := deleteBastionInfo(configName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if !result {
+		return false, &bastionNotExistError{configName}
+	}
+
+	return true, nil
+}
+
 //----------------
 
 func checkParams(configName string, providerName string, driverName string, credentialName string, regionName string) error {
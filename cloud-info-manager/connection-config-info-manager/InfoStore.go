@@ -140,3 +140,93 @@ func deleteInfo(configName string) (bool, error) {
         return false, fmt.Errorf(configName + ": is not exist!")
 }
 
+
+//----------------------------------------------- Bastion
+
+// format
+// not under /cloud-info-spaces/connection-configs, listInfo() gets all the keys with the prefix.
+// /cloud-info-spaces/bastions/<ConfigName>/{key1} [value1]
+// ex)
+// /cloud-info-spaces/bastions/config01/VMId [i-0b65e9b9d0e8e7b0c]
+// /cloud-info-spaces/bastions/config01/ServerPort []
+// /cloud-info-spaces/bastions/config01/UserName [ubuntu]
+// /cloud-info-spaces/bastions/config01/HostKey [ssh-rsa AAAAB3NzaC1yc2E...]
+
+func insertBastionInfo(bastionInfo BastionInfo) error {
+	format := "/cloud-info-spaces/bastions/" + bastionInfo.ConfigName
+
+	keyValueList := []icbs.KeyValue{
+		{Key: "VMId", Value: bastionInfo.VMId},
+		{Key: "ServerPort", Value: bastionInfo.ServerPort},
+		{Key: "UserName", Value: bastionInfo.UserName},
+		{Key: "HostKey", Value: bastionInfo.HostKey},
+	}
+// @todo lock
+	for _, kv := range keyValueList {
+		err := store.Put(format + "/" + kv.Key, kv.Value)
+		if err != nil {
+			return err
+		}
+	}
+// @todo lock
+	return nil
+}
+
+func getBastionInfo(configName string) (*BastionInfo, error) {
+	key := "/cloud-info-spaces/bastions/" + configName
+
+	// key is not the key of cb-store, so we have to use GetList()
+	keyValueList, err := store.GetList(key, true)
+	if err != nil {
+		return nil, err
+	}
+
+	bastionInfo := &BastionInfo{ConfigName: configName}
+	found := false
+	for _, kv := range keyValueList {
+		// keyValueList should have ~/config01/... or ~/config01-01/...,
+		// so we have to check the sameness of configName.
+		if utils.GetNodeValue(kv.Key, 3) != configName {
+			continue
+		}
+		found = true
+		switch utils.GetNodeValue(kv.Key, 4) {
+		case "VMId":
+			bastionInfo.VMId = kv.Value
+		case "ServerPort":
+			bastionInfo.ServerPort = kv.Value
+		case "UserName":
+			bastionInfo.UserName = kv.Value
+		case "HostKey":
+			bastionInfo.HostKey = kv.Value
+		}
+	}
+	if !found {
+		return nil, &bastionNotExistError{configName}
+	}
+
+	return bastionInfo, nil
+}
+
+func deleteBastionInfo(configName string) (bool, error) {
+	key := "/cloud-info-spaces/bastions/" + configName
+
+// @todo lock-start
+	keyValueList, err := store.GetList(key, true)
+	if err != nil {
+		return false, err
+	}
+	result := false
+	for _, kv := range keyValueList {
+		if utils.GetNodeValue(kv.Key, 3) == configName {
+			err = store.Delete(kv.Key)
+			if err != nil {
+				return false, err
+			}
+			result = true
+		}
+	}
+// @todo lock-end
+
+	return result, nil
+}
@@ -0,0 +1,140 @@
+// ScriptInfo <-> CB-Store Handler for Script Info. Manager.
+// Script Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by agent@local, 2026.10.
+
+package scriptinfomanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-store/utils"
+	"github.com/cloud-barista/cb-store"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+var store icbs.Store
+
+func init() {
+        store = cbstore.GetStore()
+}
+
+
+// format
+// /cloud-info-spaces/scripts/<ScriptName>/{key1} [value1]
+// ex)
+// /cloud-info-spaces/scripts/install-agent/Shell [bash]
+// /cloud-info-spaces/scripts/install-agent/Description [install the monitoring agent]
+// /cloud-info-spaces/scripts/install-agent/Script [#!/bin/bash\ncurl -sSL ...]
+
+func insertInfo(scriptInfo ScriptInfo) error {
+	format := "/cloud-info-spaces/scripts/" + scriptInfo.ScriptName
+
+	keyValueList := []icbs.KeyValue{
+		{Key: "Shell", Value: scriptInfo.Shell},
+		{Key: "Description", Value: scriptInfo.Description},
+		{Key: "Script", Value: strings.Join(scriptInfo.Script, "\n")},
+	}
+// @todo lock
+	for _, kv := range keyValueList {
+		err := store.Put(format + "/" + kv.Key, kv.Value)
+		if err != nil {
+			return err
+		}
+	}
+// @todo lock
+	return nil
+}
+
+// 1. get key-value list
+// 2. create ScriptInfo List & return
+func listInfo() ([]*ScriptInfo, error) {
+	key := "/cloud-info-spaces/scripts"
+	keyValueList, err := store.GetList(key, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var scriptInfoList []*ScriptInfo
+	scriptInfoMap := map[string]*ScriptInfo{}
+	for _, kv := range keyValueList {
+		scriptName := utils.GetNodeValue(kv.Key, 3)
+		scriptInfo, ok := scriptInfoMap[scriptName]
+		if !ok {
+			scriptInfo = &ScriptInfo{ScriptName: scriptName}
+			scriptInfoMap[scriptName] = scriptInfo
+			scriptInfoList = append(scriptInfoList, scriptInfo)
+		}
+		setValue(scriptInfo, utils.GetNodeValue(kv.Key, 4), kv.Value)
+	}
+
+	return scriptInfoList, nil
+}
+
+// 1. get key-value list
+// 2. create ScriptInfo & return
+func getInfo(scriptName string) (*ScriptInfo, error) {
+	key := "/cloud-info-spaces/scripts/" + scriptName
+
+	// key is not the key of cb-store, so we have to use GetList()
+	keyValueList, err := store.GetList(key, true)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptInfo := &ScriptInfo{ScriptName: scriptName}
+	found := false
+	for _, kv := range keyValueList {
+		// keyValueList should have ~/script01/... or ~/script01-01/...,
+		// so we have to check the sameness of scriptName.
+		if utils.GetNodeValue(kv.Key, 3) != scriptName {
+			continue
+		}
+		found = true
+		setValue(scriptInfo, utils.GetNodeValue(kv.Key, 4), kv.Value)
+	}
+	if !found {
+		return nil, fmt.Errorf("%s: is not exist!", scriptName)
+	}
+
+	return scriptInfo, nil
+}
+
+func deleteInfo(scriptName string) (bool, error) {
+	key := "/cloud-info-spaces/scripts/" + scriptName
+
+// @todo lock-start
+	keyValueList, err := store.GetList(key, true)
+	if err != nil {
+		return false, err
+	}
+	result := false
+	for _, kv := range keyValueList {
+		if utils.GetNodeValue(kv.Key, 3) == scriptName {
+			err = store.Delete(kv.Key)
+			if err != nil {
+				return false, err
+			}
+			result = true
+		}
+	}
+// @todo lock-end
+
+	return result, nil
+}
+
+func setValue(scriptInfo *ScriptInfo, key string, value string) {
+	switch key {
+	case "Shell":
+		scriptInfo.Shell = value
+	case "Description":
+		scriptInfo.Description = value
+	case "Script":
+		scriptInfo.Script = strings.Split(value, "\n")
+	}
+}
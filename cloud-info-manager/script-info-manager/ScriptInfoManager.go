@@ -0,0 +1,147 @@
+// Script Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Reusable named scripts for the VM's SSH(POST /sshrun/script).
+//
+// by agent@local, 2026.10.
+
+package scriptinfomanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/cloud-barista/cb-store/config"
+)
+
+var cblog *logrus.Logger
+
+func init() {
+        cblog = config.Cblogger
+}
+
+//====================================================================
+type ScriptInfo struct {
+	ScriptName	string	// ex) "install-agent"
+	Shell		string	// ex) "bash"(default), "powershell"
+	Description	string	// ex) "install the monitoring agent"
+	Script		[]string	// ex) ["#!/bin/bash",
+					//	"curl -sSL {{.Vars.url}} | bash -s {{.VM.PublicIP}}"]
+}
+//====================================================================
+
+
+func RegisterScriptInfo(scriptInfo ScriptInfo) (*ScriptInfo, error) {
+	return RegisterScript(scriptInfo.ScriptName, scriptInfo.Shell, scriptInfo.Description, scriptInfo.Script)
+}
+
+// 1. check params
+// 2. insert them into cb-store
+func RegisterScript(scriptName string, shell string, description string, script []string) (*ScriptInfo, error) {
+	cblog.Info("call RegisterScript()")
+
+	cblog.Debug("check params")
+	err := checkParams(scriptName, shell, script)
+	if err != nil {
+		return nil, err
+	}
+	if shell == "" {
+		shell = "bash"
+	}
+
+	cblog.Debug("insert metainfo into store")
+
+	// replace the old one
+	if _, err := deleteInfo(scriptName); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	scriptInfo := &ScriptInfo{scriptName, shell, description, script}
+	err = insertInfo(*scriptInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return scriptInfo, nil
+}
+
+func ListScript() ([]*ScriptInfo, error) {
+	cblog.Info("call ListScript()")
+
+	scriptInfoList, err := listInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return scriptInfoList, nil
+}
+
+// 1. check params
+// 2. get ScriptInfo from cb-store
+func GetScript(scriptName string) (*ScriptInfo, error) {
+	cblog.Info("call GetScript()")
+
+	if err := checkScriptName(scriptName); err != nil {
+		return nil, err
+	}
+
+	scriptInfo, err := getInfo(scriptName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return scriptInfo, nil
+}
+
+func UnRegisterScript(scriptName string) (bool, error) {
+	cblog.Info("call UnRegisterScript()")
+
+	if err := checkScriptName(scriptName); err != nil {
+		return false, err
+	}
+
+	result, err := deleteInfo(scriptName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if !result {
+		return false, fmt.Errorf("%s: is not exist!", scriptName)
+	}
+
+	return true, nil
+}
+
+//----------------
+
+// the name is a node of the cb-store key(/cloud-info-spaces/scripts/<ScriptName>/...).
+func checkScriptName(scriptName string) error {
+	if scriptName == "" {
+		return fmt.Errorf("scriptName is empty!")
+	}
+	if strings.Contains(scriptName, "/") {
+		return fmt.Errorf("%s: scriptName can not include '/'!", scriptName)
+	}
+	return nil
+}
+
+func checkParams(scriptName string, shell string, script []string) error {
+	if err := checkScriptName(scriptName); err != nil {
+		return err
+	}
+	switch shell {
+	case "", "bash", "powershell":
+	default:
+		return fmt.Errorf("%s: is not a supported shell!", shell)
+	}
+	if len(script) == 0 {
+		return fmt.Errorf("script is empty!")
+	}
+	return nil
+}
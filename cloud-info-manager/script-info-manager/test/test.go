@@ -0,0 +1,78 @@
+// Test for Script Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by agent@local, 2026.10.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/cloud-barista/cb-store/config"
+	sim "github.com/cloud-barista/cb-spider/cloud-info-manager/script-info-manager"
+)
+func main() {
+
+fmt.Println("\n============== RegisterScript()")
+	sName := "hostname"
+	script := []string{ "#!/bin/bash",
+			    "echo {{.Vars.greeting}} $(hostname)",
+			  }
+	scriptInfo, err := sim.RegisterScript(sName, "bash", "print the hostname", script)
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+
+	fmt.Printf(" === %#v\n", scriptInfo)
+
+fmt.Println("\n============== RegisterScript()")
+	sName = "install-agent"
+	script = []string{ "#!/bin/bash",
+			   "curl -sSL {{.Vars.url}} -o /tmp/agent && chmod +x /tmp/agent",
+			   "/tmp/agent --server {{.VM.PublicIP}}",
+			 }
+	scriptInfo, err = sim.RegisterScript(sName, "bash", "install the monitoring agent", script)
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+
+	fmt.Printf(" === %#v\n", scriptInfo)
+
+fmt.Println("\n============== ListScript()")
+	scriptInfoList, err := sim.ListScript()
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+
+	for _, scriptInfo := range scriptInfoList {
+		fmt.Printf(" === %#v\n", scriptInfo)
+	}
+
+fmt.Println("\n============== GetScript()")
+	scriptInfo, err = sim.GetScript(sName)
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+
+	fmt.Printf(" === %#v\n", scriptInfo)
+
+fmt.Println("\n============== UnRegisterScript()")
+	result, err := sim.UnRegisterScript(sName)
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+
+	fmt.Printf(" === UnRegisterScript %s : %#v\n", sName, result)
+
+fmt.Println("\n============== UnRegisterScript()")
+	sName = "hostname"
+	result, err = sim.UnRegisterScript(sName)
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+
+	fmt.Printf(" === UnRegisterScript %s : %#v\n", sName, result)
+}